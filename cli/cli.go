@@ -88,6 +88,9 @@ Options:
 
 var ErrConfig = errors.New(client.ConfigMessage)
 
+// ErrPolicyLocked is returned when the setting is locked by the policy file
+var ErrPolicyLocked = newCodedError(internal.CodePolicyLocked, errors.New(SettingLockedError))
+
 func NewApp(version, environment, hash, salt string,
 	pingErr error,
 	conn *grpc.ClientConn,
@@ -133,6 +136,22 @@ func NewApp(version, environment, hash, salt string,
 
 	app := cli.NewApp()
 	app.EnableBashCompletion = true
+	app.Flags = []cli.Flag{
+		&cli.StringFlag{
+			Name:    flagOutput,
+			Aliases: []string{"o"},
+			Usage:   OutputUsageText,
+			Value:   string(outputText),
+		},
+	}
+	app.Before = func(ctx *cli.Context) error {
+		output, err := parseOutputFormat(ctx.String(flagOutput))
+		if err != nil {
+			return formatError(err)
+		}
+		cmd.output = output
+		return nil
+	}
 	status.Code(err)
 	cmd.loaderInterceptor = loaderInterceptor
 	app.After = func(*cli.Context) error {
@@ -173,6 +192,34 @@ func NewApp(version, environment, hash, salt string,
 					Aliases: []string{"g"},
 					Usage:   ConnectFlagGroupUsageText,
 				},
+				&cli.StringFlag{
+					Name:  flagVia,
+					Usage: ConnectFlagViaUsageText,
+				},
+				&cli.BoolFlag{
+					Name:  flagFastest,
+					Usage: ConnectFlagFastestUsageText,
+				},
+				&cli.StringFlag{
+					Name:  flagTechnology,
+					Usage: ConnectFlagTechnologyUsageText,
+				},
+				&cli.StringFlag{
+					Name:  flagProtocol,
+					Usage: ConnectFlagProtocolUsageText,
+				},
+				&cli.StringFlag{
+					Name:  flagObfuscate,
+					Usage: ConnectFlagObfuscateUsageText,
+				},
+				&cli.StringFlag{
+					Name:  flagPostQuantum,
+					Usage: ConnectFlagPostQuantumUsageText,
+				},
+				&cli.StringFlag{
+					Name:  flagDNS,
+					Usage: ConnectFlagDNSUsageText,
+				},
 			},
 		},
 		{
@@ -250,12 +297,8 @@ func NewApp(version, environment, hash, salt string,
 			Action: cmd.Register,
 		},
 		&setCommand,
-		{
-			Name:               "settings",
-			Usage:              SettingsUsageText,
-			Action:             cmd.Settings,
-			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
-		},
+		settingsCommand(cmd),
+		configCommand(cmd),
 		{
 			Name:               "status",
 			Usage:              StatusUsageText,
@@ -271,7 +314,7 @@ func NewApp(version, environment, hash, salt string,
 		{
 			Name:    "allowlist",
 			Aliases: []string{"whitelist"},
-			Usage:   "Specify ports, port ranges, or subnets to exclude from VPN protection. Allowlisted ports may accept incoming connections from any external source outside your network.",
+			Usage:   "Specify ports, port ranges, subnets, or domains to exclude from VPN protection. Allowlisted ports may accept incoming connections from any external source outside your network.",
 			Subcommands: []*cli.Command{
 				{
 					Name:  "add",
@@ -301,6 +344,14 @@ func NewApp(version, environment, hash, salt string,
 							ArgsUsage:    AllowlistAddSubnetArgsUsageText,
 							Description:  AllowlistAddSubnetDescription,
 						},
+						{
+							Name:         "domain",
+							Usage:        AllowlistAddDomainUsageText,
+							Action:       cmd.AllowlistAddDomain,
+							BashComplete: cmd.AllowlistAddDomainAutoComplete,
+							ArgsUsage:    AllowlistAddDomainArgsUsageText,
+							Description:  AllowlistAddDomainDescription,
+						},
 					},
 				},
 				{
@@ -337,10 +388,25 @@ func NewApp(version, environment, hash, salt string,
 							ArgsUsage:    AllowlistRemoveSubnetArgsUsageText,
 							Description:  AllowlistRemoveSubnetArgsDescription,
 						},
+						{
+							Name:         "domain",
+							Usage:        AllowlistRemoveDomainUsageText,
+							Action:       cmd.AllowlistRemoveDomain,
+							BashComplete: cmd.AllowlistRemoveDomainAutoComplete,
+							ArgsUsage:    AllowlistRemoveDomainArgsUsageText,
+							Description:  AllowlistRemoveDomainArgsDescription,
+						},
 					},
 				},
 			},
 		},
+		splitTunnelCommand(cmd),
+		scheduleCommand(cmd),
+		trustedNetworksCommand(cmd),
+		dnsCommand(cmd),
+		leakTestCommand(cmd),
+		historyCommand(cmd),
+		favoriteCommand(cmd),
 		{
 			Name:   "user",
 			Action: cmd.User,
@@ -376,8 +442,7 @@ func NewApp(version, environment, hash, salt string,
 	app.Commands = addLoaderToActions(cmd, pingErr, app.Commands)
 	// Unknown command handler
 	app.CommandNotFound = func(c *cli.Context, command string) {
-		color.Red(fmt.Sprintf(NoSuchCommand, command))
-		os.Exit(1)
+		cmd.exitWithError(internal.ErrUnhandled, fmt.Sprintf(NoSuchCommand, command))
 	}
 
 	return app, nil
@@ -401,9 +466,28 @@ func fileshareCommand(c *cmd) *cli.Command {
 						Name:  flagFileshareNoWait,
 						Usage: MsgFileshareNoWaitUsage,
 					},
+					&cli.StringFlag{
+						Name:  flagFilesharePriority,
+						Usage: MsgFilesharePriorityUsage,
+						Value: "normal",
+					},
 				},
 				BashComplete: c.FileshareAutoCompletePeers,
 			},
+			{
+				Name:        FileshareSendTextName,
+				Action:      c.FileshareSendText,
+				Usage:       MsgFileshareSendTextUsage,
+				ArgsUsage:   MsgFileshareSendTextArgs,
+				Description: MsgFileshareSendTextDesc,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  flagFileshareNoWait,
+						Usage: MsgFileshareNoWaitUsage,
+					},
+				},
+				BashComplete: c.FileshareAutoCompleteSendText,
+			},
 			{
 				Name:        FileshareAcceptName,
 				Action:      c.FileshareAccept,
@@ -447,6 +531,70 @@ func fileshareCommand(c *cmd) *cli.Command {
 				ArgsUsage:    MsgFileshareCancelArgsUsage,
 				BashComplete: c.FileshareAutoCompleteTransfersCancel,
 			},
+			{
+				Name:        FileshareResumeName,
+				Action:      c.FileshareResume,
+				Usage:       MsgFileshareResumeUsage,
+				ArgsUsage:   MsgFileshareResumeArgsUsage,
+				Description: MsgFileshareResumeDescription,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  flagFileshareNoWait,
+						Usage: MsgFileshareNoWaitUsage,
+					},
+				},
+				BashComplete: c.FileshareAutoCompleteTransfersResume,
+			},
+			{
+				Name:        FileshareWatchName,
+				Usage:       MsgFileshareWatchUsage,
+				Description: MsgFileshareWatchDescription,
+				Subcommands: []*cli.Command{
+					{
+						Name:         "add",
+						Action:       c.FileshareWatchAdd,
+						Usage:        MsgFileshareWatchAddUsage,
+						ArgsUsage:    MsgFileshareWatchAddArgsUsage,
+						BashComplete: c.FileshareWatchAutoCompleteAdd,
+					},
+					{
+						Name:         "remove",
+						Action:       c.FileshareWatchRemove,
+						Usage:        MsgFileshareWatchRemoveUsage,
+						ArgsUsage:    MsgFileshareWatchRemoveArgs,
+						BashComplete: c.FileshareWatchAutoCompleteRemove,
+					},
+					{
+						Name:   "list",
+						Action: c.FileshareWatchList,
+						Usage:  MsgFileshareWatchListUsage,
+					},
+				},
+			},
+			{
+				Name:        FileshareLimitName,
+				Action:      c.FileshareLimit,
+				Usage:       MsgFileshareLimitUsage,
+				ArgsUsage:   MsgFileshareLimitArgsUsage,
+				Description: MsgFileshareLimitDescription,
+				Flags: []cli.Flag{
+					&cli.Uint64Flag{
+						Name:  flagFileshareUploadRate,
+						Usage: MsgFileshareUploadRateUsage,
+					},
+					&cli.Uint64Flag{
+						Name:  flagFilesharePeerUploadRate,
+						Usage: MsgFilesharePeerRateUsage,
+					},
+				},
+			},
+			{
+				Name:        FileshareHookName,
+				Action:      c.FileshareHook,
+				Usage:       MsgFileshareHookUsage,
+				ArgsUsage:   MsgFileshareHookArgsUsage,
+				Description: MsgFileshareHookDescription,
+			},
 			{
 				Name:         FileshareClearName,
 				Action:       c.FileshareClear,
@@ -956,6 +1104,42 @@ func getSetSubcommands(cmd *cmd, isMeshnetEnabled bool) []*cli.Command {
 				"arp-ignore",
 			),
 		},
+		{
+			Name:         "metrics",
+			Usage:        SetMetricsUsageText,
+			Action:       cmd.SetMetrics,
+			BashComplete: cmd.SetBoolAutocomplete,
+			ArgsUsage:    MsgSetBoolArgsUsage,
+			Description:  SetMetricsDescription,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  flagMetricsListen,
+					Usage: SetMetricsFlagListenUsageText,
+				},
+			},
+		},
+		{
+			Name:         "failover",
+			Usage:        SetFailoverUsageText,
+			Action:       cmd.SetFailover,
+			BashComplete: cmd.SetBoolAutocomplete,
+			ArgsUsage:    MsgSetBoolArgsUsage,
+			Description:  SetFailoverDescription,
+			Flags: []cli.Flag{
+				&cli.DurationFlag{
+					Name:  flagFailoverMaxHandshakeAge,
+					Usage: SetFailoverFlagHandshakeAgeUsageText,
+				},
+				&cli.UintFlag{
+					Name:  flagFailoverMaxPacketLoss,
+					Usage: SetFailoverFlagPacketLossUsageText,
+				},
+				&cli.DurationFlag{
+					Name:  flagFailoverMaxLatency,
+					Usage: SetFailoverFlagLatencyUsageText,
+				},
+			},
+		},
 	}
 
 	if features.NordWhisperEnabled {
@@ -998,6 +1182,8 @@ type cmd struct {
 	fileshareClient   filesharepb.FileshareClient
 	environment       internal.Environment
 	loaderInterceptor *LoaderInterceptor
+	// output is the format of the command results selected with the global flag
+	output outputFormat
 	// settingsCache memoizes the daemon Settings response for the lifetime of this cmd.
 	// The CLI process builds its whole command tree (which evaluates several Hidden:
 	// cmd.Except(...) gates) and runs a single command before exiting, so fetching Settings
@@ -1008,6 +1194,7 @@ type cmd struct {
 func newCommander(environment internal.Environment) *cmd {
 	return &cmd{
 		environment: environment,
+		output:      outputText,
 	}
 }
 
@@ -1022,6 +1209,10 @@ func formatError(e error) error {
 	if !strings.HasSuffix(capitalized, ".") {
 		capitalized += "."
 	}
+	// the message is replaced, so the code is kept in the structured output
+	if code := errorCode(e); code != internal.CodeFailure {
+		return newCodedError(code, errors.New(capitalized))
+	}
 	return errors.New(capitalized)
 }
 
@@ -1102,27 +1293,24 @@ func isLoaderEnabled() bool {
 
 func (c *cmd) action(err error, f func(*cli.Context) error) func(*cli.Context) error {
 	return func(ctx *cli.Context) error {
-		c.loaderInterceptor.enabled = isLoaderEnabled()
+		c.loaderInterceptor.enabled = isLoaderEnabled() && !c.output.isStructured()
 		if err != nil {
 			log.Error(err)
-			color.Red(internal.ErrDaemonConnectionRefused.Error())
-			os.Exit(1)
+			c.exitWithError(internal.ErrDaemonConnectionRefused, internal.ErrDaemonConnectionRefused.Error())
 		}
 		err = c.Ping()
 		if err != nil {
 			// this is snap-check is performed on daemon side
 			if snapErr := RetrieveSnapConnsError(err); snapErr != nil {
-				color.Red(FormatSnapMissingConnsErr(snapErr))
-				os.Exit(1)
+				c.exitWithError(err, FormatSnapMissingConnsErr(snapErr))
 			}
 			switch {
 			case errors.Is(err, ErrUpdateAvailable):
-				if term.IsTerminal(int(os.Stdout.Fd())) {
+				if term.IsTerminal(int(os.Stdout.Fd())) && !c.output.isStructured() {
 					color.Yellow(UpdateAvailableMessage)
 				}
 			case errors.Is(err, ErrInternetConnection):
-				color.Red(ErrInternetConnection.Error())
-				os.Exit(1)
+				c.exitWithError(err, ErrInternetConnection.Error())
 			case errors.Is(err, internal.ErrSocketAccessDenied):
 				if snapconf.IsUnderSnap() {
 					// this is additional snap-check on client side to minimize user actions
@@ -1130,25 +1318,20 @@ func (c *cmd) action(err error, f func(*cli.Context) error) func(*cli.Context) e
 					errSubject.Subscribe(logger.Subscriber{}.NotifyError)
 					err := snapconf.NewSnapChecker(errSubject).PermissionCheck()
 					if snapErr := RetrieveSnapConnsError(err); snapErr != nil {
-						color.Red(FormatSnapMissingConnsExtErr(snapErr))
+						c.exitWithError(internal.ErrSocketAccessDenied, FormatSnapMissingConnsExtErr(snapErr))
 					} else {
-						color.Red(MsgSnapNoSocketPermissions)
+						c.exitWithError(internal.ErrSocketAccessDenied, MsgSnapNoSocketPermissions)
 					}
 				} else {
-					color.Red(MsgNoSocketPermissions)
+					c.exitWithError(err, MsgNoSocketPermissions)
 				}
-				os.Exit(1)
 			case errors.Is(err, internal.ErrDaemonConnectionRefused):
-				color.Red(formatError(internal.ErrDaemonConnectionRefused).Error())
-				os.Exit(1)
+				c.exitWithError(err, formatError(internal.ErrDaemonConnectionRefused).Error())
 			case errors.Is(err, internal.ErrSocketNotFound):
-				color.Red(formatError(internal.ErrSocketNotFound).Error())
-				color.Red("The NordVPN background service isn't running. Execute the \"systemctl enable --now nordvpnd\" command with root privileges to start the background service. If you're using NordVPN in an environment without systemd (a container, for example), use the \"/etc/init.d/nordvpn start\" command.")
-				os.Exit(1)
+				c.exitWithError(err, formatError(internal.ErrSocketNotFound).Error()+"\n"+MsgDaemonNotRunning)
 			default:
 				log.Error(err)
-				color.Red(internal.UnhandledMessage)
-				os.Exit(1)
+				c.exitWithError(err, internal.UnhandledMessage)
 			}
 		}
 
@@ -1157,8 +1340,10 @@ func (c *cmd) action(err error, f func(*cli.Context) error) func(*cli.Context) e
 			// TODO: Add more error types in the future
 			// if more such errors are added
 			if err.Error() == "feature not supported" {
-				color.Red(MsgMeshnetVersionNotSupported)
-				os.Exit(1)
+				c.exitWithError(err, MsgMeshnetVersionNotSupported)
+			}
+			if c.output.isStructured() {
+				return c.printError(err)
 			}
 			return err
 		}
@@ -1166,21 +1351,23 @@ func (c *cmd) action(err error, f func(*cli.Context) error) func(*cli.Context) e
 	}
 }
 
-// addLoaderToActions wraps all actions with ping error handling and enabling loader
+// addLoaderToActions wraps all actions with ping error handling, enabling loader and the output
+// format check
 func addLoaderToActions(c *cmd, err error, commands []*cli.Command) []*cli.Command {
 	var actionCommands []*cli.Command
 	for _, command := range commands {
-		actionCommands = append(actionCommands, addLoaderToCommandRecursively(c, err, command))
+		actionCommands = append(actionCommands, addLoaderToCommandRecursively(c, err, command, ""))
 	}
 	return actionCommands
 }
 
-func addLoaderToCommandRecursively(c *cmd, err error, command *cli.Command) *cli.Command {
+func addLoaderToCommandRecursively(c *cmd, err error, command *cli.Command, parent string) *cli.Command {
+	path := strings.TrimSpace(parent + " " + command.Name)
 	if command.Action != nil {
-		command.Action = c.action(err, command.Action)
+		command.Action = c.requireOutputSupport(path, c.action(err, command.Action))
 	}
 	for _, subc := range command.Subcommands {
-		addLoaderToCommandRecursively(c, err, subc)
+		addLoaderToCommandRecursively(c, err, subc, path)
 	}
 	return command
 }
@@ -1207,7 +1394,9 @@ func serviceErrorCodeToError(code meshpb.ServiceErrorCode) error {
 	switch code {
 	case meshpb.ServiceErrorCode_NOT_LOGGED_IN:
 		return internal.ErrNotLoggedIn
-	case meshpb.ServiceErrorCode_API_FAILURE, meshpb.ServiceErrorCode_CONFIG_FAILURE:
+	case meshpb.ServiceErrorCode_CONFIG_FAILURE:
+		return newCodedError(internal.CodeConfigError, errors.New(AccountInternalError))
+	case meshpb.ServiceErrorCode_API_FAILURE:
 		fallthrough
 	default:
 		return errors.New(AccountInternalError)
@@ -1223,36 +1412,36 @@ func meshnetErrorToError(code meshpb.MeshnetErrorCode) error {
 	case meshpb.MeshnetErrorCode_LIB_FAILURE:
 		return errors.New(client.ConnectCantConnect)
 	case meshpb.MeshnetErrorCode_ALREADY_DISABLED:
-		return errors.New(MsgMeshnetAlreadyDisabled)
+		return newCodedError(internal.CodeNothingToDo, errors.New(MsgMeshnetAlreadyDisabled))
 	case meshpb.MeshnetErrorCode_ALREADY_ENABLED:
-		return errors.New(MsgMeshnetAlreadyEnabled)
+		return newCodedError(internal.CodeNothingToDo, errors.New(MsgMeshnetAlreadyEnabled))
 	case meshpb.MeshnetErrorCode_NOT_ENABLED:
 		return errors.New(MsgMeshnetNotEnabled)
 	case meshpb.MeshnetErrorCode_TECH_FAILURE:
 		return errors.New(MsgMeshnetNordlynxMustBeEnabled)
 	case meshpb.MeshnetErrorCode_TUNNEL_CLOSED:
-		return errors.New(DisconnectNotConnected)
+		return newCodedError(internal.CodeVPNNotRunning, errors.New(DisconnectNotConnected))
 	case meshpb.MeshnetErrorCode_CONFLICT_WITH_PQ:
-		return errors.New(SetPqAndMeshnet)
+		return newCodedError(internal.CodePqAndMeshnetSimultaneously, errors.New(SetPqAndMeshnet))
 	case meshpb.MeshnetErrorCode_CONFLICT_WITH_PQ_SERVER:
-		return errors.New(SetPqAndMeshnetServer)
+		return newCodedError(internal.CodePqAndMeshnetSimultaneously, errors.New(SetPqAndMeshnetServer))
 	default:
 		return errors.New(AccountInternalError)
 	}
 }
 
 func argsCountError(ctx *cli.Context) error {
-	return fmt.Errorf(
+	return newCodedError(internal.CodeFormatError, fmt.Errorf(
 		ArgumentCountError,
 		CommandFullName(ctx, os.Args),
-	)
+	))
 }
 
 func argsParseError(ctx *cli.Context) error {
-	return fmt.Errorf(
+	return newCodedError(internal.CodeFormatError, fmt.Errorf(
 		ArgumentParsingError,
 		CommandFullName(ctx, os.Args),
-	)
+	))
 }
 
 // because ctx.Command.FullName() doesn't work: https://github.com/urfave/cli/issues/1859
@@ -1419,6 +1608,13 @@ func parseConnectArgs(ctx *cli.Context) (string, string, error) {
 		serverGroup = groupName
 	}
 
+	// the multi-hop entry server, the fastest mode and the overrides are processed by the connect command
+	argsSlice = removeFlagFromArgs(argsSlice, flagVia)
+	for _, flag := range []string{flagTechnology, flagProtocol, flagObfuscate, flagPostQuantum, flagDNS} {
+		argsSlice = removeFlagFromArgs(argsSlice, flag)
+	}
+	argsSlice = slices.DeleteFunc(argsSlice, func(arg string) bool { return arg == "--"+flagFastest })
+
 	// remove any arguments that successfully parse as an on/off switch
 	argsSlice = slices.DeleteFunc(argsSlice, func(arg string) bool {
 		_, boolFromStringErr := nstrings.BoolFromString(arg)
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Allowlist add domain help text
+const (
+	AllowlistAddDomainUsageText     = "Adds domain to the allowlist"
+	AllowlistAddDomainArgsUsageText = `<domain>`
+	AllowlistAddDomainDescription   = `Use this command to allowlist a domain.
+
+Example: 'nordvpn allowlist add domain intranet.example.com'
+
+Notes:
+  Domain should be a fully qualified domain name.
+  Domain is resolved periodically and traffic to its current IPv4 and IPv6 addresses goes outside of the VPN tunnel.`
+)
+
+func (c *cmd) AllowlistAddDomain(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	if args.Len() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	domain := args.First()
+
+	resp, err := c.client.SetAllowlist(context.Background(), &pb.SetAllowlistRequest{
+		Request: &pb.SetAllowlistRequest_SetAllowlistDomainRequest{
+			SetAllowlistDomainRequest: &pb.SetAllowlistDomainRequest{Domain: domain},
+		},
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFailure:
+		return formatError(fmt.Errorf(AllowlistDomainResolveError, domain))
+	case internal.CodeVPNMisconfig:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeAllowlistInvalidDomain:
+		return formatError(argsParseError(ctx))
+	case internal.CodeAllowlistDomainNoop:
+		return formatError(fmt.Errorf(AllowlistAddDomainExistsError, domain))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(AllowlistAddDomainSuccess, domain))
+	}
+	return nil
+}
+
+func (c *cmd) AllowlistAddDomainAutoComplete(ctx *cli.Context) {}
@@ -74,6 +74,8 @@ func (c *cmd) AllowlistAddPort(ctx *cli.Context) error {
 	}
 
 	switch resp.Type {
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
 	case internal.CodeConfigError:
 		return formatError(ErrConfig)
 	case internal.CodeFailure:
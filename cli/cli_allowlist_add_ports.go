@@ -78,6 +78,8 @@ func (c *cmd) AllowlistAddPorts(ctx *cli.Context) error {
 	}
 
 	switch resp.Type {
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
 	case internal.CodeConfigError:
 		return formatError(ErrConfig)
 	case internal.CodeFailure:
@@ -12,7 +12,7 @@ import (
 )
 
 // AllowlistRemoveAllUsageText is shown next to all command by nordvpn allowlist remove --help
-const AllowlistRemoveAllUsageText = "Removes all ports and subnets from the allowlist"
+const AllowlistRemoveAllUsageText = "Removes all ports, subnets and domains from the allowlist"
 
 func (c *cmd) AllowlistRemoveAll(ctx *cli.Context) error {
 	resp, err := c.client.UnsetAllAllowlist(context.Background(), &pb.Empty{})
@@ -21,6 +21,8 @@ func (c *cmd) AllowlistRemoveAll(ctx *cli.Context) error {
 	}
 
 	switch resp.Type {
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
 	case internal.CodeConfigError:
 		return formatError(ErrConfig)
 	case internal.CodeFailure:
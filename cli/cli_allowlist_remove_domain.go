@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Allowlist remove domain help text
+const (
+	AllowlistRemoveDomainUsageText       = "Removes domain from the allowlist"
+	AllowlistRemoveDomainArgsUsageText   = `<domain>`
+	AllowlistRemoveDomainArgsDescription = `Use this command to remove domain from the allowlist.
+
+Example: 'nordvpn allowlist remove domain intranet.example.com'`
+)
+
+func (c *cmd) AllowlistRemoveDomain(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	if args.Len() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	domain := args.First()
+
+	resp, err := c.client.UnsetAllowlist(context.Background(), &pb.SetAllowlistRequest{
+		Request: &pb.SetAllowlistRequest_SetAllowlistDomainRequest{
+			SetAllowlistDomainRequest: &pb.SetAllowlistDomainRequest{Domain: domain},
+		},
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFailure:
+		return formatError(fmt.Errorf(AllowlistRemoveDomainError, domain))
+	case internal.CodeVPNMisconfig:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeAllowlistInvalidDomain:
+		return formatError(argsParseError(ctx))
+	case internal.CodeAllowlistDomainNoop:
+		return formatError(fmt.Errorf(AllowlistRemoveDomainExistsError, domain))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(AllowlistRemoveDomainSuccess, domain))
+	}
+	return nil
+}
+
+func (c *cmd) AllowlistRemoveDomainAutoComplete(ctx *cli.Context) {
+	settings, err := c.client.Settings(context.Background(), &pb.Empty{})
+	if err != nil {
+		return
+	}
+	allowlist := settings.GetData().GetAllowlist()
+	for _, domain := range allowlist.Domains {
+		if !slices.Contains(ctx.Args().Slice(), domain) {
+			fmt.Println(domain)
+		}
+	}
+}
@@ -75,6 +75,8 @@ func (c *cmd) AllowlistRemovePort(ctx *cli.Context) error {
 	}
 
 	switch resp.Type {
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
 	case internal.CodeConfigError:
 		return formatError(ErrConfig)
 	case internal.CodeFailure:
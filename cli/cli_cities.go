@@ -35,7 +35,7 @@ func (c *cmd) Cities(ctx *cli.Context) error {
 	}
 
 	if resp.Type != internal.CodeSuccess {
-		err := fmt.Errorf(MsgListIsEmpty, "cities")
+		err := newCodedError(resp.Type, fmt.Errorf(MsgListIsEmpty, "cities"))
 		log.Error(err)
 		return formatError(err)
 	}
@@ -44,6 +44,10 @@ func (c *cmd) Cities(ctx *cli.Context) error {
 		return formatError(errors.New(CitiesNotFoundError))
 	}
 
+	if c.output.isStructured() {
+		return c.printOutput(resp)
+	}
+
 	footer := footerForServerGroupsList(resp.Servers)
 	formattedList, err := columns(resp.Servers,
 		serverNameLen,
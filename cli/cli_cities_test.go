@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/test/category"
 	"github.com/NordSecurity/nordvpn-linux/test/helpers"
 	"github.com/NordSecurity/nordvpn-linux/test/mock"
@@ -28,12 +29,12 @@ func TestCitiesList(t *testing.T) {
 	}{
 		{
 			name:          "error message when missing country name",
-			expectedError: formatError(fmt.Errorf(ArgumentParsingError, "cli.test")),
+			expectedError: formatError(newCodedError(internal.CodeFormatError, fmt.Errorf(ArgumentParsingError, "cli.test"))),
 		},
 		{
 			name:          "error message when no cities are found",
 			country:       "France",
-			expectedError: formatError(fmt.Errorf(MsgListIsEmpty, "cities")),
+			expectedError: formatError(newCodedError(internal.CodeEmptyPayloadError, fmt.Errorf(MsgListIsEmpty, "cities"))),
 		},
 		{
 			name:     "return physical cities",
@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/client"
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Config export help text
+const (
+	ConfigUsageText           = "Manages VPN configs for devices without the NordVPN app"
+	ConfigExportUsageText     = "Exports a WireGuard or OpenVPN config for the selected server"
+	ConfigExportArgsUsageText = `[<country>|<server>|<country_code>|<city>|<group>|<country> <city>]`
+	ConfigExportDescription   = `Use this command to export a standalone config for routers, containers and other devices
+where the NordVPN app can't run. The server is picked the same way as for the connect command.
+NordLynx configs can be used with wg-quick, OpenVPN configs require OpenVPN 2.6 or newer.
+The config contains your VPN credentials, keep it private.
+
+Example: 'nordvpn config export --technology nordlynx Germany'
+Example: 'nordvpn config export --technology openvpn --protocol tcp --file nord.ovpn'
+Example: 'nordvpn config export --technology nordlynx --qr'`
+	ConfigExportTechnologyUsage = "Technology of the config: nordlynx or openvpn. Current technology is used if not set"
+	ConfigExportProtocolUsage   = "Protocol of the OpenVPN config: udp or tcp"
+	ConfigExportFileUsage       = "Path of the config file, use '-' to print it. <hostname>.conf or <hostname>.ovpn if not set"
+	ConfigExportQRUsage         = "Show the NordLynx config as a QR code for mobile apps instead of saving it"
+)
+
+const (
+	flagConfigTechnology = "technology"
+	flagConfigFile       = "file"
+	flagConfigQR         = "qr"
+)
+
+// qrencodeExec is used to render QR codes in the terminal
+const qrencodeExec = "qrencode"
+
+func configCommand(c *cmd) *cli.Command {
+	return &cli.Command{
+		Name:  "config",
+		Usage: ConfigUsageText,
+		Subcommands: []*cli.Command{
+			{
+				Name:        "export",
+				Usage:       ConfigExportUsageText,
+				ArgsUsage:   ConfigExportArgsUsageText,
+				Description: ConfigExportDescription,
+				Action:      c.ConfigExport,
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: flagConfigTechnology, Usage: ConfigExportTechnologyUsage},
+					&cli.StringFlag{Name: stringProtocol, Usage: ConfigExportProtocolUsage},
+					&cli.StringFlag{Name: flagGroup, Aliases: []string{"g"}, Usage: ConnectFlagGroupUsageText},
+					&cli.StringFlag{Name: flagConfigFile, Usage: ConfigExportFileUsage},
+					&cli.BoolFlag{Name: flagConfigQR, Usage: ConfigExportQRUsage},
+				},
+			},
+		},
+	}
+}
+
+func (c *cmd) ConfigExport(ctx *cli.Context) error {
+	var tech config.Technology
+	switch strings.ToUpper(ctx.String(flagConfigTechnology)) {
+	case "":
+	case config.Technology_NORDLYNX.String():
+		tech = config.Technology_NORDLYNX
+	case config.Technology_OPENVPN.String():
+		tech = config.Technology_OPENVPN
+	default:
+		return formatError(errors.New(ConfigExportUnsupported))
+	}
+
+	var proto config.Protocol
+	switch strings.ToUpper(ctx.String(stringProtocol)) {
+	case "":
+	case config.Protocol_UDP.String():
+		proto = config.Protocol_UDP
+	case config.Protocol_TCP.String():
+		proto = config.Protocol_TCP
+	default:
+		return formatError(errors.New(ConfigExportUnsupported))
+	}
+
+	serverTag, serverGroup, err := parseConnectArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.client.ExportVPNConfig(context.Background(), &pb.ExportVPNConfigRequest{
+		Technology:  tech,
+		ServerTag:   serverTag,
+		ServerGroup: serverGroup,
+		Protocol:    proto,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeSuccess:
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeVPNConfigExportUnsupported:
+		return formatError(newCodedError(resp.Type, errors.New(ConfigExportUnsupported)))
+	case internal.CodeTokenRenewError:
+		return formatError(newCodedError(resp.Type, errors.New(client.AccountTokenRenewError)))
+	case internal.CodeExpiredAccessToken, internal.CodeRevokedAccessToken:
+		return formatError(newCodedError(resp.Type, errors.New(client.AccessTokenExpired)))
+	case internal.CodeAccountExpired:
+		return formatError(newCodedError(resp.Type, errors.New(
+			c.injectLinkIntoMessage(client.SubscriptionURL, client.SubscriptionURLLogin, ExpiredAccountMessage))))
+	case internal.CodeTagNonexisting:
+		return formatError(newCodedError(resp.Type, errors.New(internal.TagNonexistentErrorMessage)))
+	case internal.CodeGroupNonexisting:
+		return formatError(newCodedError(resp.Type, errors.New(internal.GroupNonexistentErrorMessage)))
+	case internal.CodeServerUnavailable:
+		return formatError(newCodedError(resp.Type, errors.New(internal.ServerUnavailableErrorMessage)))
+	case internal.CodeVirtualLocationDisabled:
+		return formatError(newCodedError(resp.Type, errors.New(internal.SpecifiedServerIsVirtualLocation)))
+	case internal.CodeDoubleGroupError:
+		return formatError(newCodedError(resp.Type, errors.New(internal.DoubleGroupErrorMessage)))
+	case internal.CodeDedicatedIPRenewError:
+		return formatError(newCodedError(resp.Type, errors.New(
+			c.injectLinkIntoMessage(client.SubscriptionDedicatedIPURL, client.SubscriptionDedicatedIPURLLogin, NoDedicatedIPMessage))))
+	case internal.CodeDedicatedIPNoServer:
+		return formatError(newCodedError(resp.Type, errors.New(NoDedidcatedIPServerMessage)))
+	case internal.CodeDedicatedIPServiceButNoServers:
+		return formatError(newCodedError(resp.Type, errors.New(NoPreferredDedicatedIPLocationSelected)))
+	default:
+		return formatError(newCodedError(resp.Type, internal.ErrUnhandled))
+	}
+
+	if ctx.Bool(flagConfigQR) {
+		if c.output.isStructured() {
+			return formatError(fmt.Errorf(OutputNotSupported, c.output, "config export --"+flagConfigQR))
+		}
+		if resp.GetTechnology() != config.Technology_NORDLYNX {
+			return formatError(errors.New(ConfigExportQRNordlynx))
+		}
+		return showQRCode(resp.GetConfig())
+	}
+
+	path := ctx.String(flagConfigFile)
+	if path == "-" || (path == "" && c.output.isStructured()) {
+		if c.output.isStructured() {
+			return c.printOutput(resp)
+		}
+		fmt.Print(resp.GetConfig())
+		return nil
+	}
+	if path == "" {
+		path = configExportFileName(resp.GetHostname(), resp.GetTechnology())
+	}
+	// the config contains credentials
+	if err := os.WriteFile(path, []byte(resp.GetConfig()), internal.PermUserRW); err != nil {
+		return formatError(fmt.Errorf(ConfigExportWriteFailed, err))
+	}
+	if c.output.isStructured() {
+		return c.printOutput(map[string]any{
+			"technology": technologyLabel(resp.GetTechnology()),
+			"hostname":   resp.GetHostname(),
+			"file":       path,
+		})
+	}
+	color.Green(ConfigExportSuccess, technologyLabel(resp.GetTechnology()), resp.GetHostname(), path)
+	return nil
+}
+
+// configExportFileName returns the default file name which is also used
+// as the interface name by wg-quick, so it is kept short
+func configExportFileName(hostname string, tech config.Technology) string {
+	name := strings.SplitN(hostname, ".", 2)[0]
+	if tech == config.Technology_OPENVPN {
+		return name + ".ovpn"
+	}
+	return name + ".conf"
+}
+
+func technologyLabel(tech config.Technology) string {
+	if tech == config.Technology_OPENVPN {
+		return "OpenVPN"
+	}
+	return "NordLynx"
+}
+
+func showQRCode(data string) error {
+	path, err := exec.LookPath(qrencodeExec)
+	if err != nil {
+		return formatError(errors.New(ConfigExportQRMissing))
+	}
+	// #nosec G204 -- the path is resolved from a constant name
+	cmd := exec.Command(path, "-t", "ANSIUTF8")
+	cmd.Stdin = strings.NewReader(data)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return formatError(fmt.Errorf(ConfigExportQRFailed, err))
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigExportFileName(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "de1234.conf", configExportFileName("de1234.nordvpn.com", config.Technology_NORDLYNX))
+	assert.Equal(t, "de1234.ovpn", configExportFileName("de1234.nordvpn.com", config.Technology_OPENVPN))
+}
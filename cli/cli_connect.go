@@ -7,11 +7,14 @@ import (
 	"io"
 	"os"
 	"os/signal"
+	"slices"
 	"strings"
 
 	"github.com/NordSecurity/nordvpn-linux/client"
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
 	"github.com/NordSecurity/nordvpn-linux/uievent"
 
 	"github.com/fatih/color"
@@ -20,15 +23,25 @@ import (
 
 // Connect help text
 const (
-	ConnectUsageText          = "Connects you to VPN"
-	ConnectFlagGroupUsageText = "Specify a server group to connect to"
-	ConnectArgsUsageText      = "[<country>|<server>|<country_code>|<city>|<group>|<country> <city>]"
-	ConnectDescription        = `Use this command to connect to NordVPN. Adding no arguments to the command will connect you to the recommended server.
+	ConnectUsageText                = "Connects you to VPN"
+	ConnectFlagGroupUsageText       = "Specify a server group to connect to"
+	ConnectFlagViaUsageText         = "Specify an entry server to chain the connection through (NordLynx only)"
+	ConnectFlagFastestUsageText     = "Measure the latency to the best ranked servers and connect to the fastest one"
+	ConnectFlagTechnologyUsageText  = "Use the technology for this connection only: OPENVPN, NORDLYNX or NORDWHISPER"
+	ConnectFlagProtocolUsageText    = "Use the protocol for this connection only: UDP or TCP (OpenVPN only)"
+	ConnectFlagObfuscateUsageText   = "Use obfuscation for this connection only: on or off (OpenVPN only)"
+	ConnectFlagPostQuantumUsageText = "Use post-quantum encryption for this connection only: on or off (NordLynx only)"
+	ConnectFlagDNSUsageText         = "Use up to 3 comma separated DNS servers for this connection only"
+	ConnectArgsUsageText            = "[<country>|<server>|<country_code>|<city>|<group>|<country> <city>]"
+	ConnectDescription              = `Use this command to connect to NordVPN. Adding no arguments to the command will connect you to the recommended server.
 Provide a <country> argument to connect to a specific country. For example: 'nordvpn connect Australia'
 Provide a <server> argument to connect to a specific server. For example: 'nordvpn connect jp35'
 Provide a <country_code> argument to connect to a specific country. For example: 'nordvpn connect us'
 Provide a <city> argument to connect to a specific city. For example: 'nordvpn connect Hungary Budapest'
 Provide a <group> argument to connect to a specific servers group. For example: 'nordvpn connect Onion_Over_VPN'
+Provide the --via option to chain the connection through an entry server. For example: 'nordvpn connect --via Germany Sweden'
+Provide the --fastest option to pick the server with the lowest latency from this device. For example: 'nordvpn connect --fastest Germany'
+Provide the --technology, --protocol, --obfuscate, --post-quantum or --dns options to override the settings for this connection only. For example: 'nordvpn connect --technology openvpn --protocol tcp Germany'
 
 Press the Tab key to see auto-suggestions for countries and cities.`
 )
@@ -79,17 +92,15 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 		return formatError(err)
 	}
 
-	ch := make(chan os.Signal, 1)
-	signal.Notify(ch, os.Interrupt)
-	defer close(ch)
+	via, hasVia := getFlagValue(flagVia, ctx)
+	if hasVia && via == "" {
+		return formatError(argsCountError(ctx))
+	}
 
-	canceled := false
-	go func(ch chan os.Signal) {
-		for range ch {
-			canceled = true
-			_, _ = c.client.ConnectCancel(context.Background(), &pb.Empty{})
-		}
-	}(ch)
+	overrides, err := parseConnectionOverrides(ctx)
+	if err != nil {
+		return formatError(err)
+	}
 
 	// Report the connect UI event with server group info if available
 	connectEvent := &pb.UIEvent{
@@ -108,10 +119,109 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 	// #nosec G104 -- fire-and-forget analytics
 	c.client.ReportUIEvent(context.Background(), connectEvent)
 
-	resp, err := c.client.Connect(context.Background(), &pb.ConnectRequest{
-		ServerTag:   serverTag,
-		ServerGroup: serverGroup,
-	})
+	return c.connectAndWait(ctx, func() (pb.Daemon_ConnectClient, error) {
+		return c.client.Connect(context.Background(), &pb.ConnectRequest{
+			ServerTag:   serverTag,
+			ServerGroup: serverGroup,
+			Via:         strings.ToLower(via),
+			Fastest:     ctx.Bool(flagFastest) || slices.Contains(ctx.Args().Slice(), "--"+flagFastest),
+			Overrides:   overrides,
+		})
+	}, c.Connect)
+}
+
+// parseConnectionOverrides reads the settings overridden for a single connection. Flags are
+// looked up in all of the arguments, so they can be given after the connection target too.
+func parseConnectionOverrides(ctx *cli.Context) (*pb.ConnectionOverrides, error) {
+	var overrides *pb.ConnectionOverrides
+	get := func(name string) (string, bool, error) {
+		value, found := getFlagValue(name, ctx)
+		if !found {
+			return "", false, nil
+		}
+		if value == "" {
+			return "", false, argsCountError(ctx)
+		}
+		if overrides == nil {
+			overrides = &pb.ConnectionOverrides{}
+		}
+		return value, true, nil
+	}
+
+	if value, ok, err := get(flagTechnology); err != nil {
+		return nil, err
+	} else if ok {
+		technology, found := config.Technology_value[strings.ToUpper(value)]
+		if !found || technology == int32(config.Technology_UNKNOWN_TECHNOLOGY) {
+			return nil, argsParseError(ctx)
+		}
+		overrides.Technology = config.Technology(technology).Enum()
+	}
+	if value, ok, err := get(flagProtocol); err != nil {
+		return nil, err
+	} else if ok {
+		switch strings.ToUpper(value) {
+		case config.Protocol_UDP.String():
+			overrides.Protocol = config.Protocol_UDP.Enum()
+		case config.Protocol_TCP.String():
+			overrides.Protocol = config.Protocol_TCP.Enum()
+		default:
+			return nil, argsParseError(ctx)
+		}
+	}
+	if value, ok, err := get(flagObfuscate); err != nil {
+		return nil, err
+	} else if ok {
+		obfuscate, err := nstrings.BoolFromString(value)
+		if err != nil {
+			return nil, argsParseError(ctx)
+		}
+		overrides.Obfuscate = &obfuscate
+	}
+	if value, ok, err := get(flagPostQuantum); err != nil {
+		return nil, err
+	} else if ok {
+		postQuantum, err := nstrings.BoolFromString(value)
+		if err != nil {
+			return nil, argsParseError(ctx)
+		}
+		overrides.PostQuantum = &postQuantum
+	}
+	if value, ok, err := get(flagDNS); err != nil {
+		return nil, err
+	} else if ok {
+		for _, address := range strings.Split(value, ",") {
+			if address = strings.TrimSpace(address); address != "" {
+				overrides.Dns = append(overrides.Dns, address)
+			}
+		}
+		if len(overrides.Dns) == 0 {
+			return nil, argsParseError(ctx)
+		}
+	}
+	return overrides, nil
+}
+
+// connectAndWait starts the connection, prints its progress and cancels it on interrupt. The
+// retry action is run after the expired token is renewed by logging in again.
+func (c *cmd) connectAndWait(
+	ctx *cli.Context,
+	connect func() (pb.Daemon_ConnectClient, error),
+	retry cli.ActionFunc,
+) error {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt)
+	defer close(ch)
+
+	canceled := false
+	go func(ch chan os.Signal) {
+		for range ch {
+			canceled = true
+			_, _ = c.client.ConnectCancel(context.Background(), &pb.Empty{})
+		}
+	}(ch)
+
+	resp, err := connect()
 	if err != nil {
 		return formatError(err)
 	}
@@ -136,7 +246,7 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 			if rpcErr = c.Login(ctx); rpcErr != nil {
 				break
 			}
-			rpcErr = c.Connect(ctx)
+			rpcErr = retry(ctx)
 		case internal.CodeTokenRenewError:
 			rpcErr = errors.New(client.AccountTokenRenewError)
 		case internal.CodeExpiredAccessToken:
@@ -169,6 +279,14 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 			rpcErr = errors.New(internal.DoubleGroupErrorMessage)
 		case internal.CodeTechnologyDisabled:
 			rpcErr = errors.New(TechnologyDisabledMessage)
+		case internal.CodeMultiHopUnsupported:
+			rpcErr = errors.New(MultiHopUnsupportedMessage)
+		case internal.CodeInvalidConnectionOverrides:
+			rpcErr = errors.New(InvalidConnectionOverridesMessage)
+		case internal.CodePolicyLocked:
+			rpcErr = fmt.Errorf(ConnectionOverridesLockedMessage, strings.Join(out.Data, ", "))
+		case internal.CodeFavoriteNotFound:
+			rpcErr = errors.New(FavoriteNotFoundMessage)
 		case internal.CodeDedicatedServersRenewError:
 			rpcErr = errors.New(c.injectLinkIntoMessage(client.DedicatedServersUpselURL, client.DedicatedServersUpselURLLogin, DedicatedServersNoServiceMessage))
 		case internal.CodeDedicatedServersServiceButNoServers:
@@ -191,6 +309,8 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 			color.Yellow(client.ConnectConnecting)
 		case internal.CodeUFWDisabled:
 			color.Yellow(client.UFWDisabledMessage)
+		case internal.CodeServerLatencies:
+			printServerLatencies(out.Data)
 		case internal.CodeConnecting:
 			message := client.ConnectStart
 			if len(out.Data) == 1 {
@@ -214,3 +334,16 @@ func (c *cmd) ConnectAutoComplete(ctx *cli.Context) {
 	groupName, hasGroupFlag := getFlagValue(flagGroup, ctx)
 	c.printServersForAutoComplete(args.First(), hasGroupFlag, groupName)
 }
+
+// printServerLatencies prints the hostname:milliseconds pairs measured by the fastest mode
+func printServerLatencies(data []string) {
+	fmt.Println(ConnectLatenciesHeader)
+	for _, entry := range data {
+		hostname, rtt, _ := strings.Cut(entry, ":")
+		if rtt == "" {
+			fmt.Printf("\t%s: %s\n", hostname, ConnectLatencyUnreachable)
+			continue
+		}
+		fmt.Printf("\t%s: %s ms\n", hostname, rtt)
+	}
+}
@@ -19,11 +19,15 @@ func (c *cmd) Countries(ctx *cli.Context) error {
 	}
 
 	if resp.Type != internal.CodeSuccess {
-		err := fmt.Errorf(MsgListIsEmpty, "countries")
+		err := newCodedError(resp.Type, fmt.Errorf(MsgListIsEmpty, "countries"))
 		log.Error(err)
 		return formatError(err)
 	}
 
+	if c.output.isStructured() {
+		return c.printOutput(resp)
+	}
+
 	footer := footerForServerGroupsList(resp.Servers)
 	countryList, err := columns(resp.Servers,
 		serverNameLen,
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/test/category"
 	"github.com/NordSecurity/nordvpn-linux/test/helpers"
 	"github.com/NordSecurity/nordvpn-linux/test/mock"
@@ -28,7 +29,7 @@ func TestCountriesList(t *testing.T) {
 	}{
 		{
 			name:          "error message when countries list is empty",
-			expectedError: formatError(fmt.Errorf(MsgListIsEmpty, "countries")),
+			expectedError: formatError(newCodedError(internal.CodeEmptyPayloadError, fmt.Errorf(MsgListIsEmpty, "countries"))),
 		},
 		{
 			name:      "return virtual servers only",
@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// DNS routes help text
+const (
+	DNSUsageText      = "Manages DNS settings which are not covered by 'nordvpn set dns'"
+	DNSRouteUsageText = "Sends queries of selected domains to their own nameservers"
+	DNSRouteListUsage = "Lists the DNS routes"
+
+	DNSRouteAddUsageText     = "Adds a DNS route"
+	DNSRouteAddArgsUsageText = `<domain> <nameserver>`
+	DNSRouteAddDescription   = `Use this command to resolve a domain and its subdomains with a dedicated nameserver
+instead of the VPN nameservers, e.g. to keep the internal zones of your corporate network working
+while connected to VPN. Nameserver is reached outside of the VPN tunnel and has to be a private or
+link local IPv4 address. Top level domains and other public suffixes, e.g. 'co.uk', can not be routed.
+Adding a route for the domain which already has one replaces it.
+
+Example: 'nordvpn dns route add corp.example 10.0.0.53'
+
+Notes:
+  With systemd-resolved, the nameserver replaces the DNS configuration of the network interface it
+  is reachable through while connected to VPN.`
+
+	DNSRouteRemoveUsageText     = "Removes a DNS route"
+	DNSRouteRemoveArgsUsageText = `<domain>`
+	DNSRouteRemoveDescription   = `Use this command to remove the DNS route of a domain.
+
+Example: 'nordvpn dns route remove corp.example'`
+)
+
+func dnsCommand(c *cmd) *cli.Command {
+	return &cli.Command{
+		Name:  "dns",
+		Usage: DNSUsageText,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "route",
+				Usage: DNSRouteUsageText,
+				Subcommands: []*cli.Command{
+					{
+						Name:        "add",
+						Usage:       DNSRouteAddUsageText,
+						Action:      c.DNSRouteAdd,
+						ArgsUsage:   DNSRouteAddArgsUsageText,
+						Description: DNSRouteAddDescription,
+					},
+					{
+						Name:         "remove",
+						Usage:        DNSRouteRemoveUsageText,
+						Action:       c.DNSRouteRemove,
+						BashComplete: c.DNSRouteRemoveAutoComplete,
+						ArgsUsage:    DNSRouteRemoveArgsUsageText,
+						Description:  DNSRouteRemoveDescription,
+					},
+					{
+						Name:               "list",
+						Usage:              DNSRouteListUsage,
+						Action:             c.DNSRouteList,
+						CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *cmd) DNSRouteAdd(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+
+	route := &pb.DNSRoute{Domain: ctx.Args().Get(0), Nameserver: ctx.Args().Get(1)}
+	resp, err := c.client.AddDNSRoute(context.Background(), &pb.AddDNSRouteRequest{Route: route})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeDNSRouteInvalid:
+		return formatError(errors.New(DNSRouteInvalid))
+	case internal.CodeDNSRouteNoop:
+		color.Yellow(DNSRouteAddExists, route.Domain, route.Nameserver)
+	case internal.CodeFailure:
+		return formatError(errors.New(DNSRouteApplyFailed))
+	case internal.CodeSuccess:
+		color.Green(DNSRouteAddSuccess, route.Domain, route.Nameserver)
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
+
+func (c *cmd) DNSRouteRemove(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	domain := ctx.Args().First()
+	resp, err := c.client.RemoveDNSRoute(context.Background(), &pb.RemoveDNSRouteRequest{Domain: domain})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeDNSRouteNoop:
+		return formatError(fmt.Errorf(DNSRouteRemoveNotFound, domain))
+	case internal.CodeFailure:
+		return formatError(errors.New(DNSRouteApplyFailed))
+	case internal.CodeSuccess:
+		color.Green(DNSRouteRemoveSuccess, domain)
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
+
+func (c *cmd) DNSRouteRemoveAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	settings, err := c.getSettings()
+	if err != nil {
+		return
+	}
+	for _, route := range settings.GetDnsRoutes() {
+		fmt.Println(route.GetDomain())
+	}
+}
+
+func (c *cmd) DNSRouteList(ctx *cli.Context) error {
+	settings, err := c.getSettings()
+	if err != nil {
+		return formatError(err)
+	}
+
+	if len(settings.GetDnsRoutes()) == 0 {
+		fmt.Println(DNSRouteListEmpty)
+		return nil
+	}
+	displayDNSRoutes(settings.GetDnsRoutes())
+	return nil
+}
+
+func displayDNSRoutes(routes []*pb.DNSRoute) {
+	if len(routes) == 0 {
+		return
+	}
+	fmt.Printf("DNS routes:\n")
+	for _, route := range routes {
+		fmt.Printf("\t%s\n", dnsRouteLabel(route))
+	}
+}
+
+// dnsRouteLabel returns human readable DNS route, e.g. "corp.example via 10.0.0.53"
+func dnsRouteLabel(route *pb.DNSRoute) string {
+	return fmt.Sprintf("%s via %s", route.GetDomain(), route.GetNameserver())
+}
@@ -0,0 +1,13 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSRouteLabel(t *testing.T) {
+	route := &pb.DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"}
+	assert.Equal(t, "corp.example via 10.0.0.53", dnsRouteLabel(route))
+}
@@ -0,0 +1,258 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Favorite help text
+const (
+	FavoriteUsageText = "Manages named connection presets"
+
+	FavoriteAddUsageText     = "Saves a connection target with its settings as a favorite"
+	FavoriteAddArgsUsageText = "<name> [<country>|<server>|<country_code>|<city>|<group>|<country> <city>]"
+	FavoriteAddDescription   = `Use this command to save a connection target under a name. The target is given the same way as for 'nordvpn connect'.
+Technology, protocol and obfuscation flags override the global settings only while connected to the favorite.
+Flags have to be given before the name.
+
+Example: 'nordvpn favorite add work-eu Germany'
+Example: 'nordvpn favorite add --group p2p --technology openvpn --protocol tcp --obfuscate on work-eu Germany'`
+
+	FavoriteRemoveUsageText     = "Removes a favorite"
+	FavoriteRemoveArgsUsageText = "<name>"
+	FavoriteRemoveDescription   = `Use this command to remove a favorite.
+
+Example: 'nordvpn favorite remove work-eu'`
+
+	FavoriteConnectUsageText     = "Connects to a favorite"
+	FavoriteConnectArgsUsageText = "<name>"
+	FavoriteConnectDescription   = `Use this command to connect to a favorite using its settings. Global settings are not changed.
+
+Example: 'nordvpn favorite connect work-eu'`
+
+	FavoriteListUsageText = "Lists the favorites"
+
+	FavoriteFlagTechnologyUsageText = "Technology used for the favorite: OPENVPN, NORDLYNX or NORDWHISPER"
+	FavoriteFlagProtocolUsageText   = "Protocol used for the favorite: UDP or TCP (OpenVPN only)"
+	FavoriteFlagObfuscateUsageText  = "Obfuscation used for the favorite: on or off (OpenVPN only)"
+)
+
+func favoriteCommand(c *cmd) *cli.Command {
+	return &cli.Command{
+		Name:  "favorite",
+		Usage: FavoriteUsageText,
+		Subcommands: []*cli.Command{
+			{
+				Name:         "add",
+				Usage:        FavoriteAddUsageText,
+				ArgsUsage:    FavoriteAddArgsUsageText,
+				Description:  FavoriteAddDescription,
+				Action:       c.FavoriteAdd,
+				BashComplete: c.FavoriteAddAutoComplete,
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: flagGroup, Usage: ConnectFlagGroupUsageText},
+					&cli.StringFlag{Name: flagTechnology, Usage: FavoriteFlagTechnologyUsageText},
+					&cli.StringFlag{Name: flagProtocol, Usage: FavoriteFlagProtocolUsageText},
+					&cli.StringFlag{Name: flagObfuscate, Usage: FavoriteFlagObfuscateUsageText},
+				},
+			},
+			{
+				Name:         "remove",
+				Usage:        FavoriteRemoveUsageText,
+				ArgsUsage:    FavoriteRemoveArgsUsageText,
+				Description:  FavoriteRemoveDescription,
+				Action:       c.FavoriteRemove,
+				BashComplete: c.FavoriteNameAutoComplete,
+			},
+			{
+				Name:         "connect",
+				Aliases:      []string{"c"},
+				Usage:        FavoriteConnectUsageText,
+				ArgsUsage:    FavoriteConnectArgsUsageText,
+				Description:  FavoriteConnectDescription,
+				Action:       c.FavoriteConnect,
+				BashComplete: c.FavoriteNameAutoComplete,
+			},
+			{
+				Name:               "list",
+				Usage:              FavoriteListUsageText,
+				Action:             c.FavoriteList,
+				CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			},
+		},
+	}
+}
+
+func (c *cmd) FavoriteAdd(ctx *cli.Context) error {
+	args := ctx.Args()
+	if !args.Present() {
+		return formatError(argsCountError(ctx))
+	}
+
+	req := &pb.AddFavoriteRequest{
+		Name:        args.First(),
+		ServerTag:   strings.ToLower(strings.Join(args.Tail(), " ")),
+		ServerGroup: strings.ToLower(ctx.String(flagGroup)),
+	}
+	overrides, err := parseConnectionOverrides(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+	if overrides != nil {
+		req.Technology = overrides.Technology
+		req.Protocol = overrides.Protocol
+		req.Obfuscate = overrides.Obfuscate
+	}
+
+	resp, err := c.client.AddFavorite(context.Background(), req)
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFavoriteInvalidName:
+		return formatError(errors.New(FavoriteInvalidName))
+	case internal.CodeFavoriteExists:
+		return formatError(fmt.Errorf(FavoriteExists, req.Name))
+	case internal.CodeFavoritesLimitReached:
+		return formatError(errors.New(FavoritesLimitReached))
+	case internal.CodeTagNonexisting:
+		return formatError(errors.New(internal.TagNonexistentErrorMessage))
+	case internal.CodeGroupNonexisting:
+		return formatError(errors.New(internal.GroupNonexistentErrorMessage))
+	case internal.CodeInvalidConnectionOverrides:
+		return formatError(errors.New(InvalidConnectionOverridesMessage))
+	case internal.CodeSuccess:
+		color.Green(FavoriteAddSuccess, req.Name)
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
+
+func (c *cmd) FavoriteAddAutoComplete(ctx *cli.Context) {
+	// the first argument is a new name, so only the target is suggested
+	if ctx.NArg() == 0 {
+		return
+	}
+	groupName, hasGroupFlag := getFlagValue(flagGroup, ctx)
+	c.printServersForAutoComplete(ctx.Args().Get(1), hasGroupFlag, groupName)
+}
+
+func (c *cmd) FavoriteRemove(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	name := ctx.Args().First()
+	resp, err := c.client.RemoveFavorite(context.Background(), &pb.FavoriteRequest{Name: name})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFavoriteNotFound:
+		return formatError(errors.New(FavoriteNotFoundMessage))
+	case internal.CodeSuccess:
+		color.Green(FavoriteRemoveSuccess, name)
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
+
+func (c *cmd) FavoriteConnect(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	name := ctx.Args().First()
+	return c.connectAndWait(ctx, func() (pb.Daemon_ConnectClient, error) {
+		return c.client.ConnectFavorite(context.Background(), &pb.FavoriteRequest{Name: name})
+	}, c.FavoriteConnect)
+}
+
+func (c *cmd) FavoriteNameAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	resp, err := c.client.ListFavorites(context.Background(), &pb.Empty{})
+	if err != nil {
+		return
+	}
+	for _, favorite := range resp.GetFavorites() {
+		fmt.Println(favorite.GetName())
+	}
+}
+
+func (c *cmd) FavoriteList(ctx *cli.Context) error {
+	resp, err := c.client.ListFavorites(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if c.output.isStructured() {
+		return c.printOutput(resp)
+	}
+
+	if len(resp.GetFavorites()) == 0 {
+		fmt.Println(FavoriteListEmpty)
+		return nil
+	}
+	for _, favorite := range resp.GetFavorites() {
+		fmt.Printf("%s: %s\n", favorite.GetName(), favoriteLabel(favorite))
+	}
+	return nil
+}
+
+// favoriteLabel returns human readable target with the overrides, e.g.
+// "P2P in Germany, OPENVPN, TCP, obfuscated"
+func favoriteLabel(favorite *pb.Favorite) string {
+	var target string
+	location := favorite.GetCountry()
+	if favorite.GetCity() != "" {
+		location = fmt.Sprintf("%s, %s", favorite.GetCity(), favorite.GetCountry())
+	}
+	if favorite.GetSpecificServer() != "" {
+		location = favorite.GetSpecificServer()
+	}
+	switch {
+	case favorite.GetGroup() != config.ServerGroup_UNDEFINED && location != "":
+		target = fmt.Sprintf("%s in %s", favorite.GetGroup(), location)
+	case favorite.GetGroup() != config.ServerGroup_UNDEFINED:
+		target = favorite.GetGroup().String()
+	case location != "":
+		target = location
+	default:
+		target = FavoriteRecommendedServer
+	}
+
+	parts := []string{target}
+	if favorite.Technology != nil {
+		parts = append(parts, favorite.GetTechnology().String())
+	}
+	if favorite.Protocol != nil {
+		parts = append(parts, favorite.GetProtocol().String())
+	}
+	if favorite.Obfuscate != nil {
+		if favorite.GetObfuscate() {
+			parts = append(parts, "obfuscated")
+		} else {
+			parts = append(parts, "not obfuscated")
+		}
+	}
+	return strings.Join(parts, ", ")
+}
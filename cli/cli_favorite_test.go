@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFavoriteLabel(t *testing.T) {
+	obfuscate := true
+	tests := []struct {
+		name     string
+		favorite *pb.Favorite
+		expected string
+	}{
+		{
+			name:     "recommended",
+			favorite: &pb.Favorite{},
+			expected: "recommended server",
+		},
+		{
+			name:     "city",
+			favorite: &pb.Favorite{Country: "Lithuania", City: "Vilnius"},
+			expected: "Vilnius, Lithuania",
+		},
+		{
+			name:     "group",
+			favorite: &pb.Favorite{Group: config.ServerGroup_P2P},
+			expected: "P2P",
+		},
+		{
+			name: "country with group and overrides",
+			favorite: &pb.Favorite{
+				Country:    "Germany",
+				Group:      config.ServerGroup_P2P,
+				Technology: config.Technology_OPENVPN.Enum(),
+				Protocol:   config.Protocol_TCP.Enum(),
+				Obfuscate:  &obfuscate,
+			},
+			expected: "P2P in Germany, OPENVPN, TCP, obfuscated",
+		},
+		{
+			name:     "specific server",
+			favorite: &pb.Favorite{SpecificServer: "lt10", Technology: config.Technology_NORDLYNX.Enum()},
+			expected: "lt10, NORDLYNX",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, favoriteLabel(test.favorite))
+		})
+	}
+}
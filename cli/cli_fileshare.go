@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -42,6 +43,9 @@ func statusLoop(fileshareClient pb.FileshareClient, client transferStatusClient,
 	cancelChan := make(chan os.Signal, 1)
 	signal.Notify(cancelChan, syscall.SIGINT)
 	var canceledBySignal atomic.Bool
+	// queued transfers get a new ID once they are started
+	var currentTransferID atomic.Value
+	currentTransferID.Store(transferID)
 
 	go func() {
 		defer close(transferErrorChan)
@@ -63,6 +67,11 @@ func statusLoop(fileshareClient pb.FileshareClient, client transferStatusClient,
 
 			//exhaustive:ignore
 			switch resp.Status {
+			case pb.Status_REQUESTED:
+				if resp.TransferId != currentTransferID.Load() {
+					currentTransferID.Store(resp.TransferId)
+					fmt.Printf("\r%s", MsgFileshareWaitAccept)
+				}
 			case pb.Status_ONGOING:
 				fmt.Printf("\r"+MsgFileshareProgressOngoing, resp.TransferId, resp.Progress)
 			case pb.Status_SUCCESS:
@@ -90,7 +99,9 @@ func statusLoop(fileshareClient pb.FileshareClient, client transferStatusClient,
 	select {
 	case <-cancelChan:
 		canceledBySignal.Store(true)
-		resp, err := fileshareClient.Cancel(context.Background(), &pb.CancelRequest{TransferId: transferID})
+		resp, err := fileshareClient.Cancel(context.Background(), &pb.CancelRequest{
+			TransferId: currentTransferID.Load().(string),
+		})
 		if err != nil {
 			return formatError(err)
 		}
@@ -148,15 +159,21 @@ func (c *cmd) FileshareSend(ctx *cli.Context) error {
 		absPaths = append(absPaths, absPath)
 	}
 
+	priority, err := parseTransferPriority(ctx.String(flagFilesharePriority))
+	if err != nil {
+		return formatError(err)
+	}
+
 	// disable spinner, we will show message to the user instead
 	c.loaderInterceptor.enabled = false
 	sendContext, cancelFunc := context.WithCancel(context.Background())
 	defer cancelFunc()
 
 	client, err := c.fileshareClient.Send(sendContext, &pb.SendRequest{
-		Peer:   args.First(),
-		Paths:  absPaths,
-		Silent: ctx.IsSet(flagFileshareNoWait),
+		Peer:     args.First(),
+		Paths:    absPaths,
+		Silent:   ctx.IsSet(flagFileshareNoWait),
+		Priority: priority,
 	})
 	if err != nil {
 		return formatError(err)
@@ -174,6 +191,15 @@ func (c *cmd) FileshareSend(ctx *cli.Context) error {
 		}
 	}
 
+	if resp.Status == pb.Status_QUEUED {
+		if ctx.IsSet(flagFileshareNoWait) {
+			color.Green(MsgFileshareSendQueuedNoWait, resp.TransferId)
+			return nil
+		}
+		fmt.Printf(MsgFileshareSendQueued+"\n", resp.TransferId)
+		return statusLoop(c.fileshareClient, client, resp.TransferId)
+	}
+
 	if ctx.IsSet(flagFileshareNoWait) {
 		color.Green(MsgFileshareSendNoWait, resp.TransferId)
 		return nil
@@ -184,13 +210,90 @@ func (c *cmd) FileshareSend(ctx *cli.Context) error {
 	return statusLoop(c.fileshareClient, client, resp.TransferId)
 }
 
+// FileshareSendText rpc
+func (c *cmd) FileshareSendText(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	if args.Len() < 1 {
+		return argsParseError(ctx)
+	}
+
+	text := strings.Join(args.Slice()[1:], " ")
+	if args.Len() == 1 || text == "-" {
+		// read one byte over the limit, so too long texts are rejected instead of cut
+		data, err := io.ReadAll(io.LimitReader(os.Stdin, fileshare.TextSizeLimit+1))
+		if err != nil {
+			return formatError(err)
+		}
+		text = string(data)
+	}
+
+	// disable spinner, we will show message to the user instead
+	c.loaderInterceptor.enabled = false
+	sendContext, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	client, err := c.fileshareClient.SendText(sendContext, &pb.SendTextRequest{
+		Peer:   args.First(),
+		Text:   text,
+		Silent: ctx.IsSet(flagFileshareNoWait),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	// check first response to determine that transfer was started successfully
+	resp, err := client.Recv()
+	if err != nil {
+		return formatError(err)
+	}
+
+	if resp.GetError() != nil {
+		if err := getFileshareResponseToError(resp.GetError()); err != nil {
+			return formatError(err)
+		}
+	}
+
+	if ctx.IsSet(flagFileshareNoWait) {
+		color.Green(MsgFileshareSendNoWait, resp.TransferId)
+		return nil
+	}
+
+	return statusLoop(c.fileshareClient, client, resp.TransferId)
+}
+
+func parseTransferPriority(priority string) (pb.TransferPriority, error) {
+	switch strings.ToLower(priority) {
+	case "", "normal":
+		return pb.TransferPriority_PRIORITY_NORMAL, nil
+	case "low":
+		return pb.TransferPriority_PRIORITY_LOW, nil
+	case "high":
+		return pb.TransferPriority_PRIORITY_HIGH, nil
+	default:
+		return pb.TransferPriority_PRIORITY_NORMAL, fmt.Errorf(MsgFileshareInvalidPriority, priority)
+	}
+}
+
 // FileshareAutoCompletePeers implements bash autocompletion for peer hostnames
 func (c *cmd) FileshareAutoCompletePeers(ctx *cli.Context) {
 	if ctx.NArg() > 0 {
 		c.AutocompleteFilepaths(ctx)
 		return
 	}
+	c.printFilesharePeers()
+}
+
+// FileshareAutoCompleteSendText implements bash autocompletion for send-text peer hostnames
+func (c *cmd) FileshareAutoCompleteSendText(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	c.printFilesharePeers()
+}
 
+// printFilesharePeers prints names of the connected peers which are allowed to send files to us
+func (c *cmd) printFilesharePeers() {
 	resp, err := c.meshClient.GetPeers(context.Background(), &mpb.Empty{})
 	if err != nil {
 		return
@@ -300,6 +403,45 @@ func (c *cmd) FileshareAccept(ctx *cli.Context) error {
 	return statusLoop(c.fileshareClient, client, transferID)
 }
 
+// FileshareResume rpc
+func (c *cmd) FileshareResume(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	// disable spinner, we will show message to the user instead
+	c.loaderInterceptor.enabled = false
+	transferID := ctx.Args().First()
+	resumeContext, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	client, err := c.fileshareClient.Resume(resumeContext, &pb.ResumeRequest{
+		TransferId: transferID,
+		Silent:     ctx.IsSet(flagFileshareNoWait),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := client.Recv()
+	if err != nil {
+		return formatError(err)
+	}
+
+	if resp.GetError() != nil {
+		if err := getFileshareResponseToError(resp.GetError()); err != nil {
+			return formatError(err)
+		}
+	}
+
+	if ctx.IsSet(flagFileshareNoWait) {
+		color.Green(MsgFileshareResumeNoWait, transferID)
+		return nil
+	}
+
+	return statusLoop(c.fileshareClient, client, transferID)
+}
+
 // FileshareCancel rpc
 func (c *cmd) FileshareCancel(ctx *cli.Context) error {
 	if ctx.NArg() != 1 && ctx.NArg() != 2 {
@@ -365,6 +507,136 @@ func (c *cmd) FileshareClear(ctx *cli.Context) error {
 	return nil
 }
 
+// FileshareLimit rpc
+func (c *cmd) FileshareLimit(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	current, err := c.fileshareClient.GetLimits(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := getFileshareResponseToError(current.GetError()); err != nil {
+		return formatError(err)
+	}
+
+	setTransfers := ctx.NArg() == 1
+	setUploadRate := ctx.IsSet(flagFileshareUploadRate)
+	setPeerRate := ctx.IsSet(flagFilesharePeerUploadRate)
+	if !setTransfers && !setUploadRate && !setPeerRate {
+		fmt.Printf(MsgFileshareLimitCurrent+"\n", formatTransferLimit(current.GetMaxConcurrentTransfers()))
+		fmt.Printf(MsgFileshareUploadRateCurrent+"\n", formatUploadRate(current.GetMaxUploadRate()))
+		fmt.Printf(MsgFilesharePeerRateCurrent+"\n", formatUploadRate(current.GetMaxPeerUploadRate()))
+		return nil
+	}
+
+	// limits which are not provided keep their current values
+	req := &pb.SetLimitsRequest{
+		MaxConcurrentTransfers: current.GetMaxConcurrentTransfers(),
+		MaxUploadRate:          current.GetMaxUploadRate(),
+		MaxPeerUploadRate:      current.GetMaxPeerUploadRate(),
+	}
+	if setTransfers {
+		maxTransfers, err := strconv.ParseUint(ctx.Args().First(), 10, 32)
+		if err != nil {
+			return formatError(argsParseError(ctx))
+		}
+		req.MaxConcurrentTransfers = uint32(maxTransfers)
+	}
+	if setUploadRate {
+		rate, ok := kilobytesToBytes(ctx.Uint64(flagFileshareUploadRate))
+		if !ok {
+			return formatError(argsParseError(ctx))
+		}
+		req.MaxUploadRate = rate
+	}
+	if setPeerRate {
+		rate, ok := kilobytesToBytes(ctx.Uint64(flagFilesharePeerUploadRate))
+		if !ok {
+			return formatError(argsParseError(ctx))
+		}
+		req.MaxPeerUploadRate = rate
+	}
+
+	resp, err := c.fileshareClient.SetLimits(context.Background(), req)
+	if err != nil {
+		return formatError(err)
+	}
+	if err := getFileshareResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+	if setTransfers {
+		color.Green(MsgFileshareLimitSet, formatTransferLimit(req.MaxConcurrentTransfers))
+	}
+	if setUploadRate {
+		color.Green(MsgFileshareUploadRateSet, formatUploadRate(req.MaxUploadRate))
+	}
+	if setPeerRate {
+		color.Green(MsgFilesharePeerRateSet, formatUploadRate(req.MaxPeerUploadRate))
+	}
+	return nil
+}
+
+func formatTransferLimit(maxTransfers uint32) string {
+	if maxTransfers == 0 {
+		return MsgFileshareLimitUnlimited
+	}
+	return strconv.FormatUint(uint64(maxTransfers), 10)
+}
+
+// kilobytesToBytes converts the upload rate given in KB/s to bytes per second
+func kilobytesToBytes(rate uint64) (uint64, bool) {
+	if rate > math.MaxUint64/1024 {
+		return 0, false
+	}
+	return rate * 1024, true
+}
+
+func formatUploadRate(bytesPerSecond uint64) string {
+	if bytesPerSecond == 0 {
+		return MsgFileshareLimitUnlimited
+	}
+	return strconv.FormatUint(bytesPerSecond/1024, 10) + " KB/s"
+}
+
+// FileshareHook rpc
+func (c *cmd) FileshareHook(ctx *cli.Context) error {
+	switch ctx.NArg() {
+	case 0:
+		resp, err := c.fileshareClient.GetHook(context.Background(), &pb.Empty{})
+		if err != nil {
+			return formatError(err)
+		}
+		if err := getFileshareResponseToError(resp.GetError()); err != nil {
+			return formatError(err)
+		}
+		if resp.GetCommand() == "" {
+			fmt.Println(MsgFileshareHookNotSet)
+			return nil
+		}
+		fmt.Printf(MsgFileshareHookCurrent+"\n", resp.GetCommand())
+		return nil
+	case 1:
+		command := strings.TrimSpace(ctx.Args().First())
+		resp, err := c.fileshareClient.SetHook(context.Background(), &pb.SetHookRequest{Command: command})
+		if err != nil {
+			return formatError(err)
+		}
+		if err := getFileshareResponseToError(resp); err != nil {
+			return formatError(err)
+		}
+		if command == "" {
+			color.Green(MsgFileshareHookRemoved)
+			return nil
+		}
+		color.Green(MsgFileshareHookSet)
+		return nil
+	default:
+		return formatError(argsCountError(ctx))
+	}
+}
+
 // getFileshareResponseToError converts resp to error. Params are used in case of some error messages.
 func getFileshareResponseToError(resp *pb.Error, params ...any) error {
 	if resp == nil {
@@ -444,6 +716,24 @@ func fileshareErrorCodeToError(code pb.FileshareErrorCode, params ...any) error
 		return fmt.Errorf(MsgNoPermissions, params...)
 	case pb.FileshareErrorCode_PURGE_FAILURE:
 		return errors.New(MsgFileshareClearFailure)
+	case pb.FileshareErrorCode_TRANSFER_NOT_RESUMABLE:
+		return errors.New(MsgFileshareTransferNotResumable)
+	case pb.FileshareErrorCode_WATCH_ALREADY_EXISTS:
+		return errors.New(MsgFileshareWatchAlreadyExists)
+	case pb.FileshareErrorCode_WATCH_NOT_FOUND:
+		return errors.New(MsgFileshareWatchNotFound)
+	case pb.FileshareErrorCode_WATCH_NOT_A_DIRECTORY:
+		return errors.New(MsgFileshareWatchNotADirectory)
+	case pb.FileshareErrorCode_WATCH_FAILURE:
+		return errors.New(MsgFileshareWatchFailure)
+	case pb.FileshareErrorCode_LIMITS_FAILURE:
+		return errors.New(MsgFileshareLimitsFailure)
+	case pb.FileshareErrorCode_HOOK_FAILURE:
+		return errors.New(MsgFileshareHookFailure)
+	case pb.FileshareErrorCode_TEXT_EMPTY:
+		return errors.New(MsgFileshareTextEmpty)
+	case pb.FileshareErrorCode_TEXT_TOO_LONG:
+		return errors.New(MsgFileshareTextTooLong)
 	default:
 		return errors.New(AccountInternalError)
 	}
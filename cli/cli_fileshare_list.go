@@ -17,6 +17,7 @@ import (
 	"github.com/docker/go-units"
 	"github.com/fatih/color"
 	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/proto"
 )
 
 func (c *cmd) getTransfers() ([]*pb.Transfer, error) {
@@ -60,6 +61,9 @@ func (c *cmd) FileshareList(ctx *cli.Context) error {
 			return errors.New(MsgFileshareTransferNotFound)
 		}
 
+		if c.output.isStructured() {
+			return c.printOutput(transfers[idx])
+		}
 		fmt.Println(strings.TrimSpace(transferToOutputString(transfers[idx])))
 		return nil
 	}
@@ -69,6 +73,16 @@ func (c *cmd) FileshareList(ctx *cli.Context) error {
 		printIn = ctx.IsSet(flagFileshareListIn)
 		printOut = ctx.IsSet(flagFileshareListOut)
 	}
+	if c.output.isStructured() {
+		list := []proto.Message{}
+		for _, transfer := range transfers {
+			if (transfer.Direction == pb.Direction_INCOMING && printIn) ||
+				(transfer.Direction == pb.Direction_OUTGOING && printOut) {
+				list = append(list, transfer)
+			}
+		}
+		return c.printOutput(map[string]any{"transfers": list})
+	}
 	fmt.Println(strings.TrimSpace(transfersToOutputString(transfers, printIn, printOut)))
 	return nil
 }
@@ -124,7 +138,17 @@ func (c *cmd) FileshareAutoCompleteTransfersAccept(ctx *cli.Context) {
 // FileshareAutoCompleteTransfersCancel does transfer id and files autocompletion for `fileshare cancel`
 func (c *cmd) FileshareAutoCompleteTransfersCancel(ctx *cli.Context) {
 	c.fileshareAutoCompleteTransfers(ctx, pb.Direction_UNKNOWN_DIRECTION, func(s pb.Status) bool {
-		return s == pb.Status_REQUESTED || s == pb.Status_ONGOING
+		return s == pb.Status_REQUESTED || s == pb.Status_ONGOING || s == pb.Status_QUEUED
+	})
+}
+
+// FileshareAutoCompleteTransfersResume does transfer id autocompletion for `fileshare resume`
+func (c *cmd) FileshareAutoCompleteTransfersResume(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	c.fileshareAutoCompleteTransfers(ctx, pb.Direction_UNKNOWN_DIRECTION, func(s pb.Status) bool {
+		return s == pb.Status_INTERRUPTED || s == pb.Status_ONGOING
 	})
 }
 
@@ -140,19 +164,31 @@ func transferToOutputString(transfer *pb.Transfer) string {
 	tableWriter := tabwriter.NewWriter(&builder, minwidth, tabwidth, padding, padchar, flags)
 	headingCol := color.New(color.Bold)
 
+	if transfer.GetType() == pb.TransferType_TEXT {
+		builder.WriteString(headingCol.Sprintf("Text:\n"))
+		builder.WriteString(transfer.GetText())
+		builder.WriteByte('\n')
+		return builder.String()
+	}
+
 	builder.WriteString(headingCol.Sprintf("File list:\n"))
-	fmt.Fprintf(tableWriter, "file\tsize\tstatus\t\n")
+	fmt.Fprintf(tableWriter, "file\tsize\tstatus\tchecksum\t\n")
 	for _, file := range transfer.Files {
 		progress := ""
 		if file.Status == pb.Status_ONGOING && file.Size > 0 {
 			progress = " " + fmt.Sprintf("%d%%",
 				uint16(float64(file.Transferred)/float64(file.Size)*100))
 		}
-		fmt.Fprintf(tableWriter, "%s\t%s\t%s%s\t\n",
+		checksum := file.GetChecksum()
+		if checksum == "" {
+			checksum = "-"
+		}
+		fmt.Fprintf(tableWriter, "%s\t%s\t%s%s\t%s\t\n",
 			file.GetPath(),
 			units.HumanSize(float64(file.GetSize())),
 			fileshare.GetTransferFileStatus(file, transfer.Direction == pb.Direction_INCOMING),
 			progress,
+			checksum,
 		)
 	}
 
@@ -203,8 +239,17 @@ func buildTransferTable(writer *tabwriter.Writer, transfers []*pb.Transfer, dire
 		fileSize := calcTransferSize(transfer.Files)
 
 		progress := ""
-		if transfer.Status == pb.Status_ONGOING {
+		//exhaustive:ignore
+		switch transfer.Status {
+		case pb.Status_ONGOING:
 			progress = " " + calcTransferProgressPercent(transfer)
+		case pb.Status_QUEUED:
+			progress = fmt.Sprintf(" #%d", transfer.GetQueuePosition())
+		}
+
+		path := transfer.GetPath()
+		if transfer.GetType() == pb.TransferType_TEXT {
+			path = textPreview(transfer.GetText())
 		}
 
 		fmt.Fprintf(writer, "%s\t%s\t%d\t%s\t%s%s\t%s\t\n",
@@ -214,7 +259,7 @@ func buildTransferTable(writer *tabwriter.Writer, transfers []*pb.Transfer, dire
 			fileSize,
 			fileshare.GetTransferStatus(transfer),
 			progress,
-			transfer.GetPath(),
+			path,
 		)
 	}
 
@@ -223,6 +268,21 @@ func buildTransferTable(writer *tabwriter.Writer, transfers []*pb.Transfer, dire
 	}
 }
 
+// textPreview shortens the text of a text transfer to its first line
+func textPreview(text string) string {
+	const maxPreviewLength = 32
+
+	preview, _, multiline := strings.Cut(strings.TrimSpace(text), "\n")
+	if runes := []rune(preview); len(runes) > maxPreviewLength {
+		preview = string(runes[:maxPreviewLength])
+		multiline = true
+	}
+	if multiline {
+		preview += "..."
+	}
+	return fmt.Sprintf("text: %q", preview)
+}
+
 func calcTransferProgressPercent(tr *pb.Transfer) string {
 	progress := uint16(0)
 	transferred := uint64(0)
@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/log"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// FileshareWatchAdd rpc
+func (c *cmd) FileshareWatchAdd(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+
+	path, err := filepath.Abs(ctx.Args().Get(0))
+	if err != nil {
+		return fmt.Errorf(MsgFileshareInvalidPath, formatError(err))
+	}
+	peer := ctx.Args().Get(1)
+
+	resp, err := c.fileshareClient.AddWatch(context.Background(), &pb.AddWatchRequest{Path: path, Peer: peer})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := getFileshareResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgFileshareWatchAddSuccess, path, peer)
+	return nil
+}
+
+// FileshareWatchRemove rpc
+func (c *cmd) FileshareWatchRemove(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	path, err := filepath.Abs(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf(MsgFileshareInvalidPath, formatError(err))
+	}
+
+	resp, err := c.fileshareClient.RemoveWatch(context.Background(), &pb.RemoveWatchRequest{Path: path})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := getFileshareResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgFileshareWatchRemoved, path)
+	return nil
+}
+
+// FileshareWatchList rpc
+func (c *cmd) FileshareWatchList(ctx *cli.Context) error {
+	resp, err := c.fileshareClient.ListWatches(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := getFileshareResponseToError(resp.GetError()); err != nil {
+		return formatError(err)
+	}
+
+	if len(resp.GetWatches()) == 0 {
+		fmt.Println(MsgFileshareWatchListEmpty)
+		return nil
+	}
+	fmt.Print(watchesToOutputString(resp.GetWatches()))
+	return nil
+}
+
+// FileshareWatchAutoCompleteAdd implements bash autocompletion for `fileshare watch add`
+func (c *cmd) FileshareWatchAutoCompleteAdd(ctx *cli.Context) {
+	switch ctx.NArg() {
+	case 0:
+		c.AutocompleteFilepaths(ctx)
+	case 1:
+		c.printFilesharePeers()
+	}
+}
+
+// FileshareWatchAutoCompleteRemove implements bash autocompletion for `fileshare watch remove`
+func (c *cmd) FileshareWatchAutoCompleteRemove(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+
+	resp, err := c.fileshareClient.ListWatches(context.Background(), &pb.Empty{})
+	if err != nil {
+		return
+	}
+	for _, watch := range resp.GetWatches() {
+		fmt.Println(watch.GetPath())
+	}
+}
+
+func watchesToOutputString(watches []*pb.Watch) string {
+	var builder strings.Builder
+	const (
+		minwidth = 0
+		tabwidth = 1
+		padding  = 2
+		padchar  = ' '
+		flags    = 0
+	)
+	tableWriter := tabwriter.NewWriter(&builder, minwidth, tabwidth, padding, padchar, flags)
+	fmt.Fprintf(tableWriter, "directory\tpeer\t\n")
+	for _, watch := range watches {
+		fmt.Fprintf(tableWriter, "%s\t%s\t\n", watch.GetPath(), watch.GetPeer())
+	}
+	if err := tableWriter.Flush(); err != nil {
+		log.Error(err)
+	}
+	return builder.String()
+}
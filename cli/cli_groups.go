@@ -21,7 +21,11 @@ func (c *cmd) Groups(ctx *cli.Context) error {
 	}
 
 	if resp.Type != internal.CodeSuccess {
-		return formatError(fmt.Errorf(MsgListIsEmpty, "server groups"))
+		return formatError(newCodedError(resp.Type, fmt.Errorf(MsgListIsEmpty, "server groups")))
+	}
+
+	if c.output.isStructured() {
+		return c.printOutput(resp)
 	}
 
 	footer := footerForServerGroupsList(resp.Servers)
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/test/category"
 	"github.com/NordSecurity/nordvpn-linux/test/helpers"
 	"github.com/NordSecurity/nordvpn-linux/test/mock"
@@ -28,7 +29,7 @@ func TestGroupsList(t *testing.T) {
 	}{
 		{
 			name:          "error response",
-			expectedError: formatError(fmt.Errorf(MsgListIsEmpty, "server groups")),
+			expectedError: formatError(newCodedError(internal.CodeEmptyPayloadError, fmt.Errorf(MsgListIsEmpty, "server groups"))),
 		},
 		{
 			name:     "groups list",
@@ -0,0 +1,231 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// History help text
+const (
+	HistoryUsageText   = "Shows the history of VPN connections"
+	HistoryDescription = `Use this command to see when the VPN connections were started and ended, the servers used,
+the amount of data transferred and why the connections ended.
+
+Example: 'nordvpn history'
+Example: 'nordvpn history --since 24h'
+Example: 'nordvpn history --since 2024-05-01 --json'
+
+Notes:
+  History of the last 1000 connections is kept.`
+	HistorySinceUsage = "Show only the connections active after the given time. " +
+		"Supports durations, e.g. '12h' or '7d', dates, e.g. '2024-05-01', and RFC 3339 timestamps"
+	HistoryJSONUsage = "Print the history in JSON format"
+)
+
+const (
+	flagHistorySince = "since"
+	flagHistoryJSON  = "json"
+
+	historyTimeLayout = "2006-01-02 15:04:05"
+)
+
+var errHistoryInvalidSince = errors.New("invalid time")
+
+func historyCommand(c *cmd) *cli.Command {
+	return &cli.Command{
+		Name:        "history",
+		Usage:       HistoryUsageText,
+		Action:      c.History,
+		Description: HistoryDescription,
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: flagHistorySince, Usage: HistorySinceUsage},
+			&cli.BoolFlag{Name: flagHistoryJSON, Usage: HistoryJSONUsage},
+		},
+	}
+}
+
+func (c *cmd) History(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	req := &pb.ConnectionHistoryRequest{}
+	if since := ctx.String(flagHistorySince); since != "" {
+		t, err := parseHistorySince(since, time.Now())
+		if err != nil {
+			return formatError(newCodedError(internal.CodeFormatError, fmt.Errorf(HistoryInvalidSince, since)))
+		}
+		req.Since = timestamppb.New(t)
+	}
+
+	resp, err := c.client.GetConnectionHistory(context.Background(), req)
+	if err != nil {
+		return formatError(err)
+	}
+
+	if resp.Type != internal.CodeSuccess {
+		return formatError(newCodedError(resp.Type, internal.ErrUnhandled))
+	}
+
+	if ctx.Bool(flagHistoryJSON) {
+		return writeOutput(os.Stdout, outputJSON, historySessions(resp.GetSessions()))
+	}
+	if c.output.isStructured() {
+		return c.printOutput(historySessions(resp.GetSessions()))
+	}
+
+	if len(resp.GetSessions()) == 0 {
+		color.Yellow(HistoryEmpty)
+		return nil
+	}
+	fmt.Print(historyToTable(resp.GetSessions(), time.Now()))
+	return nil
+}
+
+// parseHistorySince parses a duration relative to now, a date in the local time zone or an RFC 3339
+// timestamp.
+func parseHistorySince(value string, now time.Time) (time.Time, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.ParseUint(days, 10, 16); err == nil {
+			return now.AddDate(0, 0, -int(n)), nil
+		}
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, errHistoryInvalidSince
+}
+
+type historySessionJSON struct {
+	Start       time.Time  `json:"start"`
+	End         *time.Time `json:"end"`
+	DurationSec int64      `json:"duration_sec"`
+	Server      string     `json:"server"`
+	ServerName  string     `json:"server_name"`
+	IP          string     `json:"ip"`
+	Country     string     `json:"country"`
+	CountryCode string     `json:"country_code"`
+	City        string     `json:"city"`
+	Technology  string     `json:"technology"`
+	Protocol    string     `json:"protocol"`
+	Received    uint64     `json:"received_bytes"`
+	Sent        uint64     `json:"sent_bytes"`
+	ReasonCode  int32      `json:"reason_code"`
+	Error       string     `json:"error,omitempty"`
+	Paused      bool       `json:"paused"`
+	Interrupted bool       `json:"interrupted"`
+}
+
+// historySessions converts the sessions to the schema of the structured output
+func historySessions(sessions []*pb.ConnectionSession) []historySessionJSON {
+	now := time.Now()
+	out := make([]historySessionJSON, 0, len(sessions))
+	for _, session := range sessions {
+		s := historySessionJSON{
+			Start:       session.GetStart().AsTime().Local(),
+			DurationSec: int64(historySessionDuration(session, now).Seconds()),
+			Server:      session.GetServer(),
+			ServerName:  session.GetServerName(),
+			IP:          session.GetIp(),
+			Country:     session.GetCountry(),
+			CountryCode: session.GetCountryCode(),
+			City:        session.GetCity(),
+			Technology:  strings.ToLower(session.GetTechnology().String()),
+			Protocol:    strings.ToLower(session.GetProtocol().String()),
+			Received:    session.GetRx(),
+			Sent:        session.GetTx(),
+			ReasonCode:  session.GetReasonCode(),
+			Error:       session.GetError(),
+			Paused:      session.GetPaused(),
+			Interrupted: session.GetInterrupted(),
+		}
+		if session.GetEnd() != nil {
+			end := session.GetEnd().AsTime().Local()
+			s.End = &end
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+func historyToTable(sessions []*pb.ConnectionSession, now time.Time) string {
+	var builder strings.Builder
+	const (
+		minwidth = 0
+		tabwidth = 1
+		padding  = 2
+		padchar  = ' '
+		flags    = 0
+	)
+	tableWriter := tabwriter.NewWriter(&builder, minwidth, tabwidth, padding, padchar, flags)
+	fmt.Fprintf(tableWriter, "start\tend\tduration\tserver\ttechnology\treceived\tsent\tstatus\t\n")
+	for _, session := range sessions {
+		end := "-"
+		if session.GetEnd() != nil {
+			end = session.GetEnd().AsTime().Local().Format(historyTimeLayout)
+		}
+		fmt.Fprintf(tableWriter, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			session.GetStart().AsTime().Local().Format(historyTimeLayout),
+			end,
+			historySessionDuration(session, now).Round(time.Second),
+			session.GetServer(),
+			session.GetTechnology().String(),
+			uint64ToHumanBytes(session.GetRx()),
+			uint64ToHumanBytes(session.GetTx()),
+			historySessionStatus(session),
+		)
+	}
+	if err := tableWriter.Flush(); err != nil {
+		log.Error(err)
+	}
+	return builder.String()
+}
+
+func historySessionDuration(session *pb.ConnectionSession, now time.Time) time.Duration {
+	if session.GetEnd() != nil {
+		return session.GetEnd().AsTime().Sub(session.GetStart().AsTime())
+	}
+	return now.Sub(session.GetStart().AsTime())
+}
+
+// historySessionStatus describes how the session ended
+func historySessionStatus(session *pb.ConnectionSession) string {
+	var status []string
+	switch {
+	case session.GetEnd() == nil:
+		status = append(status, "active")
+	case session.GetInterrupted():
+		status = append(status, "interrupted")
+	case session.GetPaused():
+		status = append(status, "paused")
+	case session.GetReasonCode() != int32(events.ReasonNotSpecified):
+		status = append(status, fmt.Sprintf("logged out (%d)", session.GetReasonCode()))
+	default:
+		status = append(status, "disconnected")
+	}
+	if session.GetError() != "" {
+		status = append(status, "error: "+session.GetError())
+	}
+	return strings.Join(status, ", ")
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParseHistorySince(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value    string
+		expected time.Time
+		isError  bool
+	}{
+		{value: "7d", expected: time.Date(2024, 5, 3, 12, 0, 0, 0, time.UTC)},
+		{value: "12h", expected: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)},
+		{value: "2024-05-01", expected: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "2024-05-01T08:00:00Z", expected: time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)},
+		{value: "-1h", isError: true},
+		{value: "d", isError: true},
+		{value: "yesterday", isError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			since, err := parseHistorySince(test.value, now)
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, test.expected.Equal(since), since)
+		})
+	}
+}
+
+func TestHistorySessionStatus(t *testing.T) {
+	end := timestamppb.Now()
+	notSpecified := int32(events.ReasonNotSpecified)
+	tests := []struct {
+		name     string
+		session  *pb.ConnectionSession
+		expected string
+	}{
+		{name: "active", session: &pb.ConnectionSession{ReasonCode: notSpecified}, expected: "active"},
+		{name: "disconnected", session: &pb.ConnectionSession{End: end, ReasonCode: notSpecified}, expected: "disconnected"},
+		{name: "paused", session: &pb.ConnectionSession{End: end, ReasonCode: notSpecified, Paused: true}, expected: "paused"},
+		{
+			name:     "interrupted",
+			session:  &pb.ConnectionSession{End: end, Interrupted: true, Paused: true},
+			expected: "interrupted",
+		},
+		{
+			name:     "logged out",
+			session:  &pb.ConnectionSession{End: end, ReasonCode: int32(events.ReasonAuthTokenInvalidated)},
+			expected: fmt.Sprintf("logged out (%d)", events.ReasonAuthTokenInvalidated),
+		},
+		{
+			name:     "error",
+			session:  &pb.ConnectionSession{End: end, ReasonCode: notSpecified, Error: "server maintenance"},
+			expected: "disconnected, error: server maintenance",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, historySessionStatus(test.session))
+		})
+	}
+}
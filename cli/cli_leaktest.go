@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Leak test help text
+const (
+	LeakTestUsageText   = "Checks that DNS and other traffic do not leak outside of the VPN tunnel"
+	LeakTestDescription = `Use this command to verify the active VPN connection. It checks that:
+  the internet traffic is routed through the VPN tunnel,
+  every nameserver the system uses is reachable only through the VPN tunnel,
+  no global IPv6 route bypasses the VPN tunnel,
+  the firewall has the chains and rules of the current settings.
+
+Example: 'nordvpn leaktest'
+
+Notes:
+  Nameservers of the DNS routes are expected to be reached outside of the VPN tunnel.
+  Firewall rules are matched by their names and order, their contents are not compared.
+  Checks which do not apply to the current setup are skipped.`
+)
+
+func leakTestCommand(c *cmd) *cli.Command {
+	return &cli.Command{
+		Name:               "leaktest",
+		Usage:              LeakTestUsageText,
+		Action:             c.LeakTest,
+		Description:        LeakTestDescription,
+		CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+	}
+}
+
+func (c *cmd) LeakTest(ctx *cli.Context) error {
+	resp, err := c.client.LeakTest(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeVPNNotRunning:
+		return formatError(errors.New(LeakTestNotConnected))
+	case internal.CodeSuccess:
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+
+	fmt.Print(leakTestToTable(resp.GetChecks()))
+	for _, check := range resp.GetChecks() {
+		if check.GetStatus() == pb.LeakTestStatus_LEAK_TEST_FAIL {
+			return formatError(errors.New(LeakTestFailed))
+		}
+	}
+	color.Green(LeakTestPassed)
+	return nil
+}
+
+func leakTestToTable(checks []*pb.LeakTestCheck) string {
+	var builder strings.Builder
+	const (
+		minwidth = 0
+		tabwidth = 1
+		padding  = 2
+		padchar  = ' '
+		flags    = 0
+	)
+	tableWriter := tabwriter.NewWriter(&builder, minwidth, tabwidth, padding, padchar, flags)
+	fmt.Fprintf(tableWriter, "check\tresult\tdetails\t\n")
+	for _, check := range checks {
+		fmt.Fprintf(tableWriter, "%s\t%s\t%s\t\n",
+			check.GetName(),
+			leakTestStatusLabel(check.GetStatus()),
+			check.GetDetail(),
+		)
+	}
+	if err := tableWriter.Flush(); err != nil {
+		log.Error(err)
+	}
+	return builder.String()
+}
+
+func leakTestStatusLabel(status pb.LeakTestStatus) string {
+	switch status {
+	case pb.LeakTestStatus_LEAK_TEST_PASS:
+		return "passed"
+	case pb.LeakTestStatus_LEAK_TEST_FAIL:
+		return "failed"
+	default:
+		return "skipped"
+	}
+}
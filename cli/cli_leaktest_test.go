@@ -0,0 +1,22 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeakTestToTable(t *testing.T) {
+	checks := []*pb.LeakTestCheck{
+		{Name: "Routing", Status: pb.LeakTestStatus_LEAK_TEST_PASS, Detail: "internet traffic is routed through nordlynx"},
+		{Name: "DNS", Status: pb.LeakTestStatus_LEAK_TEST_FAIL, Detail: "192.168.1.1 via eth0 outside of the tunnel"},
+		{Name: "Firewall", Status: pb.LeakTestStatus_LEAK_TEST_SKIP, Detail: "firewall is disabled"},
+	}
+
+	expected := "check     result   details                                      \n" +
+		"Routing   passed   internet traffic is routed through nordlynx  \n" +
+		"DNS       failed   192.168.1.1 via eth0 outside of the tunnel   \n" +
+		"Firewall  skipped  firewall is disabled                         \n"
+	assert.Equal(t, expected, leakTestToTable(checks))
+}
@@ -109,8 +109,8 @@ func (c *cmd) MeshPeerList(ctx *cli.Context) error {
 	if err != nil {
 		return formatError(err)
 	}
+	condition := ""
 	if ctx.IsSet(flagFilter) {
-		condition := ""
 		for _, value := range strings.Split(ctx.String(flagFilter), ",") {
 			filtersFunc, ok := availableFilters[value]
 			if !ok {
@@ -121,10 +121,18 @@ func (c *cmd) MeshPeerList(ctx *cli.Context) error {
 				condition = value
 			}
 		}
-		fmt.Println(strings.TrimSpace(peersToOutputString(peers, condition)))
-	} else {
-		fmt.Println(strings.TrimSpace(peersToOutputString(peers, "")))
 	}
+	if c.output.isStructured() {
+		// the text output hides the other list instead of filtering it
+		switch condition {
+		case internalFilter:
+			peers.External = nil
+		case externalFilter:
+			peers.Local = nil
+		}
+		return c.printOutput(peers)
+	}
+	fmt.Println(strings.TrimSpace(peersToOutputString(peers, condition)))
 	return nil
 }
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
 	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/helpers"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
@@ -153,3 +154,31 @@ func TestMeshPeerList(t *testing.T) {
 		})
 	}
 }
+
+func TestMeshPeerList_StructuredOutputFilter(t *testing.T) {
+	category.Set(t, category.Unit)
+	c := cmd{meshClient: mockMeshClient{}, output: outputJSON}
+
+	for _, test := range []struct {
+		filter   string
+		expected string
+		filtered string
+	}{
+		{filter: internalFilter, expected: "IAllowInbound_InboundAllowed_IsRoutable", filtered: "AllowsEverything"},
+		{filter: externalFilter, expected: "AllowsEverything", filtered: "IAllowInbound_InboundAllowed_IsRoutable"},
+	} {
+		t.Run(test.filter, func(t *testing.T) {
+			set := flag.NewFlagSet(flagFilter, flag.ContinueOnError)
+			set.String(flagFilter, "", "filter flag")
+			assert.NoError(t, set.Parse([]string{"--" + flagFilter, test.filter}))
+			ctx := cli.NewContext(cli.NewApp(), set, &cli.Context{Context: context.Background()})
+
+			output, err := helpers.CaptureOutput(func() {
+				assert.NoError(t, c.MeshPeerList(ctx))
+			})
+			assert.NoError(t, err)
+			assert.Contains(t, output, test.expected)
+			assert.NotContains(t, output, test.filtered)
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Schedule help text
+const (
+	ScheduleUsageText = "Pauses or disconnects the VPN connection on a recurring schedule"
+	ScheduleListUsage = "Lists the schedule rules"
+
+	ScheduleAddUsageText     = "Adds a recurring pause or disconnect window"
+	ScheduleAddArgsUsageText = `<pause|disconnect> <start> <end>`
+	ScheduleAddDescription   = `Use this command to pause or disconnect the VPN connection during a recurring window.
+
+Supported values for the action:
+	pause - connection is paused during the window
+	disconnect - VPN is disconnected during the window
+
+Start and end of the window are in HH:MM format in the local time zone. A window which ends
+before it starts spans over midnight.
+
+Example: 'nordvpn schedule add pause 12:00 12:30 --days weekdays'
+Example: 'nordvpn schedule add disconnect 01:00 05:00'
+
+Notes:
+  The VPN connection is restored once the window ends.
+  Nothing happens if VPN is not connected when the window starts.`
+
+	ScheduleRemoveUsageText     = "Removes a schedule rule"
+	ScheduleRemoveArgsUsageText = `<id>`
+	ScheduleRemoveDescription   = `Use this command to remove a schedule rule. Rule IDs are shown by 'nordvpn schedule list'.
+
+Example: 'nordvpn schedule remove 1'
+
+Notes:
+  If the window of the removed rule is in progress, the VPN connection is restored.`
+
+	ScheduleDaysUsage = "Days of the week when the window starts: 'daily', 'weekdays', 'weekends', " +
+		"or a comma separated list of days and day ranges, e.g. 'mon-fri' or 'mon,wed,fri'"
+)
+
+const (
+	flagScheduleDays = "days"
+
+	scheduleDaysDaily    = "daily"
+	scheduleDaysWeekdays = "weekdays"
+	scheduleDaysWeekends = "weekends"
+)
+
+var (
+	weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	weekends = []time.Weekday{time.Sunday, time.Saturday}
+)
+
+func scheduleCommand(c *cmd) *cli.Command {
+	return &cli.Command{
+		Name:  "schedule",
+		Usage: ScheduleUsageText,
+		Subcommands: []*cli.Command{
+			{
+				Name:         "add",
+				Usage:        ScheduleAddUsageText,
+				Action:       c.ScheduleAdd,
+				BashComplete: c.ScheduleAddAutoComplete,
+				ArgsUsage:    ScheduleAddArgsUsageText,
+				Description:  ScheduleAddDescription,
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: flagScheduleDays, Usage: ScheduleDaysUsage, Value: scheduleDaysDaily},
+				},
+			},
+			{
+				Name:         "remove",
+				Usage:        ScheduleRemoveUsageText,
+				Action:       c.ScheduleRemove,
+				BashComplete: c.ScheduleRemoveAutoComplete,
+				ArgsUsage:    ScheduleRemoveArgsUsageText,
+				Description:  ScheduleRemoveDescription,
+			},
+			{
+				Name:               "list",
+				Usage:              ScheduleListUsage,
+				Action:             c.ScheduleList,
+				CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			},
+		},
+	}
+}
+
+func (c *cmd) ScheduleAdd(ctx *cli.Context) error {
+	if ctx.Args().Len() != 3 {
+		return formatError(argsCountError(ctx))
+	}
+
+	action, ok := pb.ScheduleAction_value["SCHEDULE_"+strings.ToUpper(ctx.Args().First())]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	days, err := parseScheduleDays(ctx.String(flagScheduleDays))
+	if err != nil {
+		return formatError(fmt.Errorf(ScheduleInvalidDays, ctx.String(flagScheduleDays)))
+	}
+
+	rule := &pb.ScheduleRule{
+		Action: pb.ScheduleAction(action),
+		Days:   days,
+		Start:  ctx.Args().Get(1),
+		End:    ctx.Args().Get(2),
+	}
+	resp, err := c.client.AddScheduleRule(context.Background(), &pb.AddScheduleRuleRequest{Rule: rule})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeScheduleInvalidRule:
+		return formatError(errors.New(ScheduleInvalidRule))
+	case internal.CodeScheduleRuleNoop:
+		return formatError(errors.New(ScheduleAddExistsError))
+	case internal.CodeSuccess:
+		rule.Id = strings.Join(resp.Data, "")
+		color.Green(ScheduleAddSuccess, rule.Id, scheduleRuleLabel(rule))
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
+
+func (c *cmd) ScheduleAddAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for _, action := range []pb.ScheduleAction{pb.ScheduleAction_SCHEDULE_PAUSE, pb.ScheduleAction_SCHEDULE_DISCONNECT} {
+		fmt.Println(scheduleActionLabel(action))
+	}
+}
+
+func (c *cmd) ScheduleRemove(ctx *cli.Context) error {
+	if ctx.Args().Len() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	id := ctx.Args().First()
+	resp, err := c.client.RemoveScheduleRule(context.Background(), &pb.RemoveScheduleRuleRequest{Id: id})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeScheduleRuleNoop:
+		return formatError(fmt.Errorf(ScheduleRemoveNotFound, id))
+	case internal.CodeSuccess:
+		color.Green(ScheduleRemoveSuccess, id)
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
+
+func (c *cmd) ScheduleRemoveAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	settings, err := c.getSettings()
+	if err != nil {
+		return
+	}
+	for _, rule := range settings.GetScheduleRules() {
+		fmt.Println(rule.GetId())
+	}
+}
+
+func (c *cmd) ScheduleList(ctx *cli.Context) error {
+	settings, err := c.getSettings()
+	if err != nil {
+		return formatError(err)
+	}
+
+	if len(settings.GetScheduleRules()) == 0 {
+		fmt.Println(ScheduleListEmpty)
+		return nil
+	}
+	for _, rule := range settings.GetScheduleRules() {
+		fmt.Printf("%s: %s\n", rule.GetId(), scheduleRuleLabel(rule))
+	}
+	return nil
+}
+
+// scheduleRuleLabel returns human readable schedule rule, e.g. "pause 12:00-12:30 on weekdays"
+func scheduleRuleLabel(rule *pb.ScheduleRule) string {
+	return fmt.Sprintf("%s %s-%s %s",
+		scheduleActionLabel(rule.GetAction()), rule.GetStart(), rule.GetEnd(), scheduleDaysLabel(rule.GetDays()))
+}
+
+func scheduleActionLabel(action pb.ScheduleAction) string {
+	return strings.ToLower(strings.TrimPrefix(action.String(), "SCHEDULE_"))
+}
+
+func scheduleDaysLabel(days []uint32) string {
+	weekDays := toWeekdays(days)
+	switch {
+	case len(weekDays) == 0:
+		return scheduleDaysDaily
+	case slices.Equal(weekDays, weekdays):
+		return "on " + scheduleDaysWeekdays
+	case slices.Equal(weekDays, weekends):
+		return "on " + scheduleDaysWeekends
+	}
+
+	names := make([]string, 0, len(weekDays))
+	for _, day := range weekDays {
+		names = append(names, day.String()[:3])
+	}
+	return "on " + strings.Join(names, ", ")
+}
+
+func toWeekdays(days []uint32) []time.Weekday {
+	weekDays := make([]time.Weekday, 0, len(days))
+	for _, day := range days {
+		weekDays = append(weekDays, time.Weekday(day))
+	}
+	slices.Sort(weekDays)
+	return slices.Compact(weekDays)
+}
+
+// parseScheduleDays parses days of the week given by the user. Empty list is returned for every day.
+func parseScheduleDays(value string) ([]uint32, error) {
+	var days []time.Weekday
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", scheduleDaysDaily:
+		return nil, nil
+	case scheduleDaysWeekdays:
+		days = weekdays
+	case scheduleDaysWeekends:
+		days = weekends
+	default:
+		for _, item := range strings.Split(value, ",") {
+			from, to, isRange := strings.Cut(item, "-")
+			first, err := parseWeekday(from)
+			if err != nil {
+				return nil, err
+			}
+			last := first
+			if isRange {
+				if last, err = parseWeekday(to); err != nil {
+					return nil, err
+				}
+			}
+			// ranges may wrap around the end of the week, e.g. fri-mon
+			for day := first; ; day = (day + 1) % 7 {
+				days = append(days, day)
+				if day == last {
+					break
+				}
+			}
+		}
+	}
+
+	result := make([]uint32, 0, len(days))
+	for _, day := range days {
+		result = append(result, uint32(day)) // #nosec G115 -- weekday is in range
+	}
+	slices.Sort(result)
+	return slices.Compact(result), nil
+}
+
+func parseWeekday(value string) (time.Weekday, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if len(value) >= 3 {
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.HasPrefix(strings.ToLower(day.String()), value) {
+				return day, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unknown day: %s", value)
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScheduleDays(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected []uint32
+		isError  bool
+	}{
+		{value: "", expected: nil},
+		{value: "daily", expected: nil},
+		{value: "weekdays", expected: []uint32{1, 2, 3, 4, 5}},
+		{value: "Weekends", expected: []uint32{0, 6}},
+		{value: "mon-fri", expected: []uint32{1, 2, 3, 4, 5}},
+		{value: "mon,wed,friday", expected: []uint32{1, 3, 5}},
+		{value: "fri-mon", expected: []uint32{0, 1, 5, 6}},
+		{value: "tue,tue-wed", expected: []uint32{2, 3}},
+		{value: "mo", isError: true},
+		{value: "mon-", isError: true},
+		{value: "funday", isError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			days, err := parseScheduleDays(test.value)
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, days)
+		})
+	}
+}
+
+func TestScheduleRuleLabel(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     *pb.ScheduleRule
+		expected string
+	}{
+		{
+			name: "weekdays",
+			rule: &pb.ScheduleRule{
+				Action: pb.ScheduleAction_SCHEDULE_PAUSE,
+				Days:   []uint32{1, 2, 3, 4, 5},
+				Start:  "12:00",
+				End:    "12:30",
+			},
+			expected: "pause 12:00-12:30 on weekdays",
+		},
+		{
+			name:     "daily",
+			rule:     &pb.ScheduleRule{Action: pb.ScheduleAction_SCHEDULE_DISCONNECT, Start: "01:00", End: "05:00"},
+			expected: "disconnect 01:00-05:00 daily",
+		},
+		{
+			name: "selected days",
+			rule: &pb.ScheduleRule{
+				Action: pb.ScheduleAction_SCHEDULE_DISCONNECT,
+				Days:   []uint32{5, 1},
+				Start:  "23:00",
+				End:    "02:00",
+			},
+			expected: "disconnect 23:00-02:00 on Mon, Fri",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, scheduleRuleLabel(test.rule))
+		})
+	}
+}
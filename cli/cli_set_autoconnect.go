@@ -62,6 +62,8 @@ func (c *cmd) SetAutoConnect(ctx *cli.Context) error {
 	}
 
 	switch resp.Type {
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
 	case internal.CodeConfigError:
 		return formatError(ErrConfig)
 	case internal.CodeFailure, internal.CodeEmptyPayloadError:
@@ -26,12 +26,18 @@ Example: nordvpn set dns off
 Arguments <servers> is a list of IP addresses separated by space
 Example: nordvpn set dns 1.1.1.1 1.0.0.1
 
+Arguments <servers> can also be DNS-over-HTTPS or DNS-over-TLS endpoints. The queries are
+then resolved by a local stub resolver and forwarded to the endpoints over an encrypted connection.
+Example: nordvpn set dns https://1.1.1.1/dns-query tls://9.9.9.9
+
 Limits:
   Can set up to 3 DNS servers
   Can set only IPv4 servers addresses
+  Encrypted endpoints must use IP addresses and can not be mixed with plain DNS servers
 
 Notes:
-  Setting DNS disables ThreatProtectionLite`
+  Setting DNS disables ThreatProtectionLite
+  While encrypted DNS is set, kill switch also blocks unencrypted DNS`
 )
 
 func setDNSCommonErrorCodeToError(code pb.SetErrorCode, args ...any) error {
@@ -40,6 +46,8 @@ func setDNSCommonErrorCodeToError(code pb.SetErrorCode, args ...any) error {
 		return formatError(internal.ErrUnhandled)
 	case pb.SetErrorCode_CONFIG_ERROR:
 		return formatError(ErrConfig)
+	case pb.SetErrorCode_POLICY_LOCKED:
+		return formatError(ErrPolicyLocked)
 	case pb.SetErrorCode_ALREADY_SET:
 		return errors.New(color.YellowString(fmt.Sprintf(SetDNSAlreadySet, args...)))
 	}
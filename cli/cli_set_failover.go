@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set failover help text
+const (
+	SetFailoverUsageText   = "Enables or disables automatic reconnecting to a different server when the connection degrades"
+	SetFailoverDescription = `Checks the active connection every 30 seconds. The connection is considered unhealthy when the WireGuard
+handshake is too old (NordLynx only) or when the DNS server inside the tunnel does not answer or answers too slowly.
+After 3 unhealthy checks in a row, the app reconnects to a different server with the same connection parameters.
+Thresholds are kept when not set, use 0 to reset them to defaults.
+
+Supported values for <disabled>: 0, false, disable, off, disabled
+Example: nordvpn set failover off
+
+Supported values for <enabled>: 1, true, enable, on, enabled
+Example: nordvpn set failover on
+Example: nordvpn set failover on --max-packet-loss 30 --max-latency 1s`
+	SetFailoverFlagHandshakeAgeUsageText = "Oldest accepted WireGuard handshake, at least 3m"
+	SetFailoverFlagPacketLossUsageText   = "Highest accepted percentage of unanswered DNS probes"
+	SetFailoverFlagLatencyUsageText      = "Highest accepted average DNS round trip time, at most 5s"
+	flagFailoverMaxHandshakeAge          = "max-handshake-age"
+	flagFailoverMaxPacketLoss            = "max-packet-loss"
+	flagFailoverMaxLatency               = "max-latency"
+)
+
+func (c *cmd) SetFailover(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	req := &pb.SetFailoverRequest{Enabled: flag}
+	if ctx.IsSet(flagFailoverMaxHandshakeAge) {
+		seconds := int64(ctx.Duration(flagFailoverMaxHandshakeAge) / time.Second)
+		req.MaxHandshakeAgeSec = &seconds
+	}
+	if ctx.IsSet(flagFailoverMaxPacketLoss) {
+		loss := uint32(ctx.Uint(flagFailoverMaxPacketLoss))
+		req.MaxPacketLoss = &loss
+	}
+	if ctx.IsSet(flagFailoverMaxLatency) {
+		milliseconds := ctx.Duration(flagFailoverMaxLatency).Milliseconds()
+		req.MaxLatencyMs = &milliseconds
+	}
+
+	resp, err := c.client.SetFailover(context.Background(), req)
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFailoverInvalidThreshold:
+		return formatError(newCodedError(resp.Type, fmt.Errorf(SetFailoverInvalidThreshold,
+			config.MaxFailoverLatency)))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Failover", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Failover", nstrings.GetBoolLabel(flag)))
+	}
+	return nil
+}
+
+// failoverLabel returns the thresholds of the enabled failover
+func failoverLabel(failover *pb.FailoverSettings) string {
+	return fmt.Sprintf("%s (handshake age %s, packet loss %d%%, latency %s)",
+		nstrings.GetBoolLabel(true),
+		time.Duration(failover.GetMaxHandshakeAgeSec())*time.Second,
+		failover.GetMaxPacketLoss(),
+		time.Duration(failover.GetMaxLatencyMs())*time.Millisecond,
+	)
+}
@@ -33,6 +33,8 @@ func (c *cmd) SetKillSwitch(ctx *cli.Context) error {
 	}
 
 	switch resp.Type {
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
 	case internal.CodeConfigError:
 		return formatError(ErrConfig)
 	case internal.CodeVPNMisconfig, internal.CodeKillSwitchError, internal.CodeFailure:
@@ -18,6 +18,8 @@ func SetLANDiscoveryErrorCodeToError(code pb.SetErrorCode, flag bool) error {
 		return formatError(internal.ErrUnhandled)
 	case pb.SetErrorCode_CONFIG_ERROR:
 		return formatError(ErrConfig)
+	case pb.SetErrorCode_POLICY_LOCKED:
+		return formatError(ErrPolicyLocked)
 	case pb.SetErrorCode_ALREADY_SET:
 		return errors.New(color.YellowString(fmt.Sprintf(SetLANDiscoveryAlreadyEnabled, nstrings.GetBoolLabel(flag))))
 	}
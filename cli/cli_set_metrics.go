@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set metrics help text
+const (
+	SetMetricsUsageText   = "Enables or disables the local metrics endpoint for monitoring tools such as Prometheus"
+	SetMetricsDescription = `Serves connection, meshnet and fileshare metrics in the OpenMetrics format on the default unix socket or a loopback port.
+Metrics are available at the ` + "`/metrics`" + ` path. The default socket is accessible to the nordvpn group members.
+
+Supported values for <disabled>: 0, false, disable, off, disabled
+Example: nordvpn set metrics off
+
+Supported values for <enabled>: 1, true, enable, on, enabled
+Example: nordvpn set metrics on
+Example: nordvpn set metrics on --listen 127.0.0.1:9188`
+	SetMetricsFlagListenUsageText = "Loopback address with port to serve the metrics on instead of the default unix socket"
+	flagMetricsListen             = "listen"
+)
+
+func (c *cmd) SetMetrics(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetMetrics(context.Background(), &pb.SetMetricsRequest{
+		Enabled: flag,
+		Listen:  ctx.String(flagMetricsListen),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFeatureHidden:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeMetricsInvalidListen:
+		return formatError(fmt.Errorf(SetMetricsInvalidListen, ctx.String(flagMetricsListen)))
+	case internal.CodeMetricsListenFailed:
+		address := ctx.String(flagMetricsListen)
+		if len(resp.Data) > 0 {
+			address = resp.Data[0]
+		}
+		return formatError(fmt.Errorf(SetMetricsListenFailed, address))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Metrics", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Metrics", nstrings.GetBoolLabel(flag)))
+		if flag && len(resp.Data) > 0 {
+			color.Green(fmt.Sprintf(SetMetricsServing, resp.Data[0]))
+		}
+	}
+	return nil
+}
@@ -41,6 +41,8 @@ func (c *cmd) SetObfuscate(ctx *cli.Context) error {
 	case internal.CodeNothingToDo:
 		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Obfuscation", nstrings.GetBoolLabel(flag)))
 		return nil
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
 	case internal.CodeConfigError:
 		return formatError(ErrConfig)
 	case internal.CodeAutoConnectServerNotObfuscated:
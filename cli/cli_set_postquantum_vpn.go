@@ -32,6 +32,8 @@ func (c *cmd) SetPostquantumVpn(ctx *cli.Context) error {
 	case internal.CodeNothingToDo:
 		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Post-quantum VPN", nstrings.GetBoolLabel(flag)))
 		return nil
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
 	case internal.CodeConfigError:
 		return formatError(ErrConfig)
 	case internal.CodePqAndMeshnetSimultaneously:
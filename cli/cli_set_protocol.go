@@ -30,6 +30,8 @@ func setProtocolCommonErrorCodeToError(code pb.SetErrorCode, args ...any) error
 		return formatError(internal.ErrUnhandled)
 	case pb.SetErrorCode_CONFIG_ERROR:
 		return formatError(ErrConfig)
+	case pb.SetErrorCode_POLICY_LOCKED:
+		return formatError(ErrPolicyLocked)
 	case pb.SetErrorCode_ALREADY_SET:
 		return formatError(
 			errors.New(color.YellowString(fmt.Sprintf(SetProtocolAlreadySet, args...))))
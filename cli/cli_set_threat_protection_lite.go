@@ -34,6 +34,8 @@ func setTPLErrorCodeToError(code pb.SetErrorCode, args ...any) error {
 		return formatError(internal.ErrUnhandled)
 	case pb.SetErrorCode_CONFIG_ERROR:
 		return formatError(ErrConfig)
+	case pb.SetErrorCode_POLICY_LOCKED:
+		return formatError(ErrPolicyLocked)
 	case pb.SetErrorCode_ALREADY_SET:
 		color.Yellow(fmt.Sprintf(SetThreatProtectionLiteAlreadySet, args...))
 		return nil
@@ -27,6 +27,8 @@ func (c *cmd) SetVirtualLocation(ctx *cli.Context) error {
 	}
 
 	switch response.Type {
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
 	case internal.CodeConfigError:
 		return formatError(ErrConfig)
 	case internal.CodeNothingToDo:
@@ -31,24 +31,29 @@ func (c *cmd) Settings(ctx *cli.Context) error {
 	if err != nil {
 		return formatError(err)
 	}
+	if c.output.isStructured() {
+		return c.printOutput(settings)
+	}
 	meshEnabled := isMeshnetEnabled(c)
+	locked := func(setting string) string { return lockedSettingLabel(settings.GetLockedSettings(), setting) }
 
-	fmt.Printf("Technology: %s\n", settings.GetTechnology())
+	fmt.Printf("Technology: %s%s\n", settings.GetTechnology(), locked("technology"))
 	if settings.Technology == config.Technology_OPENVPN {
-		fmt.Printf("Protocol: %s\n", settings.GetProtocol())
+		fmt.Printf("Protocol: %s%s\n", settings.GetProtocol(), locked("protocol"))
 	}
-	fmt.Printf("Firewall: %+v\n", nstrings.GetBoolLabel(settings.GetFirewall()))
-	fmt.Printf("Firewall Mark: 0x%x\n", settings.GetFwmark())
-	fmt.Printf("Routing: %+v\n", nstrings.GetBoolLabel(settings.GetRouting()))
+	fmt.Printf("Firewall: %+v%s\n", nstrings.GetBoolLabel(settings.GetFirewall()), locked("firewall"))
+	fmt.Printf("Firewall Mark: 0x%x%s\n", settings.GetFwmark(), locked("fwmark"))
+	fmt.Printf("Routing: %+v%s\n", nstrings.GetBoolLabel(settings.GetRouting()), locked("routing"))
 	fmt.Printf("User Consent: %s\n", nstrings.UserConsent(settings.AnalyticsConsent))
-	fmt.Printf("Kill Switch: %+v\n", nstrings.GetBoolLabel(settings.GetKillSwitch()))
-	fmt.Printf("Threat Protection Lite: %+v\n", nstrings.GetBoolLabel(settings.ThreatProtectionLite))
+	fmt.Printf("Kill Switch: %+v%s\n", nstrings.GetBoolLabel(settings.GetKillSwitch()), locked("killswitch"))
+	fmt.Printf("Threat Protection Lite: %+v%s\n",
+		nstrings.GetBoolLabel(settings.ThreatProtectionLite), locked("threat_protection_lite"))
 	if settings.Technology == config.Technology_OPENVPN {
-		fmt.Printf("Obfuscate: %+v\n", nstrings.GetBoolLabel(settings.GetObfuscate()))
+		fmt.Printf("Obfuscate: %+v%s\n", nstrings.GetBoolLabel(settings.GetObfuscate()), locked("obfuscate"))
 	}
 	fmt.Printf("Notify: %+v\n", nstrings.GetBoolLabel(settings.UserSettings.Notify))
 	fmt.Printf("Tray: %+v\n", nstrings.GetBoolLabel(settings.UserSettings.Tray))
-	fmt.Printf("Auto-connect: %+v\n", nstrings.GetBoolLabel(settings.AutoConnectData.Enabled))
+	fmt.Printf("Auto-connect: %+v%s\n", nstrings.GetBoolLabel(settings.AutoConnectData.Enabled), locked("autoconnect"))
 	if settings.AutoConnectData.Enabled && internal.IsDevEnv(string(c.environment)) {
 		fmt.Printf("Auto-connect country: %s\n", settings.AutoConnectData.Country)
 		fmt.Printf("Auto-connect city: %s\n", settings.AutoConnectData.City)
@@ -60,24 +65,56 @@ func (c *cmd) Settings(ctx *cli.Context) error {
 	}
 
 	if len(settings.Dns) == 0 {
-		fmt.Printf("DNS: %+v\n", nstrings.GetBoolLabel(false))
+		fmt.Printf("DNS: %+v%s\n", nstrings.GetBoolLabel(false), locked("dns"))
 	} else {
-		fmt.Printf("DNS: %+v\n", strings.Join(settings.Dns, ", "))
+		fmt.Printf("DNS: %+v%s\n", strings.Join(settings.Dns, ", "), locked("dns"))
 	}
-	fmt.Printf("LAN Discovery: %+v\n", nstrings.GetBoolLabel(settings.LanDiscovery))
-	fmt.Printf("Virtual Location: %+v\n", nstrings.GetBoolLabel(settings.VirtualLocation))
+	displayDNSRoutes(settings.GetDnsRoutes())
+	fmt.Printf("LAN Discovery: %+v%s\n", nstrings.GetBoolLabel(settings.LanDiscovery), locked("lan_discovery"))
+	fmt.Printf("Virtual Location: %+v%s\n", nstrings.GetBoolLabel(settings.VirtualLocation), locked("virtual_location"))
 	if settings.Technology == config.Technology_NORDLYNX {
-		fmt.Printf("Post-quantum VPN: %+v\n", nstrings.GetBoolLabel(settings.PostquantumVpn))
+		fmt.Printf("Post-quantum VPN: %+v%s\n", nstrings.GetBoolLabel(settings.PostquantumVpn), locked("post_quantum"))
 	}
 	if settings.Technology == config.Technology_NORDWHISPER {
-		fmt.Printf("ECH: %+v\n", nstrings.GetBoolLabel(settings.Ech))
+		fmt.Printf("ECH: %+v%s\n", nstrings.GetBoolLabel(settings.Ech), locked("ech"))
 	}
-	fmt.Printf("ARP Ignore: %+v\n", nstrings.GetBoolLabel(settings.ArpIgnore))
+	fmt.Printf("ARP Ignore: %+v%s\n", nstrings.GetBoolLabel(settings.ArpIgnore), locked("arp_ignore"))
 
+	if locked("allowlist") != "" {
+		fmt.Printf("Allowlist:%s\n", locked("allowlist"))
+	}
 	displayAllowlist(settings.Allowlist)
+	if settings.GetSplitTunnelMode() == pb.SplitTunnelMode_INCLUDE || len(settings.GetSplitTunnelApps()) > 0 {
+		fmt.Printf("Split tunneling mode: %s%s\n", splitTunnelModeLabel(settings.GetSplitTunnelMode()), locked("split_tunnel"))
+	}
+	displaySplitTunnelApps(settings.GetSplitTunnelApps())
+	if len(settings.GetScheduleRules()) > 0 {
+		fmt.Printf("Schedule:\n")
+		for _, rule := range settings.GetScheduleRules() {
+			fmt.Printf("\t%s: %s\n", rule.GetId(), scheduleRuleLabel(rule))
+		}
+	}
+	if settings.GetTrustedNetworksConnectUntrusted() {
+		fmt.Printf("Connect on untrusted networks: %s\n", nstrings.GetBoolLabel(true))
+	}
+	displayTrustedNetworks(settings.GetTrustedNetworks())
+	if settings.GetMetrics() {
+		fmt.Printf("Metrics: %s (%s)\n", nstrings.GetBoolLabel(true), settings.GetMetricsListen())
+	}
+	if settings.GetFailover().GetEnabled() {
+		fmt.Printf("Failover: %s\n", failoverLabel(settings.GetFailover()))
+	}
 	return nil
 }
 
+// lockedSettingLabel returns the label marking the setting locked by the policy file
+func lockedSettingLabel(lockedSettings []string, setting string) string {
+	if slices.Contains(lockedSettings, setting) {
+		return " " + SettingLockedLabel
+	}
+	return ""
+}
+
 func (c *cmd) getSettings() (*pb.Settings, error) {
 	resp, err := c.client.Settings(context.Background(), &pb.Empty{})
 	if err != nil {
@@ -89,7 +126,7 @@ func (c *cmd) getSettings() (*pb.Settings, error) {
 	case internal.CodeSuccess:
 		return resp.GetData(), nil
 	default:
-		return nil, internal.ErrUnhandled
+		return nil, newCodedError(resp.Type, internal.ErrUnhandled)
 	}
 }
 
@@ -145,5 +182,12 @@ func displayAllowlist(allowlist *pb.Allowlist) {
 				fmt.Printf("\t%s\n", subnet)
 			}
 		}
+		domains := allowlist.GetDomains()
+		if len(domains) > 0 {
+			fmt.Printf("Allowlisted domains:\n")
+			for _, domain := range domains {
+				fmt.Printf("\t%s\n", domain)
+			}
+		}
 	}
 }
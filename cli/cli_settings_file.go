@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Settings file help text
+const (
+	SettingsExportUsageText   = "Prints the settings in the YAML format"
+	SettingsExportDescription = `Use this command to save the settings to a file, which can be applied on other devices.
+When Meshnet is on, the permissions granted to the Meshnet peers are exported too.
+Login data, device identifiers and per user settings are not exported.
+
+Example: 'nordvpn settings export > settings.yaml'`
+	SettingsApplyUsageText     = "Applies the settings from a YAML file"
+	SettingsApplyArgsUsageText = "<file>"
+	SettingsApplyDescription   = `Use this command to apply all of the settings from a file at once. Settings which are
+not present in the file are left unchanged. Meshnet peer permissions require Meshnet to be on,
+the entry of this device is skipped. Use '-' to read the file from the standard input.
+
+Example: 'nordvpn settings apply settings.yaml'
+Example: 'nordvpn settings apply --dry-run settings.yaml'`
+	SettingsApplyDryRunUsage = "Show the changes without applying them"
+)
+
+const flagSettingsDryRun = "dry-run"
+
+func settingsCommand(c *cmd) *cli.Command {
+	return &cli.Command{
+		Name:               "settings",
+		Usage:              SettingsUsageText,
+		Action:             c.Settings,
+		CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+		Subcommands: []*cli.Command{
+			{
+				Name:               "export",
+				Usage:              SettingsExportUsageText,
+				Description:        SettingsExportDescription,
+				Action:             c.SettingsExport,
+				CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			},
+			{
+				Name:        "apply",
+				Usage:       SettingsApplyUsageText,
+				ArgsUsage:   SettingsApplyArgsUsageText,
+				Description: SettingsApplyDescription,
+				Action:      c.SettingsApply,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{Name: flagSettingsDryRun, Usage: SettingsApplyDryRunUsage},
+				},
+			},
+		},
+	}
+}
+
+func (c *cmd) SettingsExport(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	resp, err := c.client.ExportSettings(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	switch resp.Type {
+	case internal.CodeSuccess:
+		fmt.Print(resp.GetDocument())
+		return nil
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	default:
+		return formatError(newCodedError(resp.Type, internal.ErrUnhandled))
+	}
+}
+
+func (c *cmd) SettingsApply(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	document, err := readSettingsFile(ctx.Args().First())
+	if err != nil {
+		return formatError(fmt.Errorf(SettingsApplyReadFailed, err))
+	}
+
+	dryRun := ctx.Bool(flagSettingsDryRun)
+	resp, err := c.client.ApplySettings(context.Background(), &pb.ApplySettingsRequest{
+		Document: string(document),
+		DryRun:   dryRun,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeSuccess:
+		if c.output.isStructured() {
+			return c.printOutput(resp)
+		}
+		if dryRun {
+			color.Yellow(SettingsApplyDryRun)
+		} else {
+			color.Green(SettingsApplySuccess)
+		}
+		fmt.Println(settingsChangesToString(resp.GetChanges()))
+	case internal.CodeNothingToDo:
+		if c.output.isStructured() {
+			return c.printOutput(resp)
+		}
+		color.Yellow(SettingsApplyNothingToDo)
+	case internal.CodeSettingsNotApplied:
+		if !c.output.isStructured() {
+			fmt.Println(settingsChangesToString(resp.GetChanges()))
+		}
+		return formatError(newCodedError(resp.Type, errors.New(SettingsApplyNotApplied)))
+	case internal.CodeSettingsFileInvalid:
+		return formatError(newCodedError(resp.Type, errors.New(resp.GetError())))
+	case internal.CodePolicyLocked:
+		return formatError(newCodedError(resp.Type,
+			fmt.Errorf(SettingsApplyPolicyLocked, strings.Join(resp.GetLockedSettings(), ", "))))
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFeatureHidden:
+		return formatError(newCodedError(resp.Type, errors.New(SettingsApplyFeatureUnavailable)))
+	case internal.CodeDedicatedServersNoNordlynx:
+		return formatError(newCodedError(resp.Type, errors.New(DedicatedServersAutoconnectNordlynxMessage)))
+	default:
+		return formatError(newCodedError(resp.Type, errors.New(SettingsApplyAutoConnectFailed)))
+	}
+	return nil
+}
+
+// readSettingsFile reads the file at the given path or the standard input for "-"
+func readSettingsFile(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+func settingsChangesToString(changes []string) string {
+	var b strings.Builder
+	for _, change := range changes {
+		b.WriteString("  " + change + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
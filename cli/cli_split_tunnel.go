@@ -0,0 +1,365 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Split tunnel help text
+const (
+	SplitTunnelUsageText = "Routes the traffic of selected apps outside of the VPN tunnel or only the traffic of selected apps through it"
+	SplitTunnelListUsage = "Lists the apps added to split tunneling"
+
+	SplitTunnelModeUsageText     = "Sets split tunneling mode"
+	SplitTunnelModeArgsUsageText = `<exclude|include>`
+	SplitTunnelModeDescription   = `Use this command to choose how the apps added to split tunneling are handled.
+
+Supported values for <mode>:
+	exclude - traffic of the added apps goes outside of the VPN tunnel, all other traffic goes through it (default)
+	include - only traffic of the added apps goes through the VPN tunnel, all other traffic goes outside of it
+
+Example: 'nordvpn split-tunnel mode include'
+
+Notes:
+  In include mode, system DNS settings are not changed when connecting to VPN.
+  Kill switch blocks only the traffic of the added apps when VPN is not connected.`
+
+	SplitTunnelAddPathUsageText     = "Excludes an executable from the VPN tunnel"
+	SplitTunnelAddPathArgsUsageText = `<path>`
+	SplitTunnelAddPathDescription   = `Use this command to exclude all processes started from the given executable from the VPN tunnel.
+
+Example: 'nordvpn split-tunnel add path /usr/bin/curl'
+
+Notes:
+  Traffic of the excluded apps is still blocked by the kill switch when VPN is not connected,
+  unless --killswitch-exempt flag is used.
+  Connections opened before the app was excluded keep using the VPN tunnel.`
+
+	SplitTunnelAddPIDUsageText     = "Excludes a running process and its children from the VPN tunnel"
+	SplitTunnelAddPIDArgsUsageText = `<pid>`
+	SplitTunnelAddPIDDescription   = `Use this command to exclude a running process and its child processes from the VPN tunnel.
+
+Example: 'nordvpn split-tunnel add pid 4242'
+
+Notes:
+  The process is removed from split tunneling automatically once it exits.`
+
+	SplitTunnelAddCgroupUsageText     = "Excludes a cgroup from the VPN tunnel"
+	SplitTunnelAddCgroupArgsUsageText = `<cgroup>`
+	SplitTunnelAddCgroupDescription   = `Use this command to exclude all processes of a cgroup v2 from the VPN tunnel.
+
+Example: 'nordvpn split-tunnel add cgroup /user.slice/user-1000.slice/app-firefox.scope'
+
+Notes:
+  Cgroup path is relative to the cgroup v2 mount point (/sys/fs/cgroup).`
+
+	SplitTunnelRemoveUsageText = "Removes an app from split tunneling"
+
+	SplitTunnelKillSwitchExemptUsage = "Allow the app to reach the network even when kill switch blocks the traffic"
+)
+
+const (
+	flagKillSwitchExempt = "killswitch-exempt"
+	cgroupMountPoint     = "/sys/fs/cgroup"
+)
+
+func splitTunnelCommand(c *cmd) *cli.Command {
+	exemptFlag := []cli.Flag{&cli.BoolFlag{Name: flagKillSwitchExempt, Usage: SplitTunnelKillSwitchExemptUsage}}
+	return &cli.Command{
+		Name:  "split-tunnel",
+		Usage: SplitTunnelUsageText,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "add",
+				Usage: "Excludes an app from the VPN tunnel",
+				Subcommands: []*cli.Command{
+					{
+						Name:        "path",
+						Usage:       SplitTunnelAddPathUsageText,
+						Action:      c.SplitTunnelAdd(pb.SplitTunnelAppType_PATH),
+						ArgsUsage:   SplitTunnelAddPathArgsUsageText,
+						Description: SplitTunnelAddPathDescription,
+						Flags:       exemptFlag,
+					},
+					{
+						Name:        "pid",
+						Usage:       SplitTunnelAddPIDUsageText,
+						Action:      c.SplitTunnelAdd(pb.SplitTunnelAppType_PID),
+						ArgsUsage:   SplitTunnelAddPIDArgsUsageText,
+						Description: SplitTunnelAddPIDDescription,
+						Flags:       exemptFlag,
+					},
+					{
+						Name:        "cgroup",
+						Usage:       SplitTunnelAddCgroupUsageText,
+						Action:      c.SplitTunnelAdd(pb.SplitTunnelAppType_CGROUP),
+						ArgsUsage:   SplitTunnelAddCgroupArgsUsageText,
+						Description: SplitTunnelAddCgroupDescription,
+						Flags:       exemptFlag,
+					},
+				},
+			},
+			{
+				Name:  "remove",
+				Usage: SplitTunnelRemoveUsageText,
+				Subcommands: []*cli.Command{
+					{
+						Name:      "path",
+						Usage:     SplitTunnelRemoveUsageText,
+						Action:    c.SplitTunnelRemove(pb.SplitTunnelAppType_PATH),
+						ArgsUsage: SplitTunnelAddPathArgsUsageText,
+					},
+					{
+						Name:      "pid",
+						Usage:     SplitTunnelRemoveUsageText,
+						Action:    c.SplitTunnelRemove(pb.SplitTunnelAppType_PID),
+						ArgsUsage: SplitTunnelAddPIDArgsUsageText,
+					},
+					{
+						Name:      "cgroup",
+						Usage:     SplitTunnelRemoveUsageText,
+						Action:    c.SplitTunnelRemove(pb.SplitTunnelAppType_CGROUP),
+						ArgsUsage: SplitTunnelAddCgroupArgsUsageText,
+					},
+				},
+			},
+			{
+				Name:         "mode",
+				Usage:        SplitTunnelModeUsageText,
+				Action:       c.SplitTunnelMode,
+				ArgsUsage:    SplitTunnelModeArgsUsageText,
+				Description:  SplitTunnelModeDescription,
+				BashComplete: c.SplitTunnelModeAutoComplete,
+			},
+			{
+				Name:               "list",
+				Usage:              SplitTunnelListUsage,
+				Action:             c.SplitTunnelList,
+				CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			},
+		},
+	}
+}
+
+// SplitTunnelAdd returns action which excludes given type of app from the VPN tunnel
+func (c *cmd) SplitTunnelAdd(appType pb.SplitTunnelAppType) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		if ctx.Args().Len() != 1 {
+			return formatError(argsCountError(ctx))
+		}
+
+		value, err := normalizeSplitTunnelValue(appType, ctx.Args().First())
+		if err != nil {
+			return formatError(argsParseError(ctx))
+		}
+
+		resp, err := c.client.SetSplitTunnel(context.Background(), &pb.SetSplitTunnelRequest{
+			App: &pb.SplitTunnelApp{
+				Type:             appType,
+				Value:            value,
+				KillSwitchExempt: ctx.Bool(flagKillSwitchExempt),
+			},
+		})
+		if err != nil {
+			return formatError(err)
+		}
+
+		label := splitTunnelAppLabel(appType)
+		switch resp.Type {
+		case internal.CodePolicyLocked:
+			return formatError(ErrPolicyLocked)
+		case internal.CodeConfigError:
+			return formatError(ErrConfig)
+		case internal.CodeSplitTunnelNotSupported:
+			return formatError(errors.New(SplitTunnelNotSupported))
+		case internal.CodeSplitTunnelInvalidApp:
+			return formatError(fmt.Errorf(SplitTunnelInvalidApp, label, value))
+		case internal.CodeSplitTunnelAppNoop:
+			return formatError(fmt.Errorf(SplitTunnelAddExistsError, label, value))
+		case internal.CodeFailure:
+			return formatError(errors.New(SplitTunnelApplyError))
+		case internal.CodeSuccess:
+			color.Green(fmt.Sprintf(SplitTunnelAddSuccess, label, value))
+		default:
+			return formatError(internal.ErrUnhandled)
+		}
+		return nil
+	}
+}
+
+// SplitTunnelRemove returns action which removes given type of app from split tunneling
+func (c *cmd) SplitTunnelRemove(appType pb.SplitTunnelAppType) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		if ctx.Args().Len() != 1 {
+			return formatError(argsCountError(ctx))
+		}
+
+		value, err := normalizeSplitTunnelValue(appType, ctx.Args().First())
+		if err != nil {
+			return formatError(argsParseError(ctx))
+		}
+
+		resp, err := c.client.UnsetSplitTunnel(context.Background(), &pb.SetSplitTunnelRequest{
+			App: &pb.SplitTunnelApp{Type: appType, Value: value},
+		})
+		if err != nil {
+			return formatError(err)
+		}
+
+		label := splitTunnelAppLabel(appType)
+		switch resp.Type {
+		case internal.CodePolicyLocked:
+			return formatError(ErrPolicyLocked)
+		case internal.CodeConfigError:
+			return formatError(ErrConfig)
+		case internal.CodeSplitTunnelAppNoop, internal.CodeSplitTunnelInvalidApp:
+			return formatError(fmt.Errorf(SplitTunnelRemoveNotFound, label, value))
+		case internal.CodeFailure:
+			return formatError(errors.New(SplitTunnelApplyError))
+		case internal.CodeSuccess:
+			color.Green(fmt.Sprintf(SplitTunnelRemoveSuccess, label, value))
+		default:
+			return formatError(internal.ErrUnhandled)
+		}
+		return nil
+	}
+}
+
+// SplitTunnelMode switches between excluding and including the split tunnel apps
+func (c *cmd) SplitTunnelMode(ctx *cli.Context) error {
+	if ctx.Args().Len() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	mode, ok := pb.SplitTunnelMode_value[strings.ToUpper(ctx.Args().First())]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetSplitTunnelMode(context.Background(), &pb.SetSplitTunnelModeRequest{
+		Mode: pb.SplitTunnelMode(mode),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	name := strings.ToLower(ctx.Args().First())
+	switch resp.Type {
+	case internal.CodePolicyLocked:
+		return formatError(ErrPolicyLocked)
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeSplitTunnelNotSupported:
+		return formatError(errors.New(SplitTunnelNotSupported))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(SplitTunnelModeNothingToDo, name))
+	case internal.CodeFailure:
+		return formatError(errors.New(SplitTunnelApplyError))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(SplitTunnelModeSuccess, name))
+		if pb.SplitTunnelMode(mode) == pb.SplitTunnelMode_INCLUDE {
+			settings, err := c.getSettings()
+			if err == nil && len(settings.GetSplitTunnelApps()) == 0 {
+				color.Yellow(SplitTunnelModeIncludeEmpty)
+			}
+		}
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
+
+func (c *cmd) SplitTunnelModeAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for _, mode := range []pb.SplitTunnelMode{pb.SplitTunnelMode_EXCLUDE, pb.SplitTunnelMode_INCLUDE} {
+		fmt.Println(strings.ToLower(mode.String()))
+	}
+}
+
+func (c *cmd) SplitTunnelList(ctx *cli.Context) error {
+	settings, err := c.getSettings()
+	if err != nil {
+		return formatError(err)
+	}
+
+	fmt.Printf("Split tunneling mode: %s\n", splitTunnelModeLabel(settings.GetSplitTunnelMode()))
+	if len(settings.GetSplitTunnelApps()) == 0 {
+		fmt.Println(SplitTunnelListEmpty)
+		return nil
+	}
+	displaySplitTunnelApps(settings.GetSplitTunnelApps())
+	return nil
+}
+
+// splitTunnelModeLabel returns human readable split tunnel mode
+func splitTunnelModeLabel(mode pb.SplitTunnelMode) string {
+	if mode == pb.SplitTunnelMode_INCLUDE {
+		return "include-only"
+	}
+	return "exclude"
+}
+
+func displaySplitTunnelApps(apps []*pb.SplitTunnelApp) {
+	if len(apps) == 0 {
+		return
+	}
+	fmt.Printf("Split tunnel apps:\n")
+	for _, app := range apps {
+		line := fmt.Sprintf("\t%s: %s", strings.ToLower(app.GetType().String()), app.GetValue())
+		if app.GetKillSwitchExempt() {
+			line += fmt.Sprintf(" (%s)", SplitTunnelKillSwitchExempt)
+		}
+		fmt.Println(line)
+	}
+}
+
+// normalizeSplitTunnelValue converts the user input to the form stored by the daemon. Paths are resolved
+// relative to the current working directory, as daemon runs in a different one.
+func normalizeSplitTunnelValue(appType pb.SplitTunnelAppType, value string) (string, error) {
+	switch appType {
+	case pb.SplitTunnelAppType_PATH:
+		path, err := filepath.Abs(value)
+		if err != nil {
+			return "", err
+		}
+		// kernel reports the resolved executable path for the running processes
+		if resolved, err := filepath.EvalSymlinks(path); err == nil {
+			return resolved, nil
+		}
+		// let the daemon decide what to do with the missing executable
+		return path, nil
+	case pb.SplitTunnelAppType_PID:
+		if pid, err := strconv.Atoi(value); err != nil || pid <= 0 {
+			return "", fmt.Errorf("invalid pid: %s", value)
+		}
+		return value, nil
+	case pb.SplitTunnelAppType_CGROUP:
+		cgroup := strings.TrimPrefix(filepath.Clean(value), cgroupMountPoint)
+		return filepath.Join("/", cgroup), nil
+	}
+	return "", fmt.Errorf("unknown split tunnel app type: %s", appType)
+}
+
+func splitTunnelAppLabel(appType pb.SplitTunnelAppType) string {
+	switch appType {
+	case pb.SplitTunnelAppType_PATH:
+		return "App"
+	case pb.SplitTunnelAppType_PID:
+		return "Process"
+	case pb.SplitTunnelAppType_CGROUP:
+		return "Cgroup"
+	}
+	return ""
+}
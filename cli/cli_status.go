@@ -23,6 +23,9 @@ func (c *cmd) Status(ctx *cli.Context) error {
 	if err != nil {
 		return formatError(err)
 	}
+	if c.output.isStructured() {
+		return c.printOutput(resp)
+	}
 	fmt.Print(Status(resp))
 	return nil
 }
@@ -82,6 +85,12 @@ func Status(resp *pb.StatusResponse) string {
 		b.WriteString(fmt.Sprintf("City: %s\n", resp.City))
 	}
 
+	if entry := resp.GetEntry(); entry != nil {
+		b.WriteString(fmt.Sprintf("Entry server: %s\n", entry.Name))
+		b.WriteString(fmt.Sprintf("Entry hostname: %s\n", entry.Hostname))
+		b.WriteString(fmt.Sprintf("Entry country: %s\n", entry.Country))
+	}
+
 	if resp.Uptime != -1 {
 		b.WriteString(
 			fmt.Sprintf("Current technology: %s\n", resp.Technology.String()),
@@ -99,6 +108,10 @@ func Status(resp *pb.StatusResponse) string {
 					internal.Title(nstrings.GetBoolLabel(resp.Ech))),
 			)
 		}
+		if len(resp.OverriddenSettings) > 0 {
+			b.WriteString(fmt.Sprintf("Overridden settings: %s\n",
+				strings.Join(resp.OverriddenSettings, ", ")))
+		}
 	}
 
 	// show transfer rates only if running
@@ -114,5 +127,10 @@ func Status(resp *pb.StatusResponse) string {
 		uptime := time.Duration(resp.Uptime).Truncate(1000 * time.Millisecond)
 		b.WriteString(fmt.Sprintf("Uptime: %s\n", durafmt.Parse(uptime).String()))
 	}
+
+	if resp.SplitTunnelMode == pb.SplitTunnelMode_INCLUDE || resp.SplitTunnelApps > 0 {
+		b.WriteString(fmt.Sprintf("Split tunneling: %s (%d apps)\n",
+			splitTunnelModeLabel(resp.SplitTunnelMode), resp.SplitTunnelApps))
+	}
 	return b.String()
 }
@@ -42,6 +42,66 @@ Current protocol: UDP
 Post-quantum VPN: Disabled
 Transfer: 69 B received, 69 B sent
 Uptime: 13 seconds
+`,
+		},
+		{
+			name: "multi-hop",
+			resp: &pb.StatusResponse{
+				State:      pb.ConnectionState_CONNECTED,
+				Technology: config.Technology_NORDLYNX,
+				Protocol:   config.Protocol_UDP,
+				Hostname:   "se1.nordvpn.com",
+				Country:    "Sweden",
+				Entry: &pb.MultiHopEntry{
+					Name:     "Germany #1",
+					Hostname: "de1.nordvpn.com",
+					Country:  "Germany",
+				},
+				Uptime: 13e9,
+			},
+			expected: `Status: Connected
+Hostname: se1.nordvpn.com
+Country: Sweden
+Entry server: Germany #1
+Entry hostname: de1.nordvpn.com
+Entry country: Germany
+Current technology: NORDLYNX
+Current protocol: UDP
+Post-quantum VPN: Disabled
+Uptime: 13 seconds
+`,
+		},
+		{
+			name: "overridden settings",
+			resp: &pb.StatusResponse{
+				State:              pb.ConnectionState_CONNECTED,
+				Technology:         config.Technology_OPENVPN,
+				Protocol:           config.Protocol_TCP,
+				Hostname:           "de1.nordvpn.com",
+				Country:            "Germany",
+				Uptime:             13e9,
+				OverriddenSettings: []string{"technology", "protocol"},
+			},
+			expected: `Status: Connected
+Hostname: de1.nordvpn.com
+Country: Germany
+Current technology: OPENVPN
+Current protocol: TCP
+Post-quantum VPN: Disabled
+Overridden settings: technology, protocol
+Uptime: 13 seconds
+`,
+		},
+		{
+			name: "include-only split tunneling",
+			resp: &pb.StatusResponse{
+				State:           pb.ConnectionState_DISCONNECTED,
+				Uptime:          -1,
+				SplitTunnelMode: pb.SplitTunnelMode_INCLUDE,
+				SplitTunnelApps: 2,
+			},
+			expected: `Status: Disconnected
+Split tunneling: include-only (2 apps)
 `,
 		},
 		{
@@ -0,0 +1,291 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Trusted networks help text
+const (
+	TrustedNetworksUsageText = "Disconnects from VPN on trusted networks and connects on the untrusted ones"
+	TrustedNetworksListUsage = "Lists the trusted networks and shows the current network"
+
+	TrustedNetworksAddUsageText   = "Adds a trusted network"
+	TrustedNetworksAddDescription = `Use this command to add a network where VPN is disconnected automatically.
+A network is identified by the Wi-Fi SSID, the MAC address of the default gateway, or both.
+Without --ssid and --gateway-mac flags, the network you're connected to is added.
+
+Supported values for --action:
+	disconnect - VPN is disconnected (default)
+	meshnet - VPN is disconnected and meshnet is turned on
+
+Example: 'nordvpn trusted-networks add'
+Example: 'nordvpn trusted-networks add --ssid Office --action meshnet'
+Example: 'nordvpn trusted-networks add --gateway-mac aa:bb:cc:dd:ee:ff'
+
+Notes:
+  SSID is available only for Wi-Fi networks managed by NetworkManager.`
+
+	TrustedNetworksRemoveUsageText     = "Removes a trusted network"
+	TrustedNetworksRemoveArgsUsageText = `<id>`
+	TrustedNetworksRemoveDescription   = `Use this command to remove a trusted network. Network IDs are shown by 'nordvpn trusted-networks list'.
+
+Example: 'nordvpn trusted-networks remove 1'`
+
+	TrustedNetworksConnectUntrustedUsageText     = "Connects to VPN automatically on networks which are not trusted"
+	TrustedNetworksConnectUntrustedArgsUsageText = `<enabled>|<disabled>`
+	TrustedNetworksConnectUntrustedDescription   = `Use this command to connect to VPN automatically when you join a network which is not trusted.
+
+Example: 'nordvpn trusted-networks connect-untrusted on'`
+
+	TrustedNetworksSSIDUsage       = "Wi-Fi network name"
+	TrustedNetworksGatewayMACUsage = "MAC address of the default gateway"
+	TrustedNetworksActionUsage     = "What to do on the trusted network: 'disconnect' or 'meshnet'"
+)
+
+const (
+	flagTrustedNetworkSSID       = "ssid"
+	flagTrustedNetworkGatewayMAC = "gateway-mac"
+	flagTrustedNetworkAction     = "action"
+
+	trustedNetworkActionDisconnect = "disconnect"
+	trustedNetworkActionMeshnet    = "meshnet"
+)
+
+func trustedNetworksCommand(c *cmd) *cli.Command {
+	return &cli.Command{
+		Name:  "trusted-networks",
+		Usage: TrustedNetworksUsageText,
+		Subcommands: []*cli.Command{
+			{
+				Name:               "add",
+				Usage:              TrustedNetworksAddUsageText,
+				Action:             c.TrustedNetworksAdd,
+				Description:        TrustedNetworksAddDescription,
+				CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: flagTrustedNetworkSSID, Usage: TrustedNetworksSSIDUsage},
+					&cli.StringFlag{Name: flagTrustedNetworkGatewayMAC, Usage: TrustedNetworksGatewayMACUsage},
+					&cli.StringFlag{
+						Name:  flagTrustedNetworkAction,
+						Usage: TrustedNetworksActionUsage,
+						Value: trustedNetworkActionDisconnect,
+					},
+				},
+			},
+			{
+				Name:         "remove",
+				Usage:        TrustedNetworksRemoveUsageText,
+				Action:       c.TrustedNetworksRemove,
+				BashComplete: c.TrustedNetworksRemoveAutoComplete,
+				ArgsUsage:    TrustedNetworksRemoveArgsUsageText,
+				Description:  TrustedNetworksRemoveDescription,
+			},
+			{
+				Name:         "connect-untrusted",
+				Usage:        TrustedNetworksConnectUntrustedUsageText,
+				Action:       c.TrustedNetworksConnectUntrusted,
+				BashComplete: c.SetBoolAutocomplete,
+				ArgsUsage:    TrustedNetworksConnectUntrustedArgsUsageText,
+				Description:  TrustedNetworksConnectUntrustedDescription,
+			},
+			{
+				Name:               "list",
+				Usage:              TrustedNetworksListUsage,
+				Action:             c.TrustedNetworksList,
+				CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			},
+		},
+	}
+}
+
+func (c *cmd) TrustedNetworksAdd(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	var action pb.TrustedNetworkAction
+	switch strings.ToLower(ctx.String(flagTrustedNetworkAction)) {
+	case trustedNetworkActionDisconnect:
+		action = pb.TrustedNetworkAction_TRUSTED_DISCONNECT
+	case trustedNetworkActionMeshnet:
+		action = pb.TrustedNetworkAction_TRUSTED_MESHNET_ONLY
+	default:
+		return formatError(argsParseError(ctx))
+	}
+
+	network := &pb.TrustedNetwork{
+		Ssid:       ctx.String(flagTrustedNetworkSSID),
+		GatewayMac: ctx.String(flagTrustedNetworkGatewayMAC),
+		Action:     action,
+	}
+	if network.Ssid == "" && network.GatewayMac == "" {
+		current, err := c.client.CurrentNetwork(context.Background(), &pb.Empty{})
+		if err != nil {
+			return formatError(err)
+		}
+		if current.Type != internal.CodeSuccess {
+			return formatError(errors.New(TrustedNetworkCurrentUnknown))
+		}
+		// SSID is preferred as it stays the same when roaming between access points
+		if current.Ssid != "" {
+			network.Ssid = current.Ssid
+		} else {
+			network.GatewayMac = current.GatewayMac
+		}
+	}
+
+	resp, err := c.client.AddTrustedNetwork(context.Background(), &pb.AddTrustedNetworkRequest{Network: network})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeTrustedNetworkInvalid:
+		return formatError(errors.New(TrustedNetworkInvalid))
+	case internal.CodeTrustedNetworkNoop:
+		return formatError(errors.New(TrustedNetworkAddExistsError))
+	case internal.CodeSuccess:
+		network.Id = strings.Join(resp.Data, "")
+		color.Green(TrustedNetworkAddSuccess, network.Id, trustedNetworkLabel(network))
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
+
+func (c *cmd) TrustedNetworksRemove(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	id := ctx.Args().First()
+	resp, err := c.client.RemoveTrustedNetwork(context.Background(), &pb.RemoveTrustedNetworkRequest{Id: id})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeTrustedNetworkNoop:
+		return formatError(fmt.Errorf(TrustedNetworkRemoveNotFound, id))
+	case internal.CodeSuccess:
+		color.Green(TrustedNetworkRemoveSuccess, id)
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
+
+func (c *cmd) TrustedNetworksRemoveAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	settings, err := c.getSettings()
+	if err != nil {
+		return
+	}
+	for _, network := range settings.GetTrustedNetworks() {
+		fmt.Println(network.GetId())
+	}
+}
+
+func (c *cmd) TrustedNetworksConnectUntrusted(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetTrustedNetworksConnectUntrusted(context.Background(), &pb.SetGenericRequest{Enabled: flag})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(TrustedNetworkConnectUntrustedNoop, nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(TrustedNetworkConnectUntrustedSet, nstrings.GetBoolLabel(flag)))
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
+
+func (c *cmd) TrustedNetworksList(ctx *cli.Context) error {
+	settings, err := c.getSettings()
+	if err != nil {
+		return formatError(err)
+	}
+
+	fmt.Printf("Connect on untrusted networks: %s\n",
+		nstrings.GetBoolLabel(settings.GetTrustedNetworksConnectUntrusted()))
+
+	current, err := c.client.CurrentNetwork(context.Background(), &pb.Empty{})
+	if err == nil && current.Type == internal.CodeSuccess {
+		trusted := "untrusted"
+		if current.TrustedNetworkId != "" {
+			trusted = "trusted, " + current.TrustedNetworkId
+		}
+		fmt.Printf("Current network: %s (%s)\n", currentNetworkLabel(current), trusted)
+	}
+
+	if len(settings.GetTrustedNetworks()) == 0 {
+		fmt.Println(TrustedNetworkListEmpty)
+		return nil
+	}
+	displayTrustedNetworks(settings.GetTrustedNetworks())
+	return nil
+}
+
+func displayTrustedNetworks(networks []*pb.TrustedNetwork) {
+	if len(networks) == 0 {
+		return
+	}
+	fmt.Printf("Trusted networks:\n")
+	for _, network := range networks {
+		fmt.Printf("\t%s: %s\n", network.GetId(), trustedNetworkLabel(network))
+	}
+}
+
+// trustedNetworkLabel returns human readable trusted network, e.g. "SSID Office, disconnect"
+func trustedNetworkLabel(network *pb.TrustedNetwork) string {
+	action := trustedNetworkActionDisconnect
+	if network.GetAction() == pb.TrustedNetworkAction_TRUSTED_MESHNET_ONLY {
+		action = trustedNetworkActionMeshnet
+	}
+	return fmt.Sprintf("%s, %s", networkIdentityLabel(network.GetSsid(), network.GetGatewayMac()), action)
+}
+
+func currentNetworkLabel(current *pb.CurrentNetworkResponse) string {
+	return fmt.Sprintf("%s on %s", networkIdentityLabel(current.GetSsid(), current.GetGatewayMac()), current.GetInterface())
+}
+
+func networkIdentityLabel(ssid string, gatewayMAC string) string {
+	var parts []string
+	if ssid != "" {
+		parts = append(parts, "SSID "+ssid)
+	}
+	if gatewayMAC != "" {
+		parts = append(parts, "gateway "+gatewayMAC)
+	}
+	return strings.Join(parts, ", ")
+}
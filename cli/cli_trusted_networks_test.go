@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrustedNetworkLabel(t *testing.T) {
+	tests := []struct {
+		name     string
+		network  *pb.TrustedNetwork
+		expected string
+	}{
+		{
+			name:     "ssid",
+			network:  &pb.TrustedNetwork{Ssid: "Office"},
+			expected: "SSID Office, disconnect",
+		},
+		{
+			name: "ssid and gateway for meshnet only",
+			network: &pb.TrustedNetwork{
+				Ssid:       "Home",
+				GatewayMac: "aa:bb:cc:dd:ee:ff",
+				Action:     pb.TrustedNetworkAction_TRUSTED_MESHNET_ONLY,
+			},
+			expected: "SSID Home, gateway aa:bb:cc:dd:ee:ff, meshnet",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, trustedNetworkLabel(test.network))
+		})
+	}
+}
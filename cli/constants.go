@@ -15,6 +15,13 @@ const (
 
 const (
 	flagGroup         = "group"
+	flagVia           = "via"
+	flagFastest       = "fastest"
+	flagTechnology    = "technology"
+	flagProtocol      = "protocol"
+	flagObfuscate     = "obfuscate"
+	flagPostQuantum   = "post-quantum"
+	flagDNS           = "dns"
 	flagToken         = "token"
 	flagLoginCallback = "callback"
 	stringProtocol    = "protocol"
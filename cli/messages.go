@@ -97,12 +97,62 @@ Example: nordvpn set %s on`
 	AllowlistRemoveSubnetExistsError = "Subnet %s is not on the allowlist."
 	AllowlistRemoveSubnetSuccess     = "Subnet %s has been deleted from the allowlist."
 
+	AllowlistAddDomainExistsError    = "Domain %s is already on the allowlist."
+	AllowlistAddDomainSuccess        = "Domain %s has been successfully added to the allowlist."
+	AllowlistRemoveDomainExistsError = "Domain %s is not on the allowlist."
+	AllowlistRemoveDomainSuccess     = "Domain %s has been deleted from the allowlist."
+	AllowlistRemoveDomainError       = "Domain %s could not be removed from the allowlist. Check the daemon logs for details."
+	AllowlistDomainResolveError      = "Domain %s has been saved to the allowlist, but its addresses could not be applied. Check the daemon logs for details."
+
 	AllowlistRemoveAllError   = "Allowlist elements could not be removed."
-	AllowlistRemoveAllSuccess = "All ports and subnets have been deleted from the allowlist."
+	AllowlistRemoveAllSuccess = "All ports, subnets and domains have been deleted from the allowlist."
 
 	AllowlistPortRangeError  = "Port %d value is out of range [%d - %d]."
 	AllowlistPortsRangeError = "Ports %d - %d value is out of range [%d - %d]."
 
+	SplitTunnelAddSuccess       = "%s %s has been successfully added to split tunneling."
+	SplitTunnelAddExistsError   = "%s %s is already added to split tunneling."
+	SplitTunnelRemoveSuccess    = "%s %s has been removed from split tunneling."
+	SplitTunnelRemoveNotFound   = "%s %s is not added to split tunneling."
+	SplitTunnelInvalidApp       = "%s %s was not found on this system."
+	SplitTunnelNotSupported     = "Split tunneling requires cgroup v2, which is not available on this system."
+	SplitTunnelApplyError       = "Split tunneling settings were saved but could not be applied. Check the daemon logs for details."
+	SplitTunnelListEmpty        = "No apps are added to split tunneling."
+	SplitTunnelKillSwitchExempt = "kill switch exempt"
+	SplitTunnelModeSuccess      = "Split tunneling mode is set to '%s'."
+	SplitTunnelModeNothingToDo  = "Split tunneling mode is already set to '%s'."
+	SplitTunnelModeIncludeEmpty = "No apps are added to split tunneling, so no traffic will go through the VPN tunnel. Add apps with 'nordvpn split-tunnel add'."
+
+	ScheduleAddSuccess     = "Schedule rule %s has been added: %s."
+	ScheduleAddExistsError = "The same schedule rule already exists."
+	ScheduleInvalidRule    = "The schedule rule is not valid. Use HH:MM format for the start and end of the window, and make sure they differ."
+	ScheduleRemoveSuccess  = "Schedule rule %s has been removed."
+	ScheduleRemoveNotFound = "Schedule rule %s does not exist."
+	ScheduleListEmpty      = "No schedule rules are added."
+	ScheduleInvalidDays    = "Days value '%s' is not valid. Use 'daily', 'weekdays', 'weekends', or a comma separated list of days and day ranges, e.g. 'mon-fri' or 'mon,wed,fri'."
+
+	TrustedNetworkAddSuccess           = "Trusted network %s has been added: %s."
+	TrustedNetworkAddExistsError       = "This network is already trusted."
+	TrustedNetworkInvalid              = "The trusted network is not valid. Provide an SSID of up to 32 bytes or a gateway MAC address, e.g. aa:bb:cc:dd:ee:ff."
+	TrustedNetworkCurrentUnknown       = "We couldn't identify the network you're connected to. Use --ssid or --gateway-mac to add it."
+	TrustedNetworkRemoveSuccess        = "Trusted network %s has been removed."
+	TrustedNetworkRemoveNotFound       = "Trusted network %s does not exist."
+	TrustedNetworkListEmpty            = "No trusted networks are added."
+	TrustedNetworkConnectUntrustedSet  = "Connecting to VPN on untrusted networks is set to '%s' successfully."
+	TrustedNetworkConnectUntrustedNoop = "Connecting to VPN on untrusted networks is already set to '%s'."
+
+	DNSRouteAddSuccess     = "DNS route has been added: %s via %s."
+	DNSRouteAddExists      = "DNS route %s via %s already exists."
+	DNSRouteInvalid        = "The DNS route is not valid. Provide a domain name which is not a public suffix and a private IPv4 address of the nameserver, e.g. 'corp.example 10.0.0.53'."
+	DNSRouteApplyFailed    = "The DNS route has been saved, but we couldn't apply it. It will be applied on the next connection."
+	DNSRouteRemoveSuccess  = "DNS route for %s has been removed."
+	DNSRouteRemoveNotFound = "DNS route for %s does not exist."
+	DNSRouteListEmpty      = "No DNS routes are added."
+
+	LeakTestNotConnected = "Leak test requires an active VPN connection. Connect to NordVPN and try again."
+	LeakTestPassed       = "No leaks were found."
+	LeakTestFailed       = "Traffic might leak outside of the VPN tunnel. Check the failed checks above."
+
 	AccountCreationSuccess = "Account has been successfully created."
 	// AccountInvalidData is displayed when backend returns bad request (400)
 	AccountInvalidData = "Invalid email address or password. Please make sure you're entering a valid email address and your password contains at least 8 characters."
@@ -276,17 +326,26 @@ Learn more:
 	MsgMeshnetContainsInvalidChars      = "This nickname contains disallowed characters."
 
 	// Fileshare
-	FileshareName       = "fileshare"
-	FileshareSendName   = "send"
-	FileshareAcceptName = "accept"
-	FileshareCancelName = "cancel"
-	FileshareListName   = "list"
-	FileshareClearName  = "clear"
-
-	flagFileshareNoWait  = "background"
-	flagFilesharePath    = "path"
-	flagFileshareListIn  = "incoming"
-	flagFileshareListOut = "outgoing"
+	FileshareName         = "fileshare"
+	FileshareSendName     = "send"
+	FileshareSendTextName = "send-text"
+	FileshareAcceptName   = "accept"
+	FileshareCancelName   = "cancel"
+	FileshareResumeName   = "resume"
+	FileshareWatchName    = "watch"
+	FileshareLimitName    = "limit"
+	FileshareHookName     = "hook"
+	FileshareListName     = "list"
+	FileshareClearName    = "clear"
+
+	flagFileshareNoWait   = "background"
+	flagFilesharePath     = "path"
+	flagFileshareListIn   = "incoming"
+	flagFileshareListOut  = "outgoing"
+	flagFilesharePriority = "priority"
+
+	flagFileshareUploadRate     = "upload-rate"
+	flagFilesharePeerUploadRate = "peer-upload-rate"
 
 	MsgFileshareUsage                     = "Transfer files of any size between Meshnet peers securely and privately"
 	MsgFileshareDescription               = MsgFileshareUsage + "\n" + "Learn more: https://meshnet.nordvpn.com/features/sharing-files-in-meshnet?utm_medium=app&utm_source=nordvpn-linux-cli&utm_campaign=meshnet-sharing&nm=app&ns=nordvpn-linux-cli&nc=meshnet-sharing\n\nNote: most arguments (peer name, transfer ID, file name) in fileshare commands can be entered faster using auto-completion. Simply press Tab and the app will suggest valid options for you."
@@ -307,6 +366,15 @@ Learn more:
 	MsgFileshareAlreadyAcceptedError = "This transfer is already completed."
 	MsgFileshareFileInvalidated      = "The transfer of this file is already completed or canceled."
 	MsgFileshareTransferInvalidated  = "This transfer is already completed or canceled."
+	MsgFileshareTransferNotResumable = "This transfer can't be resumed. Incoming transfers must be accepted first with \"nordvpn fileshare accept\"."
+	MsgFileshareWatchAlreadyExists   = "This directory is already watched."
+	MsgFileshareWatchNotFound        = "This directory is not watched."
+	MsgFileshareWatchNotADirectory   = "Please provide a directory to watch."
+	MsgFileshareWatchFailure         = "Can't update watched directories. See nordfileshared.log for more details."
+	MsgFileshareLimitsFailure        = "Can't update the transfer limits. See nordfileshared.log for more details."
+	MsgFileshareHookFailure          = "Can't update the post-transfer hook. See nordfileshared.log for more details."
+	MsgFileshareTextEmpty            = "The text you’re trying to send is empty."
+	MsgFileshareTextTooLong          = "Text can't exceed 64 KiB. Try sending it as a file."
 	MsgTooManyFiles                  = "Number of files in a transfer cannot exceed 1000. Try archiving the directory."
 	MsgNoFiles                       = "The directory you’re trying to send is empty. Please choose another one."
 	MsgDirectoryToDeep               = "File depth cannot exceed 5 directories. Try archiving the directory."
@@ -315,19 +383,28 @@ Learn more:
 	MsgNotEnoughSpace                = "The transfer can't be accepted because there's not enough storage on your device."
 	MsgNoPermissions                 = "You don’t have write permissions for the download directory %s. To receive the file transfer, choose another download directory using the --" + flagFilesharePath + " parameter."
 
-	MsgFileshareSendUsage       = "Send files or directories to a Meshnet peer."
-	MsgFileshareSendArgsUsage   = "<peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey> <path_1> [path_2...]"
-	MsgFileshareSendDescription = MsgFileshareSendUsage + "\n\nTo cancel a transfer in progress, press Ctrl+C"
-	MsgFileshareNoWaitUsage     = "Send a file transfer in the background instead of seeing its progress. It allows you to continue using the terminal for other commands while a transfer is in progress."
-	MsgFileshareSendNoWait      = "File transfer %s has started in the background."
-	MsgFileshareAcceptNoWait    = "File transfer has started in the background."
-	MsgFileshareWaitAccept      = "Waiting for the peer to accept your transfer..."
-	MsgTransferNotCreated       = "Can’t send the files. Please check if you have the \"read\" permission for the files you want to send."
+	MsgFileshareSendUsage        = "Send files or directories to a Meshnet peer."
+	MsgFileshareSendArgsUsage    = "<peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey> <path_1> [path_2...]"
+	MsgFileshareSendDescription  = MsgFileshareSendUsage + "\n\nTo cancel a transfer in progress, press Ctrl+C"
+	MsgFileshareNoWaitUsage      = "Send a file transfer in the background instead of seeing its progress. It allows you to continue using the terminal for other commands while a transfer is in progress."
+	MsgFileshareSendNoWait       = "File transfer %s has started in the background."
+	MsgFileshareAcceptNoWait     = "File transfer has started in the background."
+	MsgFileshareWaitAccept       = "Waiting for the peer to accept your transfer..."
+	MsgFileshareSendQueued       = "File transfer %s is queued and will start once other transfers finish."
+	MsgFileshareSendQueuedNoWait = "File transfer %s is queued and will start in the background once other transfers finish."
+	MsgFileshareSendTextUsage    = "Send a text snippet to a Meshnet peer."
+	MsgFileshareSendTextArgs     = "<peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey> [text|-]"
+	MsgFileshareSendTextDesc     = MsgFileshareSendTextUsage + "\n\nThe text is read from the standard input if it's omitted or \"-\" is given. The peer accepts it like a file transfer, or right away if it accepts files from you automatically, and receives it as a notification with an option to copy it to the clipboard. The text is kept in memory on both sides. Texts can have up to 64 KiB.\n\nFor example, \"echo hello | nordvpn fileshare send-text laptop\" sends \"hello\" to the peer \"laptop\"."
+	MsgFilesharePriorityUsage    = "Set the priority of the transfer in the queue. Can be one of: low, normal, high."
+	MsgFileshareInvalidPriority  = "Invalid priority %q. Can be one of: low, normal, high."
+	MsgTransferNotCreated        = "Can’t send the files. Please check if you have the \"read\" permission for the files you want to send."
 
 	MsgFileshareListUsage       = "Lists transfers. If transfer ID is provided, lists files in the transfer."
 	MsgFileshareListArgsUsage   = `[transfer_id]`
 	MsgFileshareListDescription = `Adding no arguments to the command will list transfers.
-Provide a [transfer_id] argument to list files in the specified transfer.`
+Provide a [transfer_id] argument to list files in the specified transfer.
+
+The checksum column shows the SHA-256 hash of the file recorded on this device. The hashes are not exchanged with the peer and are not checked, compare them with the ones listed on the other device to confirm the files are the same. Received files are only checked during the transfer, files which fail that check are marked as corrupted.`
 	MsgFileshareListInUsage       = "Show only incoming transfers."
 	MsgFileshareListOutUsage      = "Show only outgoing transfers."
 	MsgFileshareCancelUsage       = "Cancel a transfer or a single file. To cancel an entire transfer, specify the transfer ID. To cancel a single file, specify the transfer ID and the file ID."
@@ -337,6 +414,39 @@ Provide a [transfer_id] argument to list files in the specified transfer.`
 	MsgFileshareAcceptArgsUsage   = "<transfer_id> [file_id1] [file_id2...]"
 	MsgFileshareAcceptDescription = MsgFileshareAcceptUsage + "\n\nTo cancel a transfer in progress, press Ctrl+C"
 	MsgFileshareAcceptPathUsage   = "Specify download path (default: $XDG_DOWNLOAD_DIR or $HOME/Downloads)"
+	MsgFileshareResumeUsage       = "Resume an interrupted file transfer. Files which already completed are skipped and partially transferred files continue from where they stopped."
+	MsgFileshareResumeArgsUsage   = "<transfer_id>"
+	MsgFileshareResumeDescription = MsgFileshareResumeUsage + "\n\nThe resumed transfer keeps its original ID. To cancel a transfer in progress, press Ctrl+C"
+	MsgFileshareResumeNoWait      = "File transfer %s has resumed in the background."
+	MsgFileshareWatchUsage        = "Send new or modified files of a directory to a Meshnet peer automatically."
+	MsgFileshareWatchDescription  = MsgFileshareWatchUsage + "\n\nChanges are sent in batches once the directory stays unchanged for a few seconds. Only the changed files are sent, keeping their paths relative to the watched directory. Batches which fail, for example because the peer is offline, are retried. Changes made while fileshare was not running are sent once it starts.\n\nThe peer has to enable automatic file acceptance for this device with \"nordvpn meshnet peer auto-accept enable <this_device>\", otherwise every batch waits for the peer to accept it."
+	MsgFileshareWatchAddUsage     = "Start watching a directory."
+	MsgFileshareWatchAddArgsUsage = "<directory> <peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey>"
+	MsgFileshareWatchAddSuccess   = "Directory %s is now watched. Its new or modified files will be sent to %s."
+	MsgFileshareWatchRemoveUsage  = "Stop watching a directory."
+	MsgFileshareWatchRemoveArgs   = "<directory>"
+	MsgFileshareWatchRemoved      = "Directory %s is no longer watched."
+	MsgFileshareWatchListUsage    = "List watched directories."
+	MsgFileshareWatchListEmpty    = "No directories are watched."
+	MsgFileshareLimitUsage        = "Show or set the maximum number of concurrent outgoing transfers and the upload rates."
+	MsgFileshareLimitArgsUsage    = "[max_transfers]"
+	MsgFileshareLimitDescription  = MsgFileshareLimitUsage + "\n\nTransfers sent over the limit are queued and start by their priority once other transfers finish. Upload rates are set in KB/s and apply to all the outgoing transfers together or to the transfers to a single peer. A single transfer can't be limited, transfers to the same peer share the peer limit. Use 0 to remove a limit.\n\nFor example, \"nordvpn fileshare limit 2\" allows at most 2 outgoing transfers at the same time and \"nordvpn fileshare limit --upload-rate 1024\" limits the uploads to 1024 KB/s."
+	MsgFileshareLimitCurrent      = "Maximum concurrent outgoing transfers: %s"
+	MsgFileshareUploadRateCurrent = "Maximum upload rate: %s"
+	MsgFilesharePeerRateCurrent   = "Maximum upload rate per peer: %s"
+	MsgFileshareLimitUnlimited    = "unlimited"
+	MsgFileshareLimitSet          = "Maximum concurrent outgoing transfers set to %s."
+	MsgFileshareUploadRateSet     = "Maximum upload rate set to %s."
+	MsgFilesharePeerRateSet       = "Maximum upload rate per peer set to %s."
+	MsgFileshareUploadRateUsage   = "Maximum upload rate of all the outgoing transfers in KB/s"
+	MsgFilesharePeerRateUsage     = "Maximum upload rate of the outgoing transfers to a single peer in KB/s"
+	MsgFileshareHookUsage         = "Show or set a command run after every finished incoming transfer."
+	MsgFileshareHookArgsUsage     = "[command]"
+	MsgFileshareHookDescription   = MsgFileshareHookUsage + "\n\nThe command is run by the shell once the checksums of the received files are recorded, with the transfer details in the environment:\n  NORDVPN_TRANSFER_ID - ID of the transfer\n  NORDVPN_TRANSFER_PATH - download directory of the transfer\n  NORDVPN_TRANSFER_STATUS - final status of the transfer, e.g. SUCCESS or FINISHED_WITH_ERRORS\n  NORDVPN_TRANSFER_PEER - peer which sent the transfer\n\nFor example, \"nordvpn fileshare hook 'unzip -o \"$NORDVPN_TRANSFER_PATH\"/*.zip -d \"$NORDVPN_TRANSFER_PATH\"'\" unpacks the received archives. Use \"nordvpn fileshare hook ''\" to remove the hook."
+	MsgFileshareHookCurrent       = "Post-transfer hook: %s"
+	MsgFileshareHookNotSet        = "No post-transfer hook is set."
+	MsgFileshareHookSet           = "Post-transfer hook set."
+	MsgFileshareHookRemoved       = "Post-transfer hook removed."
 	MsgFileshareClearUsage        = "Clear entries older than the specified time period from the file transfer history."
 	MsgFileshareClearArgsUsage    = "all|<time_period> [time_period...]"
 	MsgFileshareClearDescription  = MsgFileshareClearUsage + "\n\nSpecify the time period using the systemd time span syntax: https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html\n\nFor example, \"nordvpn fileshare clear 1d 12h\" clears entries older than 36 hours. Use \"nordvpn fileshare clear all\" to remove all entries."
@@ -397,6 +507,15 @@ Your browsing activities remain private, regardless of your choice.
 	SetARPIgnoreNothingToSet = "ARP ignore is already set to '%s'."
 	SetARPIgnoreWarning      = "You’ve turned off arp-ignore. This is an advanced privacy setting and should only be off if your network setup requires ARP responses."
 
+	HistoryInvalidSince = "'%s' is not a valid duration, date or timestamp."
+	HistoryEmpty        = "No VPN connections were made yet."
+
+	SetMetricsInvalidListen = "'%s' is not the default metrics socket path or a loopback address with port."
+	SetMetricsListenFailed  = "Failed to serve metrics on '%s'. Make sure the address is not in use."
+	SetMetricsServing       = "Metrics are served on %s."
+
+	SetFailoverInvalidThreshold = "Failover thresholds are out of range. Handshake age has to be at least 3m, packet loss at most 100 and latency at most %s."
+
 	SetECHUsageText = "Turns Encrypted Client Hello (ECH) on or off. ECH encrypts the server name during the TLS handshake, making your connection more private. Only available for the NordWhisper protocol."
 	// SetECHTechUnsupported copy is dictated by product; the "Your are" wording is intentional
 	// pending copywriter review (likely "You are").
@@ -424,4 +543,49 @@ Your browsing activities remain private, regardless of your choice.
 	MsgDiagnosticsSuccess    = "Diagnostics collected successfully.\nFile saved to: %s"
 	MsgDiagnosticsFailure    = "We couldn't collect diagnostic logs. Please try again or contact our support team."
 	MsgDiagnosticsDisclaimer = "WARNING: This file contains sensitive information about your system and configuration. Share it only with our support team through a secure channel."
+
+	MsgDaemonNotRunning = "The NordVPN background service isn't running. Execute the \"systemctl enable --now nordvpnd\" command with root privileges to start the background service. If you're using NordVPN in an environment without systemd (a container, for example), use the \"/etc/init.d/nordvpn start\" command."
+
+	OutputInvalidFormat = "Output format '%s' is not supported. Use text, json or yaml."
+	OutputNotSupported  = "Output format '%s' is not supported by the '%s' command. Use text."
+
+	// Settings file
+	SettingsApplyReadFailed         = "We couldn't read the settings file: %s"
+	SettingsApplyDryRun             = "The following settings would be changed:"
+	SettingsApplySuccess            = "The following settings were changed:"
+	SettingsApplyNothingToDo        = "Your settings already match the settings file."
+	SettingsApplyNotApplied         = "Settings were saved, but some of them couldn't be applied. Reconnect to the VPN or restart the background service to apply them."
+	SettingsApplyFeatureUnavailable = "The technology from the settings file is not available on this device."
+	SettingsApplyAutoConnectFailed  = "We couldn't set up auto-connect to the server from the settings file. Check the server name and try again."
+	SettingsApplyPolicyLocked       = "The settings file changes settings managed by your organization: %s."
+
+	// Policy
+	SettingLockedLabel               = "(managed by your organization)"
+	SettingLockedError               = "This setting is managed by your organization and can't be changed."
+	ConnectionOverridesLockedMessage = "These connection settings are managed by your organization and can't be overridden: %s."
+
+	// VPN config export
+	ConfigExportSuccess     = "The %s config for %s was saved to %s."
+	ConfigExportUnsupported = "Only NordLynx and OpenVPN configs over UDP or TCP can be exported, and dedicated servers aren't supported."
+	ConfigExportWriteFailed = "We couldn't save the config file: %s"
+	ConfigExportQRNordlynx  = "QR codes are available only for NordLynx configs."
+	ConfigExportQRMissing   = "To show the QR code, install the \"qrencode\" package and try again."
+	ConfigExportQRFailed    = "We couldn't show the QR code: %s"
+
+	ConnectLatenciesHeader    = "Measured latencies:"
+	ConnectLatencyUnreachable = "no response"
+
+	// Multi-hop
+	MultiHopUnsupportedMessage = "Multi-hop connections are available only with the NordLynx technology on regular servers. Post-quantum encryption has to be turned off."
+
+	// Favorites
+	FavoriteAddSuccess                = "Favorite '%s' has been added."
+	FavoriteRemoveSuccess             = "Favorite '%s' has been removed."
+	FavoriteExists                    = "Favorite '%s' already exists."
+	FavoriteInvalidName               = "Favorite name can contain up to 32 letters, digits, dashes and underscores."
+	FavoritesLimitReached             = "You've reached the maximum number of favorites. Remove some of them and try again."
+	FavoriteNotFoundMessage           = "This favorite does not exist. Use 'nordvpn favorite list' to see your favorites."
+	FavoriteListEmpty                 = "No favorites are added."
+	FavoriteRecommendedServer         = "recommended server"
+	InvalidConnectionOverridesMessage = "These settings can't be used together. Protocol and obfuscation can be set only for OpenVPN, and post-quantum encryption works only with NordLynx."
 )
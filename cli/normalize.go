@@ -35,9 +35,11 @@ func NormalizeCommandCase(commands []*cli.Command, args []string) []string {
 
 	level := commands
 	matching := true
+	flagValue := false
 	for _, arg := range args[1:] {
-		if !matching {
+		if !matching || flagValue {
 			out = append(out, arg)
+			flagValue = false
 			continue
 		}
 
@@ -45,6 +47,8 @@ func NormalizeCommandCase(commands []*cli.Command, args []string) []string {
 		// them as a command boundary or descending the tree.
 		if strings.HasPrefix(arg, "-") {
 			out = append(out, arg)
+			// value of the global flag is a separate token, e.g. "--output json status"
+			flagValue = arg == "--"+flagOutput || arg == "-o"
 			continue
 		}
 
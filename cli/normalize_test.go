@@ -96,6 +96,16 @@ func TestNormalizeCommandCase(t *testing.T) {
 			input:    []string{"nordvpn", "LOGIN", "--token", "AbCdEf123XYZ"},
 			expected: []string{"nordvpn", "login", "--token", "AbCdEf123XYZ"},
 		},
+		{
+			name:     "global output flag value is skipped",
+			input:    []string{"nordvpn", "--output", "JSON", "Mesh", "Peer", "List"},
+			expected: []string{"nordvpn", "--output", "JSON", "meshnet", "peer", "list"},
+		},
+		{
+			name:     "global output flag with inline value",
+			input:    []string{"nordvpn", "-o=yaml", "SET", "killswitch", "on"},
+			expected: []string{"nordvpn", "-o=yaml", "set", "killswitch", "on"},
+		},
 		{
 			name:     "unknown command left untouched",
 			input:    []string{"nordvpn", "Foobar", "Baz"},
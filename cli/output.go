@@ -0,0 +1,244 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// OutputUsageText is shown next to the global output flag
+const OutputUsageText = "Output format of the command results and errors: text, json or yaml. " +
+	"Structured formats are supported by the status, settings, settings apply, countries, cities, groups, " +
+	"history, favorite list, config export, meshnet peer list and fileshare list commands, " +
+	"other commands fail with an error in the selected format"
+
+const flagOutput = "output"
+
+// structuredOutputCommands are the commands which print their results in the structured formats
+var structuredOutputCommands = map[string]bool{
+	"status":            true,
+	"settings":          true,
+	"settings apply":    true,
+	"countries":         true,
+	"cities":            true,
+	"groups":            true,
+	"history":           true,
+	"favorite list":     true,
+	"config export":     true,
+	"meshnet peer list": true,
+	"fileshare list":    true,
+}
+
+type outputFormat string
+
+const (
+	outputText outputFormat = "text"
+	outputJSON outputFormat = "json"
+	outputYAML outputFormat = "yaml"
+)
+
+// parseOutputFormat returns the output format for the flag value. Empty value means text.
+func parseOutputFormat(value string) (outputFormat, error) {
+	switch format := outputFormat(strings.ToLower(value)); format {
+	case "", outputText:
+		return outputText, nil
+	case outputJSON, outputYAML:
+		return format, nil
+	default:
+		return "", fmt.Errorf(OutputInvalidFormat, value)
+	}
+}
+
+// isStructured returns true if the command results have to be printed in a machine readable format
+func (f outputFormat) isStructured() bool {
+	return f == outputJSON || f == outputYAML
+}
+
+// marshalOutput encodes the value in the given format. Protobuf messages are encoded using their
+// JSON mapping with the original field names, so the schema follows the protobuf definitions
+// instead of the human readable messages.
+func marshalOutput(format outputFormat, v any) ([]byte, error) {
+	data, err := marshalJSON(v)
+	if err != nil {
+		return nil, err
+	}
+	if format != outputYAML {
+		return data, nil
+	}
+
+	// JSON is valid YAML, decoding it into a node keeps the field order and number formatting
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	resetYAMLStyle(&node)
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func marshalJSON(v any) ([]byte, error) {
+	if msg, ok := v.(proto.Message); ok {
+		data, err := protojson.MarshalOptions{
+			UseProtoNames:   true,
+			EmitUnpopulated: true,
+		}.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		// protojson output is deliberately unstable in whitespace, normalize it
+		var raw json.RawMessage = data
+		return json.MarshalIndent(raw, "", "  ")
+	}
+	if msgs, ok := v.(map[string]any); ok {
+		out := make(map[string]json.RawMessage, len(msgs))
+		for key, value := range msgs {
+			data, err := marshalJSON(value)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = data
+		}
+		return json.MarshalIndent(out, "", "  ")
+	}
+	if msgs, ok := v.([]proto.Message); ok {
+		out := make([]json.RawMessage, 0, len(msgs))
+		for _, msg := range msgs {
+			data, err := marshalJSON(msg)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, data)
+		}
+		return json.MarshalIndent(out, "", "  ")
+	}
+	return json.MarshalIndent(v, "", "  ")
+}
+
+// resetYAMLStyle drops the JSON flow style and quoting
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+// printOutput prints the command result in the selected structured format
+func (c *cmd) printOutput(v any) error {
+	return writeOutput(os.Stdout, c.output, v)
+}
+
+func writeOutput(w io.Writer, format outputFormat, v any) error {
+	data, err := marshalOutput(format, v)
+	if err != nil {
+		return formatError(err)
+	}
+	if _, err := fmt.Fprintln(w, strings.TrimSuffix(string(data), "\n")); err != nil {
+		return formatError(err)
+	}
+	return nil
+}
+
+// codedError is an error with the internal.Code* value describing it
+type codedError struct {
+	code int64
+	err  error
+}
+
+// newCodedError attaches the code to the error, so it can be reported in the structured output
+func newCodedError(code int64, err error) error {
+	return &codedError{code: code, err: err}
+}
+
+func (e *codedError) Error() string { return e.err.Error() }
+
+func (e *codedError) Unwrap() error { return e.err }
+
+// errorCode returns the internal.Code* value which describes the error best
+func errorCode(err error) int64 {
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+	switch {
+	case errors.Is(err, ErrConfig):
+		return internal.CodeConfigError
+	case errors.Is(err, internal.ErrNotLoggedIn):
+		return internal.CodeUnauthorized
+	case errors.Is(err, internal.ErrDaemonConnectionRefused),
+		errors.Is(err, internal.ErrSocketNotFound),
+		errors.Is(err, internal.ErrSocketAccessDenied):
+		return internal.CodeDaemonOffline
+	case errors.Is(err, ErrInternetConnection):
+		return internal.CodeOffline
+	}
+	if s, ok := status.FromError(err); ok && s.Code() == codes.Unavailable {
+		return internal.CodeDaemonOffline
+	}
+	return internal.CodeFailure
+}
+
+// outputError is the schema of the errors in the structured output
+type outputError struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+// printError reports the error in the selected structured format. Returned error only sets the
+// exit code, since the error was already printed.
+func (c *cmd) printError(err error) error {
+	var exitCoder cli.ExitCoder
+	if errors.As(err, &exitCoder) && exitCoder.Error() == "" {
+		return err
+	}
+	message := formatError(err).Error()
+	if printErr := writeOutput(os.Stdout, c.output, map[string]any{
+		"error": outputError{Code: errorCode(err), Message: message},
+	}); printErr != nil {
+		return err
+	}
+	return cli.Exit("", 1)
+}
+
+// requireOutputSupport fails the command before it is executed if it can't print its result in
+// the selected output format
+func (c *cmd) requireOutputSupport(command string, f cli.ActionFunc) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		if c.output.isStructured() && !structuredOutputCommands[command] {
+			return c.printError(fmt.Errorf(OutputNotSupported, c.output, command))
+		}
+		return f(ctx)
+	}
+}
+
+// exitWithError prints the error and exits the application
+func (c *cmd) exitWithError(err error, message string) {
+	if c.output.isStructured() {
+		// nolint:errcheck // exit code is set below
+		c.printError(newCodedError(errorCode(err), errors.New(message)))
+	} else {
+		color.Red(message)
+	}
+	os.Exit(1)
+}
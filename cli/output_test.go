@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		value    string
+		expected outputFormat
+		isError  bool
+	}{
+		{value: "", expected: outputText},
+		{value: "text", expected: outputText},
+		{value: "json", expected: outputJSON},
+		{value: "YAML", expected: outputYAML},
+		{value: "xml", isError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			format, err := parseOutputFormat(test.value)
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, format)
+		})
+	}
+}
+
+func TestWriteOutput(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	resp := &pb.StatusResponse{
+		State:      pb.ConnectionState_CONNECTED,
+		Technology: config.Technology_NORDLYNX,
+		Hostname:   "de1.nordvpn.com",
+		Download:   1024,
+	}
+
+	tests := []struct {
+		name     string
+		format   outputFormat
+		contains []string
+	}{
+		{
+			name:   "json",
+			format: outputJSON,
+			contains: []string{
+				`"state": "CONNECTED"`,
+				`"technology": "NORDLYNX"`,
+				`"hostname": "de1.nordvpn.com"`,
+				`"download": "1024"`,
+				`"split_tunnel_mode": "EXCLUDE"`,
+			},
+		},
+		{
+			name:   "yaml",
+			format: outputYAML,
+			contains: []string{
+				"state: CONNECTED\n",
+				"technology: NORDLYNX\n",
+				"hostname: de1.nordvpn.com\n",
+				"download: \"1024\"\n",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, writeOutput(&buf, test.format, resp))
+			for _, expected := range test.contains {
+				assert.Contains(t, buf.String(), expected)
+			}
+		})
+	}
+}
+
+func TestWriteOutput_Error(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeOutput(&buf, outputJSON, map[string]any{
+		"error": outputError{Code: internal.CodeConfigError, Message: "Config error."},
+	}))
+	assert.JSONEq(t, `{"error":{"code":3004,"message":"Config error."}}`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, writeOutput(&buf, outputYAML, map[string]any{
+		"error": outputError{Code: internal.CodeFailure, Message: "Failure."},
+	}))
+	assert.Equal(t, "error:\n  code: 3000\n  message: Failure.\n", buf.String())
+}
+
+func TestErrorCode(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		err      error
+		expected int64
+	}{
+		{name: "coded", err: newCodedError(internal.CodeVPNRunning, errors.New("running")), expected: internal.CodeVPNRunning},
+		{
+			name:     "coded formatted",
+			err:      formatError(newCodedError(internal.CodeVPNRunning, errors.New("running"))),
+			expected: internal.CodeVPNRunning,
+		},
+		{name: "config", err: ErrConfig, expected: internal.CodeConfigError},
+		{name: "not logged in", err: internal.ErrNotLoggedIn, expected: internal.CodeUnauthorized},
+		{name: "daemon", err: internal.ErrSocketNotFound, expected: internal.CodeDaemonOffline},
+		{name: "unavailable", err: status.Error(codes.Unavailable, "unavailable"), expected: internal.CodeDaemonOffline},
+		{
+			name:     "meshnet",
+			err:      formatError(meshnetErrorToError(meshpb.MeshnetErrorCode_ALREADY_ENABLED)),
+			expected: internal.CodeNothingToDo,
+		},
+		{
+			name:     "meshnet service",
+			err:      formatError(serviceErrorCodeToError(meshpb.ServiceErrorCode_NOT_LOGGED_IN)),
+			expected: internal.CodeUnauthorized,
+		},
+		{name: "arguments", err: formatError(argsCountError(cli.NewContext(cli.NewApp(), nil, nil))), expected: internal.CodeFormatError},
+		{name: "other", err: errors.New("other"), expected: internal.CodeFailure},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, errorCode(test.err))
+		})
+	}
+}
+
+func TestRequireOutputSupport(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		command  string
+		format   outputFormat
+		executed bool
+	}{
+		{name: "text", command: "connect", format: outputText, executed: true},
+		{name: "structured supported", command: "meshnet peer list", format: outputJSON, executed: true},
+		{name: "structured not supported", command: "connect", format: outputYAML, executed: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &cmd{output: test.format}
+			executed := false
+			err := c.requireOutputSupport(test.command, func(*cli.Context) error {
+				executed = true
+				return nil
+			})(nil)
+			assert.Equal(t, test.executed, executed)
+			if test.executed {
+				assert.NoError(t, err)
+				return
+			}
+			var exitCoder cli.ExitCoder
+			require.ErrorAs(t, err, &exitCoder)
+			assert.Equal(t, 1, exitCoder.ExitCode())
+		})
+	}
+}
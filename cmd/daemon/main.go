@@ -28,12 +28,18 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/config/remote"
 	"github.com/NordSecurity/nordvpn-linux/core"
 	"github.com/NordSecurity/nordvpn-linux/daemon"
+	"github.com/NordSecurity/nordvpn-linux/daemon/allowlist"
 	"github.com/NordSecurity/nordvpn-linux/daemon/device"
 	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns/stub"
 	"github.com/NordSecurity/nordvpn-linux/daemon/ens"
 	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/favorites"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/nft"
+	"github.com/NordSecurity/nordvpn-linux/daemon/health"
+	"github.com/NordSecurity/nordvpn-linux/daemon/history"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metrics"
 	"github.com/NordSecurity/nordvpn-linux/daemon/netstate"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	telemetrypb "github.com/NordSecurity/nordvpn-linux/daemon/pb/telemetry/v1"
@@ -45,6 +51,8 @@ import (
 	netlinkrouter "github.com/NordSecurity/nordvpn-linux/daemon/routes/netlink"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes/norouter"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes/norule"
+	"github.com/NordSecurity/nordvpn-linux/daemon/serverpicker"
+	"github.com/NordSecurity/nordvpn-linux/daemon/splittunnel"
 	"github.com/NordSecurity/nordvpn-linux/daemon/state"
 	"github.com/NordSecurity/nordvpn-linux/daemon/telemetry"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
@@ -57,6 +65,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/events/meshunsetter"
 	"github.com/NordSecurity/nordvpn-linux/events/refresher"
 	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/fileshare/fileshare_process"
 	grpcmiddleware "github.com/NordSecurity/nordvpn-linux/grpc_middleware"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/ipv6"
@@ -176,6 +185,11 @@ func main() {
 		log.Error("failed to cleanup config:", err)
 	}
 
+	policyLoader := config.NewPolicyLoader(internal.PolicyFilename, internal.StdFilesystemHandle{})
+	if err := daemon.EnforcePolicy(fsystem, policyLoader); err != nil {
+		log.Error("failed to enforce policy:", err)
+	}
+
 	var cfg config.Config
 	if err := fsystem.Load(&cfg); err != nil {
 		log.Error(err)
@@ -320,7 +334,8 @@ func main() {
 	)
 	gwret := netlinkrouter.Retriever{}
 
-	dnsSetter := dns.NewDNSServiceSetter(daemonEvents.Debugger.DebuggerEvents)
+	stubResolver := stub.NewResolver()
+	dnsSetter := dns.NewDNSServiceSetter(daemonEvents.Debugger.DebuggerEvents, stubResolver)
 	dnsHostSetter := dns.NewHostsFileSetter(dns.HostsFilePath)
 
 	eventsDbPath := filepath.Join(internal.DatFilesPathCommon, "moose.db")
@@ -456,6 +471,12 @@ func main() {
 	daemonEvents.User.Subscribe(statePublisher)
 	configEvents.Subscribe(statePublisher)
 
+	connectionHistory := history.NewStore(internal.ConnectionHistoryFilename, &internal.StdFilesystemHandle{})
+	daemonEvents.Service.Connect.Subscribe(connectionHistory.NotifyConnect)
+	daemonEvents.Service.Disconnect.Subscribe(connectionHistory.NotifyDisconnect)
+	daemonEvents.User.Logout.Subscribe(connectionHistory.NotifyLogout)
+	internalVpnEvents.ConnectionError.Subscribe(connectionHistory.NotifyConnectionError)
+
 	netw := networker.NewCombined(
 		vpn,
 		mesh,
@@ -490,6 +511,10 @@ func main() {
 		),
 	)
 
+	if err := netw.SetFileshareRateLimit(cfg.FileshareRateLimit); err != nil {
+		log.Error("failed to set fileshare rate limit:", err)
+	}
+
 	keygen, err := keygenImplementation(vpnFactory)
 	if err != nil {
 		log.Fatal(err)
@@ -596,6 +621,9 @@ func main() {
 	pauseEvents := daemonevents.NewPauseEvents()
 	pauseEvents.Subscribe(statePublisher)
 
+	failoverEvents := daemonevents.NewFailoverEvents()
+	failoverEvents.Subscribe(statePublisher)
+
 	consentChecker := newConsentChecker(
 		internal.IsDevEnv(Environment),
 		fsystem,
@@ -606,6 +634,13 @@ func main() {
 	)
 	consentChecker.PrepareDaemonIfConsentNotCompleted()
 
+	// interfaces created by the daemon itself are not taken into account when monitoring the network
+	ownInterfaces := []string{
+		openvpn.InterfaceName,
+		nordlynx.InterfaceName,
+		internal.NordWhisperInterfaceName,
+	}
+
 	sharedContext := sharedctx.New()
 	rpc := daemon.NewRPC(
 		internal.Environment(Environment),
@@ -643,6 +678,27 @@ func main() {
 		dataUpdateEvents,
 		pauseEvents,
 		deviceKeyManager,
+		splittunnel.NewManager(),
+		allowlist.NewDomainResolver(resolver),
+		netstate.NewSystemIdentityResolver(ownInterfaces),
+		connectionHistory,
+		policyLoader,
+		serverpicker.NewLatencyMeter(network.NewICMPPinger(func() uint32 {
+			var current config.Config
+			if err := fsystem.Load(&current); err != nil {
+				log.Error("loading firewall mark for ping:", err)
+				return cfg.FirewallMark
+			}
+			return current.FirewallMark
+		})),
+		favorites.NewStore(
+			internal.FavoritesFilename,
+			&internal.StdFilesystemHandle{},
+			func() {
+				dataUpdateEvents.FavoritesUpdate.Publish(events.DataFavoritesChanged{})
+			},
+		),
+		stubResolver,
 	)
 
 	ensMonitor := ens.NewMonitor(
@@ -654,6 +710,17 @@ func main() {
 	internalVpnEvents.ConnectionError.Subscribe(ensMonitor.HandleENSNotification)
 	ensMonitor.Start()
 
+	healthMonitor := health.NewMonitor(
+		fsystem,
+		connectionInfo,
+		metrics.WGHandshakeGetter{},
+		health.DNSProber{},
+		threatProtectionLiteServers,
+		rpc.FailoverToDifferentServer,
+		failoverEvents.Failover,
+	)
+	healthMonitor.Start()
+
 	meshService := meshnet.NewServer(
 		authChecker,
 		fsystem,
@@ -669,6 +736,7 @@ func main() {
 		sharedContext,
 	)
 	rcConfig.Subscribe(meshService)
+	rpc.SetMeshPeerPermissions(meshService)
 
 	opts := []grpc.ServerOption{
 		grpc.Creds(internal.NewUnixSocketCredentials(internal.NewDaemonAuthenticator())),
@@ -765,7 +833,10 @@ func main() {
 		}
 	}()
 
+	rpc.StartSplitTunnel()
 	rpc.StartKillSwitch()
+	rpc.StartEncryptedDNS()
+	rpc.StartDNSRoutes()
 	rpc.StartJobs(statePublisher, heartBeatSubject)
 	rpc.StartRemoteConfigLoaderJob(rcConfig)
 	meshService.StartJobs()
@@ -776,16 +847,23 @@ func main() {
 	if cfg.AutoConnect {
 		go rpc.StartAutoConnect(network.ExponentialBackoff)
 	}
-	monitor, err := netstate.NewNetlinkMonitor([]string{
-		openvpn.InterfaceName,
-		nordlynx.InterfaceName,
-		internal.NordWhisperInterfaceName,
-	})
+	monitor, err := netstate.NewNetlinkMonitor(ownInterfaces)
 	if err != nil {
 		log.Fatal(err)
 	}
+	rpc.StartTrustedNetworks(monitor, meshService)
 	monitor.Start(netw)
 
+	metricsExporter := metrics.NewExporter(
+		fsystem,
+		connectionInfo,
+		netw,
+		metrics.NewGRPCFileshareTransfers(fileshare_process.FileshareURL),
+		metrics.WGHandshakeGetter{},
+	)
+	connectionInfo.SubscribeToInternalStateChanges(metricsExporter)
+	rpc.StartMetrics(metrics.NewServer(metricsExporter))
+
 	if ok, _ := authChecker.IsLoggedIn(); ok {
 		go daemon.StartNC(notificationClient)
 		if err := rpc.RegisterDedicatedServers(); err != nil {
@@ -802,6 +880,8 @@ func main() {
 	sig := <-signals
 	log.Info("Received signal:", sig)
 	ensMonitor.Stop()
+	healthMonitor.Stop()
+	rpc.StopMetrics()
 	s.Stop()
 	norduserService.StopAll()
 
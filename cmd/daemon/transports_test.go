@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/test/category"
 
@@ -30,6 +31,11 @@ func (w workingResolver) Resolve(string) ([]netip.Addr, error) {
 	return []netip.Addr{netip.MustParseAddr("1.1.1.1")}, nil
 }
 
+func (w workingResolver) ResolveWithTTL(domain string) ([]netip.Addr, time.Duration, error) {
+	ips, err := w.Resolve(domain)
+	return ips, time.Minute, err
+}
+
 func queryAPI(url string, transp http.RoundTripper) error {
 	fmt.Printf("Query API url: %s\n\n", url)
 
@@ -6,6 +6,12 @@ import (
 	"fmt"
 	"net/netip"
 	"slices"
+	"strings"
+)
+
+const (
+	maxDomainLength      = 253
+	maxDomainLabelLength = 63
 )
 
 // ErrSubnetAlreadyCovered is returned when a subnet being added
@@ -33,10 +39,12 @@ func NewAllowlist(udpPorts []int64, tcpPorts []int64, subnets []string) Allowlis
 	}
 }
 
-// Allowlist is a collection of ports and subnets
+// Allowlist is a collection of ports, subnets and domains
 type Allowlist struct {
 	Ports   Ports    `json:"ports"`
 	Subnets []string `json:"subnets"` // TODO change to netip.Prefix and refactor
+	// Domains are resolved by the daemon and their addresses are allowlisted
+	Domains []string `json:"domains,omitempty"`
 }
 
 func (a *Allowlist) UpdateUDPPorts(ports []int64, remove bool) {
@@ -188,6 +196,59 @@ func (a *Allowlist) UpdateSubnets(subnet string, remove bool, onRemove func(remo
 	return nil
 }
 
+// UpdateDomains adds or removes the domain, returns false if allowlist was not changed
+func (a *Allowlist) UpdateDomains(domain string, remove bool) bool {
+	if slices.Contains(a.Domains, domain) != remove {
+		return false
+	}
+	// do not modify the slice shared with the loaded config in place
+	if remove {
+		a.Domains = slices.DeleteFunc(slices.Clone(a.Domains), func(element string) bool { return element == domain })
+	} else {
+		a.Domains = append(slices.Clone(a.Domains), domain)
+	}
+	return true
+}
+
+// NormalizeDomain converts the domain to the lower case form without the trailing dot and
+// returns false if it is not a valid fully qualified domain name
+func NormalizeDomain(domain string) (string, bool) {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	if len(domain) == 0 || len(domain) > maxDomainLength {
+		return "", false
+	}
+	// IP addresses should be allowlisted as subnets
+	if _, err := netip.ParseAddr(domain); err == nil {
+		return "", false
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return "", false
+	}
+	for _, label := range labels {
+		if !isDomainLabelValid(label) {
+			return "", false
+		}
+	}
+	return domain, true
+}
+
+func isDomainLabelValid(label string) bool {
+	if len(label) == 0 || len(label) > maxDomainLabelLength {
+		return false
+	}
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for _, c := range label {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
 // GetUDPPorts returns a slice of all UDP ports within the allowlist
 func (a *Allowlist) GetUDPPorts() []int64 {
 	ports := []int64{}
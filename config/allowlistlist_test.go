@@ -383,3 +383,50 @@ func TestAddSubnetInvalidInput(t *testing.T) {
 		})
 	}
 }
+
+func TestAllowlist_UpdateDomains(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	domains := []string{"example.com"}
+	allowlist := Allowlist{Domains: domains}
+
+	assert.False(t, allowlist.UpdateDomains("example.com", false))
+	assert.True(t, allowlist.UpdateDomains("nordvpn.com", false))
+	assert.Equal(t, []string{"example.com", "nordvpn.com"}, allowlist.Domains)
+
+	assert.True(t, allowlist.UpdateDomains("example.com", true))
+	assert.False(t, allowlist.UpdateDomains("example.com", true))
+	assert.Equal(t, []string{"nordvpn.com"}, allowlist.Domains)
+	// original slice is not modified
+	assert.Equal(t, []string{"example.com"}, domains)
+}
+
+func TestNormalizeDomain(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		domain   string
+		expected string
+		valid    bool
+	}{
+		{domain: "example.com", expected: "example.com", valid: true},
+		{domain: "Intranet.Example.COM.", expected: "intranet.example.com", valid: true},
+		{domain: "xn--e1afmkfd.xn--p1ai", expected: "xn--e1afmkfd.xn--p1ai", valid: true},
+		{domain: "localhost"},
+		{domain: ""},
+		{domain: "1.2.3.4"},
+		{domain: "-bad.example.com"},
+		{domain: "bad-.example.com"},
+		{domain: "under_score.example.com"},
+		{domain: "double..dot.com"},
+		{domain: "*.example.com"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.domain, func(t *testing.T) {
+			domain, valid := NormalizeDomain(test.domain)
+			assert.Equal(t, test.valid, valid)
+			assert.Equal(t, test.expected, domain)
+		})
+	}
+}
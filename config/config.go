@@ -56,6 +56,18 @@ type Config struct {
 	VirtualLocation TrueField `json:"virtual_location,omitempty"`
 	ARPIgnore       TrueField `json:"arp_ignore,omitempty"`
 	DeviceUUID      uuid.UUID `json:"device_uuid"`
+	// SplitTunnel lists applications which traffic is excluded from or included into the VPN tunnel.
+	SplitTunnel SplitTunnel `json:"split_tunnel,omitempty"`
+	// Schedule lists recurring windows when VPN connection is paused or disconnected.
+	Schedule []ScheduleRule `json:"schedule,omitempty"`
+	// TrustedNetworks defines what happens with the VPN connection when the host joins a network.
+	TrustedNetworks TrustedNetworks `json:"trusted_networks,omitempty"`
+	// Metrics configures the local OpenMetrics exporter.
+	Metrics Metrics `json:"metrics,omitempty"`
+	// Failover configures reconnecting to a different server when the connection degrades.
+	Failover Failover `json:"failover,omitempty"`
+	// FileshareRateLimit throttles the uploads of the meshnet file transfers.
+	FileshareRateLimit FileshareRateLimit `json:"fileshare_rate_limit,omitempty"`
 }
 
 // withLoginData makes a copy of current configuration
@@ -89,6 +101,11 @@ type AutoConnectData struct {
 	PostquantumVpn       bool      `json:"postquantum_vpn"`
 	// ECH controls the NordWhisper Encrypted Client Hello feature. True by default.
 	ECH TrueField `json:"ech,omitempty"`
+	// EncryptedDNS lists DoH and DoT endpoints served through the local stub resolver.
+	// Mutually exclusive with DNS.
+	EncryptedDNS []string `json:"encrypted_dns,omitempty"`
+	// DNSRoutes send the queries of the internal domains to their own nameservers
+	DNSRoutes []DNSRoute `json:"dns_routes,omitempty"`
 }
 
 type DNS []string
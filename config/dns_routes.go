@@ -0,0 +1,59 @@
+package config
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+var (
+	// ErrDNSRouteInvalidDomain is returned for malformed route domains and for the public
+	// suffixes, e.g. 'com' or 'co.uk'.
+	ErrDNSRouteInvalidDomain = errors.New("invalid DNS route domain")
+	// ErrDNSRouteInvalidNameserver is returned when the nameserver is not a private or link local
+	// IPv4 address.
+	ErrDNSRouteInvalidNameserver = errors.New("invalid DNS route nameserver")
+)
+
+// DNSRoute sends the queries of the domain and its subdomains to the nameserver outside of the
+// VPN tunnel, e.g. to resolve the internal zones of a corporate network.
+type DNSRoute struct {
+	Domain     string `json:"domain"`
+	Nameserver string `json:"nameserver"`
+}
+
+// NewDNSRoute returns the route with the normalized domain or an error if it is not valid.
+// Routes are meant for the internal zones only, so the public suffixes can not be routed and the
+// nameserver has to be in the local network. Otherwise a route could send the queries of whole
+// top level domains outside of the VPN tunnel.
+func NewDNSRoute(domain string, nameserver string) (DNSRoute, error) {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	if len(domain) == 0 || len(domain) > maxDomainLength {
+		return DNSRoute{}, ErrDNSRouteInvalidDomain
+	}
+	if _, err := netip.ParseAddr(domain); err == nil {
+		return DNSRoute{}, ErrDNSRouteInvalidDomain
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return DNSRoute{}, ErrDNSRouteInvalidDomain
+	}
+	for _, label := range labels {
+		if !isDomainLabelValid(label) {
+			return DNSRoute{}, ErrDNSRouteInvalidDomain
+		}
+	}
+	if suffix, _ := publicsuffix.PublicSuffix(domain); suffix == domain {
+		return DNSRoute{}, ErrDNSRouteInvalidDomain
+	}
+
+	// only IPv4 nameservers can be allowed outside of the tunnel by the firewall
+	addr, err := netip.ParseAddr(strings.TrimSpace(nameserver))
+	if err != nil || !addr.Is4() || !(addr.IsPrivate() || addr.IsLinkLocalUnicast()) {
+		return DNSRoute{}, ErrDNSRouteInvalidNameserver
+	}
+
+	return DNSRoute{Domain: domain, Nameserver: addr.String()}, nil
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDNSRoute(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name       string
+		domain     string
+		nameserver string
+		expected   DNSRoute
+		err        error
+	}{
+		{
+			name:       "valid",
+			domain:     "corp.example",
+			nameserver: "10.0.0.53",
+			expected:   DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"},
+		},
+		{
+			name:       "normalized",
+			domain:     " Corp.Example. ",
+			nameserver: "10.0.0.53",
+			expected:   DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"},
+		},
+		{
+			name:       "internal zone",
+			domain:     "corp.internal",
+			nameserver: "192.168.1.1",
+			expected:   DNSRoute{Domain: "corp.internal", Nameserver: "192.168.1.1"},
+		},
+		{
+			name:       "link local nameserver",
+			domain:     "corp.example",
+			nameserver: "169.254.0.53",
+			expected:   DNSRoute{Domain: "corp.example", Nameserver: "169.254.0.53"},
+		},
+		{name: "single label", domain: "internal", nameserver: "192.168.1.1", err: ErrDNSRouteInvalidDomain},
+		{name: "top level domain", domain: "com", nameserver: "10.0.0.53", err: ErrDNSRouteInvalidDomain},
+		{name: "public suffix", domain: "co.uk", nameserver: "10.0.0.53", err: ErrDNSRouteInvalidDomain},
+		{name: "empty domain", domain: "", nameserver: "10.0.0.53", err: ErrDNSRouteInvalidDomain},
+		{name: "IP as domain", domain: "10.0.0.1", nameserver: "10.0.0.53", err: ErrDNSRouteInvalidDomain},
+		{name: "invalid label", domain: "corp_.example", nameserver: "10.0.0.53", err: ErrDNSRouteInvalidDomain},
+		{name: "empty label", domain: "corp..example", nameserver: "10.0.0.53", err: ErrDNSRouteInvalidDomain},
+		{name: "nameserver not IP", domain: "corp.example", nameserver: "ns.corp.example", err: ErrDNSRouteInvalidNameserver},
+		{name: "IPv6 nameserver", domain: "corp.example", nameserver: "fd00::53", err: ErrDNSRouteInvalidNameserver},
+		{name: "loopback nameserver", domain: "corp.example", nameserver: "127.0.0.53", err: ErrDNSRouteInvalidNameserver},
+		{name: "unspecified nameserver", domain: "corp.example", nameserver: "0.0.0.0", err: ErrDNSRouteInvalidNameserver},
+		{name: "public nameserver", domain: "corp.example", nameserver: "8.8.8.8", err: ErrDNSRouteInvalidNameserver},
+		{name: "shared address space nameserver", domain: "corp.example", nameserver: "100.64.0.53", err: ErrDNSRouteInvalidNameserver},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			route, err := NewDNSRoute(test.domain, test.nameserver)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.expected, route)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultFailoverMaxHandshakeAge is longer than the WireGuard key rotation interval, so
+	// handshake is missed at least once before the connection is considered broken.
+	DefaultFailoverMaxHandshakeAge = 5 * time.Minute
+	DefaultFailoverMaxPacketLoss   = 50
+	DefaultFailoverMaxLatency      = 2 * time.Second
+	// MaxFailoverLatency is the longest a probe is waited for, slower answers count as lost
+	MaxFailoverLatency = 5 * time.Second
+)
+
+// ErrInvalidFailoverThreshold is returned when a failover threshold is out of range.
+var ErrInvalidFailoverThreshold = errors.New("invalid failover threshold")
+
+// Failover configures the connection quality monitor which reconnects to a different server
+// when the active connection stops carrying traffic. Zero thresholds mean defaults.
+type Failover struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// MaxHandshakeAge is the age of the latest WireGuard handshake after which the tunnel is
+	// considered dead. Used only for NordLynx.
+	MaxHandshakeAge time.Duration `json:"max_handshake_age,omitempty"`
+	// MaxPacketLoss is the percentage of unanswered probes to the DNS inside the tunnel.
+	MaxPacketLoss uint32 `json:"max_packet_loss,omitempty"`
+	// MaxLatency is the average round trip time of the probes to the DNS inside the tunnel.
+	MaxLatency time.Duration `json:"max_latency,omitempty"`
+}
+
+// WithDefaults returns a copy of the settings with the unset thresholds replaced by defaults.
+func (f Failover) WithDefaults() Failover {
+	if f.MaxHandshakeAge == 0 {
+		f.MaxHandshakeAge = DefaultFailoverMaxHandshakeAge
+	}
+	if f.MaxPacketLoss == 0 {
+		f.MaxPacketLoss = DefaultFailoverMaxPacketLoss
+	}
+	if f.MaxLatency == 0 {
+		f.MaxLatency = DefaultFailoverMaxLatency
+	}
+	return f
+}
+
+// Validate checks whether the thresholds can be used by the monitor.
+func (f Failover) Validate() error {
+	if f.MaxHandshakeAge < 0 || f.MaxLatency < 0 || f.MaxLatency > MaxFailoverLatency || f.MaxPacketLoss > 100 {
+		return ErrInvalidFailoverThreshold
+	}
+	// handshakes happen every 2 minutes, anything shorter fails over healthy connections
+	if f.MaxHandshakeAge != 0 && f.MaxHandshakeAge < 3*time.Minute {
+		return ErrInvalidFailoverThreshold
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailover_WithDefaults(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, Failover{
+		Enabled:         true,
+		MaxHandshakeAge: DefaultFailoverMaxHandshakeAge,
+		MaxPacketLoss:   DefaultFailoverMaxPacketLoss,
+		MaxLatency:      DefaultFailoverMaxLatency,
+	}, Failover{Enabled: true}.WithDefaults())
+
+	custom := Failover{MaxHandshakeAge: 10 * time.Minute, MaxPacketLoss: 20, MaxLatency: time.Second}
+	assert.Equal(t, custom, custom.WithDefaults())
+}
+
+func TestFailover_Validate(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, test := range []struct {
+		name     string
+		failover Failover
+		valid    bool
+	}{
+		{name: "defaults", valid: true},
+		{name: "custom", failover: Failover{MaxHandshakeAge: 3 * time.Minute, MaxPacketLoss: 100, MaxLatency: time.Second}, valid: true},
+		{name: "packet loss over 100", failover: Failover{MaxPacketLoss: 101}},
+		{name: "handshake age shorter than rekey", failover: Failover{MaxHandshakeAge: time.Minute}},
+		{name: "negative latency", failover: Failover{MaxLatency: -time.Second}},
+		{name: "latency over probe timeout", failover: Failover{MaxLatency: MaxFailoverLatency + time.Second}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.failover.Validate()
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidFailoverThreshold)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package config
+
+// FileshareRateLimit limits the upload rate of the outgoing fileshare transfers in bytes per
+// second. Zero disables the limit.
+type FileshareRateLimit struct {
+	// Total is shared by all the transfers
+	Total uint64 `json:"total,omitempty"`
+	// Peer is shared by the transfers to the same peer, since they use a single connection
+	Peer uint64 `json:"peer,omitempty"`
+}
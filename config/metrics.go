@@ -0,0 +1,57 @@
+package config
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"path/filepath"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// ErrMetricsListenNotLocal is returned when the metrics exporter would be reachable from other hosts.
+var ErrMetricsListenNotLocal = errors.New("metrics can be served only on the default unix socket or a loopback address")
+
+// Metrics configures the local OpenMetrics exporter.
+type Metrics struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Listen is the default unix socket path or a loopback host:port. Default socket is used if empty.
+	Listen string `json:"listen,omitempty"`
+}
+
+// Address returns the network and the address the exporter should listen on.
+func (m Metrics) Address() (string, string, error) {
+	return ParseMetricsListen(m.Listen)
+}
+
+// ParseMetricsListen validates the listen address and returns the network for it.
+// Only the default unix socket and loopback TCP addresses are accepted. Socket is created by the
+// root daemon, so other paths would allow the users to replace arbitrary sockets.
+func ParseMetricsListen(listen string) (string, string, error) {
+	if listen == "" {
+		return internal.Proto, internal.MetricsSocket, nil
+	}
+	if filepath.IsAbs(listen) {
+		if filepath.Clean(listen) != internal.MetricsSocket {
+			return "", "", ErrMetricsListenNotLocal
+		}
+		return internal.Proto, internal.MetricsSocket, nil
+	}
+
+	host, port, err := net.SplitHostPort(listen)
+	if err != nil {
+		return "", "", ErrMetricsListenNotLocal
+	}
+	if p, err := strconv.ParseUint(port, 10, 16); err != nil || p == 0 {
+		return "", "", ErrMetricsListenNotLocal
+	}
+	if host == "localhost" {
+		return "tcp", listen, nil
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !addr.IsLoopback() {
+		return "", "", ErrMetricsListenNotLocal
+	}
+	return "tcp", listen, nil
+}
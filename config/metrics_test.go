@@ -0,0 +1,94 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMetricsListen(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, test := range []struct {
+		name            string
+		listen          string
+		expectedNetwork string
+		expectedAddress string
+		expectedErr     error
+	}{
+		{
+			name:            "default socket",
+			expectedNetwork: internal.Proto,
+			expectedAddress: internal.MetricsSocket,
+		},
+		{
+			name:            "default socket path",
+			listen:          internal.MetricsSocket,
+			expectedNetwork: internal.Proto,
+			expectedAddress: internal.MetricsSocket,
+		},
+		{
+			name:        "custom socket",
+			listen:      "/run/prometheus/../nordvpn.sock",
+			expectedErr: ErrMetricsListenNotLocal,
+		},
+		{
+			name:        "system socket",
+			listen:      "/run/dbus/system_bus_socket",
+			expectedErr: ErrMetricsListenNotLocal,
+		},
+		{
+			name:            "loopback ipv4",
+			listen:          "127.0.0.1:9100",
+			expectedNetwork: "tcp",
+			expectedAddress: "127.0.0.1:9100",
+		},
+		{
+			name:            "loopback ipv6",
+			listen:          "[::1]:9100",
+			expectedNetwork: "tcp",
+			expectedAddress: "[::1]:9100",
+		},
+		{
+			name:            "localhost",
+			listen:          "localhost:9100",
+			expectedNetwork: "tcp",
+			expectedAddress: "localhost:9100",
+		},
+		{
+			name:        "all interfaces",
+			listen:      "0.0.0.0:9100",
+			expectedErr: ErrMetricsListenNotLocal,
+		},
+		{
+			name:        "empty host",
+			listen:      ":9100",
+			expectedErr: ErrMetricsListenNotLocal,
+		},
+		{
+			name:        "lan address",
+			listen:      "192.168.1.2:9100",
+			expectedErr: ErrMetricsListenNotLocal,
+		},
+		{
+			name:        "relative path",
+			listen:      "metrics.sock",
+			expectedErr: ErrMetricsListenNotLocal,
+		},
+		{
+			name:        "invalid port",
+			listen:      "127.0.0.1:0",
+			expectedErr: ErrMetricsListenNotLocal,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			network, address, err := ParseMetricsListen(test.listen)
+			assert.ErrorIs(t, err, test.expectedErr)
+			assert.Equal(t, test.expectedNetwork, network)
+			assert.Equal(t, test.expectedAddress, address)
+		})
+	}
+}
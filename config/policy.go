@@ -0,0 +1,257 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PolicyVersion is the version of the policy file schema.
+const PolicyVersion = 1
+
+// ErrPolicyInvalid is returned when the policy file can not be parsed.
+var ErrPolicyInvalid = errors.New("invalid policy file")
+
+// Policy is written by the administrator to lock the settings against the changes made by the
+// users. Pinned settings use the settings file schema and are enforced when the daemon starts,
+// allowed lists restrict the values which can be selected.
+type Policy struct {
+	Version int          `yaml:"version"`
+	Pin     SettingsFile `yaml:"pin"`
+	Allow   PolicyAllow  `yaml:"allow"`
+}
+
+// PolicyAllow lists the values which can be selected. Empty list does not restrict the setting.
+type PolicyAllow struct {
+	Technology []string `yaml:"technology,omitempty"`
+	Protocol   []string `yaml:"protocol,omitempty"`
+	// DNS servers which can be set. Default DNS servers are always allowed.
+	DNS []string `yaml:"dns,omitempty"`
+	// SplitTunnel set to false forbids adding the split tunnel applications and changing the
+	// mode. Applications can still be removed.
+	SplitTunnel *bool `yaml:"split_tunnel,omitempty"`
+}
+
+// ParsePolicy decodes the policy file. Unknown fields are rejected, so the typos do not leave
+// the settings unlocked.
+func ParsePolicy(data []byte) (Policy, error) {
+	var policy Policy
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&policy); err != nil {
+		return Policy{}, fmt.Errorf("%w: %w", ErrPolicyInvalid, err)
+	}
+	if policy.Version != PolicyVersion {
+		return Policy{}, fmt.Errorf("%w: unsupported version %d", ErrPolicyInvalid, policy.Version)
+	}
+
+	for _, technology := range policy.Allow.Technology {
+		if value, ok := Technology_value[strings.ToUpper(technology)]; !ok ||
+			Technology(value) == Technology_UNKNOWN_TECHNOLOGY {
+			return Policy{}, fmt.Errorf("%w: invalid allow.technology value '%s'", ErrPolicyInvalid, technology)
+		}
+	}
+	for _, protocol := range policy.Allow.Protocol {
+		if !isProtocolName(protocol) {
+			return Policy{}, fmt.Errorf("%w: invalid allow.protocol value '%s'", ErrPolicyInvalid, protocol)
+		}
+	}
+	for _, address := range policy.Allow.DNS {
+		if !internal.IsAddressValidAsDNSServer(address) {
+			return Policy{}, fmt.Errorf("%w: invalid allow.dns value '%s'", ErrPolicyInvalid, address)
+		}
+	}
+	return policy, nil
+}
+
+// Enforce returns the config with the pinned settings applied.
+func (p Policy) Enforce(cfg Config) (Config, error) {
+	return p.Pin.Apply(cfg)
+}
+
+// LockedSettings returns the names of the settings restricted by the policy in the settings
+// file schema.
+func (p Policy) LockedSettings() ([]string, error) {
+	pinned, err := p.Pin.fields()
+	if err != nil {
+		return nil, err
+	}
+	var locked []string
+	for _, field := range pinned {
+		locked = appendSetting(locked, field.name)
+	}
+	if len(p.Allow.Technology) > 0 {
+		locked = appendSetting(locked, "technology")
+	}
+	if len(p.Allow.Protocol) > 0 {
+		locked = appendSetting(locked, "protocol")
+	}
+	if len(p.Allow.DNS) > 0 {
+		locked = appendSetting(locked, "dns")
+	}
+	if !p.isSplitTunnelAllowed() {
+		locked = appendSetting(locked, "split_tunnel")
+	}
+	return locked, nil
+}
+
+// Locked returns the names of the settings which are changed between the configs against the
+// policy. Settings which already violate the policy can only be changed to the allowed values.
+func (p Policy) Locked(oldCfg Config, newCfg Config) ([]string, error) {
+	pinned, err := p.Pin.fields()
+	if err != nil {
+		return nil, err
+	}
+	oldFields, err := NewSettingsFile(oldCfg).fields()
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := NewSettingsFile(newCfg).fields()
+	if err != nil {
+		return nil, err
+	}
+
+	var locked []string
+	for _, field := range pinned {
+		newValue, ok := findField(newFields, field.name)
+		if !ok {
+			// setting is not available for the new technology
+			continue
+		}
+		oldValue, _ := findField(oldFields, field.name)
+		if newValue != oldValue && newValue != field.value {
+			locked = appendSetting(locked, field.name)
+		}
+	}
+
+	if newCfg.Technology != oldCfg.Technology &&
+		!isAllowed(p.Allow.Technology, newCfg.Technology.String()) {
+		locked = appendSetting(locked, "technology")
+	}
+	if newCfg.AutoConnectData.Protocol != oldCfg.AutoConnectData.Protocol &&
+		!isAllowed(p.Allow.Protocol, newCfg.AutoConnectData.Protocol.String()) {
+		locked = appendSetting(locked, "protocol")
+	}
+	if len(p.Allow.DNS) > 0 && !slices.Equal(oldCfg.AutoConnectData.DNS, newCfg.AutoConnectData.DNS) {
+		for _, address := range newCfg.AutoConnectData.DNS {
+			if !slices.Contains(p.Allow.DNS, address) {
+				locked = appendSetting(locked, "dns")
+				break
+			}
+		}
+	}
+	// encrypted DNS endpoints and DNS routes can not be pinned or allowed, so they are locked
+	// whenever the policy controls DNS. Routes can still be removed.
+	if p.controlsDNS(pinned) {
+		if len(newCfg.AutoConnectData.EncryptedDNS) > 0 &&
+			!slices.Equal(oldCfg.AutoConnectData.EncryptedDNS, newCfg.AutoConnectData.EncryptedDNS) {
+			locked = appendSetting(locked, "dns")
+		}
+		for _, route := range newCfg.AutoConnectData.DNSRoutes {
+			if !slices.Contains(oldCfg.AutoConnectData.DNSRoutes, route) {
+				locked = appendSetting(locked, "dns")
+				break
+			}
+		}
+	}
+	if p.isSplitTunnelLocked(pinned, oldCfg.SplitTunnel, newCfg.SplitTunnel) {
+		locked = appendSetting(locked, "split_tunnel")
+	}
+	return locked, nil
+}
+
+func (p Policy) isSplitTunnelAllowed() bool {
+	return p.Allow.SplitTunnel == nil || *p.Allow.SplitTunnel
+}
+
+// isSplitTunnelLocked returns true if the split tunnel change is not allowed. When the kill
+// switch is pinned, applications can not be exempted from it and the include mode, which routes
+// every other application outside of the tunnel, can not be enabled.
+func (p Policy) isSplitTunnelLocked(pinned []settingsField, oldSplitTunnel SplitTunnel, newSplitTunnel SplitTunnel) bool {
+	modeChanged := oldSplitTunnel.IsIncludeOnly() != newSplitTunnel.IsIncludeOnly()
+	var added []SplitTunnelApp
+	for _, app := range newSplitTunnel.Apps {
+		if !slices.Contains(oldSplitTunnel.Apps, app) {
+			added = append(added, app)
+		}
+	}
+	if !p.isSplitTunnelAllowed() && (modeChanged || len(added) > 0) {
+		return true
+	}
+
+	killSwitchPinned := slices.ContainsFunc(pinned, func(field settingsField) bool {
+		return field.name == "killswitch" && field.value == "true"
+	})
+	if !killSwitchPinned {
+		return false
+	}
+	if modeChanged && newSplitTunnel.IsIncludeOnly() {
+		return true
+	}
+	return slices.ContainsFunc(added, func(app SplitTunnelApp) bool { return app.KillSwitchExempt })
+}
+
+// controlsDNS returns true if the DNS servers are allowed or pinned by the policy
+func (p Policy) controlsDNS(pinned []settingsField) bool {
+	return len(p.Allow.DNS) > 0 || slices.ContainsFunc(pinned, func(field settingsField) bool {
+		name, _, _ := strings.Cut(field.name, ".")
+		return name == "dns"
+	})
+}
+
+// isProtocolName checks the name case insensitively, since the protocol names are not in the same case
+func isProtocolName(value string) bool {
+	for name, number := range Protocol_value {
+		if strings.EqualFold(name, value) {
+			return Protocol(number) != Protocol_UNKNOWN_PROTOCOL
+		}
+	}
+	return false
+}
+
+func isAllowed(allowed []string, value string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(allowed, func(item string) bool { return strings.EqualFold(item, value) })
+}
+
+// appendSetting appends the top level name of the field if it is not in the list yet
+func appendSetting(settings []string, field string) []string {
+	name, _, _ := strings.Cut(field, ".")
+	if slices.Contains(settings, name) {
+		return settings
+	}
+	return append(settings, name)
+}
+
+// PolicyLoader reads the policy file on every use, so the changes made by the administrator
+// take effect without restarting the daemon.
+type PolicyLoader struct {
+	path string
+	fs   internal.FileSystemHandle
+}
+
+// NewPolicyLoader returns a loader of the policy file at the given path.
+func NewPolicyLoader(path string, fs internal.FileSystemHandle) *PolicyLoader {
+	return &PolicyLoader{path: path, fs: fs}
+}
+
+// Load returns the policy. Empty policy is returned if the policy file does not exist.
+func (l *PolicyLoader) Load() (Policy, error) {
+	data, err := l.fs.ReadFile(l.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Policy{}, nil
+		}
+		return Policy{}, fmt.Errorf("reading policy file: %w", err)
+	}
+	return ParsePolicy(data)
+}
@@ -0,0 +1,237 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/fs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicy = `version: 1
+pin:
+  killswitch: true
+  threat_protection_lite: true
+allow:
+  technology: [nordlynx, openvpn]
+  dns: [1.1.1.1, 1.0.0.1]
+`
+
+func TestParsePolicy(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, test := range []struct {
+		name    string
+		data    string
+		invalid bool
+	}{
+		{name: "valid", data: testPolicy},
+		{name: "missing version", data: "pin:\n  killswitch: true\n", invalid: true},
+		{name: "unknown field", data: "version: 1\nlock:\n  killswitch: true\n", invalid: true},
+		{name: "unknown pinned setting", data: "version: 1\npin:\n  kill_switch: true\n", invalid: true},
+		{name: "invalid technology", data: "version: 1\nallow:\n  technology: [ikev2]\n", invalid: true},
+		{name: "protocol case", data: "version: 1\nallow:\n  protocol: [webtunnel]\n"},
+		{name: "invalid dns", data: "version: 1\nallow:\n  dns: [dns.example.com]\n", invalid: true},
+		{name: "split tunnel", data: "version: 1\nallow:\n  split_tunnel: false\n"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParsePolicy([]byte(test.data))
+			if test.invalid {
+				assert.ErrorIs(t, err, ErrPolicyInvalid)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPolicy_Locked(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	policy, err := ParsePolicy([]byte(testPolicy))
+	require.NoError(t, err)
+
+	enforced, err := policy.Enforce(Config{Technology: Technology_NORDLYNX, Firewall: true})
+	require.NoError(t, err)
+	assert.True(t, enforced.KillSwitch)
+	assert.True(t, enforced.AutoConnectData.ThreatProtectionLite)
+
+	for _, test := range []struct {
+		name     string
+		oldCfg   Config
+		change   func(Config) Config
+		expected []string
+	}{
+		{
+			name:   "pinned setting",
+			oldCfg: enforced,
+			change: func(c Config) Config {
+				c.KillSwitch = false
+				return c
+			},
+			expected: []string{"killswitch"},
+		},
+		{
+			name:   "unpinned setting",
+			oldCfg: enforced,
+			change: func(c Config) Config {
+				c.LanDiscovery = true
+				return c
+			},
+		},
+		{
+			name:   "change to the pinned value",
+			oldCfg: Config{Technology: Technology_NORDLYNX, Firewall: true},
+			change: func(c Config) Config {
+				c.KillSwitch = true
+				return c
+			},
+		},
+		{
+			name:   "technology not allowed",
+			oldCfg: enforced,
+			change: func(c Config) Config {
+				c.Technology = Technology_NORDWHISPER
+				c.AutoConnectData.Protocol = Protocol_Webtunnel
+				return c
+			},
+			expected: []string{"technology"},
+		},
+		{
+			name:   "dns not allowed",
+			oldCfg: enforced,
+			change: func(c Config) Config {
+				c.AutoConnectData.ThreatProtectionLite = false
+				c.AutoConnectData.DNS = DNS{"8.8.8.8"}
+				return c
+			},
+			expected: []string{"threat_protection_lite", "dns"},
+		},
+		{
+			name:   "encrypted dns with restricted dns",
+			oldCfg: Config{Technology: Technology_NORDLYNX, Firewall: true, KillSwitch: true},
+			change: func(c Config) Config {
+				c.AutoConnectData.EncryptedDNS = []string{"tls://1.1.1.1"}
+				return c
+			},
+			expected: []string{"dns"},
+		},
+		{
+			name:   "dns route with restricted dns",
+			oldCfg: enforced,
+			change: func(c Config) Config {
+				c.AutoConnectData.DNSRoutes = []DNSRoute{{Domain: "corp.example", Nameserver: "10.0.0.53"}}
+				return c
+			},
+			expected: []string{"dns"},
+		},
+		{
+			name: "dns route removed with restricted dns",
+			oldCfg: func() Config {
+				c := enforced
+				c.AutoConnectData.DNSRoutes = []DNSRoute{{Domain: "corp.example", Nameserver: "10.0.0.53"}}
+				return c
+			}(),
+			change: func(c Config) Config {
+				c.AutoConnectData.DNSRoutes = nil
+				return c
+			},
+		},
+		{
+			name:   "split tunnel app with pinned kill switch",
+			oldCfg: enforced,
+			change: func(c Config) Config {
+				c.SplitTunnel.Add(SplitTunnelApp{Type: SplitTunnelAppPath, Value: "/usr/bin/curl"})
+				return c
+			},
+		},
+		{
+			name:   "kill switch exempt app with pinned kill switch",
+			oldCfg: enforced,
+			change: func(c Config) Config {
+				c.SplitTunnel.Add(SplitTunnelApp{Type: SplitTunnelAppPath, Value: "/usr/bin/curl", KillSwitchExempt: true})
+				return c
+			},
+			expected: []string{"split_tunnel"},
+		},
+		{
+			name:   "include mode with pinned kill switch",
+			oldCfg: enforced,
+			change: func(c Config) Config {
+				c.SplitTunnel.Mode = SplitTunnelModeInclude
+				return c
+			},
+			expected: []string{"split_tunnel"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			locked, err := policy.Locked(test.oldCfg, test.change(test.oldCfg))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, locked)
+		})
+	}
+}
+
+func TestPolicy_Locked_SplitTunnelNotAllowed(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	policy, err := ParsePolicy([]byte("version: 1\nallow:\n  split_tunnel: false\n"))
+	require.NoError(t, err)
+
+	app := SplitTunnelApp{Type: SplitTunnelAppPath, Value: "/usr/bin/curl"}
+	withApp := Config{SplitTunnel: SplitTunnel{Apps: []SplitTunnelApp{app}}}
+
+	locked, err := policy.Locked(Config{}, withApp)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"split_tunnel"}, locked)
+
+	locked, err = policy.Locked(Config{}, Config{SplitTunnel: SplitTunnel{Mode: SplitTunnelModeInclude}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"split_tunnel"}, locked)
+
+	// removing applications reduces the traffic outside of the tunnel
+	locked, err = policy.Locked(withApp, Config{})
+	require.NoError(t, err)
+	assert.Empty(t, locked)
+
+	settings, err := policy.LockedSettings()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"split_tunnel"}, settings)
+}
+
+func TestPolicy_LockedSettings(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	policy, err := ParsePolicy([]byte(testPolicy))
+	require.NoError(t, err)
+
+	locked, err := policy.LockedSettings()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"killswitch", "threat_protection_lite", "technology", "dns"}, locked)
+}
+
+func TestPolicyLoader_Load(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	const path = "/etc/nordvpn/policy.yaml"
+
+	fsMock := fs.NewSystemFileHandleMock(t)
+	fsMock.ReadErr = os.ErrNotExist
+	policy, err := NewPolicyLoader(path, &fsMock).Load()
+	assert.NoError(t, err)
+	assert.Equal(t, Policy{}, policy)
+
+	fsMock = fs.NewSystemFileHandleMock(t)
+	fsMock.AddFile(path, []byte(testPolicy))
+	policy, err = NewPolicyLoader(path, &fsMock).Load()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"nordlynx", "openvpn"}, policy.Allow.Technology)
+
+	fsMock = fs.NewSystemFileHandleMock(t)
+	fsMock.AddFile(path, []byte("killswitch: true\n"))
+	_, err = NewPolicyLoader(path, &fsMock).Load()
+	assert.ErrorIs(t, err, ErrPolicyInvalid)
+}
@@ -0,0 +1,160 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const clockLayout = "15:04"
+
+// ScheduleAction defines what happens with the VPN connection during the scheduled window.
+type ScheduleAction string
+
+const (
+	// SchedulePause pauses the VPN connection for the duration of the window and reconnects once it ends.
+	SchedulePause ScheduleAction = "pause"
+	// ScheduleDisconnect disconnects from VPN for the duration of the window and reconnects once it ends.
+	ScheduleDisconnect ScheduleAction = "disconnect"
+)
+
+var (
+	// ErrScheduleInvalidTime is returned when the window start or end is not in HH:MM format.
+	ErrScheduleInvalidTime = errors.New("invalid schedule time")
+	// ErrScheduleEmptyWindow is returned when the window starts and ends at the same time.
+	ErrScheduleEmptyWindow = errors.New("schedule window is empty")
+	// ErrScheduleInvalidAction is returned for an unknown schedule action.
+	ErrScheduleInvalidAction = errors.New("invalid schedule action")
+	// ErrScheduleInvalidDay is returned for a day of the week out of range.
+	ErrScheduleInvalidDay = errors.New("invalid schedule day")
+)
+
+// ScheduleRule is a recurring window in the local time zone. Window which ends before it
+// starts spans over midnight, e.g. 23:00-02:00. Days refer to the day when the window starts.
+type ScheduleRule struct {
+	ID     string         `json:"id"`
+	Action ScheduleAction `json:"action"`
+	// Days when the window starts. Empty list means every day.
+	Days  []time.Weekday `json:"days,omitempty"`
+	Start string         `json:"start"`
+	End   string         `json:"end"`
+}
+
+// Validate returns an error if the rule can not be scheduled.
+func (r ScheduleRule) Validate() error {
+	if r.Action != SchedulePause && r.Action != ScheduleDisconnect {
+		return fmt.Errorf("%w: %s", ErrScheduleInvalidAction, r.Action)
+	}
+	for _, day := range r.Days {
+		if day < time.Sunday || day > time.Saturday {
+			return fmt.Errorf("%w: %d", ErrScheduleInvalidDay, day)
+		}
+	}
+	start, err := ParseClock(r.Start)
+	if err != nil {
+		return err
+	}
+	end, err := ParseClock(r.End)
+	if err != nil {
+		return err
+	}
+	if start == end {
+		return ErrScheduleEmptyWindow
+	}
+	return nil
+}
+
+// Equal returns true if both rules describe the same window, IDs are ignored.
+func (r ScheduleRule) Equal(other ScheduleRule) bool {
+	return r.Action == other.Action &&
+		r.Start == other.Start &&
+		r.End == other.End &&
+		slices.Equal(r.normalizedDays(), other.normalizedDays())
+}
+
+// Window returns the start and the end of the window which contains given time.
+func (r ScheduleRule) Window(now time.Time) (time.Time, time.Time, bool) {
+	start, err := ParseClock(r.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	end, err := ParseClock(r.End)
+	if err != nil || start == end {
+		return time.Time{}, time.Time{}, false
+	}
+	length := end - start
+	if length < 0 {
+		length += 24 * time.Hour
+	}
+
+	// window which started yesterday may still be active when it spans over midnight
+	for _, offset := range []int{0, -1} {
+		day := now.AddDate(0, 0, offset)
+		if !r.startsOn(day.Weekday()) {
+			continue
+		}
+		from := clockOn(day, start)
+		to := clockOn(day, start+length)
+		if !now.Before(from) && now.Before(to) {
+			return from, to, true
+		}
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+func (r ScheduleRule) startsOn(day time.Weekday) bool {
+	return len(r.Days) == 0 || slices.Contains(r.Days, day)
+}
+
+func (r ScheduleRule) normalizedDays() []time.Weekday {
+	days := slices.Clone(r.Days)
+	slices.Sort(days)
+	return slices.Compact(days)
+}
+
+// clockOn returns time of the day in the same location as day.
+func clockOn(day time.Time, clock time.Duration) time.Time {
+	year, month, date := day.Date()
+	return time.Date(year, month, date, 0, 0, 0, 0, day.Location()).Add(clock)
+}
+
+// ParseClock parses time of the day in HH:MM format and returns it as duration since midnight.
+func ParseClock(value string) (time.Duration, error) {
+	clock, err := time.Parse(clockLayout, value)
+	if err != nil || len(value) != len(clockLayout) {
+		return 0, fmt.Errorf("%w: %s", ErrScheduleInvalidTime, value)
+	}
+	return time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute, nil
+}
+
+// ActiveScheduleRule returns the first rule which window contains given time together with the window bounds.
+func ActiveScheduleRule(rules []ScheduleRule, now time.Time) (ScheduleRule, time.Time, time.Time, bool) {
+	for _, rule := range rules {
+		if start, end, ok := rule.Window(now); ok {
+			return rule, start, end, true
+		}
+	}
+	return ScheduleRule{}, time.Time{}, time.Time{}, false
+}
+
+// NextScheduleRuleID returns an ID which is not used by any of the rules.
+func NextScheduleRuleID(rules []ScheduleRule) string {
+	ids := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, rule.ID)
+	}
+	return nextID(ids)
+}
+
+// nextID returns numeric ID greater than any of the given ones.
+func nextID(ids []string) string {
+	next := 1
+	for _, value := range ids {
+		if id, err := strconv.Atoi(value); err == nil && id >= next {
+			next = id + 1
+		}
+	}
+	return strconv.Itoa(next)
+}
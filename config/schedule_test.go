@@ -0,0 +1,166 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseClock(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, test := range []struct {
+		value    string
+		expected time.Duration
+		valid    bool
+	}{
+		{value: "00:00", expected: 0, valid: true},
+		{value: "12:30", expected: 12*time.Hour + 30*time.Minute, valid: true},
+		{value: "23:59", expected: 23*time.Hour + 59*time.Minute, valid: true},
+		{value: "24:00"},
+		{value: "12:60"},
+		{value: "1:30"},
+		{value: "+1:30"},
+		{value: "12-30"},
+		{value: ""},
+	} {
+		t.Run(test.value, func(t *testing.T) {
+			clock, err := ParseClock(test.value)
+			if !test.valid {
+				assert.ErrorIs(t, err, ErrScheduleInvalidTime)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, clock)
+		})
+	}
+}
+
+func TestScheduleRule_Validate(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	valid := ScheduleRule{Action: SchedulePause, Start: "12:00", End: "12:30"}
+	assert.NoError(t, valid.Validate())
+
+	rule := valid
+	rule.Action = "stop"
+	assert.ErrorIs(t, rule.Validate(), ErrScheduleInvalidAction)
+
+	rule = valid
+	rule.Days = []time.Weekday{7}
+	assert.ErrorIs(t, rule.Validate(), ErrScheduleInvalidDay)
+
+	rule = valid
+	rule.End = rule.Start
+	assert.ErrorIs(t, rule.Validate(), ErrScheduleEmptyWindow)
+
+	rule = valid
+	rule.End = "noon"
+	assert.ErrorIs(t, rule.Validate(), ErrScheduleInvalidTime)
+}
+
+func TestScheduleRule_Window(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	lunch := ScheduleRule{Action: SchedulePause, Days: weekdays, Start: "12:00", End: "12:30"}
+	backup := ScheduleRule{Action: ScheduleDisconnect, Days: []time.Weekday{time.Friday}, Start: "23:00", End: "02:00"}
+
+	// 2024-01-05 is Friday
+	friday := func(hour, minute int) time.Time {
+		return time.Date(2024, time.January, 5, hour, minute, 0, 0, time.UTC)
+	}
+
+	for _, test := range []struct {
+		name   string
+		rule   ScheduleRule
+		now    time.Time
+		active bool
+		start  time.Time
+		end    time.Time
+	}{
+		{
+			name:   "inside window",
+			rule:   lunch,
+			now:    friday(12, 10),
+			active: true,
+			start:  friday(12, 0),
+			end:    friday(12, 30),
+		},
+		{
+			name:   "window start is inclusive",
+			rule:   lunch,
+			now:    friday(12, 0),
+			active: true,
+			start:  friday(12, 0),
+			end:    friday(12, 30),
+		},
+		{
+			name: "window end is exclusive",
+			rule: lunch,
+			now:  friday(12, 30),
+		},
+		{
+			name: "day not in the rule",
+			rule: lunch,
+			now:  friday(12, 10).AddDate(0, 0, 1),
+		},
+		{
+			name:   "window over midnight before midnight",
+			rule:   backup,
+			now:    friday(23, 30),
+			active: true,
+			start:  friday(23, 0),
+			end:    friday(2, 0).AddDate(0, 0, 1),
+		},
+		{
+			name:   "window over midnight after midnight",
+			rule:   backup,
+			now:    friday(1, 0).AddDate(0, 0, 1),
+			active: true,
+			start:  friday(23, 0),
+			end:    friday(2, 0).AddDate(0, 0, 1),
+		},
+		{
+			name: "window over midnight on the start day morning",
+			rule: backup,
+			now:  friday(1, 0),
+		},
+		{
+			name:   "every day",
+			rule:   ScheduleRule{Action: SchedulePause, Start: "01:00", End: "05:00"},
+			now:    friday(1, 0).AddDate(0, 0, 1),
+			active: true,
+			start:  friday(1, 0).AddDate(0, 0, 1),
+			end:    friday(5, 0).AddDate(0, 0, 1),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			start, end, active := test.rule.Window(test.now)
+			assert.Equal(t, test.active, active)
+			assert.Equal(t, test.start, start)
+			assert.Equal(t, test.end, end)
+		})
+	}
+}
+
+func TestScheduleRule_Equal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rule := ScheduleRule{ID: "1", Action: SchedulePause, Days: []time.Weekday{time.Monday, time.Sunday}, Start: "12:00", End: "12:30"}
+	other := ScheduleRule{ID: "2", Action: SchedulePause, Days: []time.Weekday{time.Sunday, time.Monday}, Start: "12:00", End: "12:30"}
+	assert.True(t, rule.Equal(other))
+
+	other.Action = ScheduleDisconnect
+	assert.False(t, rule.Equal(other))
+}
+
+func TestNextScheduleRuleID(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "1", NextScheduleRuleID(nil))
+	assert.Equal(t, "4", NextScheduleRuleID([]ScheduleRule{{ID: "3"}, {ID: "1"}}))
+}
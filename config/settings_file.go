@@ -0,0 +1,550 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SettingsFileVersion is the version of the settings file schema written by the export.
+const SettingsFileVersion = 1
+
+const maxSettingsFileDNS = 3
+
+// ErrSettingsFileInvalid is returned when the settings file can not be applied.
+var ErrSettingsFileInvalid = errors.New("invalid settings file")
+
+// SettingsFile is the portable representation of the user controllable settings and the
+// permissions of the meshnet peers. Login data, machine identifiers and per user settings are not
+// part of it. Omitted fields are left unchanged when the file is applied.
+type SettingsFile struct {
+	Version              int                      `yaml:"version"`
+	Technology           *string                  `yaml:"technology,omitempty"`
+	Protocol             *string                  `yaml:"protocol,omitempty"`
+	Obfuscate            *bool                    `yaml:"obfuscate,omitempty"`
+	PostQuantum          *bool                    `yaml:"post_quantum,omitempty"`
+	ECH                  *bool                    `yaml:"ech,omitempty"`
+	Firewall             *bool                    `yaml:"firewall,omitempty"`
+	FirewallMark         *uint32                  `yaml:"fwmark,omitempty"`
+	Routing              *bool                    `yaml:"routing,omitempty"`
+	KillSwitch           *bool                    `yaml:"killswitch,omitempty"`
+	ThreatProtectionLite *bool                    `yaml:"threat_protection_lite,omitempty"`
+	DNS                  *[]string                `yaml:"dns,omitempty"`
+	EncryptedDNS         *[]string                `yaml:"encrypted_dns,omitempty"`
+	LANDiscovery         *bool                    `yaml:"lan_discovery,omitempty"`
+	VirtualLocation      *bool                    `yaml:"virtual_location,omitempty"`
+	ARPIgnore            *bool                    `yaml:"arp_ignore,omitempty"`
+	Allowlist            *SettingsFileAllowlist   `yaml:"allowlist,omitempty"`
+	AutoConnect          *SettingsFileAutoConnect `yaml:"autoconnect,omitempty"`
+	Meshnet              *SettingsFileMeshnet     `yaml:"meshnet,omitempty"`
+}
+
+// SettingsFileAllowlist replaces the whole allowlist when applied.
+type SettingsFileAllowlist struct {
+	Ports   SettingsFilePorts `yaml:"ports"`
+	Subnets []string          `yaml:"subnets"`
+	Domains []string          `yaml:"domains"`
+}
+
+// SettingsFilePorts lists allowlisted ports per protocol.
+type SettingsFilePorts struct {
+	TCP []int64 `yaml:"tcp"`
+	UDP []int64 `yaml:"udp"`
+}
+
+// SettingsFileAutoConnect describes the autoconnect target in the same form as the
+// `nordvpn set autoconnect` arguments.
+type SettingsFileAutoConnect struct {
+	Enabled bool   `yaml:"enabled"`
+	Server  string `yaml:"server,omitempty"`
+	Group   string `yaml:"group,omitempty"`
+}
+
+// SettingsFileMeshnet holds the permissions of the meshnet peers. They are kept by the meshnet
+// and not in the config, so they are exported and applied separately from the other settings.
+// Peers which are not listed keep their permissions.
+type SettingsFileMeshnet struct {
+	Peers []SettingsFileMeshPeer `yaml:"peers"`
+}
+
+// SettingsFileMeshPeer holds the permissions granted to the meshnet peer. Peers are identified by
+// the hostname, which is the same on every machine of the meshnet.
+type SettingsFileMeshPeer struct {
+	Hostname          string `yaml:"hostname"`
+	AllowIncoming     *bool  `yaml:"allow_incoming,omitempty"`
+	AllowRouting      *bool  `yaml:"allow_routing,omitempty"`
+	AllowLocalNetwork *bool  `yaml:"allow_local_network,omitempty"`
+	AllowFileshare    *bool  `yaml:"allow_fileshare,omitempty"`
+	AlwaysAcceptFiles *bool  `yaml:"always_accept_files,omitempty"`
+}
+
+type meshPermission struct {
+	name  string
+	value **bool
+}
+
+func (p *SettingsFileMeshPeer) permissions() []meshPermission {
+	return []meshPermission{
+		{name: "allow_incoming", value: &p.AllowIncoming},
+		{name: "allow_routing", value: &p.AllowRouting},
+		{name: "allow_local_network", value: &p.AllowLocalNetwork},
+		{name: "allow_fileshare", value: &p.AllowFileshare},
+		{name: "always_accept_files", value: &p.AlwaysAcceptFiles},
+	}
+}
+
+// Apply returns the permissions of the peers changed by the file and the changes in the same form
+// as DiffSettings. The entry of this machine, identified by the self hostname, is skipped, so the
+// same file can be applied on every machine of the meshnet.
+func (m SettingsFileMeshnet) Apply(
+	self string,
+	current []SettingsFileMeshPeer,
+) ([]SettingsFileMeshPeer, []string, error) {
+	updated := []SettingsFileMeshPeer{}
+	changes := []string{}
+	listed := map[string]bool{}
+	for _, peer := range m.Peers {
+		hostname := strings.ToLower(peer.Hostname)
+		if hostname == "" {
+			return nil, nil, invalidValue("meshnet.peers.hostname", peer.Hostname)
+		}
+		if listed[hostname] {
+			return nil, nil, fmt.Errorf("%w: meshnet peer %s is listed more than once", ErrSettingsFileInvalid, peer.Hostname)
+		}
+		listed[hostname] = true
+		if strings.EqualFold(hostname, self) {
+			continue
+		}
+
+		index := slices.IndexFunc(current, func(p SettingsFileMeshPeer) bool {
+			return strings.EqualFold(p.Hostname, hostname)
+		})
+		if index == -1 {
+			return nil, nil, fmt.Errorf("%w: unknown meshnet peer %s", ErrSettingsFileInvalid, peer.Hostname)
+		}
+
+		merged := current[index]
+		mergedPermissions := merged.permissions()
+		changed := false
+		for i, permission := range peer.permissions() {
+			value := *permission.value
+			oldValue := *mergedPermissions[i].value
+			if value == nil || (oldValue != nil && *oldValue == *value) {
+				continue
+			}
+			old := "-"
+			if oldValue != nil {
+				old = strconv.FormatBool(*oldValue)
+			}
+			changes = append(changes, fmt.Sprintf("meshnet.peers.%s.%s: %s -> %t", merged.Hostname, permission.name, old, *value))
+			*mergedPermissions[i].value = ptr(*value)
+			changed = true
+		}
+		if changed {
+			updated = append(updated, merged)
+		}
+	}
+	return updated, changes, nil
+}
+
+// NewSettingsFile exports the settings from the config. Options which are not available for
+// the configured technology are omitted.
+func NewSettingsFile(cfg Config) SettingsFile {
+	technology := strings.ToLower(cfg.Technology.String())
+	protocol := strings.ToLower(cfg.AutoConnectData.Protocol.String())
+	dns := slices.Clone([]string(cfg.AutoConnectData.DNS))
+	if dns == nil {
+		dns = []string{}
+	}
+	encryptedDNS := slices.Clone(cfg.AutoConnectData.EncryptedDNS)
+	if encryptedDNS == nil {
+		encryptedDNS = []string{}
+	}
+
+	file := SettingsFile{
+		Version:              SettingsFileVersion,
+		Technology:           &technology,
+		Protocol:             &protocol,
+		Firewall:             ptr(cfg.Firewall),
+		FirewallMark:         ptr(cfg.FirewallMark),
+		Routing:              ptr(cfg.Routing.Get()),
+		KillSwitch:           ptr(cfg.KillSwitch),
+		ThreatProtectionLite: ptr(cfg.AutoConnectData.ThreatProtectionLite),
+		DNS:                  &dns,
+		EncryptedDNS:         &encryptedDNS,
+		LANDiscovery:         ptr(cfg.LanDiscovery),
+		VirtualLocation:      ptr(cfg.VirtualLocation.Get()),
+		ARPIgnore:            ptr(cfg.ARPIgnore.Get()),
+		Allowlist:            newSettingsFileAllowlist(cfg.AutoConnectData.Allowlist),
+		AutoConnect: &SettingsFileAutoConnect{
+			Enabled: cfg.AutoConnect,
+			Server:  cfg.AutoConnectData.ServerTag,
+		},
+	}
+	//exhaustive:ignore
+	switch cfg.Technology {
+	case Technology_OPENVPN:
+		file.Obfuscate = ptr(cfg.AutoConnectData.Obfuscate)
+	case Technology_NORDLYNX:
+		file.PostQuantum = ptr(cfg.AutoConnectData.PostquantumVpn)
+	case Technology_NORDWHISPER:
+		file.ECH = ptr(cfg.AutoConnectData.ECH.Get())
+	}
+	if group := GroupTitleForId(cfg.AutoConnectData.Group); group != "" &&
+		!strings.EqualFold(group, cfg.AutoConnectData.ServerTag) {
+		file.AutoConnect.Group = group
+	}
+	return file
+}
+
+func newSettingsFileAllowlist(allowlist Allowlist) *SettingsFileAllowlist {
+	tcp := portSetToSlice(allowlist.Ports.TCP)
+	udp := portSetToSlice(allowlist.Ports.UDP)
+	subnets := slices.Clone(allowlist.Subnets)
+	if subnets == nil {
+		subnets = []string{}
+	}
+	domains := slices.Clone(allowlist.Domains)
+	if domains == nil {
+		domains = []string{}
+	}
+	return &SettingsFileAllowlist{
+		Ports:   SettingsFilePorts{TCP: tcp, UDP: udp},
+		Subnets: subnets,
+		Domains: domains,
+	}
+}
+
+func portSetToSlice(ports PortSet) []int64 {
+	out := []int64{}
+	for port, ok := range ports {
+		if ok {
+			out = append(out, port)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// ParseSettingsFile decodes the settings file. Unknown fields are rejected, so typos do not
+// silently leave the settings unchanged.
+func ParseSettingsFile(data []byte) (SettingsFile, error) {
+	var file SettingsFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil {
+		return SettingsFile{}, fmt.Errorf("%w: %w", ErrSettingsFileInvalid, err)
+	}
+	if file.Version != 0 && file.Version != SettingsFileVersion {
+		return SettingsFile{}, fmt.Errorf("%w: unsupported version %d", ErrSettingsFileInvalid, file.Version)
+	}
+	return file, nil
+}
+
+// Marshal encodes the settings file in YAML.
+func (f SettingsFile) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(f); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Apply returns the config with the settings from the file. The result is validated as a whole,
+// so settings which depend on each other can be changed together.
+func (f SettingsFile) Apply(cfg Config) (Config, error) {
+	oldTechnology := cfg.Technology
+	if f.Technology != nil {
+		technology, ok := Technology_value[strings.ToUpper(*f.Technology)]
+		if !ok || Technology(technology) == Technology_UNKNOWN_TECHNOLOGY {
+			return cfg, invalidValue("technology", *f.Technology)
+		}
+		cfg.Technology = Technology(technology)
+	}
+	if cfg.Technology != oldTechnology {
+		// same defaults as when the technology is changed with the set command
+		cfg.AutoConnectData.Protocol = Protocol_UDP
+		if cfg.Technology == Technology_NORDWHISPER {
+			cfg.AutoConnectData.Protocol = Protocol_Webtunnel
+		}
+		cfg.AutoConnectData.Obfuscate = false
+	}
+	if f.Protocol != nil {
+		protocol, err := parseProtocol(*f.Protocol, cfg.Technology)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.AutoConnectData.Protocol = protocol
+	}
+
+	if f.Obfuscate != nil {
+		if *f.Obfuscate && cfg.Technology != Technology_OPENVPN {
+			return cfg, unavailableFor("obfuscate", cfg.Technology)
+		}
+		cfg.AutoConnectData.Obfuscate = *f.Obfuscate
+	}
+	if f.PostQuantum != nil {
+		cfg.AutoConnectData.PostquantumVpn = *f.PostQuantum
+	}
+	if cfg.AutoConnectData.PostquantumVpn {
+		if cfg.Technology != Technology_NORDLYNX {
+			return cfg, unavailableFor("post_quantum", cfg.Technology)
+		}
+		if cfg.Mesh {
+			return cfg, fmt.Errorf("%w: post_quantum can not be used with meshnet", ErrSettingsFileInvalid)
+		}
+	}
+	if f.ECH != nil {
+		if cfg.Technology != Technology_NORDWHISPER {
+			return cfg, unavailableFor("ech", cfg.Technology)
+		}
+		cfg.AutoConnectData.ECH.Set(*f.ECH)
+	}
+
+	if f.Firewall != nil {
+		cfg.Firewall = *f.Firewall
+	}
+	if f.FirewallMark != nil {
+		if *f.FirewallMark == 0 {
+			return cfg, invalidValue("fwmark", "0")
+		}
+		cfg.FirewallMark = *f.FirewallMark
+	}
+	if f.Routing != nil {
+		if !*f.Routing && cfg.Mesh {
+			return cfg, fmt.Errorf("%w: routing is required by meshnet", ErrSettingsFileInvalid)
+		}
+		cfg.Routing.Set(*f.Routing)
+	}
+	if f.KillSwitch != nil {
+		cfg.KillSwitch = *f.KillSwitch
+	}
+	if cfg.KillSwitch && !cfg.Firewall {
+		return cfg, fmt.Errorf("%w: killswitch requires firewall", ErrSettingsFileInvalid)
+	}
+
+	if f.DNS != nil {
+		dns := *f.DNS
+		if len(dns) > maxSettingsFileDNS {
+			return cfg, fmt.Errorf("%w: at most %d DNS servers can be set", ErrSettingsFileInvalid, maxSettingsFileDNS)
+		}
+		for _, address := range dns {
+			if !internal.IsAddressValidAsDNSServer(address) {
+				return cfg, invalidValue("dns", address)
+			}
+		}
+		cfg.AutoConnectData.DNS = nil
+		if len(dns) > 0 {
+			cfg.AutoConnectData.DNS = slices.Clone(dns)
+		}
+	}
+	// the endpoints are validated by the daemon, which owns the stub resolver
+	if f.EncryptedDNS != nil {
+		encryptedDNS := *f.EncryptedDNS
+		if len(encryptedDNS) > maxSettingsFileDNS {
+			return cfg, fmt.Errorf("%w: at most %d encrypted DNS servers can be set", ErrSettingsFileInvalid, maxSettingsFileDNS)
+		}
+		cfg.AutoConnectData.EncryptedDNS = nil
+		if len(encryptedDNS) > 0 {
+			cfg.AutoConnectData.EncryptedDNS = slices.Clone(encryptedDNS)
+		}
+	}
+	if len(cfg.AutoConnectData.DNS) > 0 && len(cfg.AutoConnectData.EncryptedDNS) > 0 {
+		return cfg, fmt.Errorf("%w: dns can not be used with encrypted_dns", ErrSettingsFileInvalid)
+	}
+	if f.ThreatProtectionLite != nil {
+		cfg.AutoConnectData.ThreatProtectionLite = *f.ThreatProtectionLite
+	}
+	if cfg.AutoConnectData.ThreatProtectionLite &&
+		(len(cfg.AutoConnectData.DNS) > 0 || len(cfg.AutoConnectData.EncryptedDNS) > 0) {
+		return cfg, fmt.Errorf("%w: threat_protection_lite can not be used with custom DNS", ErrSettingsFileInvalid)
+	}
+
+	if f.LANDiscovery != nil {
+		cfg.LanDiscovery = *f.LANDiscovery
+	}
+	if f.VirtualLocation != nil {
+		cfg.VirtualLocation.Set(*f.VirtualLocation)
+	}
+	if f.ARPIgnore != nil {
+		cfg.ARPIgnore.Set(*f.ARPIgnore)
+	}
+
+	if f.Allowlist != nil {
+		allowlist, err := f.Allowlist.toAllowlist()
+		if err != nil {
+			return cfg, err
+		}
+		cfg.AutoConnectData.Allowlist = allowlist
+	}
+
+	if f.AutoConnect != nil {
+		group := ServerGroup_UNDEFINED
+		if f.AutoConnect.Group != "" {
+			var ok bool
+			if group, ok = GroupMap[strings.ToLower(f.AutoConnect.Group)]; !ok {
+				return cfg, invalidValue("autoconnect.group", f.AutoConnect.Group)
+			}
+		}
+		cfg.AutoConnect = f.AutoConnect.Enabled
+		cfg.AutoConnectData.ServerTag = f.AutoConnect.Server
+		cfg.AutoConnectData.Group = group
+	}
+	return cfg, nil
+}
+
+func (a SettingsFileAllowlist) toAllowlist() (Allowlist, error) {
+	for _, port := range slices.Concat(a.Ports.TCP, a.Ports.UDP) {
+		if port < internal.AllowlistMinPort || port > internal.AllowlistMaxPort {
+			return Allowlist{}, invalidValue("allowlist.ports", fmt.Sprint(port))
+		}
+	}
+	allowlist := NewAllowlist(a.Ports.UDP, a.Ports.TCP, []string{})
+	for _, subnet := range a.Subnets {
+		prefix, err := netip.ParsePrefix(subnet)
+		if err != nil || !prefix.Addr().Is4() {
+			return Allowlist{}, invalidValue("allowlist.subnets", subnet)
+		}
+		if !slices.Contains(allowlist.Subnets, prefix.String()) {
+			allowlist.Subnets = append(allowlist.Subnets, prefix.String())
+		}
+	}
+	for _, domain := range a.Domains {
+		normalized, ok := NormalizeDomain(domain)
+		if !ok {
+			return Allowlist{}, invalidValue("allowlist.domains", domain)
+		}
+		allowlist.UpdateDomains(normalized, false)
+	}
+	return allowlist, nil
+}
+
+func parseProtocol(value string, technology Technology) (Protocol, error) {
+	for name, number := range Protocol_value {
+		if !strings.EqualFold(name, value) {
+			continue
+		}
+		protocol := Protocol(number)
+		//exhaustive:ignore
+		switch technology {
+		case Technology_OPENVPN:
+			if protocol == Protocol_UDP || protocol == Protocol_TCP {
+				return protocol, nil
+			}
+		case Technology_NORDLYNX:
+			if protocol == Protocol_UDP {
+				return protocol, nil
+			}
+		case Technology_NORDWHISPER:
+			if protocol == Protocol_Webtunnel {
+				return protocol, nil
+			}
+		}
+		return Protocol_UNKNOWN_PROTOCOL, unavailableFor("protocol "+value, technology)
+	}
+	return Protocol_UNKNOWN_PROTOCOL, invalidValue("protocol", value)
+}
+
+func invalidValue(field string, value string) error {
+	return fmt.Errorf("%w: invalid %s value '%s'", ErrSettingsFileInvalid, field, value)
+}
+
+func unavailableFor(field string, technology Technology) error {
+	return fmt.Errorf("%w: %s is not available for %s", ErrSettingsFileInvalid, field, TechNameToUpperCamelCase(technology))
+}
+
+// DiffSettings lists the settings file fields changed between the configs in the form
+// "field: old -> new".
+func DiffSettings(oldCfg Config, newCfg Config) ([]string, error) {
+	oldFields, err := NewSettingsFile(oldCfg).fields()
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := NewSettingsFile(newCfg).fields()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []string{}
+	for _, field := range newFields {
+		oldValue, ok := findField(oldFields, field.name)
+		if !ok {
+			oldValue = "-"
+		}
+		if oldValue != field.value {
+			changes = append(changes, fmt.Sprintf("%s: %s -> %s", field.name, oldValue, field.value))
+		}
+	}
+	for _, field := range oldFields {
+		if _, ok := findField(newFields, field.name); !ok {
+			changes = append(changes, fmt.Sprintf("%s: %s -> -", field.name, field.value))
+		}
+	}
+	return changes, nil
+}
+
+type settingsField struct {
+	name  string
+	value string
+}
+
+func findField(fields []settingsField, name string) (string, bool) {
+	for _, field := range fields {
+		if field.name == name {
+			return field.value, true
+		}
+	}
+	return "", false
+}
+
+// fields flattens the file to the dotted field names and their values in the file order
+func (f SettingsFile) fields() ([]settingsField, error) {
+	var node yaml.Node
+	if err := node.Encode(f); err != nil {
+		return nil, err
+	}
+	var fields []settingsField
+	flattenNode(&node, "", &fields)
+	return fields, nil
+}
+
+func flattenNode(node *yaml.Node, prefix string, fields *[]settingsField) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			name := node.Content[i].Value
+			if prefix != "" {
+				name = prefix + "." + name
+			}
+			if name == "version" {
+				continue
+			}
+			flattenNode(node.Content[i+1], name, fields)
+		}
+	case yaml.SequenceNode:
+		values := make([]string, 0, len(node.Content))
+		for _, item := range node.Content {
+			values = append(values, item.Value)
+		}
+		*fields = append(*fields, settingsField{name: prefix, value: "[" + strings.Join(values, ", ") + "]"})
+	default:
+		*fields = append(*fields, settingsField{name: prefix, value: node.Value})
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,313 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func settingsFileTestConfig() Config {
+	return Config{
+		Technology:   Technology_NORDLYNX,
+		Firewall:     true,
+		FirewallMark: defaultFWMarkValue,
+		AutoConnectData: AutoConnectData{
+			Protocol: Protocol_UDP,
+			DNS:      DNS{"1.1.1.1"},
+			Allowlist: NewAllowlist(
+				[]int64{22}, nil, []string{"192.168.1.0/24"},
+			),
+		},
+	}
+}
+
+func TestSettingsFile_RoundTrip(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := settingsFileTestConfig()
+	data, err := NewSettingsFile(cfg).Marshal()
+	require.NoError(t, err)
+
+	file, err := ParseSettingsFile(data)
+	require.NoError(t, err)
+
+	applied, err := file.Apply(cfg)
+	require.NoError(t, err)
+
+	changes, err := DiffSettings(cfg, applied)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestSettingsFile_RoundTripEncryptedDNS(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := settingsFileTestConfig()
+	cfg.AutoConnectData.DNS = nil
+	cfg.AutoConnectData.EncryptedDNS = []string{"tls://1.1.1.1"}
+	data, err := NewSettingsFile(cfg).Marshal()
+	require.NoError(t, err)
+
+	file, err := ParseSettingsFile(data)
+	require.NoError(t, err)
+
+	applied, err := file.Apply(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tls://1.1.1.1"}, applied.AutoConnectData.EncryptedDNS)
+}
+
+func TestParseSettingsFile(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, test := range []struct {
+		name    string
+		data    string
+		invalid bool
+	}{
+		{name: "minimal", data: "version: 1\n"},
+		{name: "unknown field", data: "version: 1\nunknown: true\n", invalid: true},
+		{name: "unsupported version", data: "version: 2\n", invalid: true},
+		{name: "missing version", data: "firewall: true\n"},
+		{name: "malformed", data: "version: [\n", invalid: true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseSettingsFile([]byte(test.data))
+			if test.invalid {
+				assert.ErrorIs(t, err, ErrSettingsFileInvalid)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSettingsFile_Apply(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, test := range []struct {
+		name    string
+		data    string
+		invalid bool
+		config  func(*Config)
+		check   func(*testing.T, Config)
+	}{
+		{
+			name: "technology change resets protocol",
+			data: "version: 1\ntechnology: openvpn\n",
+			check: func(t *testing.T, cfg Config) {
+				assert.Equal(t, Technology_OPENVPN, cfg.Technology)
+				assert.Equal(t, Protocol_UDP, cfg.AutoConnectData.Protocol)
+			},
+		},
+		{
+			name: "openvpn protocol",
+			data: "version: 1\ntechnology: openvpn\nprotocol: tcp\nobfuscate: true\n",
+			check: func(t *testing.T, cfg Config) {
+				assert.Equal(t, Protocol_TCP, cfg.AutoConnectData.Protocol)
+				assert.True(t, cfg.AutoConnectData.Obfuscate)
+			},
+		},
+		{
+			name:    "obfuscate on nordlynx",
+			data:    "version: 1\nobfuscate: true\n",
+			invalid: true,
+		},
+		{
+			name:    "tcp on nordlynx",
+			data:    "version: 1\nprotocol: tcp\n",
+			invalid: true,
+		},
+		{
+			name:    "killswitch without firewall",
+			data:    "version: 1\nfirewall: false\nkillswitch: true\n",
+			invalid: true,
+		},
+		{
+			name:    "too many dns servers",
+			data:    "version: 1\ndns: [1.1.1.1, 1.0.0.1, 8.8.8.8, 8.8.4.4]\n",
+			invalid: true,
+		},
+		{
+			name:    "threat protection lite with custom dns",
+			data:    "version: 1\nthreat_protection_lite: true\n",
+			invalid: true,
+		},
+		{
+			name: "threat protection lite clears dns",
+			data: "version: 1\nthreat_protection_lite: true\ndns: []\n",
+			check: func(t *testing.T, cfg Config) {
+				assert.True(t, cfg.AutoConnectData.ThreatProtectionLite)
+				assert.Empty(t, cfg.AutoConnectData.DNS)
+			},
+		},
+		{
+			name: "dns keeps encrypted dns",
+			data: "version: 1\ndns: []\n",
+			config: func(cfg *Config) {
+				cfg.AutoConnectData.EncryptedDNS = []string{"tls://1.1.1.1"}
+			},
+			check: func(t *testing.T, cfg Config) {
+				assert.Empty(t, cfg.AutoConnectData.DNS)
+				assert.Equal(t, []string{"tls://1.1.1.1"}, cfg.AutoConnectData.EncryptedDNS)
+			},
+		},
+		{
+			name: "encrypted dns replaces dns",
+			data: "version: 1\ndns: []\nencrypted_dns: [https://1.1.1.1/dns-query]\n",
+			check: func(t *testing.T, cfg Config) {
+				assert.Empty(t, cfg.AutoConnectData.DNS)
+				assert.Equal(t, []string{"https://1.1.1.1/dns-query"}, cfg.AutoConnectData.EncryptedDNS)
+			},
+		},
+		{
+			name:    "dns with encrypted dns",
+			data:    "version: 1\nencrypted_dns: [tls://1.1.1.1]\n",
+			invalid: true,
+		},
+		{
+			name:    "invalid subnet",
+			data:    "version: 1\nallowlist:\n  subnets: [300.0.0.0/8]\n",
+			invalid: true,
+		},
+		{
+			name: "allowlist is replaced",
+			data: "version: 1\nallowlist:\n  ports:\n    udp: [53]\n",
+			check: func(t *testing.T, cfg Config) {
+				assert.Equal(t, map[int64]bool{53: true}, map[int64]bool(cfg.AutoConnectData.Allowlist.Ports.UDP))
+				assert.Empty(t, cfg.AutoConnectData.Allowlist.Ports.TCP)
+				assert.Empty(t, cfg.AutoConnectData.Allowlist.Subnets)
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			file, err := ParseSettingsFile([]byte(test.data))
+			require.NoError(t, err)
+
+			cfg := settingsFileTestConfig()
+			if test.config != nil {
+				test.config(&cfg)
+			}
+			cfg, err = file.Apply(cfg)
+			if test.invalid {
+				assert.ErrorIs(t, err, ErrSettingsFileInvalid)
+				return
+			}
+			require.NoError(t, err)
+			test.check(t, cfg)
+		})
+	}
+}
+
+func TestDiffSettings(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	oldCfg := settingsFileTestConfig()
+	newCfg := oldCfg
+	newCfg.Firewall = false
+	newCfg.AutoConnectData.DNS = DNS{"1.1.1.1", "1.0.0.1"}
+
+	changes, err := DiffSettings(oldCfg, newCfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"firewall: true -> false",
+		"dns: [1.1.1.1] -> [1.1.1.1, 1.0.0.1]",
+	}, changes)
+
+	newCfg.AutoConnectData.DNS = nil
+	newCfg.AutoConnectData.EncryptedDNS = []string{"tls://1.1.1.1"}
+	changes, err = DiffSettings(oldCfg, newCfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"firewall: true -> false",
+		"dns: [1.1.1.1] -> []",
+		"encrypted_dns: [] -> [tls://1.1.1.1]",
+	}, changes)
+}
+
+func TestSettingsFileMeshnet_Apply(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	current := []SettingsFileMeshPeer{
+		{
+			Hostname:          "peer-everest.nord",
+			AllowIncoming:     ptr(true),
+			AllowRouting:      ptr(false),
+			AllowLocalNetwork: ptr(false),
+			AllowFileshare:    ptr(false),
+			AlwaysAcceptFiles: ptr(false),
+		},
+		{
+			Hostname:          "other-everest.nord",
+			AllowIncoming:     ptr(true),
+			AllowRouting:      ptr(true),
+			AllowLocalNetwork: ptr(true),
+			AllowFileshare:    ptr(true),
+			AlwaysAcceptFiles: ptr(true),
+		},
+	}
+
+	for _, test := range []struct {
+		name    string
+		data    string
+		invalid bool
+		updated []SettingsFileMeshPeer
+		changes []string
+	}{
+		{
+			name: "changed permissions",
+			data: "version: 1\nmeshnet:\n  peers:\n" +
+				"    - hostname: PEER-everest.nord\n      allow_incoming: true\n      allow_fileshare: true\n" +
+				"    - hostname: other-everest.nord\n      allow_routing: true\n",
+			updated: []SettingsFileMeshPeer{{
+				Hostname:          "peer-everest.nord",
+				AllowIncoming:     ptr(true),
+				AllowRouting:      ptr(false),
+				AllowLocalNetwork: ptr(false),
+				AllowFileshare:    ptr(true),
+				AlwaysAcceptFiles: ptr(false),
+			}},
+			changes: []string{"meshnet.peers.peer-everest.nord.allow_fileshare: false -> true"},
+		},
+		{
+			name:    "own machine is skipped",
+			data:    "version: 1\nmeshnet:\n  peers:\n    - hostname: self-everest.nord\n      allow_routing: true\n",
+			updated: []SettingsFileMeshPeer{},
+			changes: []string{},
+		},
+		{
+			name:    "unknown peer",
+			data:    "version: 1\nmeshnet:\n  peers:\n    - hostname: unknown-everest.nord\n      allow_routing: true\n",
+			invalid: true,
+		},
+		{
+			name: "duplicated peer",
+			data: "version: 1\nmeshnet:\n  peers:\n" +
+				"    - hostname: peer-everest.nord\n    - hostname: peer-everest.nord\n",
+			invalid: true,
+		},
+		{
+			name:    "missing hostname",
+			data:    "version: 1\nmeshnet:\n  peers:\n    - allow_routing: true\n",
+			invalid: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			file, err := ParseSettingsFile([]byte(test.data))
+			require.NoError(t, err)
+
+			updated, changes, err := file.Meshnet.Apply("self-everest.nord", current)
+			if test.invalid {
+				assert.ErrorIs(t, err, ErrSettingsFileInvalid)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.updated, updated)
+			assert.Equal(t, test.changes, changes)
+			// current permissions are not modified
+			assert.False(t, *current[0].AllowFileshare)
+		})
+	}
+}
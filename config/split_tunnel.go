@@ -0,0 +1,82 @@
+package config
+
+import "slices"
+
+// SplitTunnelAppType describes how an application excluded from the VPN tunnel is identified.
+type SplitTunnelAppType string
+
+const (
+	// SplitTunnelAppPath identifies application by the absolute path to its executable.
+	SplitTunnelAppPath SplitTunnelAppType = "path"
+	// SplitTunnelAppPID identifies application by the process ID. Child processes are included.
+	SplitTunnelAppPID SplitTunnelAppType = "pid"
+	// SplitTunnelAppCgroup identifies application by the cgroup v2 path.
+	SplitTunnelAppCgroup SplitTunnelAppType = "cgroup"
+)
+
+// SplitTunnelMode defines whether split tunnel applications are excluded from or the only ones
+// included into the VPN tunnel.
+type SplitTunnelMode string
+
+const (
+	// SplitTunnelModeExclude routes all the traffic through the VPN tunnel except the listed applications.
+	// Empty value is treated the same way.
+	SplitTunnelModeExclude SplitTunnelMode = "exclude"
+	// SplitTunnelModeInclude routes only the listed applications through the VPN tunnel.
+	SplitTunnelModeInclude SplitTunnelMode = "include"
+)
+
+// SplitTunnelApp is a single application which traffic is routed according to the split tunnel mode.
+type SplitTunnelApp struct {
+	Type  SplitTunnelAppType `json:"type"`
+	Value string             `json:"value"`
+	// KillSwitchExempt allows application to reach the network even when kill switch
+	// blocks the traffic.
+	KillSwitchExempt bool `json:"kill_switch_exempt,omitempty"`
+}
+
+// SplitTunnel is a collection of applications which traffic is routed according to the mode.
+type SplitTunnel struct {
+	Mode SplitTunnelMode  `json:"mode,omitempty"`
+	Apps []SplitTunnelApp `json:"apps,omitempty"`
+}
+
+// IsIncludeOnly returns true if only the listed applications are routed through the VPN tunnel.
+func (s SplitTunnel) IsIncludeOnly() bool {
+	return s.Mode == SplitTunnelModeInclude
+}
+
+// Contains returns true if application with given type and value is already in the list.
+func (s SplitTunnel) Contains(appType SplitTunnelAppType, value string) bool {
+	return s.index(appType, value) != -1
+}
+
+// Add application to the list or update the existing entry. Returns false if nothing has changed.
+func (s *SplitTunnel) Add(app SplitTunnelApp) bool {
+	idx := s.index(app.Type, app.Value)
+	if idx == -1 {
+		s.Apps = append(s.Apps, app)
+		return true
+	}
+	if s.Apps[idx] == app {
+		return false
+	}
+	s.Apps[idx] = app
+	return true
+}
+
+// Remove application from the list. Returns false if application was not in the list.
+func (s *SplitTunnel) Remove(appType SplitTunnelAppType, value string) bool {
+	idx := s.index(appType, value)
+	if idx == -1 {
+		return false
+	}
+	s.Apps = slices.Delete(s.Apps, idx, idx+1)
+	return true
+}
+
+func (s SplitTunnel) index(appType SplitTunnelAppType, value string) int {
+	return slices.IndexFunc(s.Apps, func(app SplitTunnelApp) bool {
+		return app.Type == appType && app.Value == value
+	})
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitTunnel_AddRemove(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var st SplitTunnel
+	app := SplitTunnelApp{Type: SplitTunnelAppPath, Value: "/usr/bin/curl"}
+
+	assert.True(t, st.Add(app))
+	assert.False(t, st.Add(app))
+	assert.True(t, st.Contains(SplitTunnelAppPath, "/usr/bin/curl"))
+	assert.False(t, st.Contains(SplitTunnelAppPID, "/usr/bin/curl"))
+
+	app.KillSwitchExempt = true
+	assert.True(t, st.Add(app), "changing kill switch exemption should update the entry")
+	assert.Len(t, st.Apps, 1)
+	assert.True(t, st.Apps[0].KillSwitchExempt)
+
+	assert.True(t, st.Add(SplitTunnelApp{Type: SplitTunnelAppPID, Value: "42"}))
+	assert.Len(t, st.Apps, 2)
+
+	assert.True(t, st.Remove(SplitTunnelAppPath, "/usr/bin/curl"))
+	assert.False(t, st.Remove(SplitTunnelAppPath, "/usr/bin/curl"))
+	assert.Equal(t, []SplitTunnelApp{{Type: SplitTunnelAppPID, Value: "42"}}, st.Apps)
+}
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"errors"
+	"net"
+	"slices"
+)
+
+// TrustedNetworkAction defines what happens with the VPN connection when the host joins a trusted network.
+type TrustedNetworkAction string
+
+const (
+	// TrustedNetworkDisconnect disconnects from VPN.
+	TrustedNetworkDisconnect TrustedNetworkAction = "disconnect"
+	// TrustedNetworkMeshnetOnly disconnects from VPN and turns meshnet on.
+	TrustedNetworkMeshnetOnly TrustedNetworkAction = "meshnet"
+)
+
+const maxSSIDLength = 32
+
+var (
+	// ErrTrustedNetworkNoIdentity is returned when neither SSID nor gateway MAC address is set.
+	ErrTrustedNetworkNoIdentity = errors.New("trusted network has no SSID or gateway MAC address")
+	// ErrTrustedNetworkInvalidSSID is returned for SSID longer than allowed by 802.11.
+	ErrTrustedNetworkInvalidSSID = errors.New("invalid SSID")
+	// ErrTrustedNetworkInvalidMAC is returned for malformed gateway MAC address.
+	ErrTrustedNetworkInvalidMAC = errors.New("invalid gateway MAC address")
+	// ErrTrustedNetworkInvalidAction is returned for an unknown action.
+	ErrTrustedNetworkInvalidAction = errors.New("invalid trusted network action")
+)
+
+// TrustedNetwork is identified by the Wi-Fi SSID, the MAC address of the default gateway, or both.
+// All the set fields must match for the network to be trusted.
+type TrustedNetwork struct {
+	ID         string               `json:"id"`
+	SSID       string               `json:"ssid,omitempty"`
+	GatewayMAC string               `json:"gateway_mac,omitempty"`
+	Action     TrustedNetworkAction `json:"action"`
+}
+
+// Validate returns an error if the network can not be matched.
+func (n TrustedNetwork) Validate() error {
+	if n.SSID == "" && n.GatewayMAC == "" {
+		return ErrTrustedNetworkNoIdentity
+	}
+	if len(n.SSID) > maxSSIDLength {
+		return ErrTrustedNetworkInvalidSSID
+	}
+	if n.GatewayMAC != "" {
+		if _, ok := NormalizeMAC(n.GatewayMAC); !ok {
+			return ErrTrustedNetworkInvalidMAC
+		}
+	}
+	if n.Action != TrustedNetworkDisconnect && n.Action != TrustedNetworkMeshnetOnly {
+		return ErrTrustedNetworkInvalidAction
+	}
+	return nil
+}
+
+// Matches returns true if the network with given SSID and gateway MAC address is the trusted one.
+func (n TrustedNetwork) Matches(ssid string, gatewayMAC string) bool {
+	if n.SSID == "" && n.GatewayMAC == "" {
+		return false
+	}
+	if n.SSID != "" && n.SSID != ssid {
+		return false
+	}
+	if n.GatewayMAC != "" {
+		mac, ok := NormalizeMAC(gatewayMAC)
+		if !ok {
+			return false
+		}
+		expected, _ := NormalizeMAC(n.GatewayMAC)
+		return mac == expected
+	}
+	return true
+}
+
+// SameNetwork returns true if both entries identify the same network, IDs and actions are ignored.
+func (n TrustedNetwork) SameNetwork(other TrustedNetwork) bool {
+	mac, _ := NormalizeMAC(n.GatewayMAC)
+	otherMAC, _ := NormalizeMAC(other.GatewayMAC)
+	return n.SSID == other.SSID && mac == otherMAC
+}
+
+// TrustedNetworks is a collection of trusted networks.
+type TrustedNetworks struct {
+	// ConnectUntrusted connects to VPN when the host joins a network which is not trusted.
+	ConnectUntrusted bool             `json:"connect_untrusted,omitempty"`
+	Networks         []TrustedNetwork `json:"networks,omitempty"`
+}
+
+// Match returns the first trusted network matching given SSID and gateway MAC address.
+func (t TrustedNetworks) Match(ssid string, gatewayMAC string) (TrustedNetwork, bool) {
+	idx := slices.IndexFunc(t.Networks, func(n TrustedNetwork) bool { return n.Matches(ssid, gatewayMAC) })
+	if idx == -1 {
+		return TrustedNetwork{}, false
+	}
+	return t.Networks[idx], true
+}
+
+// IsEnabled returns true if joining a network can change the VPN connection.
+func (t TrustedNetworks) IsEnabled() bool {
+	return t.ConnectUntrusted || len(t.Networks) > 0
+}
+
+// NextID returns an ID which is not used by any of the networks.
+func (t TrustedNetworks) NextID() string {
+	ids := make([]string, 0, len(t.Networks))
+	for _, network := range t.Networks {
+		ids = append(ids, network.ID)
+	}
+	return nextID(ids)
+}
+
+// NormalizeMAC returns MAC address in the lowercase colon separated form.
+func NormalizeMAC(value string) (string, bool) {
+	mac, err := net.ParseMAC(value)
+	if err != nil || len(mac) != 6 {
+		return "", false
+	}
+	return mac.String(), true
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrustedNetwork_Validate(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, test := range []struct {
+		name     string
+		network  TrustedNetwork
+		expected error
+	}{
+		{
+			name:    "ssid",
+			network: TrustedNetwork{SSID: "office", Action: TrustedNetworkDisconnect},
+		},
+		{
+			name:    "gateway mac",
+			network: TrustedNetwork{GatewayMAC: "AA-BB-CC-DD-EE-FF", Action: TrustedNetworkMeshnetOnly},
+		},
+		{
+			name:     "no identity",
+			network:  TrustedNetwork{Action: TrustedNetworkDisconnect},
+			expected: ErrTrustedNetworkNoIdentity,
+		},
+		{
+			name:     "ssid too long",
+			network:  TrustedNetwork{SSID: "0123456789abcdef0123456789abcdef0", Action: TrustedNetworkDisconnect},
+			expected: ErrTrustedNetworkInvalidSSID,
+		},
+		{
+			name:     "invalid mac",
+			network:  TrustedNetwork{GatewayMAC: "aa:bb:cc", Action: TrustedNetworkDisconnect},
+			expected: ErrTrustedNetworkInvalidMAC,
+		},
+		{
+			name:     "invalid action",
+			network:  TrustedNetwork{SSID: "office", Action: "connect"},
+			expected: ErrTrustedNetworkInvalidAction,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ErrorIs(t, test.network.Validate(), test.expected)
+		})
+	}
+}
+
+func TestTrustedNetworks_Match(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	office := TrustedNetwork{ID: "1", SSID: "office", Action: TrustedNetworkDisconnect}
+	home := TrustedNetwork{ID: "2", GatewayMAC: "AA:BB:CC:DD:EE:FF", Action: TrustedNetworkMeshnetOnly}
+	lab := TrustedNetwork{ID: "3", SSID: "lab", GatewayMAC: "11:22:33:44:55:66", Action: TrustedNetworkDisconnect}
+	networks := TrustedNetworks{Networks: []TrustedNetwork{office, home, lab}}
+
+	for _, test := range []struct {
+		name       string
+		ssid       string
+		gatewayMAC string
+		expected   TrustedNetwork
+		trusted    bool
+	}{
+		{name: "ssid matches", ssid: "office", gatewayMAC: "01:02:03:04:05:06", expected: office, trusted: true},
+		{name: "gateway mac matches", gatewayMAC: "aa:bb:cc:dd:ee:ff", expected: home, trusted: true},
+		{name: "both match", ssid: "lab", gatewayMAC: "11:22:33:44:55:66", expected: lab, trusted: true},
+		{name: "only ssid of the both matches", ssid: "lab", gatewayMAC: "01:02:03:04:05:06"},
+		{name: "ssid is case sensitive", ssid: "Office"},
+		{name: "unknown network", ssid: "cafe", gatewayMAC: "01:02:03:04:05:06"},
+		{name: "no identity"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			network, trusted := networks.Match(test.ssid, test.gatewayMAC)
+			assert.Equal(t, test.trusted, trusted)
+			assert.Equal(t, test.expected, network)
+		})
+	}
+}
+
+func TestTrustedNetwork_SameNetwork(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	network := TrustedNetwork{ID: "1", SSID: "office", GatewayMAC: "aa:bb:cc:dd:ee:ff", Action: TrustedNetworkDisconnect}
+	assert.True(t, network.SameNetwork(TrustedNetwork{SSID: "office", GatewayMAC: "AA-BB-CC-DD-EE-FF"}))
+	assert.False(t, network.SameNetwork(TrustedNetwork{SSID: "office"}))
+}
+
+func TestTrustedNetworks_NextID(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "1", TrustedNetworks{}.NextID())
+	assert.Equal(t, "3", TrustedNetworks{Networks: []TrustedNetwork{{ID: "2"}}}.NextID())
+}
@@ -33,6 +33,7 @@ const (
 	EntryPort      = "port"
 	EntryPortRange = "port_range"
 	EntrySubnet    = "subnet"
+	EntryDomain    = "domain"
 )
 
 // Protocols
@@ -81,6 +82,7 @@ type SnapshotEvent struct {
 	SubnetCount        int64   `json:"subnet_count"`
 	PrivateSubnetCount int64   `json:"private_subnet_count"`
 	PublicSubnetCount  int64   `json:"public_subnet_count"`
+	DomainCount        int64   `json:"domain_count"`
 	TotalEntryCount    int64   `json:"total_entry_count"`
 	IsEnabled          bool    `json:"is_enabled"`
 }
@@ -133,6 +135,20 @@ func newSubnetOperation(op string, subnet string, success bool, errCode int64) *
 	}
 }
 
+// newDomainOperation creates an event for domain add/remove. Domain itself is not reported.
+func newDomainOperation(op string, success bool, errCode int64) *OperationEvent {
+	return &OperationEvent{
+		Namespace: Namespace,
+		Subscope:  Subscope,
+		Event:     EventOperation,
+		Operation: op,
+		EntryType: EntryDomain,
+		Protocol:  ProtoNA,
+		Result:    analytics.BoolToResult(success),
+		Error:     codeToString(errCode),
+	}
+}
+
 // NewClearOperation creates an event for clearing all entries
 func NewClearOperation(success bool, errCode int64) *OperationEvent {
 	return &OperationEvent{
@@ -152,6 +168,7 @@ type SnapshotConfig struct {
 	TCPPorts []int64
 	UDPPorts []int64
 	Subnets  []string
+	Domains  []string
 }
 
 // NewSnapshot creates a snapshot event from current config.
@@ -159,6 +176,7 @@ func NewSnapshot(cfg SnapshotConfig) *SnapshotEvent {
 	tcpCount := int64(len(cfg.TCPPorts))
 	udpCount := int64(len(cfg.UDPPorts))
 	subnetCount := int64(len(cfg.Subnets))
+	domainCount := int64(len(cfg.Domains))
 
 	var privateCount, publicCount int64
 	for _, subnet := range cfg.Subnets {
@@ -170,7 +188,7 @@ func NewSnapshot(cfg SnapshotConfig) *SnapshotEvent {
 		}
 	}
 
-	total := tcpCount + udpCount + subnetCount
+	total := tcpCount + udpCount + subnetCount + domainCount
 	return &SnapshotEvent{
 		Namespace:          Namespace,
 		Subscope:           Subscope,
@@ -182,6 +200,7 @@ func NewSnapshot(cfg SnapshotConfig) *SnapshotEvent {
 		SubnetCount:        subnetCount,
 		PrivateSubnetCount: privateCount,
 		PublicSubnetCount:  publicCount,
+		DomainCount:        domainCount,
 		TotalEntryCount:    total,
 		IsEnabled:          total > 0,
 	}
@@ -199,6 +218,9 @@ func NewOperationEventFromRequest(
 		subnet := request.SetAllowlistSubnetRequest.GetSubnet()
 		return newSubnetOperation(op, subnet, success, errCode)
 
+	case *pb.SetAllowlistRequest_SetAllowlistDomainRequest:
+		return newDomainOperation(op, success, errCode)
+
 	case *pb.SetAllowlistRequest_SetAllowlistPortsRequest:
 		portRange := request.SetAllowlistPortsRequest.GetPortRange()
 		start := portRange.GetStartPort()
@@ -273,6 +295,7 @@ func (e *SnapshotEvent) ToDebuggerEvent() *events.DebuggerEvent {
 			events.ContextValue{Path: contextPathPrefix + ".subnet_count", Value: e.SubnetCount},
 			events.ContextValue{Path: contextPathPrefix + ".private_subnet_count", Value: e.PrivateSubnetCount},
 			events.ContextValue{Path: contextPathPrefix + ".public_subnet_count", Value: e.PublicSubnetCount},
+			events.ContextValue{Path: contextPathPrefix + ".domain_count", Value: e.DomainCount},
 			events.ContextValue{Path: contextPathPrefix + ".total_entry_count", Value: e.TotalEntryCount},
 			events.ContextValue{Path: contextPathPrefix + ".is_enabled", Value: e.IsEnabled},
 		).
@@ -311,6 +334,10 @@ func codeToString(code int64) string {
 		return "subnet unchanged: tried to add narrower"
 	case internal.CodeAllowlistSubnetWider:
 		return "subnet is wider, it eliminates some narrower subnet"
+	case internal.CodeAllowlistInvalidDomain:
+		return "invalid domain format"
+	case internal.CodeAllowlistDomainNoop:
+		return "domain unchanged: already in desired state"
 	default:
 		return fmt.Sprintf("unknown allowlist error (code %d)", code)
 	}
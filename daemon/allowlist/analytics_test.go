@@ -95,6 +95,28 @@ func TestNewOperationEventFromRequest(t *testing.T) {
 				IsPrivateSubnet: true,
 			},
 		},
+		{
+			name: "domain request add success",
+			req: &pb.SetAllowlistRequest{
+				Request: &pb.SetAllowlistRequest_SetAllowlistDomainRequest{
+					SetAllowlistDomainRequest: &pb.SetAllowlistDomainRequest{
+						Domain: "intranet.example.com",
+					},
+				},
+			},
+			op:      OpAdd,
+			success: true,
+			errCode: internal.CodeSuccess,
+			wantEvent: &OperationEvent{
+				Namespace: Namespace,
+				Subscope:  Subscope,
+				Event:     EventOperation,
+				Operation: OpAdd,
+				EntryType: EntryDomain,
+				Protocol:  ProtoNA,
+				Result:    analytics.ResultSuccess,
+			},
+		},
 		{
 			name: "subnet request remove failure",
 			req: &pb.SetAllowlistRequest{
@@ -314,6 +336,25 @@ func TestNewSnapshot(t *testing.T) {
 				IsEnabled:          false,
 			},
 		},
+		{
+			name: "only domains",
+			cfg: SnapshotConfig{
+				TCPPorts: []int64{},
+				UDPPorts: []int64{},
+				Subnets:  []string{},
+				Domains:  []string{"example.com", "nordvpn.com"},
+			},
+			wantEvent: &SnapshotEvent{
+				Namespace:       Namespace,
+				Subscope:        Subscope,
+				Event:           EventSnapshot,
+				TCPPorts:        []int64{},
+				UDPPorts:        []int64{},
+				DomainCount:     2,
+				TotalEntryCount: 2,
+				IsEnabled:       true,
+			},
+		},
 		{
 			name: "only TCP ports",
 			cfg: SnapshotConfig{
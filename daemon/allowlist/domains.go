@@ -0,0 +1,103 @@
+package allowlist
+
+import (
+	"net/netip"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+const (
+	// minDomainTTL limits how often the domain is resolved when DNS returns very short TTL
+	minDomainTTL = 30 * time.Second
+	// maxDomainTTL makes sure that the addresses are refreshed even for long living records
+	maxDomainTTL = time.Hour
+	// retryDomainTTL is used when the domain could not be resolved
+	retryDomainTTL = 30 * time.Second
+)
+
+// Resolver resolves the domain addresses together with the duration they can be cached for
+type Resolver interface {
+	ResolveWithTTL(domain string) ([]netip.Addr, time.Duration, error)
+}
+
+type domainEntry struct {
+	addrs     []netip.Addr
+	expiresAt time.Time
+}
+
+// DomainResolver keeps the addresses of the allowlisted domains up to date.
+// Thread safe.
+type DomainResolver struct {
+	resolver Resolver
+	entries  map[string]domainEntry
+	// addrs holds the addresses returned by the last refresh
+	addrs []netip.Addr
+	now   func() time.Time
+	mu    sync.Mutex
+}
+
+// NewDomainResolver creates domain resolver which caches the addresses until their TTL expires.
+func NewDomainResolver(resolver Resolver) *DomainResolver {
+	return &DomainResolver{
+		resolver: resolver,
+		entries:  map[string]domainEntry{},
+		now:      time.Now,
+	}
+}
+
+// Refresh resolves the domains which addresses have expired and returns the current addresses
+// of all given domains. Returned flag is true if addresses differ from the previous refresh.
+func (d *DomainResolver) Refresh(domains []string) ([]netip.Addr, bool) {
+	now := d.now()
+	expired := map[string]domainEntry{}
+	d.mu.Lock()
+	for domain := range d.entries {
+		if !slices.Contains(domains, domain) {
+			delete(d.entries, domain)
+		}
+	}
+	for _, domain := range domains {
+		if entry, ok := d.entries[domain]; !ok || !now.Before(entry.expiresAt) {
+			expired[domain] = entry
+		}
+	}
+	d.mu.Unlock()
+
+	// lookups can take seconds, so the lock is only held to update the cache
+	for domain, entry := range expired {
+		expired[domain] = d.resolve(domain, entry, now)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var addrs []netip.Addr
+	for _, domain := range domains {
+		entry, ok := expired[domain]
+		if ok {
+			d.entries[domain] = entry
+		} else {
+			entry = d.entries[domain]
+		}
+		addrs = append(addrs, entry.addrs...)
+	}
+
+	slices.SortFunc(addrs, func(a, b netip.Addr) int { return a.Compare(b) })
+	addrs = slices.Compact(addrs)
+	changed := !slices.Equal(d.addrs, addrs)
+	d.addrs = addrs
+	return slices.Clone(addrs), changed
+}
+
+func (d *DomainResolver) resolve(domain string, entry domainEntry, now time.Time) domainEntry {
+	addrs, ttl, err := d.resolver.ResolveWithTTL(domain)
+	if err != nil {
+		// keep the previous addresses, the failure might be temporary
+		log.Warn("resolving allowlisted domain", domain+":", err)
+		return domainEntry{addrs: entry.addrs, expiresAt: now.Add(retryDomainTTL)}
+	}
+
+	return domainEntry{addrs: addrs, expiresAt: now.Add(min(max(ttl, minDomainTTL), maxDomainTTL))}
+}
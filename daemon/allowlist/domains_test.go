@@ -0,0 +1,106 @@
+package allowlist
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockResolver struct {
+	addrs map[string][]netip.Addr
+	ttl   time.Duration
+	err   error
+	calls int
+}
+
+func (m *mockResolver) ResolveWithTTL(domain string) ([]netip.Addr, time.Duration, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, 0, m.err
+	}
+	return m.addrs[domain], m.ttl, nil
+}
+
+func TestDomainResolver_Refresh(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	first := netip.MustParseAddr("1.1.1.1")
+	second := netip.MustParseAddr("2.2.2.2")
+	v6 := netip.MustParseAddr("2001:db8::1")
+	resolver := &mockResolver{
+		addrs: map[string][]netip.Addr{
+			"example.com": {second, v6},
+			"nordvpn.com": {first, second},
+		},
+		ttl: time.Second,
+	}
+	now := time.Now()
+	domains := NewDomainResolver(resolver)
+	domains.now = func() time.Time { return now }
+
+	addrs, changed := domains.Refresh([]string{"example.com", "nordvpn.com"})
+	assert.True(t, changed)
+	assert.Equal(t, []netip.Addr{first, second, v6}, addrs)
+	assert.Equal(t, 2, resolver.calls)
+
+	// TTL shorter than minimum is not respected
+	now = now.Add(minDomainTTL - time.Second)
+	_, changed = domains.Refresh([]string{"example.com", "nordvpn.com"})
+	assert.False(t, changed)
+	assert.Equal(t, 2, resolver.calls)
+
+	// addresses are kept when domain cannot be resolved after TTL expired
+	now = now.Add(time.Second)
+	resolver.err = errors.New("timeout")
+	addrs, changed = domains.Refresh([]string{"example.com", "nordvpn.com"})
+	assert.False(t, changed)
+	assert.Equal(t, []netip.Addr{first, second, v6}, addrs)
+	assert.Equal(t, 4, resolver.calls)
+
+	// removed domain addresses are dropped without resolving
+	addrs, changed = domains.Refresh([]string{"nordvpn.com"})
+	assert.True(t, changed)
+	assert.Equal(t, []netip.Addr{first, second}, addrs)
+	assert.Equal(t, 4, resolver.calls)
+
+	addrs, changed = domains.Refresh(nil)
+	assert.True(t, changed)
+	assert.Empty(t, addrs)
+}
+
+type blockingResolver struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingResolver) ResolveWithTTL(string) ([]netip.Addr, time.Duration, error) {
+	close(b.started)
+	<-b.release
+	return []netip.Addr{netip.MustParseAddr("1.1.1.1")}, time.Minute, nil
+}
+
+func TestDomainResolver_RefreshDoesNotBlockDuringLookup(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	resolver := &blockingResolver{started: make(chan struct{}), release: make(chan struct{})}
+	domains := NewDomainResolver(resolver)
+	go domains.Refresh([]string{"nordvpn.com"})
+	<-resolver.started
+
+	refreshed := make(chan struct{})
+	go func() {
+		domains.Refresh(nil)
+		close(refreshed)
+	}()
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("refresh is blocked by the lookup in progress")
+	}
+	close(resolver.release)
+}
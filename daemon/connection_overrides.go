@@ -0,0 +1,151 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// connectionOverrides replace the global settings for a single connection. The persisted
+// config is never modified. Zero values mean that the global setting is used.
+type connectionOverrides struct {
+	technology  config.Technology
+	protocol    config.Protocol
+	obfuscate   *bool
+	postQuantum *bool
+	dns         config.DNS
+}
+
+// maxOverrideNameservers is the same limit as for the configured DNS
+const maxOverrideNameservers = 3
+
+// connectionOverridesFromProtobuf validates the overrides sent by the client.
+func connectionOverridesFromProtobuf(in *pb.ConnectionOverrides) (connectionOverrides, error) {
+	if in == nil {
+		return connectionOverrides{}, nil
+	}
+	if len(in.GetDns()) > maxOverrideNameservers {
+		return connectionOverrides{}, fmt.Errorf("at most %d nameservers are allowed", maxOverrideNameservers)
+	}
+	for _, address := range in.GetDns() {
+		if !internal.IsAddressValidAsDNSServer(address) {
+			return connectionOverrides{}, fmt.Errorf("invalid nameserver %q", address)
+		}
+	}
+	return connectionOverrides{
+		technology:  in.GetTechnology(),
+		protocol:    in.GetProtocol(),
+		obfuscate:   in.Obfuscate,
+		postQuantum: in.PostQuantum,
+		dns:         in.GetDns(),
+	}, nil
+}
+
+// apply returns the config used for the connection.
+func (o connectionOverrides) apply(cfg config.Config) config.Config {
+	if o.technology != config.Technology_UNKNOWN_TECHNOLOGY && o.technology != cfg.Technology {
+		// the same defaults as when the technology is changed in the settings
+		cfg.Technology = o.technology
+		cfg.AutoConnectData.Protocol = config.Protocol_UDP
+		if o.technology == config.Technology_NORDWHISPER {
+			cfg.AutoConnectData.Protocol = config.Protocol_Webtunnel
+		}
+		if o.technology != config.Technology_OPENVPN {
+			cfg.AutoConnectData.Obfuscate = false
+		}
+	}
+	if o.protocol != config.Protocol_UNKNOWN_PROTOCOL {
+		cfg.AutoConnectData.Protocol = o.protocol
+	}
+	if o.obfuscate != nil {
+		cfg.AutoConnectData.Obfuscate = *o.obfuscate
+	}
+	if o.postQuantum != nil {
+		cfg.AutoConnectData.PostquantumVpn = *o.postQuantum
+	}
+	if len(o.dns) > 0 {
+		// custom nameservers replace the Threat Protection Lite ones
+		cfg.AutoConnectData.DNS = o.dns
+		cfg.AutoConnectData.EncryptedDNS = nil
+		cfg.AutoConnectData.ThreatProtectionLite = false
+	}
+	return cfg
+}
+
+func (o connectionOverrides) isEmpty() bool {
+	return o.technology == config.Technology_UNKNOWN_TECHNOLOGY &&
+		o.protocol == config.Protocol_UNKNOWN_PROTOCOL &&
+		o.obfuscate == nil &&
+		o.postQuantum == nil &&
+		len(o.dns) == 0
+}
+
+// names returns the names of the overridden settings as shown to the user.
+func (o connectionOverrides) names() []string {
+	var names []string
+	if o.technology != config.Technology_UNKNOWN_TECHNOLOGY {
+		names = append(names, "technology")
+	}
+	if o.protocol != config.Protocol_UNKNOWN_PROTOCOL {
+		names = append(names, "protocol")
+	}
+	if o.obfuscate != nil {
+		names = append(names, "obfuscate")
+	}
+	if o.postQuantum != nil {
+		names = append(names, "post-quantum")
+	}
+	if len(o.dns) > 0 {
+		names = append(names, "dns")
+	}
+	return names
+}
+
+// validateConnectionSettings checks whether the settings can be used together for connecting.
+func validateConnectionSettings(cfg config.Config) error {
+	protocol := cfg.AutoConnectData.Protocol
+	switch cfg.Technology {
+	case config.Technology_OPENVPN:
+		if protocol != config.Protocol_UDP && protocol != config.Protocol_TCP {
+			return fmt.Errorf("protocol %s is not supported by OpenVPN", protocol)
+		}
+	case config.Technology_NORDLYNX:
+		if protocol != config.Protocol_UDP {
+			return fmt.Errorf("protocol %s is not supported by NordLynx", protocol)
+		}
+	case config.Technology_NORDWHISPER:
+		if protocol != config.Protocol_Webtunnel {
+			return fmt.Errorf("protocol %s is not supported by NordWhisper", protocol)
+		}
+	case config.Technology_UNKNOWN_TECHNOLOGY:
+		return errors.New("technology is not set")
+	}
+	if cfg.AutoConnectData.Obfuscate && cfg.Technology != config.Technology_OPENVPN {
+		return errors.New("obfuscation is supported only by OpenVPN")
+	}
+	if cfg.AutoConnectData.PostquantumVpn && cfg.Technology != config.Technology_NORDLYNX {
+		return errors.New("post-quantum encryption is supported only by NordLynx")
+	}
+	if cfg.AutoConnectData.PostquantumVpn && cfg.Mesh {
+		return errors.New("post-quantum encryption can't be used with meshnet")
+	}
+	return nil
+}
+
+// useTechnology switches the VPN implementation if the connection technology differs from
+// the one used by the previous connection.
+func (r *RPC) useTechnology(persisted config.Technology, technology config.Technology) error {
+	if technology == persisted && !r.technologyOverridden {
+		return nil
+	}
+	v, err := r.factory(technology)
+	if err != nil {
+		return fmt.Errorf("building %s VPN: %w", technology, err)
+	}
+	r.netw.SetVPN(v)
+	r.technologyOverridden = technology != persisted
+	return nil
+}
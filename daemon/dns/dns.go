@@ -105,9 +105,19 @@ type DNSServiceSetter struct {
 	// currentManagementService is used to identify the service in analytics
 	currentManagementService       dnsManagementService
 	networkManagerConfigGetterFunc networkManagerConfigGetterFunc
+	// routes are the split DNS routes applied together with the nameservers
+	routes []Route
+	// routingDomains applies the routes when systemd-resolved is used
+	routingDomains routingDomains
+	// forwarder applies the routes when the management service does not support them
+	forwarder  Forwarder
+	forwarding bool
 }
 
-func NewDNSServiceSetter(debugPublisher events.PublishSubcriber[events.DebuggerEvent]) *DNSServiceSetter {
+func NewDNSServiceSetter(
+	debugPublisher events.PublishSubcriber[events.DebuggerEvent],
+	forwarder Forwarder,
+) *DNSServiceSetter {
 	analytics := newDNSAnalytics(debugPublisher)
 	resolvConfMonitor := newResolvConfMonitor(analytics)
 	return &DNSServiceSetter{
@@ -118,6 +128,8 @@ func NewDNSServiceSetter(debugPublisher events.PublishSubcriber[events.DebuggerE
 		analytics:                      analytics,
 		resolvConfMonitor:              &resolvConfMonitor,
 		networkManagerConfigGetterFunc: getNetworkManagerConfig,
+		routingDomains:                 &resolvedRoutingDomains{},
+		forwarder:                      forwarder,
 	}
 }
 
@@ -246,11 +258,20 @@ func (d *DNSServiceSetter) getManagementService() dnsManagementService {
 
 // set sets DNS using the provided setter and sets a matching unsetter if the operation was successful
 func (d *DNSServiceSetter) set(setter Setter, iface string, nameservers []string) error {
-	err := setter.Set(iface, nameservers)
+	nameservers, err := d.routedNameservers(setter, nameservers)
 	if err != nil {
 		return fmt.Errorf("failed to set DNS: %w", err)
 	}
 
+	err = setter.Set(iface, nameservers)
+	if err != nil {
+		return fmt.Errorf("failed to set DNS: %w", err)
+	}
+
+	if err := d.setRoutingDomains(setter, iface, nameservers); err != nil {
+		return fmt.Errorf("failed to set DNS routes: %w", err)
+	}
+
 	d.unsetter = setter
 	d.analytics.emitDNSConfiguredEvent(d.currentManagementService)
 
@@ -361,6 +382,9 @@ func (d *DNSServiceSetter) Unset(iface string) error {
 		d.analytics.emitDNSConfigurationCriticalErrorEvent(d.currentManagementService, unsetFailedErrorType)
 		log.DNS.Error("unsetting DNS:", err)
 	}
+	if err := d.unsetRoutes(); err != nil {
+		log.DNS.Error("unsetting DNS routes:", err)
+	}
 
 	d.unsetter = nil
 
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"net"
 	"os/exec"
+	"slices"
 	"strings"
 
 	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
 )
 
 // Executables
@@ -38,28 +40,8 @@ func setDNSWithSystemdResolve(ifname string, addresses []string) error {
 		return err
 	}
 	// Set dns
-	args := []string{
-		"call",
-		"org.freedesktop.resolve1",
-		"/org/freedesktop/resolve1",
-		"org.freedesktop.resolve1.Manager",
-		"SetLinkDNS", "ia(iay)", fmt.Sprintf("%d", iface.Index), fmt.Sprintf("%d", len(addresses)),
-	}
-	// prepare addresses for busctl
-	for _, address := range addresses {
-		ip := net.ParseIP(address)
-		if ip4 := ip.To4(); ip4 != nil {
-			ip = ip4
-			args = append(args, "2", "4")
-		} else {
-			args = append(args, "10", "16")
-		}
-		for _, octet := range ip {
-			args = append(args, fmt.Sprintf("%d", octet))
-		}
-	}
 	// #nosec G204 -- input is properly validated
-	out, err := exec.Command(execBusctl, args...).CombinedOutput()
+	out, err := exec.Command(execBusctl, setLinkDNSArgs(iface.Index, addresses)...).CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("setting link dns for %s via dbus: %s: %w", iface.Name, strings.TrimSpace(string(out)), err)
 	}
@@ -178,3 +160,161 @@ func unsetDNSWithSystemdResolve(ifname string) error {
 
 	return nil
 }
+
+// setLinkDNSArgs returns the busctl arguments for setting the nameservers of the link
+func setLinkDNSArgs(index int, addresses []string) []string {
+	args := []string{
+		"call",
+		"org.freedesktop.resolve1",
+		"/org/freedesktop/resolve1",
+		"org.freedesktop.resolve1.Manager",
+		"SetLinkDNS", "ia(iay)", fmt.Sprintf("%d", index), fmt.Sprintf("%d", len(addresses)),
+	}
+	// prepare addresses for busctl
+	for _, address := range addresses {
+		ip := net.ParseIP(address)
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+			args = append(args, "2", "4")
+		} else {
+			args = append(args, "10", "16")
+		}
+		for _, octet := range ip {
+			args = append(args, fmt.Sprintf("%d", octet))
+		}
+	}
+	return args
+}
+
+// resolvedRoutingDomains maps the DNS routes onto systemd-resolved routing domains. Routing
+// domains are bound to the links, so the route nameservers and their domains are configured on
+// the links the nameservers are reachable through. Queries for the other domains keep going to
+// the VPN interface which is the only default route.
+//
+// Link configuration is replaced while the routes are applied, so the original configuration
+// is restored by asking the network manager of the link to reapply it.
+type resolvedRoutingDomains struct {
+	links []string
+}
+
+func (r *resolvedRoutingDomains) Set(routes []Route) error {
+	nameservers := map[string][]string{}
+	domains := map[string][]string{}
+	for _, route := range routes {
+		if route.Interface == "" {
+			return fmt.Errorf("interface for nameserver %s is unknown", route.Nameserver)
+		}
+		if !slices.Contains(nameservers[route.Interface], route.Nameserver) {
+			nameservers[route.Interface] = append(nameservers[route.Interface], route.Nameserver)
+		}
+		domains[route.Interface] = append(domains[route.Interface], route.Domain)
+	}
+
+	for _, link := range r.links {
+		if _, ok := nameservers[link]; !ok {
+			revertRoutingDomainsLink(link)
+		}
+	}
+	r.links = nil
+
+	for name, addresses := range nameservers {
+		r.links = append(r.links, name)
+		if err := setRoutingDomainsLink(name, addresses, domains[name]); err != nil {
+			return err
+		}
+	}
+
+	return flushSystemdResolvedCaches()
+}
+
+func (r *resolvedRoutingDomains) Unset() error {
+	if len(r.links) == 0 {
+		return nil
+	}
+	for _, link := range r.links {
+		revertRoutingDomainsLink(link)
+	}
+	r.links = nil
+	return flushSystemdResolvedCaches()
+}
+
+func setRoutingDomainsLink(ifname string, addresses []string, domains []string) error {
+	iface, err := net.InterfaceByName(ifname)
+	if err != nil {
+		return err
+	}
+
+	// #nosec G204 -- input is properly validated
+	out, err := exec.Command(execBusctl, setLinkDNSArgs(iface.Index, addresses)...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("setting link dns for %s via dbus: %s: %w", iface.Name, strings.TrimSpace(string(out)), err)
+	}
+
+	// routing-only domains are marked with true
+	args := []string{
+		"call",
+		"org.freedesktop.resolve1",
+		"/org/freedesktop/resolve1",
+		"org.freedesktop.resolve1.Manager",
+		"SetLinkDomains", "ia(sb)", fmt.Sprintf("%d", iface.Index), fmt.Sprintf("%d", len(domains)),
+	}
+	for _, domain := range domains {
+		args = append(args, domain, "true")
+	}
+	// #nosec G204 -- input is properly validated
+	out, err = exec.Command(execBusctl, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("setting link routing domains for %s via dbus: %s: %w", iface.Name, strings.TrimSpace(string(out)), err)
+	}
+
+	// only the matching queries are sent to the link
+	// #nosec G204 -- input is properly validated
+	out, err = exec.Command(execBusctl,
+		"call",
+		"org.freedesktop.resolve1",
+		"/org/freedesktop/resolve1",
+		"org.freedesktop.resolve1.Manager",
+		"SetLinkDefaultRoute", "ib", fmt.Sprintf("%d", iface.Index), "false",
+	).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("setting link default route for %s via dbus: %s: %w", iface.Name, strings.TrimSpace(string(out)), err)
+	}
+
+	return nil
+}
+
+// revertRoutingDomainsLink drops the link configuration and asks the network manager of the link
+// to apply its own again. Failures are only logged as the link may be gone already.
+func revertRoutingDomainsLink(ifname string) {
+	if err := unsetDNSWithSystemdResolve(ifname); err != nil {
+		log.DNS.Warn("reverting DNS routes link:", err)
+		return
+	}
+
+	var out []byte
+	var err error
+	if internal.IsNetworkLinkUnmanaged(ifname) {
+		// #nosec G204 -- input is properly validated
+		out, err = exec.Command(execNMCli, "device", "reapply", ifname).CombinedOutput()
+	} else {
+		// #nosec G204 -- input is properly validated
+		out, err = exec.Command(internal.NetworkctlExec, "reconfigure", ifname).CombinedOutput()
+	}
+	if err != nil {
+		log.DNS.Warn("reapplying link", ifname, "configuration:", strings.TrimSpace(string(out)), err)
+	}
+}
+
+func flushSystemdResolvedCaches() error {
+	out, err := exec.Command(execBusctl,
+		"call",
+		"org.freedesktop.resolve1",
+		"/org/freedesktop/resolve1",
+		"org.freedesktop.resolve1.Manager",
+		"FlushCaches",
+	).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("flushing local dns caches via dbus: %s: %w", strings.TrimSpace(string(out)), err)
+	}
+	return nil
+}
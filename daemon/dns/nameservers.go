@@ -17,6 +17,10 @@ const (
 	secondaryNameserver4                     = "103.86.99.100"
 	threatProtectionLitePrimaryNameserver4   = "103.86.96.96"
 	threatProtectionLiteSecondaryNameserver4 = "103.86.99.99"
+	primaryNameserver6                       = "2400:bb40:4444::100"
+	secondaryNameserver6                     = "2400:bb40:8888::100"
+	threatProtectionLitePrimaryNameserver6   = "2400:bb40:4444::103"
+	threatProtectionLiteSecondaryNameserver6 = "2400:bb40:8888::103"
 )
 
 var (
@@ -24,6 +28,13 @@ var (
 		threatProtectionLitePrimaryNameserver4, threatProtectionLiteSecondaryNameserver4,
 	}
 	defaultServers = []string{primaryNameserver4, secondaryNameserver4}
+	// ipv6Nameservers maps NordVPN nameservers to their IPv6 addresses
+	ipv6Nameservers = map[string]string{
+		primaryNameserver4:                       primaryNameserver6,
+		secondaryNameserver4:                     secondaryNameserver6,
+		threatProtectionLitePrimaryNameserver4:   threatProtectionLitePrimaryNameserver6,
+		threatProtectionLiteSecondaryNameserver4: threatProtectionLiteSecondaryNameserver6,
+	}
 )
 
 type CalculateRetryDelayForAttempt func(attempt int) time.Duration
@@ -91,6 +102,18 @@ func (n *NameServers) FetchTPServers(fetcher ServersFetcher, timeoutFn Calculate
 	return nil
 }
 
+// WithIPv6 appends the IPv6 addresses of NordVPN nameservers to the list. It is used when the
+// VPN tunnel carries IPv6 traffic. Custom nameservers are left as they are.
+func WithIPv6(nameservers []string) []string {
+	result := slices.Clone(nameservers)
+	for _, nameserver := range nameservers {
+		if ipv6, ok := ipv6Nameservers[nameserver]; ok && !slices.Contains(result, ipv6) {
+			result = append(result, ipv6)
+		}
+	}
+	return result
+}
+
 func shuffleNameservers(nameservers []string) []string {
 	// #nosec G404 - Using math/rand for nameserver shuffling is acceptable
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
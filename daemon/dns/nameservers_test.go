@@ -186,3 +186,17 @@ func TestNameserversRetriesToFetchTPOnError(t *testing.T) {
 	assert.ElementsMatch(t, servers, nameservers.Get(true))
 	assert.Equal(t, int32(0), retries.Load())
 }
+
+func TestWithIPv6(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t,
+		[]string{primaryNameserver4, secondaryNameserver4, primaryNameserver6, secondaryNameserver6},
+		WithIPv6([]string{primaryNameserver4, secondaryNameserver4}),
+	)
+	assert.Equal(t,
+		[]string{threatProtectionLitePrimaryNameserver4, threatProtectionLitePrimaryNameserver6},
+		WithIPv6([]string{threatProtectionLitePrimaryNameserver4, threatProtectionLitePrimaryNameserver6}),
+	)
+	assert.Equal(t, []string{"1.1.1.1"}, WithIPv6([]string{"1.1.1.1"}))
+}
@@ -0,0 +1,133 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// Route sends the queries of a domain and its subdomains to a dedicated nameserver instead of
+// the VPN nameservers.
+type Route struct {
+	Domain     string
+	Nameserver string
+	// Interface is the network interface the nameserver is reachable through outside of the
+	// VPN tunnel. Required by systemd-resolved which binds the routing domains to links.
+	Interface string
+}
+
+// RouteSetter is implemented by the setters which support split DNS routes. Routes are
+// applied with the next Set call.
+type RouteSetter interface {
+	SetRoutes(routes []Route)
+}
+
+// Forwarder serves DNS on the local address and forwards the queries according to the routes,
+// everything else is forwarded to the nameservers. Used with the DNS management services
+// which have no notion of per domain nameservers.
+type Forwarder interface {
+	Forward(nameservers []string, routes []Route) (address string, err error)
+	StopForwarding() error
+}
+
+// routingDomains applies the routes to the system DNS manager
+type routingDomains interface {
+	Set(routes []Route) error
+	Unset() error
+}
+
+// NormalizeRouteDomain returns the domain in the form it is stored and matched in, without the
+// trailing dot and lowercased
+func NormalizeRouteDomain(domain string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+}
+
+// MatchRoute returns the route with the longest domain matching the name or false if none of
+// them match.
+func MatchRoute(routes []Route, name string) (Route, bool) {
+	name = NormalizeRouteDomain(name)
+	var match Route
+	found := false
+	for _, route := range routes {
+		domain := NormalizeRouteDomain(route.Domain)
+		if name != domain && !strings.HasSuffix(name, "."+domain) {
+			continue
+		}
+		if !found || len(domain) > len(NormalizeRouteDomain(match.Domain)) {
+			match = route
+			found = true
+		}
+	}
+	return match, found
+}
+
+// SetRoutes to be applied with the next Set call
+func (d *DNSServiceSetter) SetRoutes(routes []Route) {
+	d.routes = slices.Clone(routes)
+}
+
+// routedNameservers returns the nameservers to configure with the setter. Only
+// systemd-resolved supports the routes natively, local forwarder is used otherwise.
+func (d *DNSServiceSetter) routedNameservers(setter Setter, nameservers []string) ([]string, error) {
+	if len(d.routes) == 0 || setter == d.systemdResolvedSetter {
+		return nameservers, d.stopForwarding()
+	}
+	if d.forwarder == nil {
+		log.DNS.Warn("DNS routes are not supported by", d.currentManagementService)
+		return nameservers, nil
+	}
+
+	address, err := d.forwarder.Forward(nameservers, d.routes)
+	if err != nil {
+		return nil, fmt.Errorf("forwarding DNS routes: %w", err)
+	}
+	d.forwarding = true
+	return []string{address}, nil
+}
+
+// setRoutingDomains configures the routes with systemd-resolved or removes them when they are
+// no longer used. Nameservers are switched to the local forwarder if it fails.
+func (d *DNSServiceSetter) setRoutingDomains(setter Setter, iface string, nameservers []string) error {
+	if d.routingDomains == nil {
+		return nil
+	}
+	if len(d.routes) == 0 || setter != d.systemdResolvedSetter {
+		return d.routingDomains.Unset()
+	}
+
+	err := d.routingDomains.Set(d.routes)
+	if err == nil {
+		return nil
+	}
+	log.DNS.Warn("setting DNS routes with systemd-resolved:", err)
+	if d.forwarder == nil {
+		return fmt.Errorf("setting routing domains: %w", err)
+	}
+
+	address, err := d.forwarder.Forward(nameservers, d.routes)
+	if err != nil {
+		return fmt.Errorf("forwarding DNS routes: %w", err)
+	}
+	d.forwarding = true
+	return setter.Set(iface, []string{address})
+}
+
+func (d *DNSServiceSetter) unsetRoutes() error {
+	var errs []error
+	if d.routingDomains != nil {
+		errs = append(errs, d.routingDomains.Unset())
+	}
+	errs = append(errs, d.stopForwarding())
+	return errors.Join(errs...)
+}
+
+func (d *DNSServiceSetter) stopForwarding() error {
+	if !d.forwarding {
+		return nil
+	}
+	d.forwarding = false
+	return d.forwarder.StopForwarding()
+}
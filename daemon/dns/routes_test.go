@@ -0,0 +1,178 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchRoute(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	routes := []Route{
+		{Domain: "corp.example", Nameserver: "10.0.0.53"},
+		{Domain: "lab.corp.example", Nameserver: "10.1.0.53"},
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		nameserver string
+		found      bool
+	}{
+		{name: "domain itself", query: "corp.example.", nameserver: "10.0.0.53", found: true},
+		{name: "subdomain", query: "wiki.corp.example.", nameserver: "10.0.0.53", found: true},
+		{name: "longest match wins", query: "host.lab.corp.example.", nameserver: "10.1.0.53", found: true},
+		{name: "case insensitive", query: "Wiki.Corp.Example.", nameserver: "10.0.0.53", found: true},
+		{name: "suffix without label boundary", query: "notcorp.example.", found: false},
+		{name: "other domain", query: "nordvpn.com.", found: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			route, found := MatchRoute(routes, test.query)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.nameserver, route.Nameserver)
+		})
+	}
+}
+
+type recordingSetter struct {
+	nameservers []string
+}
+
+func (s *recordingSetter) Set(iface string, nameservers []string) error {
+	s.nameservers = nameservers
+	return nil
+}
+
+func (s *recordingSetter) Unset(iface string) error {
+	s.nameservers = nil
+	return nil
+}
+
+type mockForwarder struct {
+	nameservers []string
+	routes      []Route
+	forwarding  bool
+	err         error
+}
+
+func (f *mockForwarder) Forward(nameservers []string, routes []Route) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	f.nameservers = nameservers
+	f.routes = routes
+	f.forwarding = true
+	return "127.0.2.53", nil
+}
+
+func (f *mockForwarder) StopForwarding() error {
+	f.forwarding = false
+	return nil
+}
+
+type mockRoutingDomains struct {
+	routes []Route
+	setErr error
+}
+
+func (r *mockRoutingDomains) Set(routes []Route) error {
+	if r.setErr != nil {
+		return r.setErr
+	}
+	r.routes = routes
+	return nil
+}
+
+func (r *mockRoutingDomains) Unset() error {
+	r.routes = nil
+	return nil
+}
+
+func TestDNSServiceSetter_Routes(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	routes := []Route{{Domain: "corp.example", Nameserver: "10.0.0.53", Interface: "eth0"}}
+
+	tests := []struct {
+		name                string
+		networkManagerDNS   string
+		routes              []Route
+		routingDomainsErr   error
+		expectedNameservers []string
+		expectedForwarding  bool
+		expectedDomains     []Route
+	}{
+		{
+			name:                "no routes",
+			networkManagerDNS:   "dns=default",
+			expectedNameservers: []string{"1.1.1.1"},
+		},
+		{
+			name:                "systemd-resolved routing domains",
+			networkManagerDNS:   "dns=systemd-resolved",
+			routes:              routes,
+			expectedNameservers: []string{"1.1.1.1"},
+			expectedDomains:     routes,
+		},
+		{
+			name:                "systemd-resolved falls back to forwarder",
+			networkManagerDNS:   "dns=systemd-resolved",
+			routes:              routes,
+			routingDomainsErr:   mock.ErrOnPurpose,
+			expectedNameservers: []string{"127.0.2.53"},
+			expectedForwarding:  true,
+		},
+		{
+			name:                "NetworkManager uses forwarder",
+			networkManagerDNS:   "dns=default",
+			routes:              routes,
+			expectedNameservers: []string{"127.0.2.53"},
+			expectedForwarding:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resolvedSetter := &recordingSetter{}
+			nmcliSetter := &recordingSetter{}
+			forwarder := &mockForwarder{}
+			routingDomains := &mockRoutingDomains{setErr: test.routingDomainsErr}
+			s := DNSServiceSetter{
+				systemdResolvedSetter: resolvedSetter,
+				nmcliSetter:           nmcliSetter,
+				resolvconfSetter:      &recordingSetter{},
+				resolvConfMonitor:     &mockResolvConfMonitor{},
+				analytics:             &analyticsMock{},
+				networkManagerConfigGetterFunc: func() ([]byte, error) {
+					return []byte(test.networkManagerDNS), nil
+				},
+				routingDomains: routingDomains,
+				forwarder:      forwarder,
+			}
+
+			s.SetRoutes(test.routes)
+			assert.NoError(t, s.Set("nordlynx", []string{"1.1.1.1"}))
+
+			nameservers := nmcliSetter.nameservers
+			if test.networkManagerDNS == "dns=systemd-resolved" {
+				nameservers = resolvedSetter.nameservers
+			}
+			assert.Equal(t, test.expectedNameservers, nameservers)
+			assert.Equal(t, test.expectedForwarding, forwarder.forwarding)
+			assert.Equal(t, test.expectedDomains, routingDomains.routes)
+			if test.expectedForwarding {
+				assert.Equal(t, []string{"1.1.1.1"}, forwarder.nameservers)
+				assert.Equal(t, test.routes, forwarder.routes)
+			}
+
+			assert.NoError(t, s.Unset("nordlynx"))
+			assert.False(t, forwarder.forwarding)
+			assert.Nil(t, routingDomains.routes)
+		})
+	}
+}
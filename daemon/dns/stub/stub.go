@@ -0,0 +1,256 @@
+/*
+Package stub implements a local DNS resolver which forwards the queries to the encrypted
+DNS-over-HTTPS or DNS-over-TLS upstreams.
+
+System DNS is pointed to the loopback address of the resolver, so plain DNS never leaves the
+machine. When VPN is connected, the upstream connections are routed through the tunnel.
+
+The resolver also forwards the split DNS routes to their plain nameservers for the DNS
+management services which cannot route the domains themselves.
+*/
+package stub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+
+	nvdns "github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/log"
+
+	"github.com/miekg/dns"
+)
+
+// Address is the loopback address the resolver listens on. It is different from
+// 127.0.0.53 so that it does not conflict with systemd-resolved.
+const Address = "127.0.2.53"
+
+const port = "53"
+
+// Resolver serves plain DNS on the loopback and forwards it to the encrypted upstreams.
+//
+// Thread-safe.
+type Resolver struct {
+	mu        sync.Mutex
+	endpoints []string
+	upstreams []upstream
+	// forwarding is set while the split DNS routes are served
+	forwarding bool
+	// forwardUpstreams are used for the domains without a route instead of the encrypted
+	// upstreams
+	forwardUpstreams []upstream
+	routes           []nvdns.Route
+	// routeUpstreams are the upstreams of the route nameservers
+	routeUpstreams map[string]upstream
+	servers        []*dns.Server
+	address        string
+}
+
+// NewResolver creates a stopped resolver.
+func NewResolver() *Resolver {
+	return &Resolver{address: net.JoinHostPort(Address, port)}
+}
+
+// Start the resolver with the given upstream endpoints or replace the upstreams if it is
+// already running.
+func (r *Resolver) Start(endpoints []string) error {
+	if len(endpoints) == 0 {
+		return errors.New("no upstream endpoints")
+	}
+
+	upstreams := make([]upstream, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		u, err := newUpstream(endpoint)
+		if err != nil {
+			return fmt.Errorf("parsing endpoint %s: %w", endpoint, err)
+		}
+		upstreams = append(upstreams, u)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.endpoints = slices.Clone(endpoints)
+	r.upstreams = upstreams
+	if len(r.servers) > 0 {
+		return nil
+	}
+	return r.listen()
+}
+
+// Forward the queries matching the routes to their nameservers and the rest to the given
+// nameservers. When the nameservers point to the resolver itself, the rest is forwarded to the
+// encrypted upstreams. Returns the address the system DNS has to be pointed to.
+func (r *Resolver) Forward(nameservers []string, routes []nvdns.Route) (string, error) {
+	var forwardUpstreams []upstream
+	for _, nameserver := range nameservers {
+		if nameserver == Address {
+			continue
+		}
+		u, err := newPlainUpstream(nameserver)
+		if err != nil {
+			return "", fmt.Errorf("parsing nameserver %s: %w", nameserver, err)
+		}
+		forwardUpstreams = append(forwardUpstreams, u)
+	}
+	routeUpstreams := map[string]upstream{}
+	for _, route := range routes {
+		u, err := newPlainUpstream(route.Nameserver)
+		if err != nil {
+			return "", fmt.Errorf("parsing route nameserver %s: %w", route.Nameserver, err)
+		}
+		routeUpstreams[route.Nameserver] = u
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.forwarding = true
+	r.forwardUpstreams = forwardUpstreams
+	r.routes = slices.Clone(routes)
+	r.routeUpstreams = routeUpstreams
+	if len(r.servers) > 0 {
+		return Address, nil
+	}
+	return Address, r.listen()
+}
+
+// StopForwarding the split DNS routes. The resolver keeps running if encrypted DNS is used.
+func (r *Resolver) StopForwarding() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.forwarding = false
+	r.forwardUpstreams = nil
+	r.routes = nil
+	r.routeUpstreams = nil
+	if len(r.upstreams) > 0 {
+		return nil
+	}
+	return r.shutdown()
+}
+
+func (r *Resolver) listen() error {
+	var servers []*dns.Server
+	for _, network := range []string{"udp", "tcp"} {
+		started := make(chan error, 1)
+		server := &dns.Server{
+			Addr:              r.address,
+			Net:               network,
+			Handler:           dns.HandlerFunc(r.ServeDNS),
+			NotifyStartedFunc: func() { started <- nil },
+		}
+		go func() {
+			if err := server.ListenAndServe(); err != nil {
+				select {
+				case started <- err:
+				default:
+					log.Error("stub resolver", network, "stopped:", err)
+				}
+			}
+		}()
+		if err := <-started; err != nil {
+			for _, s := range servers {
+				// #nosec G104 -- the listen error is returned
+				s.Shutdown()
+			}
+			return fmt.Errorf("listening on %s %s: %w", network, r.address, err)
+		}
+		servers = append(servers, server)
+	}
+	r.servers = servers
+	log.Info("stub resolver started on", r.address)
+	return nil
+}
+
+// Stop the resolver. Stopped resolver can be started again. The resolver keeps running if
+// the split DNS routes are forwarded.
+func (r *Resolver) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.endpoints = nil
+	r.upstreams = nil
+	if r.forwarding {
+		return nil
+	}
+	return r.shutdown()
+}
+
+func (r *Resolver) shutdown() error {
+	var errs []error
+	for _, server := range r.servers {
+		errs = append(errs, server.Shutdown())
+	}
+	r.servers = nil
+	return errors.Join(errs...)
+}
+
+// Endpoints returns the upstreams the resolver is running with or nil if it is stopped.
+func (r *Resolver) Endpoints() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.servers) == 0 || len(r.endpoints) == 0 {
+		return nil
+	}
+	return slices.Clone(r.endpoints)
+}
+
+// ServeDNS forwards the query to the upstreams in order until one of them responds.
+// SERVFAIL is returned when none of them do. Only the queries matching the split DNS routes
+// or forwarded to the plain nameservers are sent unencrypted.
+func (r *Resolver) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	r.mu.Lock()
+	upstreams := r.selectUpstreams(req)
+	r.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), upstreamTimeout)
+	defer cancel()
+
+	reply, err := exchange(ctx, upstreams, req)
+	if err != nil {
+		log.Warn("stub resolver:", err)
+		reply = new(dns.Msg)
+		reply.SetRcode(req, dns.RcodeServerFailure)
+	}
+	if _, ok := w.RemoteAddr().(*net.UDPAddr); ok {
+		size := dns.MinMsgSize
+		if opt := req.IsEdns0(); opt != nil {
+			size = int(opt.UDPSize())
+		}
+		// the client retries over TCP when the reply is truncated
+		reply.Truncate(size)
+	}
+	// #nosec G104 -- nothing to be done if the client is gone
+	w.WriteMsg(reply)
+}
+
+// selectUpstreams returns the upstreams for the query, the longest matching route wins.
+// Has to be called with the lock held.
+func (r *Resolver) selectUpstreams(req *dns.Msg) []upstream {
+	if len(req.Question) > 0 {
+		if route, ok := nvdns.MatchRoute(r.routes, req.Question[0].Name); ok {
+			return []upstream{r.routeUpstreams[route.Nameserver]}
+		}
+	}
+	if len(r.forwardUpstreams) > 0 {
+		return r.forwardUpstreams
+	}
+	return r.upstreams
+}
+
+func exchange(ctx context.Context, upstreams []upstream, req *dns.Msg) (*dns.Msg, error) {
+	var errs []error
+	for _, u := range upstreams {
+		reply, err := u.Exchange(ctx, req)
+		if err == nil {
+			return reply, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", u, err))
+	}
+	if len(errs) == 0 {
+		return nil, errors.New("no upstreams")
+	}
+	return nil, errors.Join(errs...)
+}
@@ -0,0 +1,176 @@
+package stub
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	nvdns "github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpstream(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		endpoint string
+		expected string
+		err      error
+	}{
+		{endpoint: "https://1.1.1.1/dns-query", expected: "https://1.1.1.1/dns-query"},
+		{endpoint: "https://9.9.9.9", expected: "https://9.9.9.9/dns-query"},
+		{endpoint: "https://[2606:4700:4700::1111]:8443/resolve", expected: "https://[2606:4700:4700::1111]:8443/resolve"},
+		{endpoint: "tls://1.1.1.1", expected: "tls://1.1.1.1:853"},
+		{endpoint: "tls://9.9.9.9:8853", expected: "tls://9.9.9.9:8853"},
+		{endpoint: "https://dns.quad9.net/dns-query", err: ErrEndpointNotIP},
+		{endpoint: "tls://one.one.one.one", err: ErrEndpointNotIP},
+		{endpoint: "http://1.1.1.1/dns-query", err: ErrInvalidEndpoint},
+		{endpoint: "tls://1.1.1.1/path", err: ErrInvalidEndpoint},
+		{endpoint: "https://1.1.1.1/dns-query?dns=1", err: ErrInvalidEndpoint},
+		{endpoint: "1.1.1.1", err: ErrInvalidEndpoint},
+	}
+
+	for _, test := range tests {
+		t.Run(test.endpoint, func(t *testing.T) {
+			u, err := newUpstream(test.endpoint)
+			assert.ErrorIs(t, err, test.err)
+			if test.err == nil {
+				assert.Equal(t, test.expected, u.String())
+			}
+		})
+	}
+}
+
+func TestIsEncryptedEndpoint(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.True(t, IsEncryptedEndpoint("https://1.1.1.1/dns-query"))
+	assert.True(t, IsEncryptedEndpoint("tls://1.1.1.1"))
+	assert.False(t, IsEncryptedEndpoint("1.1.1.1"))
+	assert.False(t, IsEncryptedEndpoint("2606:4700:4700::1111"))
+}
+
+type fakeUpstream struct {
+	reply *dns.Msg
+	err   error
+	calls int
+}
+
+func (u *fakeUpstream) Exchange(context.Context, *dns.Msg) (*dns.Msg, error) {
+	u.calls++
+	return u.reply, u.err
+}
+
+func (u *fakeUpstream) String() string { return "fake" }
+
+func TestExchange_FallsBackToNextUpstream(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	reply := new(dns.Msg)
+	failing := &fakeUpstream{err: mock.ErrOnPurpose}
+	working := &fakeUpstream{reply: reply}
+	unused := &fakeUpstream{reply: new(dns.Msg)}
+
+	got, err := exchange(context.Background(), []upstream{failing, working, unused}, new(dns.Msg))
+	require.NoError(t, err)
+	assert.Same(t, reply, got)
+	assert.Equal(t, 1, failing.calls)
+	assert.Equal(t, 0, unused.calls)
+
+	_, err = exchange(context.Background(), []upstream{failing}, new(dns.Msg))
+	assert.ErrorIs(t, err, mock.ErrOnPurpose)
+
+	_, err = exchange(context.Background(), nil, new(dns.Msg))
+	assert.Error(t, err)
+}
+
+func TestDoHUpstream_Exchange(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, dohContentType, r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		query := new(dns.Msg)
+		require.NoError(t, query.Unpack(body))
+		assert.Equal(t, uint16(0), query.Id)
+
+		reply := new(dns.Msg)
+		reply.SetReply(query)
+		reply.Answer = append(reply.Answer, &dns.A{
+			Hdr: dns.RR_Header{Name: query.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+			A:   []byte{10, 0, 0, 1},
+		})
+		packed, err := reply.Pack()
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", dohContentType)
+		_, _ = w.Write(packed)
+	}))
+	defer server.Close()
+
+	u := &dohUpstream{url: server.URL + defaultDoHPath, client: server.Client()}
+	query := new(dns.Msg)
+	query.SetQuestion("nordvpn.com.", dns.TypeA)
+
+	reply, err := u.Exchange(context.Background(), query)
+	require.NoError(t, err)
+	assert.Equal(t, query.Id, reply.Id)
+	require.Len(t, reply.Answer, 1)
+	assert.Equal(t, "10.0.0.1", reply.Answer[0].(*dns.A).A.String())
+}
+
+func TestResolver_StartRejectsInvalidEndpoints(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	r := NewResolver()
+	assert.Error(t, r.Start(nil))
+	assert.ErrorIs(t, r.Start([]string{"https://dns.quad9.net/dns-query"}), ErrEndpointNotIP)
+	assert.Nil(t, r.Endpoints())
+}
+
+func TestResolver_SelectUpstreams(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	encrypted := &fakeUpstream{}
+	plain := &fakeUpstream{}
+	corp := &fakeUpstream{}
+	r := NewResolver()
+	r.upstreams = []upstream{encrypted}
+
+	query := func(name string) *dns.Msg {
+		msg := new(dns.Msg)
+		msg.SetQuestion(name, dns.TypeA)
+		return msg
+	}
+
+	assert.Equal(t, []upstream{encrypted}, r.selectUpstreams(query("nordvpn.com.")))
+
+	r.routes = []nvdns.Route{{Domain: "corp.example", Nameserver: "10.0.0.53"}}
+	r.routeUpstreams = map[string]upstream{"10.0.0.53": corp}
+	assert.Equal(t, []upstream{corp}, r.selectUpstreams(query("wiki.corp.example.")))
+	assert.Equal(t, []upstream{encrypted}, r.selectUpstreams(query("nordvpn.com.")))
+
+	r.forwardUpstreams = []upstream{plain}
+	assert.Equal(t, []upstream{corp}, r.selectUpstreams(query("wiki.corp.example.")))
+	assert.Equal(t, []upstream{plain}, r.selectUpstreams(query("nordvpn.com.")))
+}
+
+func TestResolver_ForwardRejectsInvalidNameservers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	r := NewResolver()
+	_, err := r.Forward([]string{"dns.example"}, nil)
+	assert.Error(t, err)
+	_, err = r.Forward([]string{Address}, []nvdns.Route{{Domain: "corp.example", Nameserver: "corp"}})
+	assert.Error(t, err)
+	assert.Nil(t, r.routes)
+}
@@ -0,0 +1,191 @@
+package stub
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	schemeHTTPS = "https"
+	schemeTLS   = "tls"
+
+	defaultDoHPath = "/dns-query"
+	defaultDoTPort = "853"
+
+	dohContentType = "application/dns-message"
+	// maxResponseSize is the largest possible DNS message
+	maxResponseSize = 65535
+	upstreamTimeout = 5 * time.Second
+)
+
+var (
+	// ErrInvalidEndpoint is returned for endpoints which are neither DoH nor DoT URLs
+	ErrInvalidEndpoint = errors.New("endpoint must be https://<ip>[:port][/path] or tls://<ip>[:port]")
+	// ErrEndpointNotIP is returned when the endpoint host is a domain name. Such endpoint
+	// would have to be resolved through the stub resolver itself.
+	ErrEndpointNotIP = errors.New("endpoint host must be an IP address")
+)
+
+// upstream forwards DNS queries to the nameserver
+type upstream interface {
+	Exchange(ctx context.Context, msg *dns.Msg) (*dns.Msg, error)
+	String() string
+}
+
+// IsEncryptedEndpoint reports whether the value looks like a DoH or DoT endpoint as opposed to
+// a plain nameserver address.
+func IsEncryptedEndpoint(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	return err == nil && (u.Scheme == schemeHTTPS || u.Scheme == schemeTLS)
+}
+
+// ValidateEndpoint checks whether the endpoint can be used as an upstream of the stub resolver.
+func ValidateEndpoint(endpoint string) error {
+	_, err := newUpstream(endpoint)
+	return err
+}
+
+func newUpstream(endpoint string) (upstream, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return nil, ErrInvalidEndpoint
+	}
+	if _, err := netip.ParseAddr(u.Hostname()); err != nil {
+		return nil, ErrEndpointNotIP
+	}
+
+	switch u.Scheme {
+	case schemeHTTPS:
+		if u.Path == "" {
+			u.Path = defaultDoHPath
+		}
+		return &dohUpstream{
+			url:    u.String(),
+			client: &http.Client{Timeout: upstreamTimeout},
+		}, nil
+	case schemeTLS:
+		if u.Path != "" && u.Path != "/" {
+			return nil, ErrInvalidEndpoint
+		}
+		port := u.Port()
+		if port == "" {
+			port = defaultDoTPort
+		}
+		return &dotUpstream{
+			address: net.JoinHostPort(u.Hostname(), port),
+			client: &dns.Client{
+				Net:       "tcp-tls",
+				Timeout:   upstreamTimeout,
+				TLSConfig: &tls.Config{ServerName: u.Hostname(), MinVersion: tls.VersionTLS12},
+			},
+		}, nil
+	default:
+		return nil, ErrInvalidEndpoint
+	}
+}
+
+// dohUpstream implements DNS over HTTPS as defined in RFC 8484
+type dohUpstream struct {
+	url    string
+	client *http.Client
+}
+
+func (u *dohUpstream) Exchange(ctx context.Context, msg *dns.Msg) (*dns.Msg, error) {
+	// RFC 8484 recommends ID 0 for better HTTP caching, the original ID is restored later
+	query := msg.Copy()
+	query.Id = 0
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("packing query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.url, bytes.NewReader(packed))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", dohContentType)
+	req.Header.Set("Accept", dohContentType)
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+
+	reply := new(dns.Msg)
+	if err := reply.Unpack(body); err != nil {
+		return nil, fmt.Errorf("unpacking response: %w", err)
+	}
+	reply.Id = msg.Id
+	return reply, nil
+}
+
+func (u *dohUpstream) String() string { return u.url }
+
+// dotUpstream implements DNS over TLS as defined in RFC 7858
+type dotUpstream struct {
+	address string
+	client  *dns.Client
+}
+
+func (u *dotUpstream) Exchange(ctx context.Context, msg *dns.Msg) (*dns.Msg, error) {
+	reply, _, err := u.client.ExchangeContext(ctx, msg, u.address)
+	if err != nil {
+		return nil, fmt.Errorf("exchanging with %s: %w", u.address, err)
+	}
+	return reply, nil
+}
+
+func (u *dotUpstream) String() string { return schemeTLS + "://" + u.address }
+
+// plainUpstream forwards the queries unencrypted over UDP and retries over TCP when the
+// reply is truncated
+type plainUpstream struct {
+	address string
+	udp     *dns.Client
+	tcp     *dns.Client
+}
+
+func newPlainUpstream(nameserver string) (*plainUpstream, error) {
+	addr, err := netip.ParseAddr(nameserver)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nameserver: %w", err)
+	}
+	return &plainUpstream{
+		address: net.JoinHostPort(addr.String(), port),
+		udp:     &dns.Client{Net: "udp", Timeout: upstreamTimeout},
+		tcp:     &dns.Client{Net: "tcp", Timeout: upstreamTimeout},
+	}, nil
+}
+
+func (u *plainUpstream) Exchange(ctx context.Context, msg *dns.Msg) (*dns.Msg, error) {
+	reply, _, err := u.udp.ExchangeContext(ctx, msg, u.address)
+	if err == nil && reply.Truncated {
+		reply, _, err = u.tcp.ExchangeContext(ctx, msg, u.address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("exchanging with %s: %w", u.address, err)
+	}
+	return reply, nil
+}
+
+func (u *plainUpstream) String() string { return u.address }
@@ -0,0 +1,58 @@
+package daemon
+
+import (
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns/stub"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// EncryptedDNSResolver serves the system DNS through the encrypted upstreams
+type EncryptedDNSResolver interface {
+	Start(endpoints []string) error
+	Stop() error
+}
+
+// systemNameservers returns the nameservers configured to the system while connected
+func (r *RPC) systemNameservers(cfg config.Config) config.DNS {
+	if len(cfg.AutoConnectData.EncryptedDNS) > 0 {
+		return config.DNS{stub.Address}
+	}
+	return cfg.AutoConnectData.DNS.Or(r.nameservers.Get(cfg.AutoConnectData.ThreatProtectionLite))
+}
+
+// applyEncryptedDNS starts the local resolver with the given endpoints or stops it when there
+// are none. While it is used, kill switch also blocks the plain DNS.
+func (r *RPC) applyEncryptedDNS(endpoints []string) error {
+	if r.encryptedDNS == nil {
+		return nil
+	}
+
+	if len(endpoints) == 0 {
+		if err := r.netw.SetBlockPlainDNS(false); err != nil {
+			log.Warn("unblocking plain DNS:", err)
+		}
+		return r.encryptedDNS.Stop()
+	}
+
+	// the resolver is started even if it fails to block plain DNS because the system DNS is
+	// pointed to it anyway
+	if err := r.netw.SetBlockPlainDNS(true); err != nil {
+		log.Warn("blocking plain DNS:", err)
+	}
+	return r.encryptedDNS.Start(endpoints)
+}
+
+// StartEncryptedDNS starts the local resolver if encrypted DNS is configured
+func (r *RPC) StartEncryptedDNS() {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return
+	}
+	if len(cfg.AutoConnectData.EncryptedDNS) == 0 {
+		return
+	}
+	if err := r.applyEncryptedDNS(cfg.AutoConnectData.EncryptedDNS); err != nil {
+		log.Error("starting encrypted DNS:", err)
+	}
+}
@@ -226,6 +226,7 @@ func (s *SettingsEvents) Publish(cfg config.Config) {
 		TCPPorts: cfg.AutoConnectData.Allowlist.Ports.TCP.ToSlice(),
 		UDPPorts: cfg.AutoConnectData.Allowlist.Ports.UDP.ToSlice(),
 		Subnets:  cfg.AutoConnectData.Allowlist.Subnets,
+		Domains:  cfg.AutoConnectData.Allowlist.Domains,
 	})
 	s.Meshnet.Publish(cfg.Mesh)
 	s.Technology.Publish(cfg.Technology)
@@ -293,22 +294,26 @@ func NewConfigEvents() *ConfigEvents {
 type DataUpdatePublisher interface {
 	NotifyServersListUpdate(any) error
 	NotifyRecentsChanged(events.DataRecentsChanged) error
+	NotifyFavoritesChanged(events.DataFavoritesChanged) error
 }
 
 type DataUpdateEvents struct {
-	ServersUpdate events.PublishSubcriber[any]
-	RecentsUpdate events.PublishSubcriber[events.DataRecentsChanged]
+	ServersUpdate   events.PublishSubcriber[any]
+	RecentsUpdate   events.PublishSubcriber[events.DataRecentsChanged]
+	FavoritesUpdate events.PublishSubcriber[events.DataFavoritesChanged]
 }
 
 func (d *DataUpdateEvents) Subscribe(to DataUpdatePublisher) {
 	d.ServersUpdate.Subscribe(to.NotifyServersListUpdate)
 	d.RecentsUpdate.Subscribe(to.NotifyRecentsChanged)
+	d.FavoritesUpdate.Subscribe(to.NotifyFavoritesChanged)
 }
 
 func NewDataUpdateEvents() *DataUpdateEvents {
 	return &DataUpdateEvents{
-		ServersUpdate: &subs.Subject[any]{},
-		RecentsUpdate: &subs.Subject[events.DataRecentsChanged]{},
+		ServersUpdate:   &subs.Subject[any]{},
+		RecentsUpdate:   &subs.Subject[events.DataRecentsChanged]{},
+		FavoritesUpdate: &subs.Subject[events.DataFavoritesChanged]{},
 	}
 }
 
@@ -351,6 +356,25 @@ func NewPauseEvents() *PauseEvents {
 	}
 }
 
+// Failover events
+type FailoverEventsPublisher interface {
+	NotifyFailover(*pb.FailoverEvent) error
+}
+
+type FailoverEvents struct {
+	Failover events.PublishSubcriber[*pb.FailoverEvent]
+}
+
+func (f *FailoverEvents) Subscribe(to FailoverEventsPublisher) {
+	f.Failover.Subscribe(to.NotifyFailover)
+}
+
+func NewFailoverEvents() *FailoverEvents {
+	return &FailoverEvents{
+		Failover: &subs.Subject[*pb.FailoverEvent]{},
+	}
+}
+
 type UserServicesPublisher interface {
 	NotifyUserServicesChanged(any) error
 }
@@ -0,0 +1,45 @@
+// Package favorites stores named connection presets.
+package favorites
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+)
+
+const maxNameLength = 32
+
+var (
+	// ErrInvalidName is returned when the name is empty, too long or contains not allowed characters.
+	ErrInvalidName = errors.New("invalid favorite name")
+	// ErrExists is returned when a favorite with the same name is already stored.
+	ErrExists = errors.New("favorite already exists")
+	// ErrNotFound is returned when there is no favorite with the given name.
+	ErrNotFound = errors.New("favorite not found")
+
+	namePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+)
+
+// Favorite is a named connection target together with the settings which override the
+// global ones while connected to it. Zero valued overrides mean that global settings are used.
+type Favorite struct {
+	Name           string                     `json:"name"`
+	Country        string                     `json:"country"`
+	City           string                     `json:"city"`
+	Group          config.ServerGroup         `json:"group"`
+	CountryCode    string                     `json:"country-code"`
+	SpecificServer string                     `json:"specific-server"`
+	ConnectionType config.ServerSelectionRule `json:"connection-type"`
+	Technology     config.Technology          `json:"technology,omitempty"`
+	Protocol       config.Protocol            `json:"protocol,omitempty"`
+	Obfuscate      *bool                      `json:"obfuscate,omitempty"`
+}
+
+// ValidateName checks whether the name can be used for a favorite and typed in the command line.
+func ValidateName(name string) error {
+	if len(name) == 0 || len(name) > maxNameLength || !namePattern.MatchString(name) {
+		return ErrInvalidName
+	}
+	return nil
+}
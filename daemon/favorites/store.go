@@ -0,0 +1,161 @@
+package favorites
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// maxFavorites defines the maximum number of favorites to store
+const maxFavorites = 50
+
+// corruptedSuffix is appended to the path of the favorites file which can't be parsed when it
+// is moved aside
+const corruptedSuffix = ".corrupted"
+
+// ErrLimitReached is returned when the store is full.
+var ErrLimitReached = fmt.Errorf("at most %d favorites can be stored", maxFavorites)
+
+// Store persists favorites in the order they were added.
+type Store struct {
+	path              string
+	fsHandle          internal.FileSystemHandle
+	mu                sync.Mutex
+	onDataChangedFunc func()
+}
+
+// NewStore creates a favorites store persisted in the file at the given path.
+// The onDataChangedFunc is an optional callback invoked when favorites change;
+// pass nil to disable it.
+func NewStore(path string, fsHandle internal.FileSystemHandle, onDataChangedFunc func()) *Store {
+	return &Store{
+		path:              path,
+		fsHandle:          fsHandle,
+		onDataChangedFunc: onDataChangedFunc,
+	}
+}
+
+// List returns all stored favorites.
+func (s *Store) List() []Favorite {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	favorites, err := s.load()
+	if err != nil {
+		log.Warn(err)
+		return []Favorite{}
+	}
+	return favorites
+}
+
+// Get returns the favorite with the given name. Names are case insensitive.
+func (s *Store) Get(name string) (Favorite, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	favorites, err := s.load()
+	if err != nil {
+		return Favorite{}, err
+	}
+	idx := indexOf(favorites, name)
+	if idx == -1 {
+		return Favorite{}, ErrNotFound
+	}
+	return favorites[idx], nil
+}
+
+// Add stores a new favorite.
+func (s *Store) Add(favorite Favorite) error {
+	if err := ValidateName(favorite.Name); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	favorites, err := s.load()
+	if err != nil {
+		return err
+	}
+	if indexOf(favorites, favorite.Name) != -1 {
+		return ErrExists
+	}
+	if len(favorites) >= maxFavorites {
+		return ErrLimitReached
+	}
+	return s.save(append(favorites, favorite))
+}
+
+// Remove deletes the favorite with the given name.
+func (s *Store) Remove(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	favorites, err := s.load()
+	if err != nil {
+		return err
+	}
+	idx := indexOf(favorites, name)
+	if idx == -1 {
+		return ErrNotFound
+	}
+	return s.save(slices.Delete(favorites, idx, idx+1))
+}
+
+func indexOf(favorites []Favorite, name string) int {
+	return slices.IndexFunc(favorites, func(f Favorite) bool {
+		return strings.EqualFold(f.Name, name)
+	})
+}
+
+// load reads favorites from the file. Corrupted file is moved aside and treated as empty, so
+// that its contents are not lost when the next change is saved.
+func (s *Store) load() ([]Favorite, error) {
+	if !s.fsHandle.FileExists(s.path) {
+		return []Favorite{}, nil
+	}
+	data, err := s.fsHandle.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("reading favorites: %w", err)
+	}
+	var favorites []Favorite
+	if err := json.Unmarshal(data, &favorites); err != nil {
+		log.Warn("favorites file is corrupted:", err)
+		if err := s.moveAside(data); err != nil {
+			return nil, err
+		}
+		return []Favorite{}, nil
+	}
+	return favorites, nil
+}
+
+func (s *Store) moveAside(data []byte) error {
+	backupPath := s.path + corruptedSuffix
+	if err := s.fsHandle.WriteFile(backupPath, data, internal.PermUserRW); err != nil {
+		return fmt.Errorf("backing up corrupted favorites: %w", err)
+	}
+	if err := s.fsHandle.Remove(s.path); err != nil {
+		return fmt.Errorf("removing corrupted favorites: %w", err)
+	}
+	log.Warn("corrupted favorites file was moved to", backupPath)
+	return nil
+}
+
+func (s *Store) save(favorites []Favorite) error {
+	data, err := json.Marshal(favorites)
+	if err != nil {
+		return fmt.Errorf("marshaling favorites: %w", err)
+	}
+	if err := s.fsHandle.WriteFile(s.path, data, internal.PermUserRW); err != nil {
+		return fmt.Errorf("writing favorites: %w", err)
+	}
+	if s.onDataChangedFunc != nil {
+		s.onDataChangedFunc()
+	}
+	return nil
+}
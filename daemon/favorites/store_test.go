@@ -0,0 +1,140 @@
+package favorites
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/fs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPath = "/test/favorites"
+
+func TestValidateName(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, test := range []struct {
+		name  string
+		valid bool
+	}{
+		{name: "work-eu", valid: true},
+		{name: "Home_1", valid: true},
+		{name: ""},
+		{name: "with space"},
+		{name: "ünicode"},
+		{name: "a-very-long-favorite-name-that-is-over-the-limit"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateName(test.name)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidName)
+			}
+		})
+	}
+}
+
+func TestStore(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fsMock := fs.NewSystemFileHandleMock(t)
+	changes := 0
+	store := NewStore(testPath, &fsMock, func() { changes++ })
+	assert.Empty(t, store.List())
+
+	obfuscate := true
+	workEU := Favorite{
+		Name:           "work-eu",
+		Country:        "Germany",
+		CountryCode:    "DE",
+		Group:          config.ServerGroup_P2P,
+		ConnectionType: config.ServerSelectionRule_COUNTRY_WITH_GROUP,
+		Technology:     config.Technology_OPENVPN,
+		Protocol:       config.Protocol_TCP,
+		Obfuscate:      &obfuscate,
+	}
+	home := Favorite{
+		Name:           "home",
+		SpecificServer: "lt10",
+		ConnectionType: config.ServerSelectionRule_SPECIFIC_SERVER,
+	}
+	require.NoError(t, store.Add(workEU))
+	require.NoError(t, store.Add(home))
+	assert.ErrorIs(t, store.Add(Favorite{Name: "WORK-EU"}), ErrExists)
+	assert.ErrorIs(t, store.Add(Favorite{Name: "no spaces"}), ErrInvalidName)
+	assert.Equal(t, 2, changes)
+
+	// reload from the file to check the persisted data
+	store = NewStore(testPath, &fsMock, nil)
+	assert.Equal(t, []Favorite{workEU, home}, store.List())
+
+	favorite, err := store.Get("Work-EU")
+	require.NoError(t, err)
+	assert.Equal(t, workEU, favorite)
+
+	require.NoError(t, store.Remove("work-eu"))
+	assert.ErrorIs(t, store.Remove("work-eu"), ErrNotFound)
+	_, err = store.Get("work-eu")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, []Favorite{home}, store.List())
+}
+
+func TestStore_Corrupted(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fsMock := fs.NewSystemFileHandleMock(t)
+	fsMock.AddFile(testPath, []byte("{not json"))
+	store := NewStore(testPath, &fsMock, nil)
+	assert.Empty(t, store.List())
+
+	require.NoError(t, store.Add(Favorite{Name: "home"}))
+	assert.Len(t, store.List(), 1)
+
+	// corrupted contents are kept aside instead of being overwritten
+	backup, ok := fsMock.GetFile(testPath + corruptedSuffix)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("{not json"), backup)
+}
+
+func TestStore_CorruptedNotOverwrittenWhenBackupFails(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fsMock := fs.NewSystemFileHandleMock(t)
+	fsMock.AddFile(testPath, []byte("{not json"))
+	fsMock.WriteErr = errors.New("write failed")
+	store := NewStore(testPath, &fsMock, nil)
+
+	assert.Error(t, store.Add(Favorite{Name: "home"}))
+	data, _ := fsMock.GetFile(testPath)
+	assert.Equal(t, []byte("{not json"), data)
+}
+
+func TestStore_ReadError(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fsMock := fs.NewSystemFileHandleMock(t)
+	fsMock.AddFile(testPath, []byte("[]"))
+	fsMock.ReadErr = errors.New("read failed")
+	store := NewStore(testPath, &fsMock, nil)
+
+	assert.Empty(t, store.List())
+	assert.Error(t, store.Add(Favorite{Name: "home"}))
+	_, err := store.Get("home")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNotFound)
+}
+
+func TestStore_Limit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fsMock := fs.NewSystemFileHandleMock(t)
+	store := NewStore(testPath, &fsMock, nil)
+	for i := range maxFavorites {
+		require.NoError(t, store.Add(Favorite{Name: string(rune('a'+i%26)) + string(rune('a'+i/26))}))
+	}
+	assert.ErrorIs(t, store.Add(Favorite{Name: "one-more"}), ErrLimitReached)
+}
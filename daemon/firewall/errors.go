@@ -13,6 +13,12 @@ var (
 	ErrFirewallAlreadyEnabled = fmt.Errorf("firewall is already enabled")
 	// ErrFirewallAlreadyDisabled defines that disable was called twice in a row
 	ErrFirewallAlreadyDisabled = fmt.Errorf("firewall is already disabled")
+	// ErrFirewallDisabled is returned when the ruleset is verified while the firewall is disabled
+	ErrFirewallDisabled = fmt.Errorf("firewall is disabled")
+	// ErrVerificationNotSupported is returned when the firewall backend can not verify the ruleset
+	ErrVerificationNotSupported = fmt.Errorf("firewall backend does not support verification")
+	// ErrRulesetMismatch defines that the chains or rules in the system differ from the configured ones
+	ErrRulesetMismatch = fmt.Errorf("firewall chains or rules do not match the configuration")
 )
 
 // Error marks that it originated in firewall package
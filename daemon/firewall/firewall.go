@@ -111,3 +111,19 @@ func (fw *Firewall) Flush() error {
 
 	return fw.impl.Flush()
 }
+
+// Verify checks that the chains and rules active in the system match the config.
+func (fw *Firewall) Verify(config Config) error {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	if !fw.enabled {
+		return NewError(ErrFirewallDisabled)
+	}
+
+	verifier, ok := fw.impl.(Verifier)
+	if !ok {
+		return NewError(ErrVerificationNotSupported)
+	}
+	return verifier.Verify(config)
+}
@@ -70,6 +70,36 @@ func calculateFirstAndLastV4Prefix(cidr string) (net.IP, net.IP, error) {
 	return first, lastExclusive, nil
 }
 
+// calculateFirstAndLastV6Prefix returns the network address and the exclusive upper bound of
+// the IPv6 prefix
+func calculateFirstAndLastV6Prefix(cidr string) (net.IP, net.IP, error) {
+	pfx, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !pfx.Addr().Is6() || pfx.Addr().Is4In6() {
+		return nil, nil, fmt.Errorf("not an IPv6 CIDR: %s", cidr)
+	}
+
+	pfx = pfx.Masked()
+	first := pfx.Addr().As16()
+
+	// set the host bits and add one, the carry is dropped for ::/0
+	last := first
+	for i := pfx.Bits(); i < 128; i++ {
+		last[i/8] |= 1 << (7 - i%8)
+	}
+	for i := len(last) - 1; i >= 0; i-- {
+		last[i]++
+		if last[i] != 0 {
+			break
+		}
+	}
+
+	return net.IP(first[:]), net.IP(last[:]), nil
+}
+
 func convertPortsToSetElements(ports []int64) []nftables.SetElement {
 	if len(ports) == 0 {
 		return nil
@@ -112,6 +142,23 @@ func convertCidrToSetElements(cidrList []string) ([]nftables.SetElement, error)
 	return elems, nil
 }
 
+// Covert from a list of IPv6 CIDRs to a nftables set of IP ranges
+func convertCidr6ToSetElements(cidrList []string) ([]nftables.SetElement, error) {
+	var elems []nftables.SetElement
+	for _, cidr := range cidrList {
+		start, end, err := calculateFirstAndLastV6Prefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("convert for %s: %w", cidr, err)
+		}
+		elems = append(elems,
+			nftables.SetElement{Key: start},
+			nftables.SetElement{Key: end, IntervalEnd: true},
+		)
+	}
+
+	return elems, nil
+}
+
 // Add range to set [start, lastInclusive] into the format needed by nft
 func addPortRangeToSet(elems []nftables.SetElement, start int64, lastInclusive int64) []nftables.SetElement {
 	// #nosec G115 - ports are already checked to be uint16
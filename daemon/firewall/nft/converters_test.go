@@ -38,3 +38,46 @@ func TestCalculateFirstAndLastV4Prefix(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateFirstAndLastV6Prefix(t *testing.T) {
+	category.Set(t, category.Unit)
+	tests := []struct {
+		name          string
+		cidr          string
+		wantedStartIP net.IP
+		wantedEndIP   net.IP
+		hasError      bool
+	}{
+		{
+			name:          "unique local",
+			cidr:          "fc00::/7",
+			wantedStartIP: net.ParseIP("fc00::"),
+			wantedEndIP:   net.ParseIP("fe00::"),
+		},
+		{
+			name:          "not normalized",
+			cidr:          "fe80::1/10",
+			wantedStartIP: net.ParseIP("fe80::"),
+			wantedEndIP:   net.ParseIP("fec0::"),
+		},
+		{
+			name:          "whole range wraps",
+			cidr:          "::/0",
+			wantedStartIP: net.ParseIP("::"),
+			wantedEndIP:   net.ParseIP("::"),
+		},
+		{
+			name:     "IPv4",
+			cidr:     "192.168.0.0/16",
+			hasError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startIP, endIP, err := calculateFirstAndLastV6Prefix(tt.cidr)
+			assert.Equal(t, tt.hasError, err != nil)
+			assert.Equal(t, tt.wantedStartIP, startIP)
+			assert.Equal(t, tt.wantedEndIP, endIP)
+		})
+	}
+}
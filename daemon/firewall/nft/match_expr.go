@@ -153,7 +153,7 @@ func verifyIPIsInSet(ipSet *nftables.Set, match matchType, isIn bool) []expr.Any
 	if ipSet == nil {
 		return []expr.Any{}
 	}
-	// IPv4 header saddr offset 12, daddr at ofset 16
+	// IPv4 header saddr offset 12, daddr at offset 16
 	var offset uint32 = 12
 	if match == matchDest {
 		offset = 16
@@ -187,6 +187,103 @@ func verifyIPIsInSet(ipSet *nftables.Set, match matchType, isIn bool) []expr.Any
 	}
 }
 
+// limit rate over N bytes/second
+func limitRateOver(bytesPerSecond uint64) []expr.Any {
+	return []expr.Any{
+		&expr.Limit{
+			Type: expr.LimitTypePktBytes,
+			Rate: bytesPerSecond,
+			Over: true,
+			Unit: expr.LimitTimeSecond,
+		},
+	}
+}
+
+// update @set_name { ip saddr/daddr limit rate over N bytes/second }
+func limitRateOverPerIP(ipSet *nftables.Set, match matchType, bytesPerSecond uint64) []expr.Any {
+	// IPv4 header saddr offset 12, daddr at offset 16
+	var offset uint32 = 12
+	if match == matchDest {
+		offset = 16
+	}
+
+	return []expr.Any{
+		&expr.Meta{
+			Key:      expr.MetaKeyNFPROTO,
+			Register: 1,
+		},
+		&expr.Cmp{
+			Register: 1,
+			Op:       expr.CmpOpEq,
+			Data:     []byte{unix.NFPROTO_IPV4},
+		},
+		&expr.Payload{
+			DestRegister: 1,
+			Base:         expr.PayloadBaseNetworkHeader,
+			Offset:       offset,
+			Len:          4,
+		},
+		&expr.Dynset{
+			SrcRegKey: 1,
+			SetName:   ipSet.Name,
+			SetID:     ipSet.ID,
+			Operation: uint32(unix.NFT_DYNSET_OP_UPDATE),
+			Exprs:     limitRateOver(bytesPerSecond),
+		},
+	}
+}
+
+// ip6 saddr/daddr @set_name
+func checkIP6IsInSet(ipSet *nftables.Set, match matchType) []expr.Any {
+	if ipSet == nil {
+		return []expr.Any{}
+	}
+	// IPv6 header saddr offset 8, daddr at ofset 24
+	var offset uint32 = 8
+	if match == matchDest {
+		offset = 24
+	}
+
+	return []expr.Any{
+		// meta nfproto == ipv6
+		&expr.Meta{
+			Key:      expr.MetaKeyNFPROTO,
+			Register: 1,
+		},
+		&expr.Cmp{
+			Register: 1,
+			Op:       expr.CmpOpEq,
+			Data:     []byte{unix.NFPROTO_IPV6},
+		},
+		&expr.Payload{
+			DestRegister: 1,
+			Base:         expr.PayloadBaseNetworkHeader,
+			Offset:       offset,
+			Len:          16,
+		},
+		&expr.Lookup{
+			SourceRegister: 1,
+			SetName:        ipSet.Name,
+			SetID:          ipSet.ID,
+		},
+	}
+}
+
+// meta nfproto ipv6
+func checkNfproto(nfproto byte) []expr.Any {
+	return []expr.Any{
+		&expr.Meta{
+			Key:      expr.MetaKeyNFPROTO,
+			Register: 1,
+		},
+		&expr.Cmp{
+			Register: 1,
+			Op:       expr.CmpOpEq,
+			Data:     []byte{nfproto},
+		},
+	}
+}
+
 type ifDirection int
 
 const (
@@ -257,6 +354,48 @@ func checkMetaMarkAndSetCtMark(fwmark uint32) []expr.Any {
 	}
 }
 
+// socket cgroupv2 level 1 "nordvpn-bypass"
+func checkSocketCgroup(id uint64, level uint32) []expr.Any {
+	return []expr.Any{
+		&expr.Socket{
+			Key:      expr.SocketKeyCgroupv2,
+			Level:    level,
+			Register: 1,
+		},
+		&expr.Cmp{
+			Register: 1,
+			Op:       expr.CmpOpEq,
+			Data:     binaryutil.NativeEndian.PutUint64(id),
+		},
+	}
+}
+
+// meta mark 0xe1f1
+func checkMetaMark(fwmark uint32) []expr.Any {
+	return []expr.Any{
+		&expr.Meta{
+			Key:      expr.MetaKeyMARK,
+			Register: 1,
+		},
+		&expr.Cmp{
+			Register: 1,
+			Op:       expr.CmpOpEq,
+			Data:     binaryutil.NativeEndian.PutUint32(fwmark),
+		},
+	}
+}
+
+// meta mark set 0xe1f1 ct mark set meta mark
+func setMetaMarkAndCtMark(fwmark uint32) []expr.Any {
+	return append(setMetaMark(fwmark),
+		&expr.Ct{
+			Key:            expr.CtKeyMARK,
+			Register:       1,
+			SourceRegister: true,
+		},
+	)
+}
+
 // ct original saddr <ip>
 func checkCtOriginalSrcIP(ip netip.Addr) []expr.Any {
 	addr := ip.As4()
@@ -3,6 +3,7 @@ package nft
 import (
 	"fmt"
 	"net/netip"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/core/mesh"
@@ -17,9 +18,13 @@ import (
 const (
 	tableName                       = "nordvpn"
 	allowlistSubnetsSetName         = "allowlist_subnets"
+	allowlistDomainsSetName         = "allowlist_domains"
+	allowlistDomains6SetName        = "allowlist_domains6"
+	dnsRouteResolversSetName        = "dns_route_resolvers"
 	tcpAllowlistSetName             = "tcp_allowlist"
 	udpAllowlistSetName             = "udp_allowlist"
 	lanPrivateIpsSetName            = "lan_ranges"
+	lanPrivateIps6SetName           = "lan_ranges6"
 	inputChainName                  = "input"
 	outputChainName                 = "output"
 	forwardChainName                = "forward"
@@ -29,7 +34,9 @@ const (
 	internetToMeshPeer              = "internet_to_mesh_peer"
 	meshNatChainName                = "mesh_nat"
 	allowlistNatChainName           = "allowlist_nat"
+	splitTunnelNatChainName         = "split_tunnel_nat"
 	fileshareAllowedPeersSet        = "fileshare_allowed_peers"
+	fileshareUploadRatesSet         = "fileshare_upload_rates"
 	allowIncomingConnectionPeersSet = "allow_incoming_connections"
 	allowTrafficRoutingPeersSet     = "allow_peer_traffic_routing"
 	lanAccessPeersSet               = "peer_local_network_access"
@@ -40,19 +47,34 @@ const (
 type nftContext struct {
 	table                          *nftables.Table
 	lanRanges                      *nftables.Set
+	lanRanges6                     *nftables.Set
 	allowlistSubnets               *nftables.Set
+	allowlistDomains               *nftables.Set
+	allowlistDomains6              *nftables.Set
+	dnsRouteResolvers              *nftables.Set
 	tcpPorts                       *nftables.Set
 	udpPorts                       *nftables.Set
 	fileshareAllowedPeers          *nftables.Set
+	fileshareUploadRates           *nftables.Set
 	meshLanAllowedPeers            *nftables.Set
 	meshRoutingAllowed             *nftables.Set
 	meshAllowedIncomingConnections *nftables.Set
 }
 
+// conn is the part of nftables.Conn used to build the ruleset
+type conn interface {
+	AddTable(t *nftables.Table) *nftables.Table
+	DelTable(t *nftables.Table)
+	AddChain(c *nftables.Chain) *nftables.Chain
+	AddRule(r *nftables.Rule) *nftables.Rule
+	AddSet(s *nftables.Set, vals []nftables.SetElement) error
+	Flush() error
+}
+
 // nft class is responsible to configure the firewall using the nftables.
 // The communication with the kernel is made over netlink.
 type nft struct {
-	conn   *nftables.Conn
+	conn   conn
 	fwmark uint32
 }
 
@@ -86,6 +108,12 @@ func (n *nft) configure(config firewall.Config) error {
 		return err
 	}
 
+	if config.TunnelIPv6 {
+		if err := n.addLanRanges6Set(nftCtx); err != nil {
+			return err
+		}
+	}
+
 	if err := n.addAllowlistSubnets(config.Allowlist, nftCtx); err != nil {
 		return err
 	}
@@ -94,6 +122,14 @@ func (n *nft) configure(config firewall.Config) error {
 		return err
 	}
 
+	if err := n.addAllowlistDomains(config.AllowlistDomainIPs, nftCtx); err != nil {
+		return err
+	}
+
+	if err := n.addDNSRouteResolvers(config.DNSRouteResolvers, nftCtx); err != nil {
+		return err
+	}
+
 	if config.MeshnetInfo != nil {
 		if !config.BlockFileshare {
 			if err := n.addFilesharePeers(config.MeshnetInfo.MeshnetMap, nftCtx); err != nil {
@@ -101,6 +137,12 @@ func (n *nft) configure(config firewall.Config) error {
 			}
 		}
 
+		if config.FileshareRateLimit.Peer > 0 {
+			if err := n.addFileshareUploadRates(nftCtx); err != nil {
+				return err
+			}
+		}
+
 		if err := n.addLanAllowedPeers(config.MeshnetInfo.MeshnetMap, nftCtx); err != nil {
 			return err
 		}
@@ -121,16 +163,23 @@ func (n *nft) configure(config firewall.Config) error {
 		n.addMeshnetNat(nftCtx)
 	}
 
-	if len(config.TunnelInterface) > 0 && (nftCtx.udpPorts != nil || nftCtx.tcpPorts != nil) {
+	if len(config.TunnelInterface) > 0 && (nftCtx.udpPorts != nil || nftCtx.tcpPorts != nil ||
+		nftCtx.allowlistDomains != nil || nftCtx.allowlistDomains6 != nil ||
+		nftCtx.dnsRouteResolvers != nil) {
 		n.addAllowlistNat(config, nftCtx)
 	}
 
+	if len(config.TunnelInterface) > 0 &&
+		(len(config.SplitTunnel) > 0 || config.SplitTunnelMode == firewall.SplitTunnelInclude) {
+		n.addSplitTunnelNat(config, nftCtx)
+	}
+
 	return n.conn.Flush()
 }
 
 func (n *nft) addInputChain(config firewall.Config, nftCtx *nftContext) {
 	chainPolicy := nftables.ChainPolicyAccept
-	if config.IsVpnOrKillSwitchSet() {
+	if config.IsFullTunnelSet() {
 		chainPolicy = nftables.ChainPolicyDrop
 	}
 
@@ -162,6 +211,19 @@ func (n *nft) addInputChain(config firewall.Config, nftCtx *nftContext) {
 		UserData: userdata.AppendString(nil, userdata.TypeComment, "response for sockets with SO_MARK"),
 	})
 
+	if len(config.EntryTunnelInterface) > 0 {
+		// iifname "nordlynx-entry" drop
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: inputChain,
+			Exprs: buildRules(
+				&expr.Verdict{Kind: expr.VerdictDrop},
+				checkInterfaceName(config.EntryTunnelInterface, ifNameInput, expr.CmpOpEq),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "entry tunnel to local"),
+		})
+	}
+
 	// meshnet
 	if config.MeshnetInfo != nil {
 		// Add chain for the meshnet and the jump rule to it
@@ -211,7 +273,7 @@ func (n *nft) addInputChain(config firewall.Config, nftCtx *nftContext) {
 
 func (n *nft) addOutputChain(config firewall.Config, nftCtx *nftContext) {
 	chainPolicy := nftables.ChainPolicyAccept
-	if config.IsVpnOrKillSwitchSet() {
+	if config.IsFullTunnelSet() {
 		chainPolicy = nftables.ChainPolicyDrop
 	}
 
@@ -256,8 +318,27 @@ func (n *nft) addOutputChain(config firewall.Config, nftCtx *nftContext) {
 		UserData: userdata.AppendString(nil, userdata.TypeComment, "mark connection for socket with SO_MARK"),
 	})
 
+	if len(config.EntryTunnelInterface) > 0 {
+		// oifname "nordlynx-entry" drop
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: outputChain,
+			Exprs: buildRules(
+				&expr.Verdict{Kind: expr.VerdictDrop},
+				checkInterfaceName(config.EntryTunnelInterface, ifNameOutput, expr.CmpOpEq),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "local to entry tunnel"),
+		})
+	}
+
+	n.addSplitTunnel(config, nftCtx, outputChain)
+
+	n.addDNSRouteResolversAccept(config, nftCtx, outputChain)
+
 	n.addLanDNSDrop(config, nftCtx, outputChain)
 
+	n.addPlainDNSDrop(config, nftCtx, outputChain)
+
 	if nftCtx.allowlistSubnets != nil {
 		// ip daddr @allowed_subnets accept
 		n.conn.AddRule(&nftables.Rule{
@@ -272,6 +353,52 @@ func (n *nft) addOutputChain(config firewall.Config, nftCtx *nftContext) {
 		})
 	}
 
+	// domain addresses are not covered by the routing rules, mark the connection so that
+	// packets are re-routed outside of the tunnel and the replies are accepted
+	if nftCtx.allowlistDomains != nil {
+		// ip daddr @allowlist_domains meta mark set 0xe1f1 ct mark set meta mark accept
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: outputChain,
+			Exprs: buildRules(
+				&expr.Verdict{Kind: expr.VerdictAccept},
+				checkIPIsInSet(nftCtx.allowlistDomains, matchDest),
+				setMetaMarkAndCtMark(n.fwmark),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "local to allowlist domains"),
+		})
+	}
+
+	if nftCtx.allowlistDomains6 != nil {
+		// ip6 daddr @allowlist_domains6 meta mark set 0xe1f1 ct mark set meta mark accept
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: outputChain,
+			Exprs: buildRules(
+				&expr.Verdict{Kind: expr.VerdictAccept},
+				checkIP6IsInSet(nftCtx.allowlistDomains6, matchDest),
+				setMetaMarkAndCtMark(n.fwmark),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "local to allowlist IPv6 domains"),
+		})
+	}
+
+	// IPv6 is blocked outside of the tunnel, so the replies from the allowlisted ports must not
+	// be re-routed there
+	if config.TunnelIPv6 && len(config.TunnelInterface) > 0 && (nftCtx.tcpPorts != nil || nftCtx.udpPorts != nil) {
+		// meta nfproto ipv6 oifname "nordlynx" accept
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: outputChain,
+			Exprs: buildRules(
+				&expr.Verdict{Kind: expr.VerdictAccept},
+				checkNfproto(unix.NFPROTO_IPV6),
+				checkInterfaceName(config.TunnelInterface, ifNameOutput, expr.CmpOpEq),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "local IPv6 to VPN"),
+		})
+	}
+
 	if nftCtx.tcpPorts != nil {
 		// tcp sport @tcp_allowlist meta mark set 0x0000e1f1 accept
 		n.conn.AddRule(&nftables.Rule{
@@ -301,6 +428,8 @@ func (n *nft) addOutputChain(config firewall.Config, nftCtx *nftContext) {
 	}
 
 	if config.MeshnetInfo != nil {
+		n.addFileshareRateLimit(config, nftCtx, outputChain)
+
 		// oifname "nordlynx" ip daddr 100.64.0.0/10 accept
 		n.conn.AddRule(&nftables.Rule{
 			Table: nftCtx.table,
@@ -330,7 +459,7 @@ func (n *nft) addOutputChain(config firewall.Config, nftCtx *nftContext) {
 
 func (n *nft) addForwardChain(config firewall.Config, nftCtx *nftContext) {
 	chainPolicy := nftables.ChainPolicyAccept
-	if config.IsVpnOrKillSwitchSet() {
+	if config.IsFullTunnelSet() {
 		chainPolicy = nftables.ChainPolicyDrop
 	}
 
@@ -582,7 +711,7 @@ func (n *nft) addMeshnetInputChain(nftCtx *nftContext, selfMeshIP netip.Addr) *n
 }
 
 func (n *nft) addLanDNSDrop(config firewall.Config, nftCtx *nftContext, chain *nftables.Chain) {
-	if config.IsVpnOrKillSwitchSet() {
+	if config.IsFullTunnelSet() {
 		if !config.Allowlist.Ports.TCP[defaultDNSPort] {
 			// ip daddr @lan_ranges tcp dport 53 drop
 			n.conn.AddRule(&nftables.Rule{
@@ -610,6 +739,149 @@ func (n *nft) addLanDNSDrop(config firewall.Config, nftCtx *nftContext, chain *n
 				UserData: userdata.AppendString(nil, userdata.TypeComment, "block to LAN DNS for UDP"),
 			})
 		}
+
+		if nftCtx.lanRanges6 == nil {
+			return
+		}
+
+		if !config.Allowlist.Ports.TCP[defaultDNSPort] {
+			// ip6 daddr @lan_ranges6 tcp dport 53 drop
+			n.conn.AddRule(&nftables.Rule{
+				Table: nftCtx.table,
+				Chain: chain,
+				Exprs: buildRules(
+					&expr.Verdict{Kind: expr.VerdictDrop},
+					checkIP6IsInSet(nftCtx.lanRanges6, matchDest),
+					checkPortNumber(defaultDNSPort, unix.IPPROTO_TCP, matchDest),
+				),
+				UserData: userdata.AppendString(nil, userdata.TypeComment, "block to LAN IPv6 DNS for TCP"),
+			})
+		}
+
+		if !config.Allowlist.Ports.UDP[defaultDNSPort] {
+			// ip6 daddr @lan_ranges6 udp dport 53 drop
+			n.conn.AddRule(&nftables.Rule{
+				Table: nftCtx.table,
+				Chain: chain,
+				Exprs: buildRules(
+					&expr.Verdict{Kind: expr.VerdictDrop},
+					checkIP6IsInSet(nftCtx.lanRanges6, matchDest),
+					checkPortNumber(defaultDNSPort, unix.IPPROTO_UDP, matchDest),
+				),
+				UserData: userdata.AppendString(nil, userdata.TypeComment, "block to LAN IPv6 DNS for UDP"),
+			})
+		}
+	}
+}
+
+// addPlainDNSDrop blocks DNS which bypasses the local encrypted DNS resolver outside of the
+// tunnel. Loopback is accepted before, so the queries to the resolver itself are not affected.
+// DNS inside the tunnel is not dropped, since it does not leak and the connection quality
+// monitor measures the tunnel with it.
+func (n *nft) addPlainDNSDrop(config firewall.Config, nftCtx *nftContext, chain *nftables.Chain) {
+	if !config.KillSwitch || !config.BlockPlainDNS {
+		return
+	}
+
+	var outsideTunnel []expr.Any
+	if len(config.TunnelInterface) > 0 {
+		outsideTunnel = checkInterfaceName(config.TunnelInterface, ifNameOutput, expr.CmpOpNeq)
+	}
+
+	if !config.Allowlist.Ports.TCP[defaultDNSPort] {
+		// oifname != "nordlynx" tcp dport 53 drop
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: chain,
+			Exprs: buildRules(
+				&expr.Verdict{Kind: expr.VerdictDrop},
+				outsideTunnel,
+				checkPortNumber(defaultDNSPort, unix.IPPROTO_TCP, matchDest),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "block plain DNS for TCP"),
+		})
+	}
+
+	if !config.Allowlist.Ports.UDP[defaultDNSPort] {
+		// oifname != "nordlynx" udp dport 53 drop
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: chain,
+			Exprs: buildRules(
+				&expr.Verdict{Kind: expr.VerdictDrop},
+				outsideTunnel,
+				checkPortNumber(defaultDNSPort, unix.IPPROTO_UDP, matchDest),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "block plain DNS for UDP"),
+		})
+	}
+}
+
+// addDNSRouteResolversAccept allows DNS to the split DNS route nameservers outside of the
+// tunnel. It has to precede the DNS drop rules, any other traffic to these addresses is
+// handled as usual.
+func (n *nft) addDNSRouteResolversAccept(config firewall.Config, nftCtx *nftContext, chain *nftables.Chain) {
+	if nftCtx.dnsRouteResolvers == nil || !config.IsFullTunnelSet() {
+		return
+	}
+
+	for _, proto := range []struct {
+		number  byte
+		comment string
+	}{
+		{number: unix.IPPROTO_TCP, comment: "local to DNS route resolvers for TCP"},
+		{number: unix.IPPROTO_UDP, comment: "local to DNS route resolvers for UDP"},
+	} {
+		// ip daddr @dns_route_resolvers tcp dport 53 meta mark set 0xe1f1 ct mark set meta mark accept
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: chain,
+			Exprs: buildRules(
+				&expr.Verdict{Kind: expr.VerdictAccept},
+				checkIPIsInSet(nftCtx.dnsRouteResolvers, matchDest),
+				checkPortNumber(defaultDNSPort, proto.number, matchDest),
+				setMetaMarkAndCtMark(n.fwmark),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, proto.comment),
+		})
+	}
+}
+
+// addFileshareRateLimit throttles the uploads of the fileshare transfers by dropping the packets
+// over the rate, so TCP of the transfers slows down to it. Libdrop can not limit the transfers
+// itself. Transfers to the same peer share a connection, so the narrowest limit which can be
+// applied to them is per peer.
+func (n *nft) addFileshareRateLimit(config firewall.Config, nftCtx *nftContext, chain *nftables.Chain) {
+	limit := config.FileshareRateLimit
+	if limit.Total > 0 {
+		// oifname "nordlynx" tcp dport 49111 limit rate over 1 mbytes/second drop
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: chain,
+			Exprs: buildRules(
+				&expr.Verdict{Kind: expr.VerdictDrop},
+				checkInterfaceName(config.MeshnetInfo.MeshInterface, ifNameOutput, expr.CmpOpEq),
+				checkPortNumber(internal.FilesharePort, unix.IPPROTO_TCP, matchDest),
+				limitRateOver(limit.Total),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "fileshare upload rate"),
+		})
+	}
+
+	if nftCtx.fileshareUploadRates != nil {
+		// oifname "nordlynx" tcp dport 49111
+		// update @fileshare_upload_rates { ip daddr limit rate over 1 mbytes/second } drop
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: chain,
+			Exprs: buildRules(
+				&expr.Verdict{Kind: expr.VerdictDrop},
+				checkInterfaceName(config.MeshnetInfo.MeshInterface, ifNameOutput, expr.CmpOpEq),
+				checkPortNumber(internal.FilesharePort, unix.IPPROTO_TCP, matchDest),
+				limitRateOverPerIP(nftCtx.fileshareUploadRates, matchDest, limit.Peer),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "fileshare upload rate per peer"),
+		})
 	}
 }
 
@@ -808,6 +1080,148 @@ func (n *nft) addAllowlistNat(config firewall.Config, nftCtx *nftContext) {
 			UserData: userdata.AppendString(nil, userdata.TypeComment, "fix source IP for TCP allowlist ports"),
 		})
 	}
+
+	// oifname != "nordlynx" ip daddr @allowlist_domains masquerade
+	if nftCtx.allowlistDomains != nil {
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: natChain,
+			Exprs: buildRules(
+				&expr.Masq{},
+				checkInterfaceName(config.TunnelInterface, ifNameOutput, expr.CmpOpNeq),
+				checkIPIsInSet(nftCtx.allowlistDomains, matchDest),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "fix source IP for allowlist domains"),
+		})
+	}
+
+	// oifname != "nordlynx" ip6 daddr @allowlist_domains6 masquerade
+	if nftCtx.allowlistDomains6 != nil {
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: natChain,
+			Exprs: buildRules(
+				&expr.Masq{},
+				checkInterfaceName(config.TunnelInterface, ifNameOutput, expr.CmpOpNeq),
+				checkIP6IsInSet(nftCtx.allowlistDomains6, matchDest),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "fix source IP for allowlist IPv6 domains"),
+		})
+	}
+
+	// oifname != "nordlynx" ip daddr @dns_route_resolvers masquerade
+	if nftCtx.dnsRouteResolvers != nil {
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: natChain,
+			Exprs: buildRules(
+				&expr.Masq{},
+				checkInterfaceName(config.TunnelInterface, ifNameOutput, expr.CmpOpNeq),
+				checkIPIsInSet(nftCtx.dnsRouteResolvers, matchDest),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "fix source IP for DNS route resolvers"),
+		})
+	}
+}
+
+func (n *nft) addSplitTunnel(config firewall.Config, nftCtx *nftContext, chain *nftables.Chain) {
+	if config.SplitTunnelMode == firewall.SplitTunnelInclude {
+		n.addIncludeOnlySplitTunnel(config, nftCtx, chain)
+		return
+	}
+
+	if !config.IsVpnOrKillSwitchSet() {
+		return
+	}
+
+	for _, cgroup := range config.SplitTunnel {
+		// when VPN is not connected, only the apps exempted from kill switch are allowed
+		if len(config.TunnelInterface) == 0 && !cgroup.KillSwitchExempt {
+			continue
+		}
+		// socket cgroupv2 level 1 "nordvpn-bypass" meta mark set 0xe1f1 ct mark set meta mark accept
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: chain,
+			Exprs: buildRules(
+				&expr.Verdict{Kind: expr.VerdictAccept},
+				checkSocketCgroup(cgroup.ID, cgroup.Level),
+				setMetaMarkAndCtMark(n.fwmark),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "split tunnel app outside of VPN"),
+		})
+	}
+}
+
+// addIncludeOnlySplitTunnel keeps only the split tunnel cgroups inside the VPN tunnel,
+// the rest of the traffic is marked to be routed via the main routing table
+func (n *nft) addIncludeOnlySplitTunnel(config firewall.Config, nftCtx *nftContext, chain *nftables.Chain) {
+	if len(config.TunnelInterface) == 0 {
+		if !config.KillSwitch {
+			return
+		}
+		for _, cgroup := range config.SplitTunnel {
+			// socket cgroupv2 level 1 "nordvpn-bypass" drop
+			n.conn.AddRule(&nftables.Rule{
+				Table: nftCtx.table,
+				Chain: chain,
+				Exprs: buildRules(
+					&expr.Verdict{Kind: expr.VerdictDrop},
+					checkSocketCgroup(cgroup.ID, cgroup.Level),
+				),
+				UserData: userdata.AppendString(nil, userdata.TypeComment, "block split tunnel app while VPN is down"),
+			})
+		}
+		return
+	}
+
+	for _, cgroup := range config.SplitTunnel {
+		// socket cgroupv2 level 1 "nordvpn-bypass" accept
+		n.conn.AddRule(&nftables.Rule{
+			Table: nftCtx.table,
+			Chain: chain,
+			Exprs: buildRules(
+				&expr.Verdict{Kind: expr.VerdictAccept},
+				checkSocketCgroup(cgroup.ID, cgroup.Level),
+			),
+			UserData: userdata.AppendString(nil, userdata.TypeComment, "split tunnel app inside of VPN"),
+		})
+	}
+
+	// oifname "nordlynx" meta mark set 0xe1f1 ct mark set meta mark accept
+	n.conn.AddRule(&nftables.Rule{
+		Table: nftCtx.table,
+		Chain: chain,
+		Exprs: buildRules(
+			&expr.Verdict{Kind: expr.VerdictAccept},
+			checkInterfaceName(config.TunnelInterface, ifNameOutput, expr.CmpOpEq),
+			setMetaMarkAndCtMark(n.fwmark),
+		),
+		UserData: userdata.AppendString(nil, userdata.TypeComment, "traffic outside of include-only split tunnel"),
+	})
+}
+
+func (n *nft) addSplitTunnelNat(config firewall.Config, nftCtx *nftContext) {
+	natChain := n.conn.AddChain(&nftables.Chain{
+		Name:     splitTunnelNatChainName,
+		Table:    nftCtx.table,
+		Type:     nftables.ChainTypeNAT,
+		Hooknum:  nftables.ChainHookPostrouting,
+		Priority: nftables.ChainPriorityNATSource,
+	})
+
+	// source address was selected for the tunnel before the packet was re-routed
+	// oifname != "nordlynx" meta mark 0xe1f1 masquerade
+	n.conn.AddRule(&nftables.Rule{
+		Table: nftCtx.table,
+		Chain: natChain,
+		Exprs: buildRules(
+			&expr.Masq{},
+			checkInterfaceName(config.TunnelInterface, ifNameOutput, expr.CmpOpNeq),
+			checkMetaMark(n.fwmark),
+		),
+		UserData: userdata.AppendString(nil, userdata.TypeComment, "fix source IP for split tunnel apps"),
+	})
 }
 
 func (n *nft) addLanRangesSet(nftCtx *nftContext) error {
@@ -831,6 +1245,27 @@ func (n *nft) addLanRangesSet(nftCtx *nftContext) error {
 	return nil
 }
 
+func (n *nft) addLanRanges6Set(nftCtx *nftContext) error {
+	nftCtx.lanRanges6 = &nftables.Set{
+		Table:    nftCtx.table,
+		Name:     lanPrivateIps6SetName,
+		KeyType:  nftables.TypeIP6Addr,
+		Interval: true,
+		Constant: true,
+	}
+
+	elems, err := convertCidr6ToSetElements(internal.LocalNetworks6)
+	if err != nil {
+		return err
+	}
+
+	if err := n.conn.AddSet(nftCtx.lanRanges6, elems); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (n *nft) addFilesharePeers(meshMap mesh.MachineMap, nftCtx *nftContext) error {
 	nftCtx.fileshareAllowedPeers = &nftables.Set{
 		Table:    nftCtx.table,
@@ -858,6 +1293,23 @@ func (n *nft) addFilesharePeers(meshMap mesh.MachineMap, nftCtx *nftContext) err
 	return nil
 }
 
+// addFileshareUploadRates adds the set tracking the upload rate of every peer, peers which are
+// not sent anything for a minute are removed
+func (n *nft) addFileshareUploadRates(nftCtx *nftContext) error {
+	nftCtx.fileshareUploadRates = &nftables.Set{
+		Table:      nftCtx.table,
+		Name:       fileshareUploadRatesSet,
+		KeyType:    nftables.TypeIPAddr,
+		Dynamic:    true,
+		HasTimeout: true,
+		Timeout:    time.Minute,
+	}
+	if err := n.conn.AddSet(nftCtx.fileshareUploadRates, nil); err != nil {
+		return fmt.Errorf("add fileshare upload rates set: %w", err)
+	}
+	return nil
+}
+
 func (n *nft) addLanAllowedPeers(meshMap mesh.MachineMap, nftCtx *nftContext) error {
 	nftCtx.meshLanAllowedPeers = &nftables.Set{
 		Table:    nftCtx.table,
@@ -975,6 +1427,71 @@ func (n *nft) addAllowlistSubnets(allowlist config.Allowlist, nftCtx *nftContext
 	return nil
 }
 
+// addAllowlistDomains adds the sets of the resolved allowlist domain addresses, separate sets are
+// needed for IPv4 and IPv6 addresses
+func (n *nft) addAllowlistDomains(ips []netip.Addr, nftCtx *nftContext) error {
+	var elements, elements6 []nftables.SetElement
+	for _, ip := range ips {
+		if ip.Is4() || ip.Is4In6() {
+			elements = append(elements, nftables.SetElement{Key: ip.Unmap().AsSlice()})
+		} else if ip.Is6() {
+			elements6 = append(elements6, nftables.SetElement{Key: ip.AsSlice()})
+		}
+	}
+
+	if len(elements) > 0 {
+		nftCtx.allowlistDomains = &nftables.Set{
+			Table:    nftCtx.table,
+			Name:     allowlistDomainsSetName,
+			KeyType:  nftables.TypeIPAddr,
+			Constant: true,
+		}
+		if err := n.conn.AddSet(nftCtx.allowlistDomains, elements); err != nil {
+			return fmt.Errorf("add allowlist domains set: %w", err)
+		}
+	}
+
+	if len(elements6) > 0 {
+		nftCtx.allowlistDomains6 = &nftables.Set{
+			Table:    nftCtx.table,
+			Name:     allowlistDomains6SetName,
+			KeyType:  nftables.TypeIP6Addr,
+			Constant: true,
+		}
+		if err := n.conn.AddSet(nftCtx.allowlistDomains6, elements6); err != nil {
+			return fmt.Errorf("add allowlist IPv6 domains set: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// addDNSRouteResolvers adds the set of the split DNS route nameservers, only IPv4 nameservers
+// are supported
+func (n *nft) addDNSRouteResolvers(ips []netip.Addr, nftCtx *nftContext) error {
+	var elements []nftables.SetElement
+	for _, ip := range ips {
+		if ip.Is4() || ip.Is4In6() {
+			elements = append(elements, nftables.SetElement{Key: ip.Unmap().AsSlice()})
+		}
+	}
+
+	if len(elements) == 0 {
+		return nil
+	}
+
+	nftCtx.dnsRouteResolvers = &nftables.Set{
+		Table:    nftCtx.table,
+		Name:     dnsRouteResolversSetName,
+		KeyType:  nftables.TypeIPAddr,
+		Constant: true,
+	}
+	if err := n.conn.AddSet(nftCtx.dnsRouteResolvers, elements); err != nil {
+		return fmt.Errorf("add DNS route resolvers set: %w", err)
+	}
+	return nil
+}
+
 func (n *nft) addMainTable() *nftables.Table {
 	return n.conn.AddTable(&nftables.Table{
 		Family: nftables.TableFamilyINet,
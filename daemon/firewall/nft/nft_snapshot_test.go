@@ -12,8 +12,9 @@ import (
 )
 
 const (
-	peerIP = "100.113.144.142"
-	ifName = "nordlynx"
+	peerIP      = "100.113.144.142"
+	ifName      = "nordlynx"
+	entryIfName = "nordlynx-entry"
 )
 
 var selfMeshIP = netip.MustParseAddr("100.64.0.1")
@@ -39,6 +40,18 @@ func TestVPNRuleset(t *testing.T) {
 			name:   "vpn and kill switch",
 			config: helpers.NewFWConfig().TunnelInterface(ifName).KillSwitch(),
 		},
+		{
+			name:   "multi-hop and kill switch",
+			config: helpers.NewFWConfig().TunnelInterface(ifName).EntryTunnelInterface(entryIfName).KillSwitch(),
+		},
+		{
+			name:   "plain DNS blocked with kill switch",
+			config: helpers.NewFWConfig().TunnelInterface(ifName).KillSwitch().BlockPlainDNS(),
+		},
+		{
+			name:   "DNS route resolver allowed with kill switch",
+			config: helpers.NewFWConfig().TunnelInterface(ifName).KillSwitch().BlockPlainDNS().DNSRouteResolver("10.0.0.53"),
+		},
 		{
 			name:   "tcp port allowlisted",
 			config: helpers.NewFWConfig().TunnelInterface(ifName).AllowlistTCPPort(1337),
@@ -58,6 +71,10 @@ func TestVPNRuleset(t *testing.T) {
 			name:   "subnet allowlisted",
 			config: helpers.NewFWConfig().TunnelInterface(ifName).AllowlistSubnet("10.0.0.0/24"),
 		},
+		{
+			name:   "vpn with IPv6 and kill switch",
+			config: helpers.NewFWConfig().TunnelInterface(ifName).TunnelIPv6().KillSwitch().AllowlistTCPPort(1337),
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,101 @@
+package nft
+
+import (
+	"context"
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/health"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/helpers"
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPlainDNSDrop_HealthProbe checks that the connection quality probes reach the nameserver
+// through the tunnel while plain DNS outside of the tunnel is dropped.
+func TestPlainDNSDrop_HealthProbe(t *testing.T) {
+	category.Set(t, category.Root)
+	ns := helpers.OpenNewNamespace(t)
+	defer helpers.CleanNamespace(t, ns)
+
+	const (
+		tunnelNameserver = "103.86.96.100"
+		otherNameserver  = "198.51.100.53"
+	)
+	for _, args := range [][]string{
+		// tun interfaces do not need the neighbours, so the sent packets are never refused
+		{"tuntap", "add", "mode", "tun", "dev", ifName},
+		{"link", "set", ifName, "up"},
+		{"route", "add", tunnelNameserver + "/32", "dev", ifName},
+		{"tuntap", "add", "mode", "tun", "dev", "eth0"},
+		{"link", "set", "eth0", "up"},
+		{"route", "add", otherNameserver + "/32", "dev", "eth0"},
+	} {
+		out, err := exec.Command("ip", args...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	n := NewNft(0xe1f1)
+	require.NoError(t, n.Configure(helpers.NewFWConfig().
+		TunnelInterface(ifName).
+		KillSwitch().
+		BlockPlainDNS().
+		AllowlistSubnet(otherNameserver+"/32").
+		Build()))
+	defer func() { require.NoError(t, n.Flush()) }()
+
+	// nobody answers, so the probe through the tunnel times out instead of being refused
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := health.DNSProber{}.Probe(ctx, ifName, tunnelNameserver)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, syscall.EPERM)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = health.DNSProber{}.Probe(ctx, "", otherNameserver)
+	assert.ErrorIs(t, err, syscall.EPERM)
+}
+
+func TestFileshareRateLimit(t *testing.T) {
+	category.Set(t, category.Root)
+	ns := helpers.OpenNewNamespace(t)
+	defer helpers.CleanNamespace(t, ns)
+
+	cfg := helpers.NewFWConfig().Meshnet(ifName, selfMeshIP).Build()
+	cfg.FileshareRateLimit = config.FileshareRateLimit{Total: 1024 * 1024, Peer: 512 * 1024}
+
+	n := NewNft(0xe1f1)
+	require.NoError(t, n.Configure(cfg))
+	defer func() { require.NoError(t, n.Flush()) }()
+
+	conn := &nftables.Conn{}
+	rules, err := conn.GetRules(
+		&nftables.Table{Name: tableName, Family: nftables.TableFamilyINet},
+		&nftables.Chain{Name: outputChainName},
+	)
+	require.NoError(t, err)
+
+	var limits []*expr.Limit
+	var dynsets []*expr.Dynset
+	for _, rule := range rules {
+		for _, e := range rule.Exprs {
+			switch e := e.(type) {
+			case *expr.Limit:
+				limits = append(limits, e)
+			case *expr.Dynset:
+				dynsets = append(dynsets, e)
+			}
+		}
+	}
+	require.Len(t, limits, 1)
+	assert.Equal(t, uint64(1024*1024), limits[0].Rate)
+	require.Len(t, dynsets, 1)
+	assert.Equal(t, fileshareUploadRatesSet, dynsets[0].SetName)
+}
@@ -0,0 +1,158 @@
+package nft
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/google/nftables"
+	"github.com/google/nftables/userdata"
+)
+
+// chainState is the part of a chain compared by Verify. Rules are identified by their comments,
+// set elements and rule expressions are not compared.
+type chainState struct {
+	policy   string
+	comments []string
+}
+
+// ruleset maps the chain names of the nordvpn table to their state
+type ruleset map[string]chainState
+
+// recorder builds the ruleset in memory instead of sending it to the kernel
+type recorder struct {
+	chains ruleset
+}
+
+func newRecorder() *recorder {
+	return &recorder{chains: ruleset{}}
+}
+
+func (r *recorder) AddTable(t *nftables.Table) *nftables.Table { return t }
+
+func (r *recorder) DelTable(*nftables.Table) { r.chains = ruleset{} }
+
+func (r *recorder) AddChain(c *nftables.Chain) *nftables.Chain {
+	r.chains[c.Name] = chainState{policy: chainPolicy(c)}
+	return c
+}
+
+func (r *recorder) AddRule(rule *nftables.Rule) *nftables.Rule {
+	state := r.chains[rule.Chain.Name]
+	state.comments = append(state.comments, ruleComment(rule))
+	r.chains[rule.Chain.Name] = state
+	return rule
+}
+
+func (r *recorder) AddSet(*nftables.Set, []nftables.SetElement) error { return nil }
+
+func (r *recorder) Flush() error { return nil }
+
+// Verify compares the chains and rules of the nordvpn table in the kernel with the ones the
+// config produces. Only the chain names and policies and the rule comments in their order are
+// compared, so rules modified in place or changed set elements are not detected.
+func (n *nft) Verify(config firewall.Config) error {
+	expected := newRecorder()
+	if err := (&nft{conn: expected, fwmark: n.fwmark}).configure(config); err != nil {
+		return fmt.Errorf("building the expected ruleset: %w", err)
+	}
+
+	active, err := activeRuleset(&nftables.Conn{})
+	if err != nil {
+		return fmt.Errorf("reading the active ruleset: %w", err)
+	}
+
+	if diff := diffRulesets(expected.chains, active); len(diff) > 0 {
+		return fmt.Errorf("%w: %s", firewall.ErrRulesetMismatch, strings.Join(diff, "; "))
+	}
+	return nil
+}
+
+func activeRuleset(conn *nftables.Conn) (ruleset, error) {
+	chains, err := conn.ListChainsOfTableFamily(nftables.TableFamilyINet)
+	if err != nil {
+		return nil, fmt.Errorf("listing chains: %w", err)
+	}
+
+	active := ruleset{}
+	for _, chain := range chains {
+		if chain.Table == nil || chain.Table.Name != tableName {
+			continue
+		}
+		rules, err := conn.GetRules(chain.Table, chain)
+		if err != nil {
+			return nil, fmt.Errorf("listing rules of %s chain: %w", chain.Name, err)
+		}
+		state := chainState{policy: chainPolicy(chain)}
+		for _, rule := range rules {
+			state.comments = append(state.comments, ruleComment(rule))
+		}
+		active[chain.Name] = state
+	}
+	return active, nil
+}
+
+// diffRulesets returns the human readable differences of the active ruleset from the expected one
+func diffRulesets(expected ruleset, active ruleset) []string {
+	var diff []string
+	for _, name := range sortedChains(expected) {
+		want := expected[name]
+		got, ok := active[name]
+		if !ok {
+			diff = append(diff, fmt.Sprintf("chain %s is missing", name))
+			continue
+		}
+		if got.policy != want.policy {
+			diff = append(diff, fmt.Sprintf("chain %s has policy %s instead of %s", name, got.policy, want.policy))
+		}
+		if d, ok := diffRules(want.comments, got.comments); !ok {
+			diff = append(diff, fmt.Sprintf("chain %s %s", name, d))
+		}
+	}
+	for _, name := range sortedChains(active) {
+		if _, ok := expected[name]; !ok {
+			diff = append(diff, fmt.Sprintf("chain %s is not expected", name))
+		}
+	}
+	return diff
+}
+
+// diffRules describes the first rule which differs
+func diffRules(expected []string, active []string) (string, bool) {
+	for i := 0; i < max(len(expected), len(active)); i++ {
+		switch {
+		case i >= len(active):
+			return fmt.Sprintf("is missing rule %q", expected[i]), false
+		case i >= len(expected):
+			return fmt.Sprintf("has unexpected rule %q", active[i]), false
+		case expected[i] != active[i]:
+			return fmt.Sprintf("has rule %q instead of %q", active[i], expected[i]), false
+		}
+	}
+	return "", true
+}
+
+func sortedChains(chains ruleset) []string {
+	names := make([]string, 0, len(chains))
+	for name := range chains {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func chainPolicy(chain *nftables.Chain) string {
+	if chain.Policy == nil {
+		return ""
+	}
+	if *chain.Policy == nftables.ChainPolicyDrop {
+		return "drop"
+	}
+	return "accept"
+}
+
+func ruleComment(rule *nftables.Rule) string {
+	comment, _ := userdata.GetString(rule.UserData, userdata.TypeComment)
+	return comment
+}
@@ -0,0 +1,105 @@
+package nft
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder_BuildsExpectedRuleset(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	expected := newRecorder()
+	n := &nft{conn: expected, fwmark: 0xe1f1}
+	require.NoError(t, n.configure(helpers.NewFWConfig().TunnelInterface(ifName).KillSwitch().Build()))
+	// configuring twice must not duplicate the rules as the table is recreated
+	require.NoError(t, n.configure(helpers.NewFWConfig().TunnelInterface(ifName).KillSwitch().Build()))
+
+	output, ok := expected.chains[outputChainName]
+	require.True(t, ok)
+	assert.Equal(t, "drop", output.policy)
+	assert.Equal(t, 1, countOf(output.comments, "local to loopback"))
+}
+
+func TestDiffRulesets(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	expected := ruleset{
+		"input":  {policy: "drop", comments: []string{"local to local", "traffic from the tunnel"}},
+		"output": {policy: "drop", comments: []string{"local to loopback"}},
+	}
+
+	tests := []struct {
+		name   string
+		active ruleset
+		diff   []string
+	}{
+		{
+			name: "same",
+			active: ruleset{
+				"input":  {policy: "drop", comments: []string{"local to local", "traffic from the tunnel"}},
+				"output": {policy: "drop", comments: []string{"local to loopback"}},
+			},
+		},
+		{
+			name:   "table missing",
+			active: ruleset{},
+			diff:   []string{"chain input is missing", "chain output is missing"},
+		},
+		{
+			name: "policy changed",
+			active: ruleset{
+				"input":  {policy: "drop", comments: []string{"local to local", "traffic from the tunnel"}},
+				"output": {policy: "accept", comments: []string{"local to loopback"}},
+			},
+			diff: []string{"chain output has policy accept instead of drop"},
+		},
+		{
+			name: "rule missing",
+			active: ruleset{
+				"input":  {policy: "drop", comments: []string{"local to local"}},
+				"output": {policy: "drop", comments: []string{"local to loopback"}},
+			},
+			diff: []string{`chain input is missing rule "traffic from the tunnel"`},
+		},
+		{
+			name: "rule added",
+			active: ruleset{
+				"input":  {policy: "drop", comments: []string{"local to local", "traffic from the tunnel"}},
+				"output": {policy: "drop", comments: []string{"local to loopback", ""}},
+			},
+			diff: []string{`chain output has unexpected rule ""`},
+		},
+		{
+			name: "rules reordered and chain added",
+			active: ruleset{
+				"input":  {policy: "drop", comments: []string{"traffic from the tunnel", "local to local"}},
+				"output": {policy: "drop", comments: []string{"local to loopback"}},
+				"extra":  {},
+			},
+			diff: []string{
+				`chain input has rule "traffic from the tunnel" instead of "local to local"`,
+				"chain extra is not expected",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.diff, diffRulesets(expected, test.active))
+		})
+	}
+}
+
+func countOf(items []string, item string) int {
+	count := 0
+	for _, i := range items {
+		if i == item {
+			count++
+		}
+	}
+	return count
+}
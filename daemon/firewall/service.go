@@ -1,6 +1,7 @@
 package firewall
 
 import (
+	"net/netip"
 	"slices"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
@@ -22,14 +23,60 @@ type FirewallBackend interface {
 	Flush() error
 }
 
+// Verifier is implemented by the backends which can check that the ruleset active in the system
+// has the chains and rules produced by the config. Backends document which parts of the rules
+// are compared.
+type Verifier interface {
+	Verify(config Config) error
+}
+
 // Config keeps all the information needed to configure the firewall
 type Config struct {
 	TunnelInterface string
-	Allowlist       config.Allowlist
-	KillSwitch      bool
+	// EntryTunnelInterface carries only the VPN transport of the multi-hop connection
+	EntryTunnelInterface string
+	Allowlist            config.Allowlist
+	// AllowlistDomainIPs are the current addresses of the allowlisted domains
+	AllowlistDomainIPs []netip.Addr
+	KillSwitch         bool
 	// is controlled by the fileshare process monitoring
 	BlockFileshare bool
 	MeshnetInfo    *MeshInfo
+	// SplitTunnel lists cgroups which traffic is handled according to SplitTunnelMode
+	SplitTunnel     []SplitTunnelCgroup
+	SplitTunnelMode SplitTunnelMode
+	// BlockPlainDNS drops unencrypted DNS leaving the machine while kill switch is enabled.
+	// Used when system DNS is served by the local encrypted DNS resolver.
+	BlockPlainDNS bool
+	// DNSRouteResolvers are the nameservers of the split DNS routes. Only DNS to them is
+	// allowed outside the VPN tunnel.
+	DNSRouteResolvers []netip.Addr
+	// TunnelIPv6 is set when the VPN tunnel carries IPv6 traffic. IPv6 stays blocked on the
+	// other interfaces.
+	TunnelIPv6 bool
+	// FileshareRateLimit throttles the uploads to the fileshare port of the meshnet peers
+	FileshareRateLimit config.FileshareRateLimit
+}
+
+// SplitTunnelMode defines how the traffic of split tunnel cgroups is routed
+type SplitTunnelMode int
+
+const (
+	// SplitTunnelExclude routes everything through the VPN tunnel except the split tunnel cgroups
+	SplitTunnelExclude SplitTunnelMode = iota
+	// SplitTunnelInclude routes only the split tunnel cgroups through the VPN tunnel
+	SplitTunnelInclude
+)
+
+// SplitTunnelCgroup is a cgroup v2 which traffic is routed according to the split tunnel mode
+type SplitTunnelCgroup struct {
+	// ID is the inode number of the cgroup directory
+	ID uint64
+	// Level is the depth of the cgroup in the cgroup v2 hierarchy
+	Level uint32
+	// KillSwitchExempt allows traffic from the cgroup even when kill switch is enabled
+	// and VPN is not connected. Not used in the include-only mode.
+	KillSwitchExempt bool
 }
 
 func NewConfig(opts ...Option) Config {
@@ -104,6 +151,12 @@ func (c *Config) IsVpnOrKillSwitchSet() bool {
 	return c.KillSwitch || len(c.TunnelInterface) > 0
 }
 
+// IsFullTunnelSet returns true if all the traffic has to go through the VPN tunnel
+// or be blocked by the kill switch
+func (c *Config) IsFullTunnelSet() bool {
+	return c.IsVpnOrKillSwitchSet() && c.SplitTunnelMode != SplitTunnelInclude
+}
+
 type Option func(*Config)
 
 func WithKillSwitch(v bool) Option {
@@ -118,12 +171,24 @@ func WithAllowlist(allowlist config.Allowlist) Option {
 	}
 }
 
+func WithAllowlistDomainIPs(ips []netip.Addr) Option {
+	return func(c *Config) {
+		c.AllowlistDomainIPs = ips
+	}
+}
+
 func WithTunnelInterface(tunnelInterface string) Option {
 	return func(c *Config) {
 		c.TunnelInterface = tunnelInterface
 	}
 }
 
+func WithEntryTunnelInterface(entryTunnelInterface string) Option {
+	return func(c *Config) {
+		c.EntryTunnelInterface = entryTunnelInterface
+	}
+}
+
 func WithMeshnetInfo(meshInfo *MeshInfo) Option {
 	return func(c *Config) {
 		c.MeshnetInfo = meshInfo
@@ -135,3 +200,34 @@ func WithBlockFileshare(block bool) Option {
 		c.BlockFileshare = block
 	}
 }
+
+func WithBlockPlainDNS(block bool) Option {
+	return func(c *Config) {
+		c.BlockPlainDNS = block
+	}
+}
+
+func WithDNSRouteResolvers(ips []netip.Addr) Option {
+	return func(c *Config) {
+		c.DNSRouteResolvers = ips
+	}
+}
+
+func WithTunnelIPv6(v bool) Option {
+	return func(c *Config) {
+		c.TunnelIPv6 = v
+	}
+}
+
+func WithFileshareRateLimit(limit config.FileshareRateLimit) Option {
+	return func(c *Config) {
+		c.FileshareRateLimit = limit
+	}
+}
+
+func WithSplitTunnel(mode SplitTunnelMode, cgroups []SplitTunnelCgroup) Option {
+	return func(c *Config) {
+		c.SplitTunnelMode = mode
+		c.SplitTunnel = cgroups
+	}
+}
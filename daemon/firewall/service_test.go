@@ -55,6 +55,14 @@ func TestConfigOptions(t *testing.T) {
 			options:  []Option{WithMeshnetInfo(meshInfo)},
 			expected: Config{MeshnetInfo: meshInfo},
 		},
+		{
+			name:    "SplitTunnel interface changes",
+			options: []Option{WithSplitTunnel(SplitTunnelInclude, []SplitTunnelCgroup{{ID: 1, Level: 1}})},
+			expected: Config{
+				SplitTunnelMode: SplitTunnelInclude,
+				SplitTunnel:     []SplitTunnelCgroup{{ID: 1, Level: 1}},
+			},
+		},
 		{
 			name: "combine all members works",
 			options: []Option{
@@ -208,3 +216,12 @@ func TestMeshInfoIsSimilar(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigIsFullTunnelSet(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.False(t, (&Config{}).IsFullTunnelSet())
+	assert.True(t, (&Config{KillSwitch: true}).IsFullTunnelSet())
+	assert.True(t, (&Config{TunnelInterface: "nordlynx"}).IsFullTunnelSet())
+	assert.False(t, (&Config{TunnelInterface: "nordlynx", SplitTunnelMode: SplitTunnelInclude}).IsFullTunnelSet())
+}
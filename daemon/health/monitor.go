@@ -0,0 +1,202 @@
+// Package health monitors the quality of the active VPN connection.
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metrics"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/state/types"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+const (
+	checkInterval = 30 * time.Second
+	// gracePeriod skips the checks right after connecting while the tunnel settles down
+	gracePeriod = time.Minute
+	// failuresBeforeFailover is the number of unhealthy checks in a row which trigger the failover,
+	// so the short hiccups do not drop the connection
+	failuresBeforeFailover = 3
+	probesPerCheck         = 5
+)
+
+// ConnectionStatusGetter is implemented by state.ConnectionInfo.
+type ConnectionStatusGetter interface {
+	Status() types.ConnectionStatus
+}
+
+// Nameservers returns the DNS servers which are reached through the tunnel.
+type Nameservers interface {
+	Get(isThreatProtectionLite bool) []string
+}
+
+// FailoverCallback reconnects to a different server than the given one.
+type FailoverCallback func(hostname string) error
+
+// Monitor periodically checks the handshake age, packet loss and latency of the active
+// connection and fails over to a different server when the thresholds are breached.
+type Monitor struct {
+	cm          config.Manager
+	connection  ConnectionStatusGetter
+	handshake   metrics.HandshakeGetter
+	prober      Prober
+	nameservers Nameservers
+	failoverFn  FailoverCallback
+	publisher   events.Publisher[*pb.FailoverEvent]
+	ctx         context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+	// failures counts unhealthy checks in a row for the hostname
+	failures int
+	hostname string
+}
+
+func NewMonitor(
+	cm config.Manager,
+	connection ConnectionStatusGetter,
+	handshake metrics.HandshakeGetter,
+	prober Prober,
+	nameservers Nameservers,
+	failoverFn FailoverCallback,
+	publisher events.Publisher[*pb.FailoverEvent],
+) *Monitor {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Monitor{
+		cm:          cm,
+		connection:  connection,
+		handshake:   handshake,
+		prober:      prober,
+		nameservers: nameservers,
+		failoverFn:  failoverFn,
+		publisher:   publisher,
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+func (m *Monitor) Start() {
+	m.wg.Go(m.run)
+}
+
+func (m *Monitor) Stop() {
+	log.Info("stopping connection quality monitor")
+	m.cancel()
+	m.wg.Wait()
+}
+
+func (m *Monitor) run() {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			m.check(m.ctx, time.Now())
+		case <-m.ctx.Done():
+			return
+		}
+	}
+}
+
+// check measures the connection and fails over if it was unhealthy for several checks in a row.
+func (m *Monitor) check(ctx context.Context, now time.Time) {
+	var cfg config.Config
+	if err := m.cm.Load(&cfg); err != nil {
+		log.Error("loading config for connection quality monitor:", err)
+		return
+	}
+
+	status := m.connection.Status()
+	if !cfg.Failover.Enabled ||
+		status.State != pb.ConnectionState_CONNECTED ||
+		status.StartTime == nil ||
+		now.Sub(*status.StartTime) < gracePeriod {
+		m.failures = 0
+		return
+	}
+	if status.Hostname != m.hostname {
+		m.hostname = status.Hostname
+		m.failures = 0
+	}
+
+	reason, healthy := m.measure(ctx, cfg, status, now)
+	if healthy {
+		m.failures = 0
+		return
+	}
+	m.failures++
+	log.Warnf("connection to %s is unhealthy (%d/%d): %s",
+		status.Hostname, m.failures, failuresBeforeFailover, reason)
+	if m.failures < failuresBeforeFailover {
+		return
+	}
+	m.failures = 0
+
+	event := &pb.FailoverEvent{Reason: reason, FromHostname: status.Hostname}
+	if err := m.failoverFn(status.Hostname); err != nil {
+		log.Error("failing over to a different server:", err)
+	}
+	if newStatus := m.connection.Status(); newStatus.State == pb.ConnectionState_CONNECTED &&
+		newStatus.Hostname != status.Hostname {
+		event.ToHostname = newStatus.Hostname
+	}
+	m.publisher.Publish(event)
+}
+
+// measure returns the first breached threshold or true if the connection is healthy.
+func (m *Monitor) measure(
+	ctx context.Context,
+	cfg config.Config,
+	status types.ConnectionStatus,
+	now time.Time,
+) (pb.FailoverReason, bool) {
+	thresholds := cfg.Failover.WithDefaults()
+
+	// handshakes can be read only for kernel space NordLynx interfaces
+	if status.Technology == config.Technology_NORDLYNX && status.TunnelName != "" {
+		handshake, err := m.handshake.LatestHandshake(status.TunnelName)
+		switch {
+		case errors.Is(err, metrics.ErrNoHandshake):
+			return pb.FailoverReason_HANDSHAKE_TIMEOUT, false
+		case err != nil:
+			log.Debug("reading latest handshake:", err)
+		case now.Sub(handshake) > thresholds.MaxHandshakeAge:
+			return pb.FailoverReason_HANDSHAKE_TIMEOUT, false
+		}
+	}
+
+	nameservers := m.nameservers.Get(cfg.AutoConnectData.ThreatProtectionLite)
+	if len(nameservers) == 0 {
+		return 0, true
+	}
+	// without binding to the tunnel the probes would go through the default route
+	if status.TunnelName == "" && cfg.SplitTunnel.IsIncludeOnly() {
+		return 0, true
+	}
+	var lost int
+	var total time.Duration
+	for range probesPerCheck {
+		rtt, err := m.prober.Probe(ctx, status.TunnelName, nameservers[0])
+		if err != nil {
+			if ctx.Err() != nil {
+				return 0, true
+			}
+			lost++
+			continue
+		}
+		total += rtt
+	}
+
+	if uint32(lost*100/probesPerCheck) > thresholds.MaxPacketLoss {
+		return pb.FailoverReason_PACKET_LOSS, false
+	}
+	if answered := probesPerCheck - lost; answered > 0 &&
+		total/time.Duration(answered) > thresholds.MaxLatency {
+		return pb.FailoverReason_HIGH_LATENCY, false
+	}
+	return 0, true
+}
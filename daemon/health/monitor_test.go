@@ -0,0 +1,240 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metrics"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/state/types"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockConnection struct{ status types.ConnectionStatus }
+
+func (m *mockConnection) Status() types.ConnectionStatus { return m.status }
+
+type mockHandshake struct {
+	handshake time.Time
+	err       error
+}
+
+func (m mockHandshake) LatestHandshake(string) (time.Time, error) { return m.handshake, m.err }
+
+type mockProber struct {
+	rtt time.Duration
+	// lost is the number of the probes failed before answering
+	lost int
+	// tunnels are the interfaces the probes were bound to
+	tunnels []string
+}
+
+func (m *mockProber) Probe(_ context.Context, tunnel string, _ string) (time.Duration, error) {
+	m.tunnels = append(m.tunnels, tunnel)
+	if m.lost > 0 {
+		m.lost--
+		return 0, errors.New("timeout")
+	}
+	return m.rtt, nil
+}
+
+type mockNameservers struct{}
+
+func (mockNameservers) Get(bool) []string { return []string{"103.86.96.100"} }
+
+func TestMonitor_Check(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	startTime := now.Add(-time.Hour)
+	for _, test := range []struct {
+		name           string
+		disabled       bool
+		startTime      time.Time
+		technology     config.Technology
+		handshake      mockHandshake
+		prober         mockProber
+		expectedReason pb.FailoverReason
+		expectFailover bool
+	}{
+		{
+			name:       "healthy",
+			startTime:  startTime,
+			technology: config.Technology_NORDLYNX,
+			handshake:  mockHandshake{handshake: now.Add(-time.Minute)},
+			prober:     mockProber{rtt: 50 * time.Millisecond, lost: 1},
+		},
+		{
+			name:       "disabled",
+			disabled:   true,
+			startTime:  startTime,
+			technology: config.Technology_NORDLYNX,
+			handshake:  mockHandshake{err: metrics.ErrNoHandshake},
+		},
+		{
+			name:       "grace period",
+			startTime:  now.Add(-time.Second),
+			technology: config.Technology_NORDLYNX,
+			handshake:  mockHandshake{err: metrics.ErrNoHandshake},
+		},
+		{
+			name:           "stale handshake",
+			startTime:      startTime,
+			technology:     config.Technology_NORDLYNX,
+			handshake:      mockHandshake{handshake: now.Add(-10 * time.Minute)},
+			expectedReason: pb.FailoverReason_HANDSHAKE_TIMEOUT,
+			expectFailover: true,
+		},
+		{
+			name:           "no handshake",
+			startTime:      startTime,
+			technology:     config.Technology_NORDLYNX,
+			handshake:      mockHandshake{err: metrics.ErrNoHandshake},
+			expectedReason: pb.FailoverReason_HANDSHAKE_TIMEOUT,
+			expectFailover: true,
+		},
+		{
+			name:       "handshake is ignored for openvpn",
+			startTime:  startTime,
+			technology: config.Technology_OPENVPN,
+			handshake:  mockHandshake{err: metrics.ErrNoHandshake},
+		},
+		{
+			name:           "packet loss",
+			startTime:      startTime,
+			technology:     config.Technology_OPENVPN,
+			prober:         mockProber{lost: 3 * probesPerCheck},
+			expectedReason: pb.FailoverReason_PACKET_LOSS,
+			expectFailover: true,
+		},
+		{
+			name:           "high latency",
+			startTime:      startTime,
+			technology:     config.Technology_OPENVPN,
+			prober:         mockProber{rtt: 3 * time.Second},
+			expectedReason: pb.FailoverReason_HIGH_LATENCY,
+			expectFailover: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Failover.Enabled = !test.disabled
+			connection := &mockConnection{status: types.ConnectionStatus{
+				State:      pb.ConnectionState_CONNECTED,
+				Technology: test.technology,
+				Hostname:   "de1.nordvpn.com",
+				TunnelName: "nordlynx",
+				StartTime:  &test.startTime,
+			}}
+			var failedOver []string
+			failoverFn := func(hostname string) error {
+				failedOver = append(failedOver, hostname)
+				connection.status.Hostname = "de2.nordvpn.com"
+				return nil
+			}
+			publisher := &daemonevents.MockPublisherSubscriber[*pb.FailoverEvent]{}
+			monitor := NewMonitor(cm, connection, test.handshake, &test.prober,
+				mockNameservers{}, failoverFn, publisher)
+
+			for i := 0; i < failuresBeforeFailover; i++ {
+				assert.Empty(t, failedOver, "failed over before the failure threshold")
+				monitor.check(context.Background(), now)
+			}
+
+			if !test.expectFailover {
+				assert.Empty(t, failedOver)
+				assert.False(t, publisher.EventPublished)
+				return
+			}
+			assert.Equal(t, []string{"de1.nordvpn.com"}, failedOver)
+			assert.True(t, publisher.EventPublished)
+			assert.Equal(t, &pb.FailoverEvent{
+				Reason:       test.expectedReason,
+				FromHostname: "de1.nordvpn.com",
+				ToHostname:   "de2.nordvpn.com",
+			}, publisher.Event)
+		})
+	}
+}
+
+func TestMonitor_CheckResetsOnServerChange(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	startTime := now.Add(-time.Hour)
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Failover.Enabled = true
+	connection := &mockConnection{status: types.ConnectionStatus{
+		State:      pb.ConnectionState_CONNECTED,
+		Technology: config.Technology_OPENVPN,
+		Hostname:   "de1.nordvpn.com",
+		StartTime:  &startTime,
+	}}
+	failedOver := false
+	monitor := NewMonitor(cm, connection, mockHandshake{}, &mockProber{lost: 100},
+		mockNameservers{}, func(string) error { failedOver = true; return nil },
+		&daemonevents.MockPublisherSubscriber[*pb.FailoverEvent]{})
+
+	for i := 0; i < failuresBeforeFailover-1; i++ {
+		monitor.check(context.Background(), now)
+	}
+	connection.status.Hostname = "de2.nordvpn.com"
+	monitor.check(context.Background(), now)
+	assert.False(t, failedOver)
+}
+
+func TestMonitor_ProbesThroughTunnel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	startTime := now.Add(-time.Hour)
+	for _, test := range []struct {
+		name            string
+		tunnel          string
+		includeOnly     bool
+		expectedTunnels []string
+	}{
+		{
+			name:            "bound to tunnel",
+			tunnel:          "nordtun",
+			expectedTunnels: []string{"nordtun", "nordtun", "nordtun", "nordtun", "nordtun"},
+		},
+		{
+			name:            "include only split tunnel is bound to tunnel",
+			tunnel:          "nordtun",
+			includeOnly:     true,
+			expectedTunnels: []string{"nordtun", "nordtun", "nordtun", "nordtun", "nordtun"},
+		},
+		{
+			name:        "include only split tunnel without tunnel name is not probed",
+			includeOnly: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Failover.Enabled = true
+			if test.includeOnly {
+				cm.Cfg.SplitTunnel.Mode = config.SplitTunnelModeInclude
+			}
+			connection := &mockConnection{status: types.ConnectionStatus{
+				State:      pb.ConnectionState_CONNECTED,
+				Technology: config.Technology_OPENVPN,
+				Hostname:   "de1.nordvpn.com",
+				TunnelName: test.tunnel,
+				StartTime:  &startTime,
+			}}
+			prober := &mockProber{lost: 100}
+			monitor := NewMonitor(cm, connection, mockHandshake{}, prober, mockNameservers{},
+				func(string) error { return nil }, &daemonevents.MockPublisherSubscriber[*pb.FailoverEvent]{})
+
+			monitor.check(context.Background(), now)
+			assert.Equal(t, test.expectedTunnels, prober.tunnels)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package health
+
+import (
+	"context"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+
+	"github.com/miekg/dns"
+	"golang.org/x/sys/unix"
+)
+
+// probeDomain is queried to measure the DNS round trip, any answer including errors counts
+const probeDomain = "nordvpn.com."
+
+// Prober measures the round trip time to the nameserver through the tunnel interface.
+type Prober interface {
+	Probe(ctx context.Context, tunnel string, nameserver string) (time.Duration, error)
+}
+
+// DNSProber sends DNS queries without the firewall mark. Queries are bound to the tunnel
+// interface if it is known, so they go through the tunnel even if only the split tunnel
+// applications are routed through it.
+type DNSProber struct{}
+
+func (DNSProber) Probe(ctx context.Context, tunnel string, nameserver string) (time.Duration, error) {
+	client := dns.Client{Net: "udp", Timeout: config.MaxFailoverLatency}
+	if tunnel != "" {
+		client.Dialer = &net.Dialer{
+			Timeout: config.MaxFailoverLatency,
+			Control: func(_ string, _ string, conn syscall.RawConn) error {
+				var bindErr error
+				if err := conn.Control(func(fd uintptr) {
+					bindErr = unix.BindToDevice(int(fd), tunnel)
+				}); err != nil {
+					return err
+				}
+				return bindErr
+			},
+		}
+	}
+	msg := new(dns.Msg)
+	msg.SetQuestion(probeDomain, dns.TypeA)
+	_, rtt, err := client.ExchangeContext(ctx, msg, net.JoinHostPort(nameserver, "53"))
+	if err != nil {
+		return 0, err
+	}
+	return rtt, nil
+}
@@ -0,0 +1,55 @@
+// Package history keeps a bounded log of VPN connection sessions.
+package history
+
+import (
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/events"
+)
+
+// Session is a single VPN connection from the moment it was established until it was ended.
+type Session struct {
+	Start time.Time `json:"start"`
+	// End is nil while the session is active
+	End *time.Time `json:"end,omitempty"`
+	// LastSeen is the last time the session was known to be active
+	LastSeen    time.Time         `json:"last_seen"`
+	Server      string            `json:"server"`
+	ServerName  string            `json:"server_name"`
+	IP          string            `json:"ip"`
+	Country     string            `json:"country"`
+	CountryCode string            `json:"country_code"`
+	City        string            `json:"city"`
+	Technology  config.Technology `json:"technology"`
+	Protocol    config.Protocol   `json:"protocol"`
+	Rx          uint64            `json:"rx"`
+	Tx          uint64            `json:"tx"`
+	// Reason is set when the session was ended because the user was logged out
+	Reason events.ReasonCode `json:"reason"`
+	// Error is the last VPN connection error reported during the session
+	Error *events.VPNConnectionError `json:"error,omitempty"`
+	// Paused is set when the session was ended by pausing the connection
+	Paused bool `json:"paused,omitempty"`
+	// Interrupted is set when the daemon stopped without ending the session. End is
+	// set to the last time the session was seen in such case.
+	Interrupted bool `json:"interrupted,omitempty"`
+}
+
+// IsActive returns true if the session was not ended yet.
+func (s Session) IsActive() bool {
+	return s.End == nil
+}
+
+// Duration of the session. Duration of the active session is calculated until now.
+func (s Session) Duration(now time.Time) time.Duration {
+	if s.End != nil {
+		return s.End.Sub(s.Start)
+	}
+	return now.Sub(s.Start)
+}
+
+// EndedAfter returns true if any part of the session happened after the given time.
+func (s Session) EndedAfter(t time.Time) bool {
+	return s.End == nil || s.End.After(t)
+}
@@ -0,0 +1,203 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// maxSessions defines the maximum number of sessions to keep, the oldest ones are dropped first
+const maxSessions = 1000
+
+// Store records VPN connection sessions based on the daemon events and persists them.
+type Store struct {
+	path     string
+	fsHandle internal.FileSystemHandle
+	mu       sync.Mutex
+	// sessions are sorted from the oldest to the newest, only the last one can be active
+	sessions []Session
+	loaded   bool
+	now      func() time.Time
+}
+
+// NewStore creates a connection history store persisted in the file at the given path.
+func NewStore(path string, fsHandle internal.FileSystemHandle) *Store {
+	return &Store{
+		path:     path,
+		fsHandle: fsHandle,
+		now:      time.Now,
+	}
+}
+
+// Sessions returns sessions which were active after the given time, from the oldest to the newest.
+func (s *Store) Sessions(since time.Time) []Session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.load()
+
+	sessions := []Session{}
+	for _, session := range s.sessions {
+		if session.EndedAfter(since) {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions
+}
+
+// NotifyConnect starts a new session once the connection is established.
+func (s *Store) NotifyConnect(e events.DataConnect) error {
+	if e.EventStatus != events.StatusSuccess {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.load()
+
+	now := s.now()
+	// connecting to a different server does not end the previous session explicitly
+	s.endActive(now, func(*Session) {})
+	s.sessions = append(s.sessions, Session{
+		Start:       now,
+		LastSeen:    now,
+		Server:      e.TargetServerDomain,
+		ServerName:  e.TargetServerName,
+		IP:          ipString(e),
+		Country:     e.TargetServerCountry,
+		CountryCode: e.TargetServerCountryCode,
+		City:        e.TargetServerCity,
+		Technology:  e.Technology,
+		Protocol:    e.Protocol,
+		Reason:      events.ReasonNotSpecified,
+	})
+	if len(s.sessions) > maxSessions {
+		s.sessions = slices.Clone(s.sessions[len(s.sessions)-maxSessions:])
+	}
+	return s.save()
+}
+
+// NotifyDisconnect ends the active session.
+func (s *Store) NotifyDisconnect(e events.DataDisconnect) error {
+	if e.IsRefresh {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.load()
+
+	if !s.endActive(s.now(), func(session *Session) { session.Paused = e.PauseInterval > 0 }) {
+		return nil
+	}
+	return s.save()
+}
+
+// NotifyLogout records the logout reason for the active session. Logout attempt is published
+// before the VPN is disconnected, so the reason is the cause of the session end.
+func (s *Store) NotifyLogout(e events.DataAuthorization) error {
+	if e.EventStatus != events.StatusAttempt {
+		return nil
+	}
+	return s.updateActive(func(session *Session) { session.Reason = e.Reason })
+}
+
+// NotifyConnectionError records the VPN connection error for the active session.
+func (s *Store) NotifyConnectionError(e events.VPNConnectionErrorEvent) error {
+	code := e.Code
+	return s.updateActive(func(session *Session) { session.Error = &code })
+}
+
+// UpdateTransfer stores the amount of data transferred during the active session. It has to be
+// called before the tunnel is closed, because the counters are lost together with the tunnel.
+func (s *Store) UpdateTransfer(rx uint64, tx uint64) error {
+	return s.updateActive(func(session *Session) {
+		session.Rx = max(session.Rx, rx)
+		session.Tx = max(session.Tx, tx)
+	})
+}
+
+func (s *Store) updateActive(update func(*Session)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.load()
+
+	session := s.active()
+	if session == nil {
+		return nil
+	}
+	update(session)
+	session.LastSeen = s.now()
+	return s.save()
+}
+
+func (s *Store) active() *Session {
+	if len(s.sessions) == 0 || !s.sessions[len(s.sessions)-1].IsActive() {
+		return nil
+	}
+	return &s.sessions[len(s.sessions)-1]
+}
+
+func (s *Store) endActive(now time.Time, update func(*Session)) bool {
+	session := s.active()
+	if session == nil {
+		return false
+	}
+	update(session)
+	session.LastSeen = now
+	session.End = &now
+	return true
+}
+
+// load reads sessions from the file once. Session left active by the previous daemon run is
+// marked as interrupted.
+func (s *Store) load() {
+	if s.loaded {
+		return
+	}
+	s.loaded = true
+
+	if !s.fsHandle.FileExists(s.path) {
+		return
+	}
+	data, err := s.fsHandle.ReadFile(s.path)
+	if err != nil {
+		log.Warn("reading connection history:", err)
+		return
+	}
+	var sessions []Session
+	if err := json.Unmarshal(data, &sessions); err != nil {
+		log.Warn("connection history is corrupted, starting a new one:", err)
+		return
+	}
+	s.sessions = sessions
+
+	if session := s.active(); session != nil {
+		lastSeen := session.LastSeen
+		session.End = &lastSeen
+		session.Interrupted = true
+	}
+}
+
+func (s *Store) save() error {
+	data, err := json.Marshal(s.sessions)
+	if err != nil {
+		return fmt.Errorf("marshaling connection history: %w", err)
+	}
+	if err := s.fsHandle.WriteFile(s.path, data, internal.PermUserRW); err != nil {
+		return fmt.Errorf("writing connection history: %w", err)
+	}
+	return nil
+}
+
+func ipString(e events.DataConnect) string {
+	if !e.TargetServerIP.IsValid() {
+		return ""
+	}
+	return e.TargetServerIP.String()
+}
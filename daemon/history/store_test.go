@@ -0,0 +1,184 @@
+package history
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/fs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPath = "/test/history"
+
+type testClock struct{ now time.Time }
+
+func (c *testClock) tick(d time.Duration) time.Time {
+	c.now = c.now.Add(d)
+	return c.now
+}
+
+func newTestStore(t *testing.T) (*Store, *fs.SystemFileHandleMock, *testClock) {
+	t.Helper()
+	fsMock := fs.NewSystemFileHandleMock(t)
+	store := NewStore(testPath, &fsMock)
+	clock := &testClock{now: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}
+	store.now = func() time.Time { return clock.now }
+	return store, &fsMock, clock
+}
+
+func connected(domain string) events.DataConnect {
+	return events.DataConnect{
+		EventStatus:             events.StatusSuccess,
+		Technology:              config.Technology_NORDLYNX,
+		Protocol:                config.Protocol_UDP,
+		TargetServerDomain:      domain,
+		TargetServerName:        "Germany #1",
+		TargetServerIP:          netip.MustParseAddr("10.0.0.1"),
+		TargetServerCountry:     "Germany",
+		TargetServerCountryCode: "DE",
+		TargetServerCity:        "Berlin",
+	}
+}
+
+func TestStore_Session(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	store, fsMock, clock := newTestStore(t)
+	start := clock.now
+
+	require.NoError(t, store.NotifyConnect(events.DataConnect{EventStatus: events.StatusAttempt}))
+	require.NoError(t, store.NotifyConnect(connected("de1.nordvpn.com")))
+	clock.tick(time.Minute)
+	require.NoError(t, store.UpdateTransfer(100, 50))
+	require.NoError(t, store.NotifyConnectionError(events.VPNConnectionErrorEvent{
+		Code: events.VPNConnectionErrorServerMaintenance,
+	}))
+	// refresh keeps the session going
+	require.NoError(t, store.NotifyDisconnect(events.DataDisconnect{IsRefresh: true}))
+	end := clock.tick(time.Minute)
+	require.NoError(t, store.NotifyDisconnect(events.DataDisconnect{PauseInterval: time.Minute}))
+
+	maintenance := events.VPNConnectionErrorServerMaintenance
+	expected := Session{
+		Start:       start,
+		End:         &end,
+		LastSeen:    end,
+		Server:      "de1.nordvpn.com",
+		ServerName:  "Germany #1",
+		IP:          "10.0.0.1",
+		Country:     "Germany",
+		CountryCode: "DE",
+		City:        "Berlin",
+		Technology:  config.Technology_NORDLYNX,
+		Protocol:    config.Protocol_UDP,
+		Rx:          100,
+		Tx:          50,
+		Reason:      events.ReasonNotSpecified,
+		Error:       &maintenance,
+		Paused:      true,
+	}
+	assert.Equal(t, []Session{expected}, store.Sessions(time.Time{}))
+
+	var stored []Session
+	data, err := fsMock.ReadFile(testPath)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &stored))
+	require.Len(t, stored, 1)
+	assert.True(t, expected.End.Equal(*stored[0].End))
+}
+
+func TestStore_LogoutReason(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	store, _, _ := newTestStore(t)
+	require.NoError(t, store.NotifyConnect(connected("de1.nordvpn.com")))
+	require.NoError(t, store.NotifyLogout(events.DataAuthorization{
+		EventStatus: events.StatusAttempt,
+		Reason:      events.ReasonAuthTokenInvalidated,
+	}))
+	require.NoError(t, store.NotifyDisconnect(events.DataDisconnect{}))
+	// logout result is published after the disconnect and does not change anything
+	require.NoError(t, store.NotifyLogout(events.DataAuthorization{
+		EventStatus: events.StatusSuccess,
+		Reason:      events.ReasonTokenMissing,
+	}))
+
+	sessions := store.Sessions(time.Time{})
+	require.Len(t, sessions, 1)
+	assert.Equal(t, events.ReasonAuthTokenInvalidated, sessions[0].Reason)
+}
+
+func TestStore_ConnectEndsActiveSession(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	store, _, clock := newTestStore(t)
+	require.NoError(t, store.NotifyConnect(connected("de1.nordvpn.com")))
+	switched := clock.tick(time.Hour)
+	require.NoError(t, store.NotifyConnect(connected("de2.nordvpn.com")))
+
+	sessions := store.Sessions(time.Time{})
+	require.Len(t, sessions, 2)
+	assert.Equal(t, &switched, sessions[0].End)
+	assert.True(t, sessions[1].IsActive())
+	assert.Equal(t, "de2.nordvpn.com", sessions[1].Server)
+}
+
+func TestStore_Since(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	store, _, clock := newTestStore(t)
+	require.NoError(t, store.NotifyConnect(connected("de1.nordvpn.com")))
+	clock.tick(time.Hour)
+	require.NoError(t, store.NotifyDisconnect(events.DataDisconnect{}))
+	since := clock.tick(time.Hour)
+	require.NoError(t, store.NotifyConnect(connected("de2.nordvpn.com")))
+
+	sessions := store.Sessions(since)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "de2.nordvpn.com", sessions[0].Server)
+	assert.Len(t, store.Sessions(time.Time{}), 2)
+}
+
+func TestStore_Bounded(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	store, _, clock := newTestStore(t)
+	for range maxSessions + 5 {
+		require.NoError(t, store.NotifyConnect(connected("de1.nordvpn.com")))
+		clock.tick(time.Minute)
+	}
+
+	sessions := store.Sessions(time.Time{})
+	assert.Len(t, sessions, maxSessions)
+	assert.True(t, sessions[len(sessions)-1].IsActive())
+}
+
+func TestStore_InterruptedSession(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	lastSeen := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+	data, err := json.Marshal([]Session{{
+		Start:    time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		LastSeen: lastSeen,
+		Server:   "de1.nordvpn.com",
+	}})
+	require.NoError(t, err)
+
+	fsMock := fs.NewSystemFileHandleMock(t)
+	fsMock.AddFile(testPath, data)
+	store := NewStore(testPath, &fsMock)
+
+	sessions := store.Sessions(time.Time{})
+	require.Len(t, sessions, 1)
+	assert.True(t, sessions[0].Interrupted)
+	assert.True(t, lastSeen.Equal(*sessions[0].End))
+	// interrupted session is not updated by the new events
+	require.NoError(t, store.UpdateTransfer(100, 100))
+	assert.Zero(t, store.Sessions(time.Time{})[0].Rx)
+}
@@ -0,0 +1,36 @@
+package daemon
+
+import (
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// JobAllowlistDomains resolves the allowlisted domains again once the TTL of their addresses
+// expires and updates the firewall if the addresses have changed.
+func JobAllowlistDomains(r *RPC) func() error {
+	return func() error {
+		var cfg config.Config
+		if err := r.cm.Load(&cfg); err != nil {
+			return fmt.Errorf("loading config: %w", err)
+		}
+		return r.refreshAllowlistDomains(cfg.AutoConnectData.Allowlist.Domains)
+	}
+}
+
+func (r *RPC) refreshAllowlistDomains(domains []string) error {
+	if r.allowlistDomains == nil {
+		return nil
+	}
+
+	ips, changed := r.allowlistDomains.Refresh(domains)
+	if changed {
+		log.Info("allowlisted domain addresses changed:", ips)
+	}
+	// networker ignores unchanged addresses, call it anyway in case the previous update has failed
+	if err := r.netw.SetAllowlistDomainIPs(ips); err != nil {
+		return fmt.Errorf("setting allowlisted domain addresses: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package daemon
+
+import (
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/state/types"
+)
+
+// JobConnectionHistory stores the amount of data transferred during the active session, so it
+// is not lost if the daemon stops unexpectedly.
+func JobConnectionHistory(r *RPC) func() error {
+	return func() error {
+		return r.updateConnectionHistory(r.connectionInfo.Status())
+	}
+}
+
+func (r *RPC) updateConnectionHistory(status types.ConnectionStatus) error {
+	if r.connectionHistory == nil || status.State != pb.ConnectionState_CONNECTED {
+		return nil
+	}
+	return r.connectionHistory.UpdateTransfer(status.Rx, status.Tx)
+}
@@ -0,0 +1,133 @@
+package daemon
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// scheduleWindow is the schedule rule window currently in progress.
+type scheduleWindow struct {
+	ruleID string
+	start  time.Time
+	end    time.Time
+	// action is set only if the connection was paused or disconnected when the window started
+	action config.ScheduleAction
+}
+
+type scheduleState struct {
+	mu     sync.Mutex
+	window scheduleWindow
+}
+
+// JobSchedule pauses or disconnects the VPN connection when one of the schedule rule windows starts
+// and restores the connection once it ends.
+func JobSchedule(r *RPC) func() error {
+	return func() error {
+		var cfg config.Config
+		if err := r.cm.Load(&cfg); err != nil {
+			return fmt.Errorf("loading config: %w", err)
+		}
+		r.applySchedule(cfg.Schedule, time.Now())
+		return nil
+	}
+}
+
+func (r *RPC) applySchedule(rules []config.ScheduleRule, now time.Time) {
+	r.schedule.mu.Lock()
+	defer r.schedule.mu.Unlock()
+
+	rule, start, end, ok := config.ActiveScheduleRule(rules, now)
+	current := r.schedule.window
+	if ok && rule.ID == current.ruleID && start.Equal(current.start) {
+		return
+	}
+
+	if current.ruleID != "" {
+		r.endScheduleWindow(current, now)
+		r.schedule.window = scheduleWindow{}
+	}
+
+	if ok {
+		r.schedule.window = scheduleWindow{
+			ruleID: rule.ID,
+			start:  start,
+			end:    end,
+			action: r.startScheduleWindow(rule, now, end),
+		}
+	}
+}
+
+// startScheduleWindow applies the rule action and returns it. Empty action is returned if the
+// connection was left untouched.
+func (r *RPC) startScheduleWindow(rule config.ScheduleRule, now time.Time, end time.Time) config.ScheduleAction {
+	if !r.netw.IsVPNActive() || r.connectionInfo.IsPaused() || r.connectionInfo.Status().IsMeshnetPeer {
+		log.Info("schedule rule", rule.ID, "started, VPN is not connected")
+		return ""
+	}
+
+	event := &pb.PauseEvent{ScheduleRuleId: rule.ID, ScheduleEnd: rule.End}
+	switch rule.Action {
+	case config.SchedulePause:
+		duration := end.Sub(now)
+		r.pauseManager.ScheduleReconnection(duration)
+		if _, err := r.DoPause(duration); err != nil {
+			r.pauseManager.CancelReconnection()
+			log.Error("pausing the connection on schedule:", err)
+			return ""
+		}
+		event.Type = pb.PauseEventType_SCHEDULED_PAUSE_STARTED
+	case config.ScheduleDisconnect:
+		if _, err := r.DoDisconnect(); err != nil {
+			log.Error("disconnecting on schedule:", err)
+			return ""
+		}
+		event.Type = pb.PauseEventType_SCHEDULED_DISCONNECT_STARTED
+	default:
+		return ""
+	}
+
+	log.Info("schedule rule", rule.ID, "started, action:", rule.Action, "until:", rule.End)
+	r.pauseEvents.PauseNotifications.Publish(event)
+	return rule.Action
+}
+
+// endScheduleWindow restores the connection if it was paused or disconnected by the window.
+// Window may end before its time if the rule was removed or changed.
+func (r *RPC) endScheduleWindow(window scheduleWindow, now time.Time) {
+	if window.action == "" {
+		return
+	}
+
+	log.Info("schedule rule", window.ruleID, "ended")
+	r.pauseEvents.PauseNotifications.Publish(&pb.PauseEvent{
+		Type:           pb.PauseEventType_SCHEDULE_ENDED,
+		ScheduleRuleId: window.ruleID,
+	})
+
+	switch window.action {
+	case config.SchedulePause:
+		// reconnection is scheduled by the pause itself, it only needs to be brought forward
+		if now.Before(window.end) && r.connectionInfo.IsPaused() {
+			r.reconnectAfterSchedule(r.pauseManager.CancelReconnection())
+		}
+	case config.ScheduleDisconnect:
+		// user might have connected manually during the window
+		if !r.netw.IsVPNActive() {
+			r.reconnectAfterSchedule(0)
+		}
+	}
+}
+
+func (r *RPC) reconnectAfterSchedule(pauseDuration time.Duration) {
+	connServer := connectServer{}
+	err := r.ConnectFromLastSelection(&connServer, pb.ConnectionSource_AUTO, pauseDuration)
+	if err != nil || connServer.err != nil {
+		log.Error("failed to reconnect after a schedule window: connection error:", err, "server error:", connServer.err)
+		r.pauseEvents.PauseNotifications.Publish(&pb.PauseEvent{Type: pb.PauseEventType_RECONNECT_FAILED})
+	}
+}
@@ -0,0 +1,153 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	daemonEvents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/recents"
+	"github.com/NordSecurity/nordvpn-linux/daemon/state"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type pauseEventsCollector struct {
+	events []*pb.PauseEvent
+}
+
+func (c *pauseEventsCollector) NotifyPauseEvent(e *pb.PauseEvent) error {
+	c.events = append(c.events, e)
+	return nil
+}
+
+func TestApplySchedule(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	// 2024-01-05 is Friday
+	noon := time.Date(2024, time.January, 5, 12, 0, 0, 0, time.Local)
+	pause := config.ScheduleRule{ID: "1", Action: config.SchedulePause, Start: "12:00", End: "12:30"}
+	disconnect := config.ScheduleRule{ID: "2", Action: config.ScheduleDisconnect, Start: "12:00", End: "12:30"}
+	weekend := config.ScheduleRule{
+		ID:     "3",
+		Action: config.ScheduleDisconnect,
+		Days:   []time.Weekday{time.Saturday, time.Sunday},
+		Start:  "12:00",
+		End:    "12:30",
+	}
+
+	tests := []struct {
+		name                  string
+		rules                 []config.ScheduleRule
+		isVPNActive           bool
+		now                   []time.Time
+		expectedEvents        []pb.PauseEventType
+		expectedPauseDuration time.Duration
+		expectedVPNState      bool
+	}{
+		{
+			name:                  "pause window starts",
+			rules:                 []config.ScheduleRule{pause},
+			isVPNActive:           true,
+			now:                   []time.Time{noon.Add(10 * time.Minute)},
+			expectedEvents:        []pb.PauseEventType{pb.PauseEventType_SCHEDULED_PAUSE_STARTED},
+			expectedPauseDuration: 20 * time.Minute,
+			expectedVPNState:      false,
+		},
+		{
+			name:             "disconnect window starts",
+			rules:            []config.ScheduleRule{disconnect},
+			isVPNActive:      true,
+			now:              []time.Time{noon},
+			expectedEvents:   []pb.PauseEventType{pb.PauseEventType_SCHEDULED_DISCONNECT_STARTED},
+			expectedVPNState: false,
+		},
+		{
+			name:        "window is applied once",
+			rules:       []config.ScheduleRule{pause},
+			isVPNActive: true,
+			now:         []time.Time{noon, noon.Add(time.Minute), noon.Add(2 * time.Minute)},
+			expectedEvents: []pb.PauseEventType{
+				pb.PauseEventType_SCHEDULED_PAUSE_STARTED,
+			},
+			expectedPauseDuration: 30 * time.Minute,
+			expectedVPNState:      false,
+		},
+		{
+			name:        "pause window ends",
+			rules:       []config.ScheduleRule{pause},
+			isVPNActive: true,
+			now:         []time.Time{noon, noon.Add(30 * time.Minute)},
+			expectedEvents: []pb.PauseEventType{
+				pb.PauseEventType_SCHEDULED_PAUSE_STARTED,
+				pb.PauseEventType_SCHEDULE_ENDED,
+			},
+			expectedPauseDuration: 30 * time.Minute,
+			expectedVPNState:      false,
+		},
+		{
+			name:             "window starts when VPN is not connected",
+			rules:            []config.ScheduleRule{disconnect},
+			isVPNActive:      false,
+			now:              []time.Time{noon, noon.Add(30 * time.Minute)},
+			expectedVPNState: false,
+		},
+		{
+			name:             "rule for other days",
+			rules:            []config.ScheduleRule{weekend},
+			isVPNActive:      true,
+			now:              []time.Time{noon},
+			expectedVPNState: true,
+		},
+		{
+			name:             "outside of the window",
+			rules:            []config.ScheduleRule{pause},
+			isVPNActive:      true,
+			now:              []time.Time{noon.Add(-time.Minute)},
+			expectedVPNState: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			networkerMock := testnetworker.Mock{}
+			networkerMock.VpnActive = test.isVPNActive
+
+			pauseSchedulerMock := &mock.PauseSchedulerMock{}
+			pauseEvents := daemonEvents.NewPauseEvents()
+			collector := &pauseEventsCollector{}
+			pauseEvents.Subscribe(collector)
+
+			connectionInfo := state.NewConnectionInfo()
+			connectionInfo.ConnectionStatusNotifyConnect(events.DataConnect{})
+
+			r := RPC{
+				netw:               &networkerMock,
+				cm:                 newMockConfigManager(),
+				events:             daemonEvents.NewEventsEmpty(),
+				recentVPNConnStore: recents.NewRecentConnectionsStore(TestdataPath+TestRecentConnFile, &internal.StdFilesystemHandle{}, nil),
+				pauseManager:       pauseSchedulerMock,
+				pauseEvents:        pauseEvents,
+				connectionInfo:     connectionInfo,
+			}
+
+			for _, now := range test.now {
+				r.applySchedule(test.rules, now)
+			}
+
+			eventTypes := []pb.PauseEventType{}
+			for _, e := range collector.events {
+				eventTypes = append(eventTypes, e.Type)
+			}
+			assert.ElementsMatch(t, test.expectedEvents, eventTypes)
+			assert.Equal(t, test.expectedPauseDuration, pauseSchedulerMock.PauseDuration)
+			assert.Equal(t, test.expectedVPNState, networkerMock.VpnActive)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package daemon
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// JobSplitTunnel moves newly started processes of the split tunnel applications to the
+// split tunnel cgroups and forgets the processes which are no longer running.
+func JobSplitTunnel(r *RPC) func() error {
+	return func() error {
+		if !r.splitTunnel.IsSupported() {
+			return nil
+		}
+
+		var cfg config.Config
+		if err := r.cm.Load(&cfg); err != nil {
+			return fmt.Errorf("loading config: %w", err)
+		}
+
+		splitTunnel := cfg.SplitTunnel
+		var stale []config.SplitTunnelApp
+		for _, app := range splitTunnel.Apps {
+			if app.Type != config.SplitTunnelAppPID {
+				continue
+			}
+			if pid, err := strconv.Atoi(app.Value); err != nil || !r.splitTunnel.ProcessExists(pid) {
+				stale = append(stale, app)
+			}
+		}
+
+		if len(stale) > 0 {
+			if err := r.cm.SaveWith(func(c config.Config) config.Config {
+				for _, app := range stale {
+					log.Info("removing exited process from split tunnel:", app.Value)
+					c.SplitTunnel.Remove(app.Type, app.Value)
+				}
+				splitTunnel = c.SplitTunnel
+				return c
+			}); err != nil {
+				return fmt.Errorf("saving config: %w", err)
+			}
+		}
+
+		return r.applySplitTunnel(splitTunnel)
+	}
+}
@@ -32,6 +32,14 @@ import (
 const (
 	heartBeatPeriod = time.Hour * 6
 	envRcLoadTime   = "RC_LOAD_TIME_MIN" // env variable name
+	// splitTunnelPeriod defines how fast newly started split tunnel apps are moved outside of the tunnel
+	splitTunnelPeriod = 3 * time.Second
+	// allowlistDomainsPeriod defines how often the TTL of the allowlisted domain addresses is checked
+	allowlistDomainsPeriod = 10 * time.Second
+	// schedulePeriod defines how fast the schedule rule windows are applied after they start or end
+	schedulePeriod = 15 * time.Second
+	// connectionHistoryPeriod defines how often the transferred data of the active session is stored
+	connectionHistoryPeriod = time.Minute
 )
 
 func (r *RPC) StartJobs(
@@ -102,6 +110,35 @@ func (r *RPC) StartJobs(
 		gocron.WithEventListeners(gocron.AfterJobRunsWithError(gocronErrorLogger))); err != nil {
 		log.Warn("job heart beat schedule error:", err)
 	}
+	if _, err := r.scheduler.NewJob(gocron.DurationJob(splitTunnelPeriod),
+		gocron.NewTask(JobSplitTunnel(r)),
+		gocron.WithName("job split tunnel"),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+		gocron.WithEventListeners(gocron.AfterJobRunsWithError(gocronErrorLogger))); err != nil {
+		log.Warn("job split tunnel schedule error:", err)
+	}
+	if _, err := r.scheduler.NewJob(gocron.DurationJob(allowlistDomainsPeriod),
+		gocron.NewTask(JobAllowlistDomains(r)),
+		gocron.WithName("job allowlist domains"),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+		gocron.WithEventListeners(gocron.AfterJobRunsWithError(gocronErrorLogger))); err != nil {
+		log.Warn("job allowlist domains schedule error:", err)
+	}
+	if _, err := r.scheduler.NewJob(gocron.DurationJob(schedulePeriod),
+		gocron.NewTask(JobSchedule(r)),
+		gocron.WithName("job schedule"),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+		gocron.WithEventListeners(gocron.AfterJobRunsWithError(gocronErrorLogger))); err != nil {
+		log.Warn("job schedule schedule error:", err)
+	}
+	if _, err := r.scheduler.NewJob(gocron.DurationJob(connectionHistoryPeriod),
+		gocron.NewTask(JobConnectionHistory(r)),
+		gocron.WithName("job connection history"),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+		gocron.WithEventListeners(gocron.AfterJobRunsWithError(gocronErrorLogger))); err != nil {
+		log.Warn("job connection history schedule error:", err)
+	}
+
 	if _, err := r.scheduler.NewJob(gocron.DurationJob(7*24*time.Hour), gocron.NewTask(func() {
 		r.events.Service.AccountCheck.Publish(nil)
 	})); err != nil {
@@ -179,6 +216,14 @@ func (r *RPC) StartRemoteConfigLoaderJob(
 	}
 }
 
+// StartSplitTunnel applies split tunnel configuration before the firewall is configured,
+// so that kill switch exempt applications are not blocked on startup
+func (r *RPC) StartSplitTunnel() {
+	if err := JobSplitTunnel(r)(); err != nil {
+		log.Error("starting split tunnel:", err)
+	}
+}
+
 func (r *RPC) StartKillSwitch() {
 	var cfg config.Config
 	err := r.cm.Load(&cfg)
@@ -412,7 +457,8 @@ func (r *RPC) doAutoConnect() error {
 			ServerTag:   cfg.AutoConnectData.ServerTag,
 			ServerGroup: groupTag,
 		}
-		return r.connectWithParameters(ctx, param, &server, pb.ConnectionSource_AUTO, "", events.VPNConnectionReasonAutoConnect)
+		return r.connectWithParameters(ctx, param, connectionOverrides{}, &server,
+			pb.ConnectionSource_AUTO, "", events.VPNConnectionReasonAutoConnect)
 	})
 
 	if err == nil && server.err == nil {
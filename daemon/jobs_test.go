@@ -305,6 +305,8 @@ func (n *meshNetworker) ForbidFileshare() error {
 	return nil
 }
 
+func (*meshNetworker) SetFileshareRateLimit(config.FileshareRateLimit) error { return nil }
+
 func (*meshNetworker) Refresh(mesh.MachineMap) error { return nil }
 func (*meshNetworker) StatusMap() (map[string]string, error) {
 	return map[string]string{}, nil
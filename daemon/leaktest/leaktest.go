@@ -0,0 +1,216 @@
+// Package leaktest checks that the traffic of the VPN connection can not leave the host outside of
+// the tunnel.
+package leaktest
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+)
+
+// Names of the checks
+const (
+	CheckRouting  = "Routing"
+	CheckDNS      = "DNS"
+	CheckIPv6     = "IPv6"
+	CheckFirewall = "Firewall"
+)
+
+var (
+	// publicIPv4 and publicIPv6 are the destinations used to find out where the internet
+	// traffic is routed to
+	publicIPv4 = netip.MustParseAddr("1.1.1.1")
+	publicIPv6 = netip.MustParseAddr("2606:4700:4700::1111")
+)
+
+// Status of a check
+type Status int
+
+const (
+	StatusPass Status = iota
+	StatusFail
+	// StatusSkip is used when the check does not apply to the current setup or it could not be
+	// performed
+	StatusSkip
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusPass:
+		return "pass"
+	case StatusFail:
+		return "fail"
+	default:
+		return "skip"
+	}
+}
+
+// Check is the result of a single check
+type Check struct {
+	Name   string
+	Status Status
+	Detail string
+}
+
+// Report lists the results of all checks
+type Report []Check
+
+// Passed returns true if none of the checks failed
+func (r Report) Passed() bool {
+	return !slices.ContainsFunc(r, func(c Check) bool { return c.Status == StatusFail })
+}
+
+// State of the VPN connection which the system is checked against
+type State struct {
+	TunnelInterface string
+	// RouteNameservers are the nameservers of the DNS routes which are meant to be reached
+	// outside of the tunnel
+	RouteNameservers []netip.Addr
+	Firewall         firewall.Config
+}
+
+// System probes the network configuration of the host
+type System interface {
+	// RouteInterface returns the interface the traffic to dst is routed through or an empty
+	// string if dst is unreachable
+	RouteInterface(dst netip.Addr) (string, error)
+	// Resolvers returns the nameservers the system resolver sends the queries to
+	Resolvers() ([]netip.Addr, error)
+}
+
+// VerifyFunc checks that the firewall chains and rules in the system match the config
+type VerifyFunc func(firewall.Config) error
+
+// Run performs all checks
+func Run(state State, system System, verify VerifyFunc) Report {
+	return Report{
+		checkRouting(state, system),
+		checkDNS(state, system),
+		checkIPv6(state, system),
+		checkFirewall(state, verify),
+	}
+}
+
+func checkRouting(state State, system System) Check {
+	check := Check{Name: CheckRouting}
+	if state.Firewall.SplitTunnelMode == firewall.SplitTunnelInclude {
+		check.Status = StatusSkip
+		check.Detail = "only the split tunnel apps are routed through the tunnel"
+		return check
+	}
+
+	iface, err := system.RouteInterface(publicIPv4)
+	switch {
+	case err != nil:
+		check.Status = StatusSkip
+		check.Detail = fmt.Sprintf("looking up the route to %s: %s", publicIPv4, err)
+	case iface == state.TunnelInterface:
+		check.Status = StatusPass
+		check.Detail = fmt.Sprintf("internet traffic is routed through %s", iface)
+	case iface == "":
+		check.Status = StatusFail
+		check.Detail = fmt.Sprintf("%s is unreachable", publicIPv4)
+	default:
+		check.Status = StatusFail
+		check.Detail = fmt.Sprintf("internet traffic is routed through %s instead of %s", iface, state.TunnelInterface)
+	}
+	return check
+}
+
+func checkDNS(state State, system System) Check {
+	check := Check{Name: CheckDNS, Status: StatusSkip}
+	resolvers, err := system.Resolvers()
+	if err != nil {
+		check.Detail = fmt.Sprintf("reading the system resolvers: %s", err)
+		return check
+	}
+	if len(resolvers) == 0 {
+		check.Detail = "no resolvers are configured"
+		return check
+	}
+
+	var details []string
+	for _, resolver := range resolvers {
+		status, detail := checkResolver(state, system, resolver)
+		details = append(details, detail)
+		switch {
+		case status == StatusFail:
+			check.Status = StatusFail
+		case status == StatusPass && check.Status == StatusSkip:
+			check.Status = StatusPass
+		}
+	}
+	check.Detail = strings.Join(details, ", ")
+	return check
+}
+
+func checkResolver(state State, system System, resolver netip.Addr) (Status, string) {
+	if resolver.IsLoopback() {
+		// local forwarders send the queries over the default route which is covered by the
+		// routing check
+		return StatusSkip, fmt.Sprintf("%s is a local forwarder", resolver)
+	}
+	if slices.Contains(state.RouteNameservers, resolver) {
+		return StatusPass, fmt.Sprintf("%s is a DNS route nameserver", resolver)
+	}
+
+	iface, err := system.RouteInterface(resolver)
+	switch {
+	case err != nil:
+		return StatusSkip, fmt.Sprintf("%s route lookup failed: %s", resolver, err)
+	case iface == state.TunnelInterface:
+		return StatusPass, fmt.Sprintf("%s via %s", resolver, iface)
+	case iface == "":
+		return StatusPass, fmt.Sprintf("%s is unreachable", resolver)
+	default:
+		return StatusFail, fmt.Sprintf("%s via %s outside of the tunnel", resolver, iface)
+	}
+}
+
+func checkIPv6(state State, system System) Check {
+	check := Check{Name: CheckIPv6}
+	iface, err := system.RouteInterface(publicIPv6)
+	switch {
+	case err != nil:
+		check.Status = StatusSkip
+		check.Detail = fmt.Sprintf("looking up the route to %s: %s", publicIPv6, err)
+	case iface == "":
+		check.Status = StatusPass
+		check.Detail = "no global IPv6 route"
+	case iface == state.TunnelInterface:
+		check.Status = StatusPass
+		check.Detail = fmt.Sprintf("IPv6 traffic is routed through %s", iface)
+	default:
+		check.Status = StatusFail
+		check.Detail = fmt.Sprintf("IPv6 traffic is routed through %s outside of the tunnel", iface)
+	}
+	return check
+}
+
+func checkFirewall(state State, verify VerifyFunc) Check {
+	check := Check{Name: CheckFirewall}
+	if verify == nil {
+		check.Status = StatusSkip
+		check.Detail = firewall.ErrVerificationNotSupported.Error()
+		return check
+	}
+
+	err := verify(state.Firewall)
+	switch {
+	case err == nil:
+		check.Status = StatusPass
+		check.Detail = "chains, policies and rule order match the configuration, rule contents are not compared"
+	case errors.Is(err, firewall.ErrFirewallDisabled),
+		errors.Is(err, firewall.ErrVerificationNotSupported):
+		check.Status = StatusSkip
+		check.Detail = err.Error()
+	default:
+		check.Status = StatusFail
+		check.Detail = err.Error()
+	}
+	return check
+}
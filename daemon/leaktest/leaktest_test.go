@@ -0,0 +1,197 @@
+package leaktest
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const tunnel = "nordlynx"
+
+type mockSystem struct {
+	routes       map[netip.Addr]string
+	defaultRoute string
+	resolvers    []netip.Addr
+	resolversErr error
+}
+
+func (s mockSystem) RouteInterface(dst netip.Addr) (string, error) {
+	if iface, ok := s.routes[dst]; ok {
+		return iface, nil
+	}
+	if dst.Is6() {
+		return "", nil
+	}
+	return s.defaultRoute, nil
+}
+
+func (s mockSystem) Resolvers() ([]netip.Addr, error) {
+	return s.resolvers, s.resolversErr
+}
+
+func statuses(report Report) map[string]Status {
+	result := map[string]Status{}
+	for _, check := range report {
+		result[check.Name] = check.Status
+	}
+	return result
+}
+
+func TestRun(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	vpnDNS := netip.MustParseAddr("103.86.96.100")
+	lanDNS := netip.MustParseAddr("192.168.1.1")
+	routeDNS := netip.MustParseAddr("10.0.0.53")
+	verifyOK := func(firewall.Config) error { return nil }
+
+	tests := []struct {
+		name     string
+		state    State
+		system   mockSystem
+		verify   VerifyFunc
+		expected map[string]Status
+		passed   bool
+	}{
+		{
+			name:   "everything through the tunnel",
+			state:  State{TunnelInterface: tunnel},
+			system: mockSystem{defaultRoute: tunnel, resolvers: []netip.Addr{vpnDNS}},
+			verify: verifyOK,
+			expected: map[string]Status{
+				CheckRouting: StatusPass, CheckDNS: StatusPass, CheckIPv6: StatusPass, CheckFirewall: StatusPass,
+			},
+			passed: true,
+		},
+		{
+			name:  "default route outside of the tunnel",
+			state: State{TunnelInterface: tunnel},
+			system: mockSystem{
+				defaultRoute: "eth0",
+				routes:       map[netip.Addr]string{vpnDNS: tunnel},
+				resolvers:    []netip.Addr{vpnDNS},
+			},
+			verify: verifyOK,
+			expected: map[string]Status{
+				CheckRouting: StatusFail, CheckDNS: StatusPass, CheckIPv6: StatusPass, CheckFirewall: StatusPass,
+			},
+		},
+		{
+			name:  "LAN resolver leaks",
+			state: State{TunnelInterface: tunnel},
+			system: mockSystem{
+				defaultRoute: tunnel,
+				routes:       map[netip.Addr]string{lanDNS: "eth0"},
+				resolvers:    []netip.Addr{vpnDNS, lanDNS},
+			},
+			verify: verifyOK,
+			expected: map[string]Status{
+				CheckRouting: StatusPass, CheckDNS: StatusFail, CheckIPv6: StatusPass, CheckFirewall: StatusPass,
+			},
+		},
+		{
+			name:  "DNS route nameserver outside of the tunnel is expected",
+			state: State{TunnelInterface: tunnel, RouteNameservers: []netip.Addr{routeDNS}},
+			system: mockSystem{
+				defaultRoute: tunnel,
+				routes:       map[netip.Addr]string{routeDNS: "eth0"},
+				resolvers:    []netip.Addr{vpnDNS, routeDNS},
+			},
+			verify: verifyOK,
+			expected: map[string]Status{
+				CheckRouting: StatusPass, CheckDNS: StatusPass, CheckIPv6: StatusPass, CheckFirewall: StatusPass,
+			},
+			passed: true,
+		},
+		{
+			name:  "local forwarder only",
+			state: State{TunnelInterface: tunnel},
+			system: mockSystem{
+				defaultRoute: tunnel,
+				resolvers:    []netip.Addr{netip.MustParseAddr("127.0.2.53")},
+			},
+			verify: verifyOK,
+			expected: map[string]Status{
+				CheckRouting: StatusPass, CheckDNS: StatusSkip, CheckIPv6: StatusPass, CheckFirewall: StatusPass,
+			},
+			passed: true,
+		},
+		{
+			name:  "IPv6 bypasses the tunnel",
+			state: State{TunnelInterface: tunnel},
+			system: mockSystem{
+				defaultRoute: tunnel,
+				routes:       map[netip.Addr]string{publicIPv6: "eth0"},
+				resolvers:    []netip.Addr{vpnDNS},
+			},
+			verify: verifyOK,
+			expected: map[string]Status{
+				CheckRouting: StatusPass, CheckDNS: StatusPass, CheckIPv6: StatusFail, CheckFirewall: StatusPass,
+			},
+		},
+		{
+			name:   "firewall ruleset differs",
+			state:  State{TunnelInterface: tunnel},
+			system: mockSystem{defaultRoute: tunnel, resolvers: []netip.Addr{vpnDNS}},
+			verify: func(firewall.Config) error {
+				return fmt.Errorf("%w: chain output is missing", firewall.ErrRulesetMismatch)
+			},
+			expected: map[string]Status{
+				CheckRouting: StatusPass, CheckDNS: StatusPass, CheckIPv6: StatusPass, CheckFirewall: StatusFail,
+			},
+		},
+		{
+			name:   "firewall disabled",
+			state:  State{TunnelInterface: tunnel},
+			system: mockSystem{defaultRoute: tunnel, resolvers: []netip.Addr{vpnDNS}},
+			verify: func(firewall.Config) error { return firewall.NewError(firewall.ErrFirewallDisabled) },
+			expected: map[string]Status{
+				CheckRouting: StatusPass, CheckDNS: StatusPass, CheckIPv6: StatusPass, CheckFirewall: StatusSkip,
+			},
+			passed: true,
+		},
+		{
+			name: "include only split tunnel leaves the default route alone",
+			state: State{
+				TunnelInterface: tunnel,
+				Firewall:        firewall.Config{SplitTunnelMode: firewall.SplitTunnelInclude},
+			},
+			system: mockSystem{defaultRoute: "eth0", resolversErr: mock.ErrOnPurpose},
+			expected: map[string]Status{
+				CheckRouting: StatusSkip, CheckDNS: StatusSkip, CheckIPv6: StatusPass, CheckFirewall: StatusSkip,
+			},
+			passed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report := Run(test.state, test.system, test.verify)
+			assert.Equal(t, test.expected, statuses(report))
+			assert.Equal(t, test.passed, report.Passed())
+		})
+	}
+}
+
+func TestParseResolvConf(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	conf := `# Generated by NetworkManager
+search lan
+nameserver 127.0.0.53
+nameserver fe80::1%eth0
+nameserver invalid
+nameserver 127.0.0.53
+options edns0 trust-ad`
+
+	assert.Equal(t, []netip.Addr{
+		netip.MustParseAddr("127.0.0.53"),
+		netip.MustParseAddr("fe80::1%eth0"),
+	}, parseResolvConf(strings.NewReader(conf)))
+}
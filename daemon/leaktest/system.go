@@ -0,0 +1,170 @@
+package leaktest
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	resolvConfPath      = "/etc/resolv.conf"
+	resolvedDest        = "org.freedesktop.resolve1"
+	resolvedPath        = "/org/freedesktop/resolve1"
+	resolvedDNS         = resolvedDest + ".Manager.DNS"
+	resolvedGetLink     = resolvedDest + ".Manager.GetLink"
+	resolvedLinkDefault = resolvedDest + ".Link.DefaultRoute"
+)
+
+// resolvedStubs are the addresses systemd-resolved serves DNS on
+var resolvedStubs = []netip.Addr{
+	netip.MustParseAddr("127.0.0.53"),
+	netip.MustParseAddr("127.0.0.54"),
+}
+
+// HostSystem probes the host over netlink and systemd-resolved D-Bus API.
+type HostSystem struct{}
+
+// RouteInterface asks the kernel which interface it would send the packets to dst through.
+func (HostSystem) RouteInterface(dst netip.Addr) (string, error) {
+	routes, err := netlink.RouteGet(dst.AsSlice())
+	if err != nil {
+		if errors.Is(err, unix.ENETUNREACH) || errors.Is(err, unix.EHOSTUNREACH) || errors.Is(err, unix.EACCES) {
+			return "", nil
+		}
+		return "", err
+	}
+	if len(routes) == 0 {
+		return "", nil
+	}
+
+	link, err := netlink.LinkByIndex(routes[0].LinkIndex)
+	if err != nil {
+		return "", fmt.Errorf("looking up link %d: %w", routes[0].LinkIndex, err)
+	}
+	return link.Attrs().Name, nil
+}
+
+// Resolvers returns the nameservers from resolv.conf. systemd-resolved stub is replaced with the
+// nameservers it sends the queries not matching any routing domain to.
+func (HostSystem) Resolvers() ([]netip.Addr, error) {
+	file, err := os.Open(resolvConfPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close() // nolint:errcheck
+
+	nameservers := parseResolvConf(file)
+	if !slices.ContainsFunc(nameservers, func(addr netip.Addr) bool {
+		return slices.Contains(resolvedStubs, addr)
+	}) {
+		return nameservers, nil
+	}
+
+	upstreams, err := resolvedNameservers()
+	if err != nil {
+		return nil, fmt.Errorf("reading systemd-resolved nameservers: %w", err)
+	}
+
+	var resolvers []netip.Addr
+	for _, nameserver := range nameservers {
+		if slices.Contains(resolvedStubs, nameserver) {
+			resolvers = append(resolvers, upstreams...)
+		} else {
+			resolvers = append(resolvers, nameserver)
+		}
+	}
+	return uniqueAddrs(resolvers), nil
+}
+
+func parseResolvConf(r io.Reader) []netip.Addr {
+	var nameservers []netip.Addr
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "nameserver" {
+			continue
+		}
+		addr, err := netip.ParseAddr(fields[1])
+		if err != nil {
+			continue
+		}
+		nameservers = append(nameservers, addr)
+	}
+	return uniqueAddrs(nameservers)
+}
+
+// resolvedServer is a nameserver as listed by systemd-resolved
+type resolvedServer struct {
+	Ifindex int32
+	Family  int32
+	Address []byte
+}
+
+// resolvedNameservers returns the global nameservers and the ones of the links used for the
+// domains not matching any routing domain
+func resolvedNameservers() ([]netip.Addr, error) {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return nil, err
+	}
+	manager := conn.Object(resolvedDest, resolvedPath)
+
+	var servers []resolvedServer
+	if err := manager.StoreProperty(resolvedDNS, &servers); err != nil {
+		return nil, err
+	}
+
+	defaultRoute := map[int32]bool{}
+	var nameservers []netip.Addr
+	for _, server := range servers {
+		addr, ok := netip.AddrFromSlice(server.Address)
+		if !ok {
+			continue
+		}
+		if server.Ifindex != 0 {
+			isDefault, ok := defaultRoute[server.Ifindex]
+			if !ok {
+				isDefault = isLinkDefaultRoute(conn, manager, server.Ifindex)
+				defaultRoute[server.Ifindex] = isDefault
+			}
+			if !isDefault {
+				continue
+			}
+		}
+		nameservers = append(nameservers, addr)
+	}
+	return nameservers, nil
+}
+
+// isLinkDefaultRoute returns true if the queries not matching any routing domain can be sent
+// through the link. Links which can not be inspected are assumed to be used.
+func isLinkDefaultRoute(conn *dbus.Conn, manager dbus.BusObject, ifindex int32) bool {
+	var path dbus.ObjectPath
+	if err := manager.Call(resolvedGetLink, 0, ifindex).Store(&path); err != nil {
+		return true
+	}
+	var isDefault bool
+	if err := conn.Object(resolvedDest, path).StoreProperty(resolvedLinkDefault, &isDefault); err != nil {
+		return true
+	}
+	return isDefault
+}
+
+func uniqueAddrs(addrs []netip.Addr) []netip.Addr {
+	var unique []netip.Addr
+	for _, addr := range addrs {
+		if !slices.Contains(unique, addr) {
+			unique = append(unique, addr)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,228 @@
+package metrics
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/state/types"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+const fileshareTimeout = 2 * time.Second
+
+// ConnectionStatusGetter is implemented by state.ConnectionInfo.
+type ConnectionStatusGetter interface {
+	Status() types.ConnectionStatus
+}
+
+// MeshnetPeers returns the status of every meshnet peer keyed by its public key.
+type MeshnetPeers interface {
+	StatusMap() (map[string]string, error)
+}
+
+var connectionStates = []pb.ConnectionState{
+	pb.ConnectionState_DISCONNECTED,
+	pb.ConnectionState_CONNECTING,
+	pb.ConnectionState_CONNECTED,
+	pb.ConnectionState_PAUSED,
+}
+
+// Exporter collects the metrics on every scrape. Connection and reconnection counters are
+// accumulated from the connection state changes since the daemon start.
+type Exporter struct {
+	cm          config.Manager
+	connection  ConnectionStatusGetter
+	peers       MeshnetPeers
+	fileshare   FileshareTransfers
+	handshake   HandshakeGetter
+	mu          sync.Mutex
+	connections uint64
+	reconnects  uint64
+	// sessionStart is the start time of the last connection, tunnel re-establishment keeps it
+	sessionStart *time.Time
+	lastState    pb.ConnectionState
+}
+
+func NewExporter(
+	cm config.Manager,
+	connection ConnectionStatusGetter,
+	peers MeshnetPeers,
+	fileshare FileshareTransfers,
+	handshake HandshakeGetter,
+) *Exporter {
+	return &Exporter{
+		cm:         cm,
+		connection: connection,
+		peers:      peers,
+		fileshare:  fileshare,
+		handshake:  handshake,
+	}
+}
+
+// OnStateChange counts connections and reconnections. Connection gets a new start time,
+// while the tunnel being re-established within the same connection keeps the old one.
+func (e *Exporter) OnStateChange(notif events.DataConnectChangeNotif) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	status := notif.Status
+	if status.State == pb.ConnectionState_CONNECTED && e.lastState != pb.ConnectionState_CONNECTED {
+		switch {
+		case status.StartTime == nil:
+		case e.sessionStart != nil && e.sessionStart.Equal(*status.StartTime):
+			e.reconnects++
+		default:
+			e.connections++
+			start := *status.StartTime
+			e.sessionStart = &start
+		}
+	}
+	e.lastState = status.State
+	return nil
+}
+
+// Collect returns all of the metrics in the OpenMetrics text format.
+func (e *Exporter) Collect(ctx context.Context) []byte {
+	var w writer
+	e.collectConnection(&w)
+
+	var cfg config.Config
+	if err := e.cm.Load(&cfg); err != nil {
+		log.Error("loading config for metrics:", err)
+	}
+	e.collectMeshnet(&w, cfg.Mesh)
+	e.collectFileshare(ctx, &w, cfg.Mesh)
+	return w.bytes()
+}
+
+func (e *Exporter) collectConnection(w *writer) {
+	status := e.connection.Status()
+	state := status.State
+	if state == pb.ConnectionState_UNKNOWN_STATE {
+		state = pb.ConnectionState_DISCONNECTED
+	}
+
+	w.family("nordvpn_connection_state", typeGauge, "Current VPN connection state.")
+	for _, s := range connectionStates {
+		w.sample("nordvpn_connection_state", boolValue(s == state), "state", strings.ToLower(s.String()))
+	}
+
+	e.mu.Lock()
+	connections, reconnects := e.connections, e.reconnects
+	e.mu.Unlock()
+	w.family("nordvpn_connections", typeCounter, "Established VPN connections since the daemon start.")
+	w.sample("nordvpn_connections_total", float64(connections))
+	w.family("nordvpn_reconnects", typeCounter, "Tunnel re-establishments within an active VPN connection since the daemon start.")
+	w.sample("nordvpn_reconnects_total", float64(reconnects))
+
+	if state != pb.ConnectionState_CONNECTED {
+		return
+	}
+
+	w.family("nordvpn_connection", typeInfo, "Currently connected VPN server.")
+	w.sample("nordvpn_connection_info", 1,
+		"technology", strings.ToLower(status.Technology.String()),
+		"protocol", strings.ToLower(status.Protocol.String()),
+		"server", status.Hostname,
+		"country_code", status.CountryCode,
+	)
+	if status.StartTime != nil {
+		w.family("nordvpn_connection_uptime_seconds", typeGauge, "Duration of the current VPN connection.")
+		w.sample("nordvpn_connection_uptime_seconds", time.Since(*status.StartTime).Seconds())
+	}
+	w.family("nordvpn_tunnel_received_bytes", typeCounter, "Bytes received through the VPN tunnel.")
+	w.sample("nordvpn_tunnel_received_bytes_total", float64(status.Rx))
+	w.family("nordvpn_tunnel_sent_bytes", typeCounter, "Bytes sent through the VPN tunnel.")
+	w.sample("nordvpn_tunnel_sent_bytes_total", float64(status.Tx))
+
+	if status.Technology != config.Technology_NORDLYNX || status.TunnelName == "" {
+		return
+	}
+	handshake, err := e.handshake.LatestHandshake(status.TunnelName)
+	if err != nil {
+		log.Debug("reading latest handshake:", err)
+		return
+	}
+	w.family("nordvpn_handshake_age_seconds", typeGauge, "Time since the latest WireGuard handshake with the VPN server.")
+	w.sample("nordvpn_handshake_age_seconds", time.Since(handshake).Seconds())
+}
+
+func (e *Exporter) collectMeshnet(w *writer, enabled bool) {
+	w.family("nordvpn_meshnet_enabled", typeGauge, "Whether meshnet is enabled.")
+	w.sample("nordvpn_meshnet_enabled", boolValue(enabled))
+	if !enabled {
+		return
+	}
+
+	peers, err := e.peers.StatusMap()
+	if err != nil {
+		log.Warn("reading meshnet peers status:", err)
+		return
+	}
+	keys := make([]string, 0, len(peers))
+	for key := range peers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	w.family("nordvpn_meshnet_peer_connected", typeGauge, "Whether the connection to the meshnet peer is established.")
+	for _, key := range keys {
+		w.sample("nordvpn_meshnet_peer_connected", boolValue(peers[key] == "connected"),
+			"public_key", key, "state", peers[key])
+	}
+}
+
+func (e *Exporter) collectFileshare(ctx context.Context, w *writer, meshEnabled bool) {
+	if !meshEnabled {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, fileshareTimeout)
+	defer cancel()
+	transfers, err := e.fileshare.Transfers(ctx)
+	if err != nil {
+		// fileshare process is not running when no user is logged in
+		log.Debug("listing fileshare transfers:", err)
+		return
+	}
+
+	type key struct {
+		direction string
+		status    string
+	}
+	counts := map[key]int{}
+	for _, tr := range transfers {
+		counts[key{
+			direction: strings.ToLower(tr.GetDirection().String()),
+			status:    strings.ToLower(tr.GetStatus().String()),
+		}]++
+	}
+	keys := make([]key, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].direction != keys[j].direction {
+			return keys[i].direction < keys[j].direction
+		}
+		return keys[i].status < keys[j].status
+	})
+
+	w.family("nordvpn_fileshare_transfers", typeGauge, "Fileshare transfers in the transfer history.")
+	for _, k := range keys {
+		w.sample("nordvpn_fileshare_transfers", float64(counts[k]), "direction", k.direction, "status", k.status)
+	}
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
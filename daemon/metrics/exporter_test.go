@@ -0,0 +1,192 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/state/types"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	filesharepb "github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockConnection struct{ status types.ConnectionStatus }
+
+func (m mockConnection) Status() types.ConnectionStatus { return m.status }
+
+type mockPeers struct{ peers map[string]string }
+
+func (m mockPeers) StatusMap() (map[string]string, error) { return m.peers, nil }
+
+type mockFileshare struct {
+	transfers []*filesharepb.Transfer
+	err       error
+}
+
+func (m mockFileshare) Transfers(context.Context) ([]*filesharepb.Transfer, error) {
+	return m.transfers, m.err
+}
+
+type mockHandshake struct{ err error }
+
+func (m mockHandshake) LatestHandshake(string) (time.Time, error) {
+	return time.Now().Add(-time.Minute), m.err
+}
+
+func TestExporter_CollectDisconnected(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	exporter := NewExporter(
+		mock.NewMockConfigManager(),
+		mockConnection{},
+		mockPeers{},
+		mockFileshare{},
+		mockHandshake{},
+	)
+
+	expected := `# TYPE nordvpn_connection_state gauge
+# HELP nordvpn_connection_state Current VPN connection state.
+nordvpn_connection_state{state="disconnected"} 1
+nordvpn_connection_state{state="connecting"} 0
+nordvpn_connection_state{state="connected"} 0
+nordvpn_connection_state{state="paused"} 0
+# TYPE nordvpn_connections counter
+# HELP nordvpn_connections Established VPN connections since the daemon start.
+nordvpn_connections_total 0
+# TYPE nordvpn_reconnects counter
+# HELP nordvpn_reconnects Tunnel re-establishments within an active VPN connection since the daemon start.
+nordvpn_reconnects_total 0
+# TYPE nordvpn_meshnet_enabled gauge
+# HELP nordvpn_meshnet_enabled Whether meshnet is enabled.
+nordvpn_meshnet_enabled 0
+# EOF
+`
+	assert.Equal(t, expected, string(exporter.Collect(context.Background())))
+}
+
+func TestExporter_CollectConnected(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Mesh = true
+	start := time.Now().Add(-time.Hour)
+	exporter := NewExporter(
+		cm,
+		mockConnection{status: types.ConnectionStatus{
+			State:       pb.ConnectionState_CONNECTED,
+			Technology:  config.Technology_NORDLYNX,
+			Protocol:    config.Protocol_UDP,
+			Hostname:    "de1.nordvpn.com",
+			CountryCode: "DE",
+			StartTime:   &start,
+			TunnelName:  "nordlynx",
+			Rx:          1024,
+			Tx:          512,
+		}},
+		mockPeers{peers: map[string]string{"peerB": "connecting", "peerA": "connected"}},
+		mockFileshare{transfers: []*filesharepb.Transfer{
+			{Direction: filesharepb.Direction_OUTGOING, Status: filesharepb.Status_SUCCESS},
+			{Direction: filesharepb.Direction_INCOMING, Status: filesharepb.Status_ONGOING},
+			{Direction: filesharepb.Direction_OUTGOING, Status: filesharepb.Status_SUCCESS},
+		}},
+		mockHandshake{},
+	)
+
+	out := string(exporter.Collect(context.Background()))
+	assert.Contains(t, out, `nordvpn_connection_state{state="connected"} 1`)
+	assert.Contains(t, out,
+		`nordvpn_connection_info{technology="nordlynx",protocol="udp",server="de1.nordvpn.com",country_code="DE"} 1`)
+	assert.Contains(t, out, "nordvpn_connection_uptime_seconds 3600")
+	assert.Contains(t, out, "nordvpn_tunnel_received_bytes_total 1024\n")
+	assert.Contains(t, out, "nordvpn_tunnel_sent_bytes_total 512\n")
+	assert.Contains(t, out, "nordvpn_handshake_age_seconds 60")
+	assert.Contains(t, out, `nordvpn_meshnet_peer_connected{public_key="peerA",state="connected"} 1
+nordvpn_meshnet_peer_connected{public_key="peerB",state="connecting"} 0
+`)
+	assert.Contains(t, out, `nordvpn_fileshare_transfers{direction="incoming",status="ongoing"} 1
+nordvpn_fileshare_transfers{direction="outgoing",status="success"} 2
+`)
+	assert.True(t, strings.HasSuffix(out, "# EOF\n"))
+}
+
+func TestExporter_CollectSkipsUnavailableSources(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Mesh = true
+	start := time.Now()
+	exporter := NewExporter(
+		cm,
+		mockConnection{status: types.ConnectionStatus{
+			State:      pb.ConnectionState_CONNECTED,
+			Technology: config.Technology_NORDLYNX,
+			StartTime:  &start,
+			TunnelName: "nordlynx",
+		}},
+		mockPeers{},
+		mockFileshare{err: errors.New("fileshare is not running")},
+		mockHandshake{err: ErrNoHandshake},
+	)
+
+	out := string(exporter.Collect(context.Background()))
+	assert.NotContains(t, out, "nordvpn_handshake_age_seconds")
+	assert.NotContains(t, out, "nordvpn_fileshare_transfers")
+}
+
+func TestExporter_OnStateChange(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	exporter := NewExporter(mock.NewMockConfigManager(), mockConnection{}, mockPeers{}, mockFileshare{}, mockHandshake{})
+	first := time.Now()
+	second := first.Add(time.Hour)
+
+	for _, status := range []types.ConnectionStatus{
+		{State: pb.ConnectionState_CONNECTING},
+		{State: pb.ConnectionState_CONNECTED, StartTime: &first},
+		// tunnel re-established within the same connection
+		{State: pb.ConnectionState_CONNECTING, StartTime: &first},
+		{State: pb.ConnectionState_CONNECTED, StartTime: &first},
+		{State: pb.ConnectionState_CONNECTED, StartTime: &first},
+		{State: pb.ConnectionState_DISCONNECTED},
+		{State: pb.ConnectionState_CONNECTED, StartTime: &second},
+	} {
+		assert.NoError(t, exporter.OnStateChange(events.DataConnectChangeNotif{Status: status}))
+	}
+
+	assert.Equal(t, uint64(2), exporter.connections)
+	assert.Equal(t, uint64(1), exporter.reconnects)
+}
+
+func TestParseLatestHandshakes(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	handshake, err := parseLatestHandshakes("keyA=\t1700000000\nkeyB=\t1700000100\n")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1700000100, 0), handshake)
+
+	_, err = parseLatestHandshakes("keyA=\t0\n")
+	assert.ErrorIs(t, err, ErrNoHandshake)
+
+	_, err = parseLatestHandshakes("keyA=\tnever\n")
+	assert.Error(t, err)
+}
+
+func TestWriter_EscapesLabels(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var w writer
+	w.family("metric", typeGauge, "Help with \\ and\nnewline.")
+	w.sample("metric", 0.5, "label", "quote \" backslash \\ newline \n")
+	assert.Equal(t, `# TYPE metric gauge
+# HELP metric Help with \\ and\nnewline.
+metric{label="quote \" backslash \\ newline \n"} 0.5
+# EOF
+`, string(w.bytes()))
+}
@@ -0,0 +1,79 @@
+// Package metrics exposes connection, meshnet and fileshare telemetry in the OpenMetrics text format.
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ContentType of the OpenMetrics text exposition format.
+const ContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+type metricType string
+
+const (
+	typeGauge   metricType = "gauge"
+	typeCounter metricType = "counter"
+	typeInfo    metricType = "info"
+)
+
+// writer builds OpenMetrics text exposition. Every metric family must be declared with
+// family before its samples are written.
+type writer struct {
+	buf bytes.Buffer
+}
+
+func (w *writer) family(name string, typ metricType, help string) {
+	fmt.Fprintf(&w.buf, "# TYPE %s %s\n", name, typ)
+	fmt.Fprintf(&w.buf, "# HELP %s %s\n", name, escapeHelp(help))
+}
+
+// sample writes a single sample. Labels are given as name and value pairs.
+func (w *writer) sample(name string, value float64, labels ...string) {
+	w.buf.WriteString(name)
+	if len(labels) > 1 {
+		w.buf.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			w.buf.WriteString(labels[i])
+			w.buf.WriteString(`="`)
+			w.buf.WriteString(escapeLabelValue(labels[i+1]))
+			w.buf.WriteByte('"')
+		}
+		w.buf.WriteByte('}')
+	}
+	w.buf.WriteByte(' ')
+	w.buf.WriteString(formatValue(value))
+	w.buf.WriteByte('\n')
+}
+
+// bytes terminates the exposition and returns it.
+func (w *writer) bytes() []byte {
+	w.buf.WriteString("# EOF\n")
+	return w.buf.Bytes()
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+var (
+	helpReplacer  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string       { return helpReplacer.Replace(s) }
+func escapeLabelValue(s string) string { return labelReplacer.Replace(s) }
@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+const (
+	// Path on which the metrics are served.
+	Path = "/metrics"
+
+	readHeaderTimeout = 5 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+// Server serves exporter metrics over HTTP. It can be restarted with a different address.
+type Server struct {
+	exporter *Exporter
+	mu       sync.Mutex
+	srv      *http.Server
+}
+
+func NewServer(exporter *Exporter) *Server {
+	return &Server{exporter: exporter}
+}
+
+// Start stops the running server, if any, and starts serving on a given address.
+func (s *Server) Start(network string, address string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stop()
+
+	listener, err := listen(network, address)
+	if err != nil {
+		return fmt.Errorf("listening for metrics on %s: %w", address, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(Path, s)
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: readHeaderTimeout}
+	s.srv = srv
+	go func() {
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("serving metrics:", err)
+		}
+	}()
+	log.Info("serving metrics on", address)
+	return nil
+}
+
+// Stop stops the running server. It is a no-op if the server is not running.
+func (s *Server) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stop()
+}
+
+func (s *Server) stop() {
+	if s.srv == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := s.srv.Shutdown(ctx); err != nil {
+		log.Warn("stopping metrics server:", err)
+	}
+	s.srv = nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", ContentType)
+	if _, err := w.Write(s.exporter.Collect(r.Context())); err != nil {
+		log.Debug("writing metrics:", err)
+	}
+}
+
+func listen(network string, address string) (net.Listener, error) {
+	if network != internal.Proto {
+		return net.Listen(network, address)
+	}
+	if address != internal.MetricsSocket {
+		return nil, fmt.Errorf("%s is not the metrics socket", address)
+	}
+
+	// socket file may be left over from the previous daemon run, never remove anything else
+	if info, err := os.Lstat(address); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", address)
+		}
+		if err := os.Remove(address); err != nil {
+			return nil, err
+		}
+	}
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(address, internal.PermUserRWGroupRW); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	if gid, err := internal.GetNordvpnGid(); err == nil {
+		if err := os.Chown(address, os.Getuid(), gid); err != nil {
+			log.Warn("changing metrics socket owner:", err)
+		}
+	} else {
+		log.Warn(err)
+	}
+	return listener, nil
+}
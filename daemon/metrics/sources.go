@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	filesharepb "github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ErrNoHandshake is returned when the tunnel has no completed handshake.
+var ErrNoHandshake = errors.New("no handshake")
+
+// HandshakeGetter returns the time of the latest WireGuard handshake on a tunnel interface.
+type HandshakeGetter interface {
+	LatestHandshake(tunnel string) (time.Time, error)
+}
+
+// FileshareTransfers lists transfers known to the fileshare process.
+type FileshareTransfers interface {
+	Transfers(ctx context.Context) ([]*filesharepb.Transfer, error)
+}
+
+// WGHandshakeGetter reads the latest handshake using wg tool. It works only with kernel
+// space WireGuard interfaces.
+type WGHandshakeGetter struct{}
+
+func (WGHandshakeGetter) LatestHandshake(tunnel string) (time.Time, error) {
+	// #nosec G204 -- tunnel name comes from the daemon itself
+	out, err := exec.Command("wg", "show", tunnel, "latest-handshakes").Output()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("reading latest handshakes: %w", err)
+	}
+	return parseLatestHandshakes(string(out))
+}
+
+// parseLatestHandshakes parses `wg show <iface> latest-handshakes` output and returns the most
+// recent handshake among all of the peers.
+func parseLatestHandshakes(out string) (time.Time, error) {
+	var latest int64
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		timestamp, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing handshake time: %w", err)
+		}
+		latest = max(latest, timestamp)
+	}
+	if latest == 0 {
+		return time.Time{}, ErrNoHandshake
+	}
+	return time.Unix(latest, 0), nil
+}
+
+// GRPCFileshareTransfers lists transfers using fileshare process gRPC API.
+type GRPCFileshareTransfers struct {
+	url string
+}
+
+func NewGRPCFileshareTransfers(url string) *GRPCFileshareTransfers {
+	return &GRPCFileshareTransfers{url: url}
+}
+
+func (f *GRPCFileshareTransfers) Transfers(ctx context.Context) ([]*filesharepb.Transfer, error) {
+	conn, err := grpc.NewClient(f.url, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("connecting to fileshare: %w", err)
+	}
+	defer conn.Close()
+
+	stream, err := filesharepb.NewFileshareClient(conn).List(ctx, &filesharepb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("listing transfers: %w", err)
+	}
+
+	transfers := []*filesharepb.Transfer{}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("listing transfers: %w", err)
+		}
+		if resp.GetError().GetResponse() != nil && resp.GetError().GetEmpty() == nil {
+			return nil, fmt.Errorf("listing transfers: %s", resp.GetError())
+		}
+		transfers = append(transfers, resp.GetTransfers()...)
+	}
+	return transfers, nil
+}
@@ -1,7 +1,17 @@
 package daemon
 
 import (
+	"context"
+	"net/netip"
+	"slices"
+	"time"
+
 	"github.com/google/uuid"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/netstate"
+	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
 )
 
 type machineIDGetterMock struct {
@@ -11,3 +21,78 @@ type machineIDGetterMock struct {
 func (mid *machineIDGetterMock) GetMachineID() uuid.UUID {
 	return mid.machineID
 }
+
+type mockSplitTunnelManager struct {
+	notSupported bool
+	cgroups      []string
+	pids         []int
+	synced       []config.SplitTunnelApp
+	syncErr      error
+}
+
+func (m *mockSplitTunnelManager) IsSupported() bool { return !m.notSupported }
+
+func (m *mockSplitTunnelManager) CgroupExists(path string) bool {
+	return slices.Contains(m.cgroups, path)
+}
+
+func (m *mockSplitTunnelManager) ProcessExists(pid int) bool { return slices.Contains(m.pids, pid) }
+
+func (m *mockSplitTunnelManager) Sync(apps []config.SplitTunnelApp) ([]firewall.SplitTunnelCgroup, error) {
+	if m.syncErr != nil {
+		return nil, m.syncErr
+	}
+	m.synced = apps
+	cgroups := make([]firewall.SplitTunnelCgroup, 0, len(apps))
+	for i, app := range apps {
+		cgroups = append(cgroups, firewall.SplitTunnelCgroup{
+			ID:               uint64(i + 1), // #nosec G115 -- index is positive
+			Level:            1,
+			KillSwitchExempt: app.KillSwitchExempt,
+		})
+	}
+	return cgroups, nil
+}
+
+type mockDomainResolver struct {
+	addrs map[string][]netip.Addr
+}
+
+func (m *mockDomainResolver) ResolveWithTTL(domain string) ([]netip.Addr, time.Duration, error) {
+	return m.addrs[domain], time.Minute, nil
+}
+
+type mockIdentityResolver struct {
+	identity netstate.NetworkIdentity
+	err      error
+}
+
+func (m *mockIdentityResolver) Identity() (netstate.NetworkIdentity, error) {
+	return m.identity, m.err
+}
+
+type mockMeshnetEnabler struct {
+	enabled bool
+}
+
+func (m *mockMeshnetEnabler) EnableMeshnet(context.Context, *meshpb.Empty) (*meshpb.MeshnetResponse, error) {
+	m.enabled = true
+	return &meshpb.MeshnetResponse{Response: &meshpb.MeshnetResponse_Empty{Empty: &meshpb.Empty{}}}, nil
+}
+
+type mockMetricsServer struct {
+	startErr error
+	network  string
+	address  string
+	running  bool
+}
+
+func (m *mockMetricsServer) Start(network string, address string) error {
+	if m.startErr != nil {
+		return m.startErr
+	}
+	m.network, m.address, m.running = network, address, true
+	return nil
+}
+
+func (m *mockMetricsServer) Stop() { m.running = false }
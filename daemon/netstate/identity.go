@@ -0,0 +1,161 @@
+package netstate
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	nmDest                 = "org.freedesktop.NetworkManager"
+	nmPath                 = "/org/freedesktop/NetworkManager"
+	nmGetDeviceByIPIface   = nmDest + ".GetDeviceByIpIface"
+	nmDeviceType           = nmDest + ".Device.DeviceType"
+	nmActiveAccessPoint    = nmDest + ".Device.Wireless.ActiveAccessPoint"
+	nmAccessPointSSID      = nmDest + ".AccessPoint.Ssid"
+	nmDeviceTypeWifi       = 2
+	noActiveAccessPoint    = dbus.ObjectPath("/")
+	defaultRouteFamily     = netlink.FAMILY_V4
+	neighborStateReachable = netlink.NUD_REACHABLE | netlink.NUD_STALE | netlink.NUD_DELAY |
+		netlink.NUD_PROBE | netlink.NUD_PERMANENT | netlink.NUD_NOARP
+)
+
+// ErrNoDefaultRoute is returned when host has no default route outside of the ignored interfaces.
+var ErrNoDefaultRoute = errors.New("no default route")
+
+// NetworkIdentity identifies the network which the default route goes through.
+type NetworkIdentity struct {
+	Interface string
+	// SSID is set only for Wi-Fi networks managed by NetworkManager
+	SSID string
+	// GatewayMAC is empty if the gateway is not in the neighbor table yet
+	GatewayMAC string
+}
+
+// IsEmpty returns true if the network can not be identified.
+func (n NetworkIdentity) IsEmpty() bool {
+	return n.SSID == "" && n.GatewayMAC == ""
+}
+
+// IdentityResolver finds out which network the host is connected to.
+type IdentityResolver interface {
+	Identity() (NetworkIdentity, error)
+}
+
+// SystemIdentityResolver identifies the network by the Wi-Fi SSID from NetworkManager and the MAC
+// address of the default gateway from the neighbor table.
+type SystemIdentityResolver struct {
+	ignored []string
+}
+
+// NewSystemIdentityResolver creates resolver which skips default routes through given interfaces.
+func NewSystemIdentityResolver(ignoreIntfs []string) *SystemIdentityResolver {
+	return &SystemIdentityResolver{ignored: ignoreIntfs}
+}
+
+// Identity of the network which the default route goes through.
+func (s *SystemIdentityResolver) Identity() (NetworkIdentity, error) {
+	route, link, err := s.defaultRoute()
+	if err != nil {
+		return NetworkIdentity{}, err
+	}
+
+	identity := NetworkIdentity{Interface: link.Attrs().Name}
+	if route.Gw != nil {
+		identity.GatewayMAC = gatewayMAC(route.LinkIndex, route.Gw)
+	}
+	// NetworkManager might be not installed or not managing the interface, gateway MAC is enough then
+	identity.SSID, _ = wifiSSID(identity.Interface)
+	return identity, nil
+}
+
+// defaultRoute returns the default route with the lowest metric outside of the ignored interfaces.
+func (s *SystemIdentityResolver) defaultRoute() (netlink.Route, netlink.Link, error) {
+	routes, err := netlink.RouteList(nil, defaultRouteFamily)
+	if err != nil {
+		return netlink.Route{}, nil, fmt.Errorf("listing routes: %w", err)
+	}
+
+	var (
+		best     netlink.Route
+		bestLink netlink.Link
+	)
+	for _, route := range routes {
+		if route.Dst != nil {
+			if ones, _ := route.Dst.Mask.Size(); ones != 0 {
+				continue
+			}
+		}
+		link, err := netlink.LinkByIndex(route.LinkIndex)
+		if err != nil || slices.Contains(s.ignored, link.Attrs().Name) {
+			continue
+		}
+		if bestLink == nil || route.Priority < best.Priority {
+			best, bestLink = route, link
+		}
+	}
+	if bestLink == nil {
+		return netlink.Route{}, nil, ErrNoDefaultRoute
+	}
+	return best, bestLink, nil
+}
+
+func gatewayMAC(linkIndex int, gateway net.IP) string {
+	neighbors, err := netlink.NeighList(linkIndex, defaultRouteFamily)
+	if err != nil {
+		return ""
+	}
+	for _, neighbor := range neighbors {
+		if neighbor.IP.Equal(gateway) && neighbor.State&neighborStateReachable != 0 && len(neighbor.HardwareAddr) > 0 {
+			return neighbor.HardwareAddr.String()
+		}
+	}
+	return ""
+}
+
+// wifiSSID returns SSID of the access point the interface is associated with. Empty SSID is
+// returned for the non Wi-Fi interfaces.
+func wifiSSID(iface string) (string, error) {
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return "", fmt.Errorf("connecting to system bus: %w", err)
+	}
+	defer conn.Close()
+
+	var devicePath dbus.ObjectPath
+	if err := conn.Object(nmDest, nmPath).Call(nmGetDeviceByIPIface, 0, iface).Store(&devicePath); err != nil {
+		return "", fmt.Errorf("getting NetworkManager device: %w", err)
+	}
+
+	device := conn.Object(nmDest, devicePath)
+	deviceType, err := device.GetProperty(nmDeviceType)
+	if err != nil {
+		return "", fmt.Errorf("getting device type: %w", err)
+	}
+	if t, ok := deviceType.Value().(uint32); !ok || t != nmDeviceTypeWifi {
+		return "", nil
+	}
+
+	accessPoint, err := device.GetProperty(nmActiveAccessPoint)
+	if err != nil {
+		return "", fmt.Errorf("getting active access point: %w", err)
+	}
+	accessPointPath, ok := accessPoint.Value().(dbus.ObjectPath)
+	if !ok || accessPointPath == noActiveAccessPoint {
+		return "", nil
+	}
+
+	ssid, err := conn.Object(nmDest, accessPointPath).GetProperty(nmAccessPointSSID)
+	if err != nil {
+		return "", fmt.Errorf("getting SSID: %w", err)
+	}
+	value, ok := ssid.Value().([]byte)
+	if !ok {
+		return "", nil
+	}
+	return string(value), nil
+}
@@ -14,6 +14,12 @@ type Reconnector interface {
 	Reconnect(stateIsUp bool)
 }
 
+// NetworkChangeListener is notified about every link or route change on the host. It is called
+// from the monitoring goroutine, so it must not block.
+type NetworkChangeListener interface {
+	NetworkChanged()
+}
+
 // NetlinkMonitor keeps track of the interfaces on this host.
 type NetlinkMonitor struct {
 	linkUpdatesChan  chan netlink.LinkUpdate
@@ -22,6 +28,7 @@ type NetlinkMonitor struct {
 	mtx              sync.Mutex
 	cached           mapset.Set[string] // interface cache
 	ignored          mapset.Set[string] // ignore our-selfs created interfaces
+	listeners        []NetworkChangeListener
 }
 
 // NewNetlinkMonitor instantiate netlink monitor
@@ -44,6 +51,11 @@ func NewNetlinkMonitor(ignoreIntfs []string) (*NetlinkMonitor, error) {
 	return nlmon, nil
 }
 
+// AddListener registers listener for all the link and route changes. Must be called before Start.
+func (m *NetlinkMonitor) AddListener(listener NetworkChangeListener) {
+	m.listeners = append(m.listeners, listener)
+}
+
 // Start start monitoring
 func (m *NetlinkMonitor) Start(re Reconnector) {
 	go m.run(re)
@@ -76,6 +88,10 @@ func (m *NetlinkMonitor) checkForChanges(re Reconnector) {
 	if m.setCachedInterfaces(interfaces) {
 		re.Reconnect(!interfaces.IsEmpty())
 	}
+
+	for _, listener := range m.listeners {
+		listener.NetworkChanged()
+	}
 }
 
 func (m *NetlinkMonitor) setCachedInterfaces(interfaces mapset.Set[string]) bool {
@@ -2,11 +2,12 @@
 // versions:
 // 	protoc-gen-go v1.35.1
 // 	protoc        v3.21.6
-// source: protobuf/daemon/connect.proto
+// source: connect.proto
 
 package pb
 
 import (
+	config "github.com/NordSecurity/nordvpn-linux/config"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -27,11 +28,17 @@ type ConnectRequest struct {
 
 	ServerTag   string `protobuf:"bytes,1,opt,name=server_tag,json=serverTag,proto3" json:"server_tag,omitempty"`
 	ServerGroup string `protobuf:"bytes,11,opt,name=server_group,json=serverGroup,proto3" json:"server_group,omitempty"`
+	// entry server of the multi-hop connection, the connection is direct if not set
+	Via string `protobuf:"bytes,12,opt,name=via,proto3" json:"via,omitempty"`
+	// probe the best ranked servers and pick the one with the lowest latency
+	Fastest bool `protobuf:"varint,13,opt,name=fastest,proto3" json:"fastest,omitempty"`
+	// settings used only for this connection instead of the configured ones
+	Overrides *ConnectionOverrides `protobuf:"bytes,14,opt,name=overrides,proto3" json:"overrides,omitempty"`
 }
 
 func (x *ConnectRequest) Reset() {
 	*x = ConnectRequest{}
-	mi := &file_protobuf_daemon_connect_proto_msgTypes[0]
+	mi := &file_connect_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -43,7 +50,7 @@ func (x *ConnectRequest) String() string {
 func (*ConnectRequest) ProtoMessage() {}
 
 func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_daemon_connect_proto_msgTypes[0]
+	mi := &file_connect_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,7 +63,7 @@ func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
 func (*ConnectRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_daemon_connect_proto_rawDescGZIP(), []int{0}
+	return file_connect_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *ConnectRequest) GetServerTag() string {
@@ -73,17 +80,144 @@ func (x *ConnectRequest) GetServerGroup() string {
 	return ""
 }
 
-var File_protobuf_daemon_connect_proto protoreflect.FileDescriptor
+func (x *ConnectRequest) GetVia() string {
+	if x != nil {
+		return x.Via
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetFastest() bool {
+	if x != nil {
+		return x.Fastest
+	}
+	return false
+}
+
+func (x *ConnectRequest) GetOverrides() *ConnectionOverrides {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+// ConnectionOverrides replace the configured settings for a single connection, the
+// configured settings are used for the fields which are not set
+type ConnectionOverrides struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Technology  *config.Technology `protobuf:"varint,1,opt,name=technology,proto3,enum=config.Technology,oneof" json:"technology,omitempty"`
+	Protocol    *config.Protocol   `protobuf:"varint,2,opt,name=protocol,proto3,enum=config.Protocol,oneof" json:"protocol,omitempty"`
+	Obfuscate   *bool              `protobuf:"varint,3,opt,name=obfuscate,proto3,oneof" json:"obfuscate,omitempty"`
+	PostQuantum *bool              `protobuf:"varint,4,opt,name=post_quantum,json=postQuantum,proto3,oneof" json:"post_quantum,omitempty"`
+	// nameservers used instead of the configured ones
+	Dns []string `protobuf:"bytes,5,rep,name=dns,proto3" json:"dns,omitempty"`
+}
+
+func (x *ConnectionOverrides) Reset() {
+	*x = ConnectionOverrides{}
+	mi := &file_connect_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectionOverrides) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionOverrides) ProtoMessage() {}
+
+func (x *ConnectionOverrides) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionOverrides.ProtoReflect.Descriptor instead.
+func (*ConnectionOverrides) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConnectionOverrides) GetTechnology() config.Technology {
+	if x != nil && x.Technology != nil {
+		return *x.Technology
+	}
+	return config.Technology(0)
+}
+
+func (x *ConnectionOverrides) GetProtocol() config.Protocol {
+	if x != nil && x.Protocol != nil {
+		return *x.Protocol
+	}
+	return config.Protocol(0)
+}
+
+func (x *ConnectionOverrides) GetObfuscate() bool {
+	if x != nil && x.Obfuscate != nil {
+		return *x.Obfuscate
+	}
+	return false
+}
+
+func (x *ConnectionOverrides) GetPostQuantum() bool {
+	if x != nil && x.PostQuantum != nil {
+		return *x.PostQuantum
+	}
+	return false
+}
+
+func (x *ConnectionOverrides) GetDns() []string {
+	if x != nil {
+		return x.Dns
+	}
+	return nil
+}
+
+var File_connect_proto protoreflect.FileDescriptor
 
-var file_protobuf_daemon_connect_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x02, 0x70, 0x62, 0x22, 0x52, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
-	0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x54, 0x61, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+var file_connect_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x02, 0x70, 0x62, 0x1a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x61, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x69, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61,
+	0x73, 0x74, 0x65, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x61, 0x73,
+	0x74, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0x99, 0x02, 0x0a, 0x13,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x74,
+	0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x48, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x21, 0x0a, 0x09, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x02, 0x52, 0x09, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x64, 0x6e, 0x73, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x62, 0x66,
+	0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
 	0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78,
 	0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
@@ -91,50 +225,57 @@ var file_protobuf_daemon_connect_proto_rawDesc = []byte{
 }
 
 var (
-	file_protobuf_daemon_connect_proto_rawDescOnce sync.Once
-	file_protobuf_daemon_connect_proto_rawDescData = file_protobuf_daemon_connect_proto_rawDesc
+	file_connect_proto_rawDescOnce sync.Once
+	file_connect_proto_rawDescData = file_connect_proto_rawDesc
 )
 
-func file_protobuf_daemon_connect_proto_rawDescGZIP() []byte {
-	file_protobuf_daemon_connect_proto_rawDescOnce.Do(func() {
-		file_protobuf_daemon_connect_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_daemon_connect_proto_rawDescData)
+func file_connect_proto_rawDescGZIP() []byte {
+	file_connect_proto_rawDescOnce.Do(func() {
+		file_connect_proto_rawDescData = protoimpl.X.CompressGZIP(file_connect_proto_rawDescData)
 	})
-	return file_protobuf_daemon_connect_proto_rawDescData
+	return file_connect_proto_rawDescData
 }
 
-var file_protobuf_daemon_connect_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_protobuf_daemon_connect_proto_goTypes = []any{
-	(*ConnectRequest)(nil), // 0: pb.ConnectRequest
+var file_connect_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_connect_proto_goTypes = []any{
+	(*ConnectRequest)(nil),      // 0: pb.ConnectRequest
+	(*ConnectionOverrides)(nil), // 1: pb.ConnectionOverrides
+	(config.Technology)(0),      // 2: config.Technology
+	(config.Protocol)(0),        // 3: config.Protocol
 }
-var file_protobuf_daemon_connect_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+var file_connect_proto_depIdxs = []int32{
+	1, // 0: pb.ConnectRequest.overrides:type_name -> pb.ConnectionOverrides
+	2, // 1: pb.ConnectionOverrides.technology:type_name -> config.Technology
+	3, // 2: pb.ConnectionOverrides.protocol:type_name -> config.Protocol
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
-func init() { file_protobuf_daemon_connect_proto_init() }
-func file_protobuf_daemon_connect_proto_init() {
-	if File_protobuf_daemon_connect_proto != nil {
+func init() { file_connect_proto_init() }
+func file_connect_proto_init() {
+	if File_connect_proto != nil {
 		return
 	}
+	file_connect_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_protobuf_daemon_connect_proto_rawDesc,
+			RawDescriptor: file_connect_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
-		GoTypes:           file_protobuf_daemon_connect_proto_goTypes,
-		DependencyIndexes: file_protobuf_daemon_connect_proto_depIdxs,
-		MessageInfos:      file_protobuf_daemon_connect_proto_msgTypes,
+		GoTypes:           file_connect_proto_goTypes,
+		DependencyIndexes: file_connect_proto_depIdxs,
+		MessageInfos:      file_connect_proto_msgTypes,
 	}.Build()
-	File_protobuf_daemon_connect_proto = out.File
-	file_protobuf_daemon_connect_proto_rawDesc = nil
-	file_protobuf_daemon_connect_proto_goTypes = nil
-	file_protobuf_daemon_connect_proto_depIdxs = nil
+	File_connect_proto = out.File
+	file_connect_proto_rawDesc = nil
+	file_connect_proto_goTypes = nil
+	file_connect_proto_depIdxs = nil
 }
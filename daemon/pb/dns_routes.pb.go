@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v3.21.6
+// source: dns_routes.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DNSRoute sends the queries of the domain and its subdomains to the nameserver
+type DNSRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain     string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Nameserver string `protobuf:"bytes,2,opt,name=nameserver,proto3" json:"nameserver,omitempty"`
+}
+
+func (x *DNSRoute) Reset() {
+	*x = DNSRoute{}
+	mi := &file_dns_routes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DNSRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DNSRoute) ProtoMessage() {}
+
+func (x *DNSRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_routes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DNSRoute.ProtoReflect.Descriptor instead.
+func (*DNSRoute) Descriptor() ([]byte, []int) {
+	return file_dns_routes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DNSRoute) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *DNSRoute) GetNameserver() string {
+	if x != nil {
+		return x.Nameserver
+	}
+	return ""
+}
+
+type AddDNSRouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Route *DNSRoute `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+}
+
+func (x *AddDNSRouteRequest) Reset() {
+	*x = AddDNSRouteRequest{}
+	mi := &file_dns_routes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDNSRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDNSRouteRequest) ProtoMessage() {}
+
+func (x *AddDNSRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_routes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDNSRouteRequest.ProtoReflect.Descriptor instead.
+func (*AddDNSRouteRequest) Descriptor() ([]byte, []int) {
+	return file_dns_routes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddDNSRouteRequest) GetRoute() *DNSRoute {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+type RemoveDNSRouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (x *RemoveDNSRouteRequest) Reset() {
+	*x = RemoveDNSRouteRequest{}
+	mi := &file_dns_routes_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDNSRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDNSRouteRequest) ProtoMessage() {}
+
+func (x *RemoveDNSRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_routes_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDNSRouteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDNSRouteRequest) Descriptor() ([]byte, []int) {
+	return file_dns_routes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RemoveDNSRouteRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+var File_dns_routes_proto protoreflect.FileDescriptor
+
+var file_dns_routes_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x64, 0x6e, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x42, 0x0a, 0x08, 0x44, 0x4e, 0x53, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x38, 0x0a, 0x12, 0x41, 0x64,
+	0x64, 0x44, 0x4e, 0x53, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x22, 0x2f, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x4e,
+	0x53, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dns_routes_proto_rawDescOnce sync.Once
+	file_dns_routes_proto_rawDescData = file_dns_routes_proto_rawDesc
+)
+
+func file_dns_routes_proto_rawDescGZIP() []byte {
+	file_dns_routes_proto_rawDescOnce.Do(func() {
+		file_dns_routes_proto_rawDescData = protoimpl.X.CompressGZIP(file_dns_routes_proto_rawDescData)
+	})
+	return file_dns_routes_proto_rawDescData
+}
+
+var file_dns_routes_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_dns_routes_proto_goTypes = []any{
+	(*DNSRoute)(nil),              // 0: pb.DNSRoute
+	(*AddDNSRouteRequest)(nil),    // 1: pb.AddDNSRouteRequest
+	(*RemoveDNSRouteRequest)(nil), // 2: pb.RemoveDNSRouteRequest
+}
+var file_dns_routes_proto_depIdxs = []int32{
+	0, // 0: pb.AddDNSRouteRequest.route:type_name -> pb.DNSRoute
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_dns_routes_proto_init() }
+func file_dns_routes_proto_init() {
+	if File_dns_routes_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dns_routes_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_dns_routes_proto_goTypes,
+		DependencyIndexes: file_dns_routes_proto_depIdxs,
+		MessageInfos:      file_dns_routes_proto_msgTypes,
+	}.Build()
+	File_dns_routes_proto = out.File
+	file_dns_routes_proto_rawDesc = nil
+	file_dns_routes_proto_goTypes = nil
+	file_dns_routes_proto_depIdxs = nil
+}
@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v3.21.6
+// source: leaktest.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LeakTestStatus int32
+
+const (
+	LeakTestStatus_LEAK_TEST_PASS LeakTestStatus = 0
+	LeakTestStatus_LEAK_TEST_FAIL LeakTestStatus = 1
+	// check does not apply to the current setup or could not be performed
+	LeakTestStatus_LEAK_TEST_SKIP LeakTestStatus = 2
+)
+
+// Enum value maps for LeakTestStatus.
+var (
+	LeakTestStatus_name = map[int32]string{
+		0: "LEAK_TEST_PASS",
+		1: "LEAK_TEST_FAIL",
+		2: "LEAK_TEST_SKIP",
+	}
+	LeakTestStatus_value = map[string]int32{
+		"LEAK_TEST_PASS": 0,
+		"LEAK_TEST_FAIL": 1,
+		"LEAK_TEST_SKIP": 2,
+	}
+)
+
+func (x LeakTestStatus) Enum() *LeakTestStatus {
+	p := new(LeakTestStatus)
+	*p = x
+	return p
+}
+
+func (x LeakTestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeakTestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_leaktest_proto_enumTypes[0].Descriptor()
+}
+
+func (LeakTestStatus) Type() protoreflect.EnumType {
+	return &file_leaktest_proto_enumTypes[0]
+}
+
+func (x LeakTestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeakTestStatus.Descriptor instead.
+func (LeakTestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_leaktest_proto_rawDescGZIP(), []int{0}
+}
+
+type LeakTestCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status LeakTestStatus `protobuf:"varint,2,opt,name=status,proto3,enum=pb.LeakTestStatus" json:"status,omitempty"`
+	Detail string         `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *LeakTestCheck) Reset() {
+	*x = LeakTestCheck{}
+	mi := &file_leaktest_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeakTestCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeakTestCheck) ProtoMessage() {}
+
+func (x *LeakTestCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_leaktest_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeakTestCheck.ProtoReflect.Descriptor instead.
+func (*LeakTestCheck) Descriptor() ([]byte, []int) {
+	return file_leaktest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LeakTestCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LeakTestCheck) GetStatus() LeakTestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LeakTestStatus_LEAK_TEST_PASS
+}
+
+func (x *LeakTestCheck) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type LeakTestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   int64            `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Checks []*LeakTestCheck `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+}
+
+func (x *LeakTestResponse) Reset() {
+	*x = LeakTestResponse{}
+	mi := &file_leaktest_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeakTestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeakTestResponse) ProtoMessage() {}
+
+func (x *LeakTestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leaktest_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeakTestResponse.ProtoReflect.Descriptor instead.
+func (*LeakTestResponse) Descriptor() ([]byte, []int) {
+	return file_leaktest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LeakTestResponse) GetType() int64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *LeakTestResponse) GetChecks() []*LeakTestCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+var File_leaktest_proto protoreflect.FileDescriptor
+
+var file_leaktest_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x22, 0x67, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x6b, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x65, 0x61, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x51, 0x0a,
+	0x10, 0x4c, 0x65, 0x61, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x6b, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x2a, 0x4c, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x45, 0x41, 0x4b, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f,
+	0x50, 0x41, 0x53, 0x53, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x45, 0x41, 0x4b, 0x5f, 0x54,
+	0x45, 0x53, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x45,
+	0x41, 0x4b, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x02, 0x42, 0x31,
+	0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72,
+	0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70,
+	0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_leaktest_proto_rawDescOnce sync.Once
+	file_leaktest_proto_rawDescData = file_leaktest_proto_rawDesc
+)
+
+func file_leaktest_proto_rawDescGZIP() []byte {
+	file_leaktest_proto_rawDescOnce.Do(func() {
+		file_leaktest_proto_rawDescData = protoimpl.X.CompressGZIP(file_leaktest_proto_rawDescData)
+	})
+	return file_leaktest_proto_rawDescData
+}
+
+var file_leaktest_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_leaktest_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_leaktest_proto_goTypes = []any{
+	(LeakTestStatus)(0),      // 0: pb.LeakTestStatus
+	(*LeakTestCheck)(nil),    // 1: pb.LeakTestCheck
+	(*LeakTestResponse)(nil), // 2: pb.LeakTestResponse
+}
+var file_leaktest_proto_depIdxs = []int32{
+	0, // 0: pb.LeakTestCheck.status:type_name -> pb.LeakTestStatus
+	1, // 1: pb.LeakTestResponse.checks:type_name -> pb.LeakTestCheck
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_leaktest_proto_init() }
+func file_leaktest_proto_init() {
+	if File_leaktest_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_leaktest_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_leaktest_proto_goTypes,
+		DependencyIndexes: file_leaktest_proto_depIdxs,
+		EnumInfos:         file_leaktest_proto_enumTypes,
+		MessageInfos:      file_leaktest_proto_msgTypes,
+	}.Build()
+	File_leaktest_proto = out.File
+	file_leaktest_proto_rawDesc = nil
+	file_leaktest_proto_goTypes = nil
+	file_leaktest_proto_depIdxs = nil
+}
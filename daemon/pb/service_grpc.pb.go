@@ -19,57 +19,79 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Daemon_IsLoggedIn_FullMethodName               = "/pb.Daemon/IsLoggedIn"
-	Daemon_LoginWithToken_FullMethodName           = "/pb.Daemon/LoginWithToken"
-	Daemon_LoginOAuth2_FullMethodName              = "/pb.Daemon/LoginOAuth2"
-	Daemon_LoginOAuth2Callback_FullMethodName      = "/pb.Daemon/LoginOAuth2Callback"
-	Daemon_Logout_FullMethodName                   = "/pb.Daemon/Logout"
-	Daemon_AccountInfo_FullMethodName              = "/pb.Daemon/AccountInfo"
-	Daemon_TokenInfo_FullMethodName                = "/pb.Daemon/TokenInfo"
-	Daemon_ClaimOnlinePurchase_FullMethodName      = "/pb.Daemon/ClaimOnlinePurchase"
-	Daemon_Connect_FullMethodName                  = "/pb.Daemon/Connect"
-	Daemon_ConnectCancel_FullMethodName            = "/pb.Daemon/ConnectCancel"
-	Daemon_Disconnect_FullMethodName               = "/pb.Daemon/Disconnect"
-	Daemon_Status_FullMethodName                   = "/pb.Daemon/Status"
-	Daemon_RateConnection_FullMethodName           = "/pb.Daemon/RateConnection"
-	Daemon_PauseConnection_FullMethodName          = "/pb.Daemon/PauseConnection"
-	Daemon_GetServers_FullMethodName               = "/pb.Daemon/GetServers"
-	Daemon_Countries_FullMethodName                = "/pb.Daemon/Countries"
-	Daemon_Cities_FullMethodName                   = "/pb.Daemon/Cities"
-	Daemon_Groups_FullMethodName                   = "/pb.Daemon/Groups"
-	Daemon_RecommendedServer_FullMethodName        = "/pb.Daemon/RecommendedServer"
-	Daemon_Settings_FullMethodName                 = "/pb.Daemon/Settings"
-	Daemon_SetDefaults_FullMethodName              = "/pb.Daemon/SetDefaults"
-	Daemon_SetAutoConnect_FullMethodName           = "/pb.Daemon/SetAutoConnect"
-	Daemon_SetProtocol_FullMethodName              = "/pb.Daemon/SetProtocol"
-	Daemon_SetTechnology_FullMethodName            = "/pb.Daemon/SetTechnology"
-	Daemon_SetObfuscate_FullMethodName             = "/pb.Daemon/SetObfuscate"
-	Daemon_SetPostQuantum_FullMethodName           = "/pb.Daemon/SetPostQuantum"
-	Daemon_SetECH_FullMethodName                   = "/pb.Daemon/SetECH"
-	Daemon_GetRecentConnections_FullMethodName     = "/pb.Daemon/GetRecentConnections"
-	Daemon_SetDNS_FullMethodName                   = "/pb.Daemon/SetDNS"
-	Daemon_SetFirewall_FullMethodName              = "/pb.Daemon/SetFirewall"
-	Daemon_SetFirewallMark_FullMethodName          = "/pb.Daemon/SetFirewallMark"
-	Daemon_SetRouting_FullMethodName               = "/pb.Daemon/SetRouting"
-	Daemon_SetKillSwitch_FullMethodName            = "/pb.Daemon/SetKillSwitch"
-	Daemon_SetLANDiscovery_FullMethodName          = "/pb.Daemon/SetLANDiscovery"
-	Daemon_SetVirtualLocation_FullMethodName       = "/pb.Daemon/SetVirtualLocation"
-	Daemon_SetNotify_FullMethodName                = "/pb.Daemon/SetNotify"
-	Daemon_SetTray_FullMethodName                  = "/pb.Daemon/SetTray"
-	Daemon_SettingsProtocols_FullMethodName        = "/pb.Daemon/SettingsProtocols"
-	Daemon_SettingsTechnologies_FullMethodName     = "/pb.Daemon/SettingsTechnologies"
-	Daemon_GetFeatureToggles_FullMethodName        = "/pb.Daemon/GetFeatureToggles"
-	Daemon_SetAllowlist_FullMethodName             = "/pb.Daemon/SetAllowlist"
-	Daemon_SetARPIgnore_FullMethodName             = "/pb.Daemon/SetARPIgnore"
-	Daemon_UnsetAllowlist_FullMethodName           = "/pb.Daemon/UnsetAllowlist"
-	Daemon_UnsetAllAllowlist_FullMethodName        = "/pb.Daemon/UnsetAllAllowlist"
-	Daemon_SetAnalytics_FullMethodName             = "/pb.Daemon/SetAnalytics"
-	Daemon_SetThreatProtectionLite_FullMethodName  = "/pb.Daemon/SetThreatProtectionLite"
-	Daemon_Ping_FullMethodName                     = "/pb.Daemon/Ping"
-	Daemon_ReportUIEvent_FullMethodName            = "/pb.Daemon/ReportUIEvent"
-	Daemon_SubscribeToStateChanges_FullMethodName  = "/pb.Daemon/SubscribeToStateChanges"
-	Daemon_InjectVpnConnectionError_FullMethodName = "/pb.Daemon/InjectVpnConnectionError"
-	Daemon_CollectDiagnostics_FullMethodName       = "/pb.Daemon/CollectDiagnostics"
+	Daemon_IsLoggedIn_FullMethodName                         = "/pb.Daemon/IsLoggedIn"
+	Daemon_LoginWithToken_FullMethodName                     = "/pb.Daemon/LoginWithToken"
+	Daemon_LoginOAuth2_FullMethodName                        = "/pb.Daemon/LoginOAuth2"
+	Daemon_LoginOAuth2Callback_FullMethodName                = "/pb.Daemon/LoginOAuth2Callback"
+	Daemon_Logout_FullMethodName                             = "/pb.Daemon/Logout"
+	Daemon_AccountInfo_FullMethodName                        = "/pb.Daemon/AccountInfo"
+	Daemon_TokenInfo_FullMethodName                          = "/pb.Daemon/TokenInfo"
+	Daemon_ClaimOnlinePurchase_FullMethodName                = "/pb.Daemon/ClaimOnlinePurchase"
+	Daemon_Connect_FullMethodName                            = "/pb.Daemon/Connect"
+	Daemon_ConnectCancel_FullMethodName                      = "/pb.Daemon/ConnectCancel"
+	Daemon_Disconnect_FullMethodName                         = "/pb.Daemon/Disconnect"
+	Daemon_Status_FullMethodName                             = "/pb.Daemon/Status"
+	Daemon_RateConnection_FullMethodName                     = "/pb.Daemon/RateConnection"
+	Daemon_PauseConnection_FullMethodName                    = "/pb.Daemon/PauseConnection"
+	Daemon_GetServers_FullMethodName                         = "/pb.Daemon/GetServers"
+	Daemon_Countries_FullMethodName                          = "/pb.Daemon/Countries"
+	Daemon_Cities_FullMethodName                             = "/pb.Daemon/Cities"
+	Daemon_Groups_FullMethodName                             = "/pb.Daemon/Groups"
+	Daemon_RecommendedServer_FullMethodName                  = "/pb.Daemon/RecommendedServer"
+	Daemon_ExportVPNConfig_FullMethodName                    = "/pb.Daemon/ExportVPNConfig"
+	Daemon_Settings_FullMethodName                           = "/pb.Daemon/Settings"
+	Daemon_SetDefaults_FullMethodName                        = "/pb.Daemon/SetDefaults"
+	Daemon_ExportSettings_FullMethodName                     = "/pb.Daemon/ExportSettings"
+	Daemon_ApplySettings_FullMethodName                      = "/pb.Daemon/ApplySettings"
+	Daemon_SetAutoConnect_FullMethodName                     = "/pb.Daemon/SetAutoConnect"
+	Daemon_SetProtocol_FullMethodName                        = "/pb.Daemon/SetProtocol"
+	Daemon_SetTechnology_FullMethodName                      = "/pb.Daemon/SetTechnology"
+	Daemon_SetObfuscate_FullMethodName                       = "/pb.Daemon/SetObfuscate"
+	Daemon_SetPostQuantum_FullMethodName                     = "/pb.Daemon/SetPostQuantum"
+	Daemon_SetECH_FullMethodName                             = "/pb.Daemon/SetECH"
+	Daemon_GetRecentConnections_FullMethodName               = "/pb.Daemon/GetRecentConnections"
+	Daemon_GetConnectionHistory_FullMethodName               = "/pb.Daemon/GetConnectionHistory"
+	Daemon_ListFavorites_FullMethodName                      = "/pb.Daemon/ListFavorites"
+	Daemon_AddFavorite_FullMethodName                        = "/pb.Daemon/AddFavorite"
+	Daemon_RemoveFavorite_FullMethodName                     = "/pb.Daemon/RemoveFavorite"
+	Daemon_ConnectFavorite_FullMethodName                    = "/pb.Daemon/ConnectFavorite"
+	Daemon_SetDNS_FullMethodName                             = "/pb.Daemon/SetDNS"
+	Daemon_SetFirewall_FullMethodName                        = "/pb.Daemon/SetFirewall"
+	Daemon_SetFirewallMark_FullMethodName                    = "/pb.Daemon/SetFirewallMark"
+	Daemon_SetRouting_FullMethodName                         = "/pb.Daemon/SetRouting"
+	Daemon_SetKillSwitch_FullMethodName                      = "/pb.Daemon/SetKillSwitch"
+	Daemon_SetLANDiscovery_FullMethodName                    = "/pb.Daemon/SetLANDiscovery"
+	Daemon_SetVirtualLocation_FullMethodName                 = "/pb.Daemon/SetVirtualLocation"
+	Daemon_SetNotify_FullMethodName                          = "/pb.Daemon/SetNotify"
+	Daemon_SetTray_FullMethodName                            = "/pb.Daemon/SetTray"
+	Daemon_SettingsProtocols_FullMethodName                  = "/pb.Daemon/SettingsProtocols"
+	Daemon_SettingsTechnologies_FullMethodName               = "/pb.Daemon/SettingsTechnologies"
+	Daemon_GetFeatureToggles_FullMethodName                  = "/pb.Daemon/GetFeatureToggles"
+	Daemon_SetAllowlist_FullMethodName                       = "/pb.Daemon/SetAllowlist"
+	Daemon_SetARPIgnore_FullMethodName                       = "/pb.Daemon/SetARPIgnore"
+	Daemon_UnsetAllowlist_FullMethodName                     = "/pb.Daemon/UnsetAllowlist"
+	Daemon_UnsetAllAllowlist_FullMethodName                  = "/pb.Daemon/UnsetAllAllowlist"
+	Daemon_SetSplitTunnel_FullMethodName                     = "/pb.Daemon/SetSplitTunnel"
+	Daemon_UnsetSplitTunnel_FullMethodName                   = "/pb.Daemon/UnsetSplitTunnel"
+	Daemon_SetSplitTunnelMode_FullMethodName                 = "/pb.Daemon/SetSplitTunnelMode"
+	Daemon_AddScheduleRule_FullMethodName                    = "/pb.Daemon/AddScheduleRule"
+	Daemon_RemoveScheduleRule_FullMethodName                 = "/pb.Daemon/RemoveScheduleRule"
+	Daemon_AddTrustedNetwork_FullMethodName                  = "/pb.Daemon/AddTrustedNetwork"
+	Daemon_RemoveTrustedNetwork_FullMethodName               = "/pb.Daemon/RemoveTrustedNetwork"
+	Daemon_SetTrustedNetworksConnectUntrusted_FullMethodName = "/pb.Daemon/SetTrustedNetworksConnectUntrusted"
+	Daemon_CurrentNetwork_FullMethodName                     = "/pb.Daemon/CurrentNetwork"
+	Daemon_AddDNSRoute_FullMethodName                        = "/pb.Daemon/AddDNSRoute"
+	Daemon_RemoveDNSRoute_FullMethodName                     = "/pb.Daemon/RemoveDNSRoute"
+	Daemon_SetAnalytics_FullMethodName                       = "/pb.Daemon/SetAnalytics"
+	Daemon_SetThreatProtectionLite_FullMethodName            = "/pb.Daemon/SetThreatProtectionLite"
+	Daemon_Ping_FullMethodName                               = "/pb.Daemon/Ping"
+	Daemon_LeakTest_FullMethodName                           = "/pb.Daemon/LeakTest"
+	Daemon_ReportUIEvent_FullMethodName                      = "/pb.Daemon/ReportUIEvent"
+	Daemon_SubscribeToStateChanges_FullMethodName            = "/pb.Daemon/SubscribeToStateChanges"
+	Daemon_SetMetrics_FullMethodName                         = "/pb.Daemon/SetMetrics"
+	Daemon_SetFailover_FullMethodName                        = "/pb.Daemon/SetFailover"
+	Daemon_InjectVpnConnectionError_FullMethodName           = "/pb.Daemon/InjectVpnConnectionError"
+	Daemon_CollectDiagnostics_FullMethodName                 = "/pb.Daemon/CollectDiagnostics"
 )
 
 // DaemonClient is the client API for Daemon service.
@@ -99,9 +121,12 @@ type DaemonClient interface {
 	Cities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*ServerGroupsList, error)
 	Groups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerGroupsList, error)
 	RecommendedServer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RecommendedServerLocation, error)
+	ExportVPNConfig(ctx context.Context, in *ExportVPNConfigRequest, opts ...grpc.CallOption) (*ExportVPNConfigResponse, error)
 	// ==================== General Settings ====================
 	Settings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SettingsResponse, error)
 	SetDefaults(ctx context.Context, in *SetDefaultsRequest, opts ...grpc.CallOption) (*Payload, error)
+	ExportSettings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ExportSettingsResponse, error)
+	ApplySettings(ctx context.Context, in *ApplySettingsRequest, opts ...grpc.CallOption) (*ApplySettingsResponse, error)
 	// ==================== Connection Settings ====================
 	SetAutoConnect(ctx context.Context, in *SetAutoconnectRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetProtocol(ctx context.Context, in *SetProtocolRequest, opts ...grpc.CallOption) (*SetProtocolResponse, error)
@@ -110,6 +135,11 @@ type DaemonClient interface {
 	SetPostQuantum(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetECH(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	GetRecentConnections(ctx context.Context, in *RecentConnectionsRequest, opts ...grpc.CallOption) (*RecentConnectionsResponse, error)
+	GetConnectionHistory(ctx context.Context, in *ConnectionHistoryRequest, opts ...grpc.CallOption) (*ConnectionHistoryResponse, error)
+	ListFavorites(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FavoritesResponse, error)
+	AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*Payload, error)
+	RemoveFavorite(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*Payload, error)
+	ConnectFavorite(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Payload], error)
 	// ==================== Network Settings ====================
 	SetDNS(ctx context.Context, in *SetDNSRequest, opts ...grpc.CallOption) (*SetDNSResponse, error)
 	SetFirewall(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
@@ -130,13 +160,31 @@ type DaemonClient interface {
 	SetARPIgnore(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	UnsetAllowlist(ctx context.Context, in *SetAllowlistRequest, opts ...grpc.CallOption) (*Payload, error)
 	UnsetAllAllowlist(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
+	// ==================== Split Tunneling ====================
+	SetSplitTunnel(ctx context.Context, in *SetSplitTunnelRequest, opts ...grpc.CallOption) (*Payload, error)
+	UnsetSplitTunnel(ctx context.Context, in *SetSplitTunnelRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetSplitTunnelMode(ctx context.Context, in *SetSplitTunnelModeRequest, opts ...grpc.CallOption) (*Payload, error)
+	// ==================== Schedule ====================
+	AddScheduleRule(ctx context.Context, in *AddScheduleRuleRequest, opts ...grpc.CallOption) (*Payload, error)
+	RemoveScheduleRule(ctx context.Context, in *RemoveScheduleRuleRequest, opts ...grpc.CallOption) (*Payload, error)
+	// ==================== Trusted Networks ====================
+	AddTrustedNetwork(ctx context.Context, in *AddTrustedNetworkRequest, opts ...grpc.CallOption) (*Payload, error)
+	RemoveTrustedNetwork(ctx context.Context, in *RemoveTrustedNetworkRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetTrustedNetworksConnectUntrusted(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	CurrentNetwork(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CurrentNetworkResponse, error)
+	// ==================== DNS Routes ====================
+	AddDNSRoute(ctx context.Context, in *AddDNSRouteRequest, opts ...grpc.CallOption) (*Payload, error)
+	RemoveDNSRoute(ctx context.Context, in *RemoveDNSRouteRequest, opts ...grpc.CallOption) (*Payload, error)
 	// ==================== Privacy & Security ====================
 	SetAnalytics(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetThreatProtectionLite(ctx context.Context, in *SetThreatProtectionLiteRequest, opts ...grpc.CallOption) (*SetThreatProtectionLiteResponse, error)
 	// ==================== System & Monitoring ====================
 	Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PingResponse, error)
+	LeakTest(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LeakTestResponse, error)
 	ReportUIEvent(ctx context.Context, in *UIEvent, opts ...grpc.CallOption) (*Payload, error)
 	SubscribeToStateChanges(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AppState], error)
+	SetMetrics(ctx context.Context, in *SetMetricsRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetFailover(ctx context.Context, in *SetFailoverRequest, opts ...grpc.CallOption) (*Payload, error)
 	// InjectVpnConnectionError is a DEV-only endpoint that injects a simulated ENS event
 	InjectVpnConnectionError(ctx context.Context, in *InjectVpnConnectionErrorRequest, opts ...grpc.CallOption) (*Payload, error)
 	// ==================== Diagnostics ====================
@@ -359,6 +407,16 @@ func (c *daemonClient) RecommendedServer(ctx context.Context, in *Empty, opts ..
 	return out, nil
 }
 
+func (c *daemonClient) ExportVPNConfig(ctx context.Context, in *ExportVPNConfigRequest, opts ...grpc.CallOption) (*ExportVPNConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportVPNConfigResponse)
+	err := c.cc.Invoke(ctx, Daemon_ExportVPNConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Settings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SettingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SettingsResponse)
@@ -379,6 +437,26 @@ func (c *daemonClient) SetDefaults(ctx context.Context, in *SetDefaultsRequest,
 	return out, nil
 }
 
+func (c *daemonClient) ExportSettings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ExportSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportSettingsResponse)
+	err := c.cc.Invoke(ctx, Daemon_ExportSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ApplySettings(ctx context.Context, in *ApplySettingsRequest, opts ...grpc.CallOption) (*ApplySettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplySettingsResponse)
+	err := c.cc.Invoke(ctx, Daemon_ApplySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) SetAutoConnect(ctx context.Context, in *SetAutoconnectRequest, opts ...grpc.CallOption) (*Payload, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Payload)
@@ -449,6 +527,65 @@ func (c *daemonClient) GetRecentConnections(ctx context.Context, in *RecentConne
 	return out, nil
 }
 
+func (c *daemonClient) GetConnectionHistory(ctx context.Context, in *ConnectionHistoryRequest, opts ...grpc.CallOption) (*ConnectionHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConnectionHistoryResponse)
+	err := c.cc.Invoke(ctx, Daemon_GetConnectionHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ListFavorites(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FavoritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavoritesResponse)
+	err := c.cc.Invoke(ctx, Daemon_ListFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_AddFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RemoveFavorite(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_RemoveFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectFavorite(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Payload], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[2], Daemon_ConnectFavorite_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[FavoriteRequest, Payload]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Daemon_ConnectFavoriteClient = grpc.ServerStreamingClient[Payload]
+
 func (c *daemonClient) SetDNS(ctx context.Context, in *SetDNSRequest, opts ...grpc.CallOption) (*SetDNSResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetDNSResponse)
@@ -609,6 +746,116 @@ func (c *daemonClient) UnsetAllAllowlist(ctx context.Context, in *Empty, opts ..
 	return out, nil
 }
 
+func (c *daemonClient) SetSplitTunnel(ctx context.Context, in *SetSplitTunnelRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_SetSplitTunnel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) UnsetSplitTunnel(ctx context.Context, in *SetSplitTunnelRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_UnsetSplitTunnel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetSplitTunnelMode(ctx context.Context, in *SetSplitTunnelModeRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_SetSplitTunnelMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) AddScheduleRule(ctx context.Context, in *AddScheduleRuleRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_AddScheduleRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RemoveScheduleRule(ctx context.Context, in *RemoveScheduleRuleRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_RemoveScheduleRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) AddTrustedNetwork(ctx context.Context, in *AddTrustedNetworkRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_AddTrustedNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RemoveTrustedNetwork(ctx context.Context, in *RemoveTrustedNetworkRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_RemoveTrustedNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetTrustedNetworksConnectUntrusted(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_SetTrustedNetworksConnectUntrusted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) CurrentNetwork(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CurrentNetworkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CurrentNetworkResponse)
+	err := c.cc.Invoke(ctx, Daemon_CurrentNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) AddDNSRoute(ctx context.Context, in *AddDNSRouteRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_AddDNSRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RemoveDNSRoute(ctx context.Context, in *RemoveDNSRouteRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_RemoveDNSRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) SetAnalytics(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Payload)
@@ -639,6 +886,16 @@ func (c *daemonClient) Ping(ctx context.Context, in *Empty, opts ...grpc.CallOpt
 	return out, nil
 }
 
+func (c *daemonClient) LeakTest(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LeakTestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeakTestResponse)
+	err := c.cc.Invoke(ctx, Daemon_LeakTest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) ReportUIEvent(ctx context.Context, in *UIEvent, opts ...grpc.CallOption) (*Payload, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Payload)
@@ -651,7 +908,7 @@ func (c *daemonClient) ReportUIEvent(ctx context.Context, in *UIEvent, opts ...g
 
 func (c *daemonClient) SubscribeToStateChanges(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AppState], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[2], Daemon_SubscribeToStateChanges_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[3], Daemon_SubscribeToStateChanges_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -668,6 +925,26 @@ func (c *daemonClient) SubscribeToStateChanges(ctx context.Context, in *Empty, o
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Daemon_SubscribeToStateChangesClient = grpc.ServerStreamingClient[AppState]
 
+func (c *daemonClient) SetMetrics(ctx context.Context, in *SetMetricsRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_SetMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetFailover(ctx context.Context, in *SetFailoverRequest, opts ...grpc.CallOption) (*Payload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, Daemon_SetFailover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) InjectVpnConnectionError(ctx context.Context, in *InjectVpnConnectionErrorRequest, opts ...grpc.CallOption) (*Payload, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Payload)
@@ -680,7 +957,7 @@ func (c *daemonClient) InjectVpnConnectionError(ctx context.Context, in *InjectV
 
 func (c *daemonClient) CollectDiagnostics(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiagnosticsProgress], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[3], Daemon_CollectDiagnostics_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[4], Daemon_CollectDiagnostics_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -724,9 +1001,12 @@ type DaemonServer interface {
 	Cities(context.Context, *CitiesRequest) (*ServerGroupsList, error)
 	Groups(context.Context, *Empty) (*ServerGroupsList, error)
 	RecommendedServer(context.Context, *Empty) (*RecommendedServerLocation, error)
+	ExportVPNConfig(context.Context, *ExportVPNConfigRequest) (*ExportVPNConfigResponse, error)
 	// ==================== General Settings ====================
 	Settings(context.Context, *Empty) (*SettingsResponse, error)
 	SetDefaults(context.Context, *SetDefaultsRequest) (*Payload, error)
+	ExportSettings(context.Context, *Empty) (*ExportSettingsResponse, error)
+	ApplySettings(context.Context, *ApplySettingsRequest) (*ApplySettingsResponse, error)
 	// ==================== Connection Settings ====================
 	SetAutoConnect(context.Context, *SetAutoconnectRequest) (*Payload, error)
 	SetProtocol(context.Context, *SetProtocolRequest) (*SetProtocolResponse, error)
@@ -735,6 +1015,11 @@ type DaemonServer interface {
 	SetPostQuantum(context.Context, *SetGenericRequest) (*Payload, error)
 	SetECH(context.Context, *SetGenericRequest) (*Payload, error)
 	GetRecentConnections(context.Context, *RecentConnectionsRequest) (*RecentConnectionsResponse, error)
+	GetConnectionHistory(context.Context, *ConnectionHistoryRequest) (*ConnectionHistoryResponse, error)
+	ListFavorites(context.Context, *Empty) (*FavoritesResponse, error)
+	AddFavorite(context.Context, *AddFavoriteRequest) (*Payload, error)
+	RemoveFavorite(context.Context, *FavoriteRequest) (*Payload, error)
+	ConnectFavorite(*FavoriteRequest, grpc.ServerStreamingServer[Payload]) error
 	// ==================== Network Settings ====================
 	SetDNS(context.Context, *SetDNSRequest) (*SetDNSResponse, error)
 	SetFirewall(context.Context, *SetGenericRequest) (*Payload, error)
@@ -755,13 +1040,31 @@ type DaemonServer interface {
 	SetARPIgnore(context.Context, *SetGenericRequest) (*Payload, error)
 	UnsetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error)
 	UnsetAllAllowlist(context.Context, *Empty) (*Payload, error)
+	// ==================== Split Tunneling ====================
+	SetSplitTunnel(context.Context, *SetSplitTunnelRequest) (*Payload, error)
+	UnsetSplitTunnel(context.Context, *SetSplitTunnelRequest) (*Payload, error)
+	SetSplitTunnelMode(context.Context, *SetSplitTunnelModeRequest) (*Payload, error)
+	// ==================== Schedule ====================
+	AddScheduleRule(context.Context, *AddScheduleRuleRequest) (*Payload, error)
+	RemoveScheduleRule(context.Context, *RemoveScheduleRuleRequest) (*Payload, error)
+	// ==================== Trusted Networks ====================
+	AddTrustedNetwork(context.Context, *AddTrustedNetworkRequest) (*Payload, error)
+	RemoveTrustedNetwork(context.Context, *RemoveTrustedNetworkRequest) (*Payload, error)
+	SetTrustedNetworksConnectUntrusted(context.Context, *SetGenericRequest) (*Payload, error)
+	CurrentNetwork(context.Context, *Empty) (*CurrentNetworkResponse, error)
+	// ==================== DNS Routes ====================
+	AddDNSRoute(context.Context, *AddDNSRouteRequest) (*Payload, error)
+	RemoveDNSRoute(context.Context, *RemoveDNSRouteRequest) (*Payload, error)
 	// ==================== Privacy & Security ====================
 	SetAnalytics(context.Context, *SetGenericRequest) (*Payload, error)
 	SetThreatProtectionLite(context.Context, *SetThreatProtectionLiteRequest) (*SetThreatProtectionLiteResponse, error)
 	// ==================== System & Monitoring ====================
 	Ping(context.Context, *Empty) (*PingResponse, error)
+	LeakTest(context.Context, *Empty) (*LeakTestResponse, error)
 	ReportUIEvent(context.Context, *UIEvent) (*Payload, error)
 	SubscribeToStateChanges(*Empty, grpc.ServerStreamingServer[AppState]) error
+	SetMetrics(context.Context, *SetMetricsRequest) (*Payload, error)
+	SetFailover(context.Context, *SetFailoverRequest) (*Payload, error)
 	// InjectVpnConnectionError is a DEV-only endpoint that injects a simulated ENS event
 	InjectVpnConnectionError(context.Context, *InjectVpnConnectionErrorRequest) (*Payload, error)
 	// ==================== Diagnostics ====================
@@ -833,12 +1136,21 @@ func (UnimplementedDaemonServer) Groups(context.Context, *Empty) (*ServerGroupsL
 func (UnimplementedDaemonServer) RecommendedServer(context.Context, *Empty) (*RecommendedServerLocation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecommendedServer not implemented")
 }
+func (UnimplementedDaemonServer) ExportVPNConfig(context.Context, *ExportVPNConfigRequest) (*ExportVPNConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportVPNConfig not implemented")
+}
 func (UnimplementedDaemonServer) Settings(context.Context, *Empty) (*SettingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Settings not implemented")
 }
 func (UnimplementedDaemonServer) SetDefaults(context.Context, *SetDefaultsRequest) (*Payload, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDefaults not implemented")
 }
+func (UnimplementedDaemonServer) ExportSettings(context.Context, *Empty) (*ExportSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSettings not implemented")
+}
+func (UnimplementedDaemonServer) ApplySettings(context.Context, *ApplySettingsRequest) (*ApplySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySettings not implemented")
+}
 func (UnimplementedDaemonServer) SetAutoConnect(context.Context, *SetAutoconnectRequest) (*Payload, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAutoConnect not implemented")
 }
@@ -860,6 +1172,21 @@ func (UnimplementedDaemonServer) SetECH(context.Context, *SetGenericRequest) (*P
 func (UnimplementedDaemonServer) GetRecentConnections(context.Context, *RecentConnectionsRequest) (*RecentConnectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRecentConnections not implemented")
 }
+func (UnimplementedDaemonServer) GetConnectionHistory(context.Context, *ConnectionHistoryRequest) (*ConnectionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectionHistory not implemented")
+}
+func (UnimplementedDaemonServer) ListFavorites(context.Context, *Empty) (*FavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedDaemonServer) AddFavorite(context.Context, *AddFavoriteRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavorite not implemented")
+}
+func (UnimplementedDaemonServer) RemoveFavorite(context.Context, *FavoriteRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavorite not implemented")
+}
+func (UnimplementedDaemonServer) ConnectFavorite(*FavoriteRequest, grpc.ServerStreamingServer[Payload]) error {
+	return status.Errorf(codes.Unimplemented, "method ConnectFavorite not implemented")
+}
 func (UnimplementedDaemonServer) SetDNS(context.Context, *SetDNSRequest) (*SetDNSResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDNS not implemented")
 }
@@ -908,6 +1235,39 @@ func (UnimplementedDaemonServer) UnsetAllowlist(context.Context, *SetAllowlistRe
 func (UnimplementedDaemonServer) UnsetAllAllowlist(context.Context, *Empty) (*Payload, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsetAllAllowlist not implemented")
 }
+func (UnimplementedDaemonServer) SetSplitTunnel(context.Context, *SetSplitTunnelRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSplitTunnel not implemented")
+}
+func (UnimplementedDaemonServer) UnsetSplitTunnel(context.Context, *SetSplitTunnelRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsetSplitTunnel not implemented")
+}
+func (UnimplementedDaemonServer) SetSplitTunnelMode(context.Context, *SetSplitTunnelModeRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSplitTunnelMode not implemented")
+}
+func (UnimplementedDaemonServer) AddScheduleRule(context.Context, *AddScheduleRuleRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScheduleRule not implemented")
+}
+func (UnimplementedDaemonServer) RemoveScheduleRule(context.Context, *RemoveScheduleRuleRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveScheduleRule not implemented")
+}
+func (UnimplementedDaemonServer) AddTrustedNetwork(context.Context, *AddTrustedNetworkRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTrustedNetwork not implemented")
+}
+func (UnimplementedDaemonServer) RemoveTrustedNetwork(context.Context, *RemoveTrustedNetworkRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTrustedNetwork not implemented")
+}
+func (UnimplementedDaemonServer) SetTrustedNetworksConnectUntrusted(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrustedNetworksConnectUntrusted not implemented")
+}
+func (UnimplementedDaemonServer) CurrentNetwork(context.Context, *Empty) (*CurrentNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentNetwork not implemented")
+}
+func (UnimplementedDaemonServer) AddDNSRoute(context.Context, *AddDNSRouteRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDNSRoute not implemented")
+}
+func (UnimplementedDaemonServer) RemoveDNSRoute(context.Context, *RemoveDNSRouteRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDNSRoute not implemented")
+}
 func (UnimplementedDaemonServer) SetAnalytics(context.Context, *SetGenericRequest) (*Payload, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAnalytics not implemented")
 }
@@ -917,12 +1277,21 @@ func (UnimplementedDaemonServer) SetThreatProtectionLite(context.Context, *SetTh
 func (UnimplementedDaemonServer) Ping(context.Context, *Empty) (*PingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
+func (UnimplementedDaemonServer) LeakTest(context.Context, *Empty) (*LeakTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeakTest not implemented")
+}
 func (UnimplementedDaemonServer) ReportUIEvent(context.Context, *UIEvent) (*Payload, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportUIEvent not implemented")
 }
 func (UnimplementedDaemonServer) SubscribeToStateChanges(*Empty, grpc.ServerStreamingServer[AppState]) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeToStateChanges not implemented")
 }
+func (UnimplementedDaemonServer) SetMetrics(context.Context, *SetMetricsRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMetrics not implemented")
+}
+func (UnimplementedDaemonServer) SetFailover(context.Context, *SetFailoverRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFailover not implemented")
+}
 func (UnimplementedDaemonServer) InjectVpnConnectionError(context.Context, *InjectVpnConnectionErrorRequest) (*Payload, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InjectVpnConnectionError not implemented")
 }
@@ -1278,6 +1647,24 @@ func _Daemon_RecommendedServer_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_ExportVPNConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportVPNConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ExportVPNConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ExportVPNConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ExportVPNConfig(ctx, req.(*ExportVPNConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_Settings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -1314,6 +1701,42 @@ func _Daemon_SetDefaults_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_ExportSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ExportSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ExportSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ExportSettings(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ApplySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ApplySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ApplySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ApplySettings(ctx, req.(*ApplySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_SetAutoConnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetAutoconnectRequest)
 	if err := dec(in); err != nil {
@@ -1440,62 +1863,145 @@ func _Daemon_GetRecentConnections_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetDNS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetDNSRequest)
+func _Daemon_GetConnectionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetDNS(ctx, in)
+		return srv.(DaemonServer).GetConnectionHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Daemon_SetDNS_FullMethodName,
+		FullMethod: Daemon_GetConnectionHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetDNS(ctx, req.(*SetDNSRequest))
+		return srv.(DaemonServer).GetConnectionHistory(ctx, req.(*ConnectionHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetFirewall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGenericRequest)
+func _Daemon_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetFirewall(ctx, in)
+		return srv.(DaemonServer).ListFavorites(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Daemon_SetFirewall_FullMethodName,
+		FullMethod: Daemon_ListFavorites_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetFirewall(ctx, req.(*SetGenericRequest))
+		return srv.(DaemonServer).ListFavorites(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetFirewallMark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUint32Request)
+func _Daemon_AddFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavoriteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetFirewallMark(ctx, in)
+		return srv.(DaemonServer).AddFavorite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Daemon_SetFirewallMark_FullMethodName,
+		FullMethod: Daemon_AddFavorite_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetFirewallMark(ctx, req.(*SetUint32Request))
+		return srv.(DaemonServer).AddFavorite(ctx, req.(*AddFavoriteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGenericRequest)
+func _Daemon_RemoveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).RemoveFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_RemoveFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RemoveFavorite(ctx, req.(*FavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectFavorite_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FavoriteRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).ConnectFavorite(m, &grpc.GenericServerStream[FavoriteRequest, Payload]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Daemon_ConnectFavoriteServer = grpc.ServerStreamingServer[Payload]
+
+func _Daemon_SetDNS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDNSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetDNS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetDNS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetDNS(ctx, req.(*SetDNSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetFirewall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetFirewall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetFirewall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetFirewall(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetFirewallMark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUint32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetFirewallMark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetFirewallMark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetFirewallMark(ctx, req.(*SetUint32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1728,6 +2234,204 @@ func _Daemon_UnsetAllAllowlist_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_SetSplitTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSplitTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetSplitTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetSplitTunnel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetSplitTunnel(ctx, req.(*SetSplitTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_UnsetSplitTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSplitTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).UnsetSplitTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_UnsetSplitTunnel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).UnsetSplitTunnel(ctx, req.(*SetSplitTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetSplitTunnelMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSplitTunnelModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetSplitTunnelMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetSplitTunnelMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetSplitTunnelMode(ctx, req.(*SetSplitTunnelModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_AddScheduleRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddScheduleRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AddScheduleRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_AddScheduleRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AddScheduleRule(ctx, req.(*AddScheduleRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_RemoveScheduleRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveScheduleRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).RemoveScheduleRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_RemoveScheduleRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RemoveScheduleRule(ctx, req.(*RemoveScheduleRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_AddTrustedNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTrustedNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AddTrustedNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_AddTrustedNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AddTrustedNetwork(ctx, req.(*AddTrustedNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_RemoveTrustedNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTrustedNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).RemoveTrustedNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_RemoveTrustedNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RemoveTrustedNetwork(ctx, req.(*RemoveTrustedNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetTrustedNetworksConnectUntrusted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetTrustedNetworksConnectUntrusted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetTrustedNetworksConnectUntrusted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetTrustedNetworksConnectUntrusted(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_CurrentNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CurrentNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_CurrentNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CurrentNetwork(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_AddDNSRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDNSRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AddDNSRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_AddDNSRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AddDNSRoute(ctx, req.(*AddDNSRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_RemoveDNSRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDNSRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).RemoveDNSRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_RemoveDNSRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RemoveDNSRoute(ctx, req.(*RemoveDNSRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_SetAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetGenericRequest)
 	if err := dec(in); err != nil {
@@ -1782,6 +2486,24 @@ func _Daemon_Ping_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_LeakTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).LeakTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_LeakTest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).LeakTest(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_ReportUIEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UIEvent)
 	if err := dec(in); err != nil {
@@ -1811,6 +2533,42 @@ func _Daemon_SubscribeToStateChanges_Handler(srv interface{}, stream grpc.Server
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Daemon_SubscribeToStateChangesServer = grpc.ServerStreamingServer[AppState]
 
+func _Daemon_SetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetMetrics(ctx, req.(*SetMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetFailover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFailoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetFailover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetFailover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetFailover(ctx, req.(*SetFailoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_InjectVpnConnectionError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InjectVpnConnectionErrorRequest)
 	if err := dec(in); err != nil {
@@ -1915,6 +2673,10 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecommendedServer",
 			Handler:    _Daemon_RecommendedServer_Handler,
 		},
+		{
+			MethodName: "ExportVPNConfig",
+			Handler:    _Daemon_ExportVPNConfig_Handler,
+		},
 		{
 			MethodName: "Settings",
 			Handler:    _Daemon_Settings_Handler,
@@ -1923,6 +2685,14 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetDefaults",
 			Handler:    _Daemon_SetDefaults_Handler,
 		},
+		{
+			MethodName: "ExportSettings",
+			Handler:    _Daemon_ExportSettings_Handler,
+		},
+		{
+			MethodName: "ApplySettings",
+			Handler:    _Daemon_ApplySettings_Handler,
+		},
 		{
 			MethodName: "SetAutoConnect",
 			Handler:    _Daemon_SetAutoConnect_Handler,
@@ -1951,6 +2721,22 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRecentConnections",
 			Handler:    _Daemon_GetRecentConnections_Handler,
 		},
+		{
+			MethodName: "GetConnectionHistory",
+			Handler:    _Daemon_GetConnectionHistory_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _Daemon_ListFavorites_Handler,
+		},
+		{
+			MethodName: "AddFavorite",
+			Handler:    _Daemon_AddFavorite_Handler,
+		},
+		{
+			MethodName: "RemoveFavorite",
+			Handler:    _Daemon_RemoveFavorite_Handler,
+		},
 		{
 			MethodName: "SetDNS",
 			Handler:    _Daemon_SetDNS_Handler,
@@ -2015,6 +2801,50 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnsetAllAllowlist",
 			Handler:    _Daemon_UnsetAllAllowlist_Handler,
 		},
+		{
+			MethodName: "SetSplitTunnel",
+			Handler:    _Daemon_SetSplitTunnel_Handler,
+		},
+		{
+			MethodName: "UnsetSplitTunnel",
+			Handler:    _Daemon_UnsetSplitTunnel_Handler,
+		},
+		{
+			MethodName: "SetSplitTunnelMode",
+			Handler:    _Daemon_SetSplitTunnelMode_Handler,
+		},
+		{
+			MethodName: "AddScheduleRule",
+			Handler:    _Daemon_AddScheduleRule_Handler,
+		},
+		{
+			MethodName: "RemoveScheduleRule",
+			Handler:    _Daemon_RemoveScheduleRule_Handler,
+		},
+		{
+			MethodName: "AddTrustedNetwork",
+			Handler:    _Daemon_AddTrustedNetwork_Handler,
+		},
+		{
+			MethodName: "RemoveTrustedNetwork",
+			Handler:    _Daemon_RemoveTrustedNetwork_Handler,
+		},
+		{
+			MethodName: "SetTrustedNetworksConnectUntrusted",
+			Handler:    _Daemon_SetTrustedNetworksConnectUntrusted_Handler,
+		},
+		{
+			MethodName: "CurrentNetwork",
+			Handler:    _Daemon_CurrentNetwork_Handler,
+		},
+		{
+			MethodName: "AddDNSRoute",
+			Handler:    _Daemon_AddDNSRoute_Handler,
+		},
+		{
+			MethodName: "RemoveDNSRoute",
+			Handler:    _Daemon_RemoveDNSRoute_Handler,
+		},
 		{
 			MethodName: "SetAnalytics",
 			Handler:    _Daemon_SetAnalytics_Handler,
@@ -2027,10 +2857,22 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _Daemon_Ping_Handler,
 		},
+		{
+			MethodName: "LeakTest",
+			Handler:    _Daemon_LeakTest_Handler,
+		},
 		{
 			MethodName: "ReportUIEvent",
 			Handler:    _Daemon_ReportUIEvent_Handler,
 		},
+		{
+			MethodName: "SetMetrics",
+			Handler:    _Daemon_SetMetrics_Handler,
+		},
+		{
+			MethodName: "SetFailover",
+			Handler:    _Daemon_SetFailover_Handler,
+		},
 		{
 			MethodName: "InjectVpnConnectionError",
 			Handler:    _Daemon_InjectVpnConnectionError_Handler,
@@ -2047,6 +2889,11 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Daemon_Disconnect_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ConnectFavorite",
+			Handler:       _Daemon_ConnectFavorite_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeToStateChanges",
 			Handler:       _Daemon_SubscribeToStateChanges_Handler,
@@ -24,9 +24,10 @@ const (
 type SetErrorCode int32
 
 const (
-	SetErrorCode_FAILURE      SetErrorCode = 0
-	SetErrorCode_CONFIG_ERROR SetErrorCode = 1
-	SetErrorCode_ALREADY_SET  SetErrorCode = 2
+	SetErrorCode_FAILURE       SetErrorCode = 0
+	SetErrorCode_CONFIG_ERROR  SetErrorCode = 1
+	SetErrorCode_ALREADY_SET   SetErrorCode = 2
+	SetErrorCode_POLICY_LOCKED SetErrorCode = 3
 )
 
 // Enum value maps for SetErrorCode.
@@ -35,11 +36,13 @@ var (
 		0: "FAILURE",
 		1: "CONFIG_ERROR",
 		2: "ALREADY_SET",
+		3: "POLICY_LOCKED",
 	}
 	SetErrorCode_value = map[string]int32{
-		"FAILURE":      0,
-		"CONFIG_ERROR": 1,
-		"ALREADY_SET":  2,
+		"FAILURE":       0,
+		"CONFIG_ERROR":  1,
+		"ALREADY_SET":   2,
+		"POLICY_LOCKED": 3,
 	}
 )
 
@@ -369,6 +372,130 @@ func (x *SetGenericRequest) GetEnabled() bool {
 	return false
 }
 
+type SetMetricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// listen is an absolute unix socket path or a loopback host:port, configured address is kept when empty
+	Listen string `protobuf:"bytes,2,opt,name=listen,proto3" json:"listen,omitempty"`
+}
+
+func (x *SetMetricsRequest) Reset() {
+	*x = SetMetricsRequest{}
+	mi := &file_set_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMetricsRequest) ProtoMessage() {}
+
+func (x *SetMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMetricsRequest.ProtoReflect.Descriptor instead.
+func (*SetMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetMetricsRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMetricsRequest) GetListen() string {
+	if x != nil {
+		return x.Listen
+	}
+	return ""
+}
+
+type SetFailoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// thresholds are kept unchanged when not set, zero resets them to defaults
+	MaxHandshakeAgeSec *int64  `protobuf:"varint,2,opt,name=max_handshake_age_sec,json=maxHandshakeAgeSec,proto3,oneof" json:"max_handshake_age_sec,omitempty"`
+	MaxPacketLoss      *uint32 `protobuf:"varint,3,opt,name=max_packet_loss,json=maxPacketLoss,proto3,oneof" json:"max_packet_loss,omitempty"`
+	MaxLatencyMs       *int64  `protobuf:"varint,4,opt,name=max_latency_ms,json=maxLatencyMs,proto3,oneof" json:"max_latency_ms,omitempty"`
+}
+
+func (x *SetFailoverRequest) Reset() {
+	*x = SetFailoverRequest{}
+	mi := &file_set_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFailoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFailoverRequest) ProtoMessage() {}
+
+func (x *SetFailoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFailoverRequest.ProtoReflect.Descriptor instead.
+func (*SetFailoverRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetFailoverRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetFailoverRequest) GetMaxHandshakeAgeSec() int64 {
+	if x != nil && x.MaxHandshakeAgeSec != nil {
+		return *x.MaxHandshakeAgeSec
+	}
+	return 0
+}
+
+func (x *SetFailoverRequest) GetMaxPacketLoss() uint32 {
+	if x != nil && x.MaxPacketLoss != nil {
+		return *x.MaxPacketLoss
+	}
+	return 0
+}
+
+func (x *SetFailoverRequest) GetMaxLatencyMs() int64 {
+	if x != nil && x.MaxLatencyMs != nil {
+		return *x.MaxLatencyMs
+	}
+	return 0
+}
+
 type SetUint32Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -379,7 +506,7 @@ type SetUint32Request struct {
 
 func (x *SetUint32Request) Reset() {
 	*x = SetUint32Request{}
-	mi := &file_set_proto_msgTypes[2]
+	mi := &file_set_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -391,7 +518,7 @@ func (x *SetUint32Request) String() string {
 func (*SetUint32Request) ProtoMessage() {}
 
 func (x *SetUint32Request) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[2]
+	mi := &file_set_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,7 +531,7 @@ func (x *SetUint32Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetUint32Request.ProtoReflect.Descriptor instead.
 func (*SetUint32Request) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{2}
+	return file_set_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SetUint32Request) GetValue() uint32 {
@@ -424,7 +551,7 @@ type SetThreatProtectionLiteRequest struct {
 
 func (x *SetThreatProtectionLiteRequest) Reset() {
 	*x = SetThreatProtectionLiteRequest{}
-	mi := &file_set_proto_msgTypes[3]
+	mi := &file_set_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -436,7 +563,7 @@ func (x *SetThreatProtectionLiteRequest) String() string {
 func (*SetThreatProtectionLiteRequest) ProtoMessage() {}
 
 func (x *SetThreatProtectionLiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[3]
+	mi := &file_set_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +576,7 @@ func (x *SetThreatProtectionLiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetThreatProtectionLiteRequest.ProtoReflect.Descriptor instead.
 func (*SetThreatProtectionLiteRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{3}
+	return file_set_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SetThreatProtectionLiteRequest) GetThreatProtectionLite() bool {
@@ -473,7 +600,7 @@ type SetThreatProtectionLiteResponse struct {
 
 func (x *SetThreatProtectionLiteResponse) Reset() {
 	*x = SetThreatProtectionLiteResponse{}
-	mi := &file_set_proto_msgTypes[4]
+	mi := &file_set_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +612,7 @@ func (x *SetThreatProtectionLiteResponse) String() string {
 func (*SetThreatProtectionLiteResponse) ProtoMessage() {}
 
 func (x *SetThreatProtectionLiteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[4]
+	mi := &file_set_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +625,7 @@ func (x *SetThreatProtectionLiteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetThreatProtectionLiteResponse.ProtoReflect.Descriptor instead.
 func (*SetThreatProtectionLiteResponse) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{4}
+	return file_set_proto_rawDescGZIP(), []int{6}
 }
 
 func (m *SetThreatProtectionLiteResponse) GetResponse() isSetThreatProtectionLiteResponse_Response {
@@ -550,7 +677,7 @@ type SetDNSRequest struct {
 
 func (x *SetDNSRequest) Reset() {
 	*x = SetDNSRequest{}
-	mi := &file_set_proto_msgTypes[5]
+	mi := &file_set_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -562,7 +689,7 @@ func (x *SetDNSRequest) String() string {
 func (*SetDNSRequest) ProtoMessage() {}
 
 func (x *SetDNSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[5]
+	mi := &file_set_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,7 +702,7 @@ func (x *SetDNSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDNSRequest.ProtoReflect.Descriptor instead.
 func (*SetDNSRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{5}
+	return file_set_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SetDNSRequest) GetDns() []string {
@@ -606,7 +733,7 @@ type SetDNSResponse struct {
 
 func (x *SetDNSResponse) Reset() {
 	*x = SetDNSResponse{}
-	mi := &file_set_proto_msgTypes[6]
+	mi := &file_set_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +745,7 @@ func (x *SetDNSResponse) String() string {
 func (*SetDNSResponse) ProtoMessage() {}
 
 func (x *SetDNSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[6]
+	mi := &file_set_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +758,7 @@ func (x *SetDNSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDNSResponse.ProtoReflect.Descriptor instead.
 func (*SetDNSResponse) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{6}
+	return file_set_proto_rawDescGZIP(), []int{8}
 }
 
 func (m *SetDNSResponse) GetResponse() isSetDNSResponse_Response {
@@ -681,7 +808,7 @@ type SetKillSwitchRequest struct {
 
 func (x *SetKillSwitchRequest) Reset() {
 	*x = SetKillSwitchRequest{}
-	mi := &file_set_proto_msgTypes[7]
+	mi := &file_set_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -693,7 +820,7 @@ func (x *SetKillSwitchRequest) String() string {
 func (*SetKillSwitchRequest) ProtoMessage() {}
 
 func (x *SetKillSwitchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[7]
+	mi := &file_set_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +833,7 @@ func (x *SetKillSwitchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetKillSwitchRequest.ProtoReflect.Descriptor instead.
 func (*SetKillSwitchRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{7}
+	return file_set_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SetKillSwitchRequest) GetKillSwitch() bool {
@@ -726,7 +853,7 @@ type SetNotifyRequest struct {
 
 func (x *SetNotifyRequest) Reset() {
 	*x = SetNotifyRequest{}
-	mi := &file_set_proto_msgTypes[8]
+	mi := &file_set_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -738,7 +865,7 @@ func (x *SetNotifyRequest) String() string {
 func (*SetNotifyRequest) ProtoMessage() {}
 
 func (x *SetNotifyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[8]
+	mi := &file_set_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +878,7 @@ func (x *SetNotifyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNotifyRequest.ProtoReflect.Descriptor instead.
 func (*SetNotifyRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{8}
+	return file_set_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SetNotifyRequest) GetNotify() bool {
@@ -771,7 +898,7 @@ type SetTrayRequest struct {
 
 func (x *SetTrayRequest) Reset() {
 	*x = SetTrayRequest{}
-	mi := &file_set_proto_msgTypes[9]
+	mi := &file_set_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -783,7 +910,7 @@ func (x *SetTrayRequest) String() string {
 func (*SetTrayRequest) ProtoMessage() {}
 
 func (x *SetTrayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[9]
+	mi := &file_set_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,7 +923,7 @@ func (x *SetTrayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetTrayRequest.ProtoReflect.Descriptor instead.
 func (*SetTrayRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{9}
+	return file_set_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SetTrayRequest) GetTray() bool {
@@ -816,7 +943,7 @@ type SetProtocolRequest struct {
 
 func (x *SetProtocolRequest) Reset() {
 	*x = SetProtocolRequest{}
-	mi := &file_set_proto_msgTypes[10]
+	mi := &file_set_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -828,7 +955,7 @@ func (x *SetProtocolRequest) String() string {
 func (*SetProtocolRequest) ProtoMessage() {}
 
 func (x *SetProtocolRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[10]
+	mi := &file_set_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,7 +968,7 @@ func (x *SetProtocolRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetProtocolRequest.ProtoReflect.Descriptor instead.
 func (*SetProtocolRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{10}
+	return file_set_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SetProtocolRequest) GetProtocol() config.Protocol {
@@ -865,7 +992,7 @@ type SetProtocolResponse struct {
 
 func (x *SetProtocolResponse) Reset() {
 	*x = SetProtocolResponse{}
-	mi := &file_set_proto_msgTypes[11]
+	mi := &file_set_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -877,7 +1004,7 @@ func (x *SetProtocolResponse) String() string {
 func (*SetProtocolResponse) ProtoMessage() {}
 
 func (x *SetProtocolResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[11]
+	mi := &file_set_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,7 +1017,7 @@ func (x *SetProtocolResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetProtocolResponse.ProtoReflect.Descriptor instead.
 func (*SetProtocolResponse) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{11}
+	return file_set_proto_rawDescGZIP(), []int{13}
 }
 
 func (m *SetProtocolResponse) GetResponse() isSetProtocolResponse_Response {
@@ -940,7 +1067,7 @@ type SetTechnologyRequest struct {
 
 func (x *SetTechnologyRequest) Reset() {
 	*x = SetTechnologyRequest{}
-	mi := &file_set_proto_msgTypes[12]
+	mi := &file_set_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -952,7 +1079,7 @@ func (x *SetTechnologyRequest) String() string {
 func (*SetTechnologyRequest) ProtoMessage() {}
 
 func (x *SetTechnologyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[12]
+	mi := &file_set_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +1092,7 @@ func (x *SetTechnologyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetTechnologyRequest.ProtoReflect.Descriptor instead.
 func (*SetTechnologyRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{12}
+	return file_set_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SetTechnologyRequest) GetTechnology() config.Technology {
@@ -986,7 +1113,7 @@ type PortRange struct {
 
 func (x *PortRange) Reset() {
 	*x = PortRange{}
-	mi := &file_set_proto_msgTypes[13]
+	mi := &file_set_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -998,7 +1125,7 @@ func (x *PortRange) String() string {
 func (*PortRange) ProtoMessage() {}
 
 func (x *PortRange) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[13]
+	mi := &file_set_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,7 +1138,7 @@ func (x *PortRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
 func (*PortRange) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{13}
+	return file_set_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PortRange) GetStartPort() int64 {
@@ -1039,7 +1166,7 @@ type SetAllowlistSubnetRequest struct {
 
 func (x *SetAllowlistSubnetRequest) Reset() {
 	*x = SetAllowlistSubnetRequest{}
-	mi := &file_set_proto_msgTypes[14]
+	mi := &file_set_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1051,7 +1178,7 @@ func (x *SetAllowlistSubnetRequest) String() string {
 func (*SetAllowlistSubnetRequest) ProtoMessage() {}
 
 func (x *SetAllowlistSubnetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[14]
+	mi := &file_set_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,7 +1191,7 @@ func (x *SetAllowlistSubnetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAllowlistSubnetRequest.ProtoReflect.Descriptor instead.
 func (*SetAllowlistSubnetRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{14}
+	return file_set_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SetAllowlistSubnetRequest) GetSubnet() string {
@@ -1093,7 +1220,7 @@ type SetAllowlistPortsRequest struct {
 
 func (x *SetAllowlistPortsRequest) Reset() {
 	*x = SetAllowlistPortsRequest{}
-	mi := &file_set_proto_msgTypes[15]
+	mi := &file_set_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1105,7 +1232,7 @@ func (x *SetAllowlistPortsRequest) String() string {
 func (*SetAllowlistPortsRequest) ProtoMessage() {}
 
 func (x *SetAllowlistPortsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[15]
+	mi := &file_set_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1118,7 +1245,7 @@ func (x *SetAllowlistPortsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAllowlistPortsRequest.ProtoReflect.Descriptor instead.
 func (*SetAllowlistPortsRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{15}
+	return file_set_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SetAllowlistPortsRequest) GetIsUdp() bool {
@@ -1142,6 +1269,51 @@ func (x *SetAllowlistPortsRequest) GetPortRange() *PortRange {
 	return nil
 }
 
+type SetAllowlistDomainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (x *SetAllowlistDomainRequest) Reset() {
+	*x = SetAllowlistDomainRequest{}
+	mi := &file_set_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAllowlistDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllowlistDomainRequest) ProtoMessage() {}
+
+func (x *SetAllowlistDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllowlistDomainRequest.ProtoReflect.Descriptor instead.
+func (*SetAllowlistDomainRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetAllowlistDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
 type SetAllowlistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1151,12 +1323,13 @@ type SetAllowlistRequest struct {
 	//
 	//	*SetAllowlistRequest_SetAllowlistSubnetRequest
 	//	*SetAllowlistRequest_SetAllowlistPortsRequest
+	//	*SetAllowlistRequest_SetAllowlistDomainRequest
 	Request isSetAllowlistRequest_Request `protobuf_oneof:"request"`
 }
 
 func (x *SetAllowlistRequest) Reset() {
 	*x = SetAllowlistRequest{}
-	mi := &file_set_proto_msgTypes[16]
+	mi := &file_set_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1168,7 +1341,7 @@ func (x *SetAllowlistRequest) String() string {
 func (*SetAllowlistRequest) ProtoMessage() {}
 
 func (x *SetAllowlistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[16]
+	mi := &file_set_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,7 +1354,7 @@ func (x *SetAllowlistRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAllowlistRequest.ProtoReflect.Descriptor instead.
 func (*SetAllowlistRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{16}
+	return file_set_proto_rawDescGZIP(), []int{19}
 }
 
 func (m *SetAllowlistRequest) GetRequest() isSetAllowlistRequest_Request {
@@ -1205,6 +1378,13 @@ func (x *SetAllowlistRequest) GetSetAllowlistPortsRequest() *SetAllowlistPortsRe
 	return nil
 }
 
+func (x *SetAllowlistRequest) GetSetAllowlistDomainRequest() *SetAllowlistDomainRequest {
+	if x, ok := x.GetRequest().(*SetAllowlistRequest_SetAllowlistDomainRequest); ok {
+		return x.SetAllowlistDomainRequest
+	}
+	return nil
+}
+
 type isSetAllowlistRequest_Request interface {
 	isSetAllowlistRequest_Request()
 }
@@ -1217,10 +1397,16 @@ type SetAllowlistRequest_SetAllowlistPortsRequest struct {
 	SetAllowlistPortsRequest *SetAllowlistPortsRequest `protobuf:"bytes,2,opt,name=set_allowlist_ports_request,json=setAllowlistPortsRequest,proto3,oneof"`
 }
 
+type SetAllowlistRequest_SetAllowlistDomainRequest struct {
+	SetAllowlistDomainRequest *SetAllowlistDomainRequest `protobuf:"bytes,3,opt,name=set_allowlist_domain_request,json=setAllowlistDomainRequest,proto3,oneof"`
+}
+
 func (*SetAllowlistRequest_SetAllowlistSubnetRequest) isSetAllowlistRequest_Request() {}
 
 func (*SetAllowlistRequest_SetAllowlistPortsRequest) isSetAllowlistRequest_Request() {}
 
+func (*SetAllowlistRequest_SetAllowlistDomainRequest) isSetAllowlistRequest_Request() {}
+
 type SetLANDiscoveryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1231,7 +1417,7 @@ type SetLANDiscoveryRequest struct {
 
 func (x *SetLANDiscoveryRequest) Reset() {
 	*x = SetLANDiscoveryRequest{}
-	mi := &file_set_proto_msgTypes[17]
+	mi := &file_set_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1243,7 +1429,7 @@ func (x *SetLANDiscoveryRequest) String() string {
 func (*SetLANDiscoveryRequest) ProtoMessage() {}
 
 func (x *SetLANDiscoveryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[17]
+	mi := &file_set_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +1442,7 @@ func (x *SetLANDiscoveryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLANDiscoveryRequest.ProtoReflect.Descriptor instead.
 func (*SetLANDiscoveryRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{17}
+	return file_set_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SetLANDiscoveryRequest) GetEnabled() bool {
@@ -1280,7 +1466,7 @@ type SetLANDiscoveryResponse struct {
 
 func (x *SetLANDiscoveryResponse) Reset() {
 	*x = SetLANDiscoveryResponse{}
-	mi := &file_set_proto_msgTypes[18]
+	mi := &file_set_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1292,7 +1478,7 @@ func (x *SetLANDiscoveryResponse) String() string {
 func (*SetLANDiscoveryResponse) ProtoMessage() {}
 
 func (x *SetLANDiscoveryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[18]
+	mi := &file_set_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1305,7 +1491,7 @@ func (x *SetLANDiscoveryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLANDiscoveryResponse.ProtoReflect.Descriptor instead.
 func (*SetLANDiscoveryResponse) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{18}
+	return file_set_proto_rawDescGZIP(), []int{21}
 }
 
 func (m *SetLANDiscoveryResponse) GetResponse() isSetLANDiscoveryResponse_Response {
@@ -1362,100 +1548,130 @@ var file_set_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x75, 0x70, 0x22, 0x2d, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x72,
 	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
 	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x22, 0x28, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x56, 0x0a,
-	0x1e, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x34, 0x0a, 0x16, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x74, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72,
+	0x6c, 0x65, 0x64, 0x22, 0x45, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x22, 0xff, 0x01, 0x0a, 0x12, 0x53,
+	0x65, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x15, 0x6d,
+	0x61, 0x78, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x5f, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x12, 0x6d, 0x61,
+	0x78, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63,
+	0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x0d,
+	0x6d, 0x61, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x5f, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x65, 0x63, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x22, 0x28, 0x0a, 0x10,
+	0x53, 0x65, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x56, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72,
 	0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48,
-	0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x6d, 0x0a, 0x21,
-	0x73, 0x65, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
-	0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x1d, 0x73, 0x65,
-	0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x44, 0x4e,
-	0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x64, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6c, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x68, 0x72, 0x65,
-	0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65,
-	0x22, 0x89, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x6e,
-	0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x44, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x14,
-	0x53, 0x65, 0x74, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x53,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x22, 0x2a, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x22, 0x2f, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x54, 0x72, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x72, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x74, 0x72, 0x61, 0x79, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x03, 0x75,
-	0x69, 0x64, 0x22, 0x42, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
-	0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x47, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x11, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x54, 0x65, 0x63,
-	0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
-	0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x65, 0x63, 0x68,
-	0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f,
-	0x67, 0x79, 0x22, 0x45, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x49, 0x0a, 0x19, 0x53, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x22, 0x76, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x75, 0x64, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x69, 0x73, 0x55, 0x64, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x74, 0x63,
-	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x54, 0x63, 0x70, 0x12, 0x2c,
-	0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xe1, 0x01, 0x0a,
-	0x13, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x1c, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6e,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x19, 0x73, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5d, 0x0a, 0x1b, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x18, 0x73, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x22, 0xcf,
+	0x01, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x6d, 0x0a, 0x21, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c,
+	0x69, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x1d, 0x73, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x57, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03,
+	0x64, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x0e, 0x53, 0x65,
+	0x74, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x38, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x6e, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x44, 0x4e, 0x53, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x74,
+	0x44, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4b, 0x69, 0x6c, 0x6c,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x22, 0x2a,
+	0x0a, 0x10, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x22, 0x2f, 0x0a, 0x0e, 0x53, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x72, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x72, 0x61, 0x79,
+	0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x12, 0x53,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22,
+	0x9d, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x73, 0x65,
+	0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00,
+	0x52, 0x11, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4a, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52,
+	0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x22, 0x45, 0x0a, 0x09, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x50, 0x6f,
+	0x72, 0x74, 0x22, 0x49, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x76, 0x0a,
+	0x18, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f,
+	0x75, 0x64, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x55, 0x64, 0x70,
+	0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x69, 0x73, 0x54, 0x63, 0x70, 0x12, 0x2c, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x33, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0xc3, 0x02, 0x0a, 0x13, 0x53,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x60, 0x0a, 0x1c, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x19, 0x73, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x5d, 0x0a, 0x1b, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x18, 0x73, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x1c, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x19, 0x73, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x22, 0x32, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
 	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
@@ -1471,39 +1687,40 @@ var file_set_proto_rawDesc = []byte{
 	0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
 	0x48, 0x00, 0x52, 0x15, 0x73, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
 	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x3e, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x51, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f,
 	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
 	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45, 0x52, 0x52,
 	0x4f, 0x52, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
-	0x53, 0x45, 0x54, 0x10, 0x02, 0x2a, 0x51, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x50, 0x4c, 0x5f, 0x43, 0x4f,
-	0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x50,
-	0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x44, 0x4e, 0x53,
-	0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x2a, 0x6e, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x44,
-	0x4e, 0x53, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x4e, 0x53, 0x5f,
-	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
-	0x44, 0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x54,
-	0x50, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e,
-	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x44, 0x4e, 0x53, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53,
-	0x53, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f,
-	0x56, 0x41, 0x4c, 0x55, 0x45, 0x53, 0x10, 0x03, 0x2a, 0x64, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a,
-	0x13, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
-	0x4f, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x56, 0x50,
-	0x4e, 0x5f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
-	0x44, 0x5f, 0x54, 0x45, 0x43, 0x48, 0x4e, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x10, 0x02, 0x2a, 0x5b,
-	0x0a, 0x15, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x49, 0x53, 0x43, 0x4f,
-	0x56, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x28, 0x0a, 0x24, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x43,
-	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c,
-	0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x42, 0x31, 0x5a, 0x2f, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c,
-	0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x45, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x51, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x50, 0x4c,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a,
+	0x18, 0x54, 0x50, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f,
+	0x44, 0x4e, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x2a, 0x6e, 0x0a, 0x0c, 0x53,
+	0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x44,
+	0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1c, 0x0a, 0x18, 0x44, 0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45,
+	0x44, 0x5f, 0x54, 0x50, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x44, 0x4e, 0x53, 0x5f, 0x41, 0x44, 0x44,
+	0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41,
+	0x4e, 0x59, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x53, 0x10, 0x03, 0x2a, 0x64, 0x0a, 0x11, 0x53,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44,
+	0x5f, 0x56, 0x50, 0x4e, 0x5f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x45, 0x43, 0x48, 0x4e, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x10,
+	0x02, 0x2a, 0x5b, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x49,
+	0x53, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x56, 0x45, 0x52,
+	0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x41, 0x4c, 0x4c,
+	0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x42, 0x31,
+	0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72,
+	0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70,
+	0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1519,7 +1736,7 @@ func file_set_proto_rawDescGZIP() []byte {
 }
 
 var file_set_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_set_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_set_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_set_proto_goTypes = []any{
 	(SetErrorCode)(0),                       // 0: pb.SetErrorCode
 	(SetThreatProtectionLiteStatus)(0),      // 1: pb.SetThreatProtectionLiteStatus
@@ -1528,45 +1745,49 @@ var file_set_proto_goTypes = []any{
 	(SetLANDiscoveryStatus)(0),              // 4: pb.SetLANDiscoveryStatus
 	(*SetAutoconnectRequest)(nil),           // 5: pb.SetAutoconnectRequest
 	(*SetGenericRequest)(nil),               // 6: pb.SetGenericRequest
-	(*SetUint32Request)(nil),                // 7: pb.SetUint32Request
-	(*SetThreatProtectionLiteRequest)(nil),  // 8: pb.SetThreatProtectionLiteRequest
-	(*SetThreatProtectionLiteResponse)(nil), // 9: pb.SetThreatProtectionLiteResponse
-	(*SetDNSRequest)(nil),                   // 10: pb.SetDNSRequest
-	(*SetDNSResponse)(nil),                  // 11: pb.SetDNSResponse
-	(*SetKillSwitchRequest)(nil),            // 12: pb.SetKillSwitchRequest
-	(*SetNotifyRequest)(nil),                // 13: pb.SetNotifyRequest
-	(*SetTrayRequest)(nil),                  // 14: pb.SetTrayRequest
-	(*SetProtocolRequest)(nil),              // 15: pb.SetProtocolRequest
-	(*SetProtocolResponse)(nil),             // 16: pb.SetProtocolResponse
-	(*SetTechnologyRequest)(nil),            // 17: pb.SetTechnologyRequest
-	(*PortRange)(nil),                       // 18: pb.PortRange
-	(*SetAllowlistSubnetRequest)(nil),       // 19: pb.SetAllowlistSubnetRequest
-	(*SetAllowlistPortsRequest)(nil),        // 20: pb.SetAllowlistPortsRequest
-	(*SetAllowlistRequest)(nil),             // 21: pb.SetAllowlistRequest
-	(*SetLANDiscoveryRequest)(nil),          // 22: pb.SetLANDiscoveryRequest
-	(*SetLANDiscoveryResponse)(nil),         // 23: pb.SetLANDiscoveryResponse
-	(config.Protocol)(0),                    // 24: config.Protocol
-	(config.Technology)(0),                  // 25: config.Technology
+	(*SetMetricsRequest)(nil),               // 7: pb.SetMetricsRequest
+	(*SetFailoverRequest)(nil),              // 8: pb.SetFailoverRequest
+	(*SetUint32Request)(nil),                // 9: pb.SetUint32Request
+	(*SetThreatProtectionLiteRequest)(nil),  // 10: pb.SetThreatProtectionLiteRequest
+	(*SetThreatProtectionLiteResponse)(nil), // 11: pb.SetThreatProtectionLiteResponse
+	(*SetDNSRequest)(nil),                   // 12: pb.SetDNSRequest
+	(*SetDNSResponse)(nil),                  // 13: pb.SetDNSResponse
+	(*SetKillSwitchRequest)(nil),            // 14: pb.SetKillSwitchRequest
+	(*SetNotifyRequest)(nil),                // 15: pb.SetNotifyRequest
+	(*SetTrayRequest)(nil),                  // 16: pb.SetTrayRequest
+	(*SetProtocolRequest)(nil),              // 17: pb.SetProtocolRequest
+	(*SetProtocolResponse)(nil),             // 18: pb.SetProtocolResponse
+	(*SetTechnologyRequest)(nil),            // 19: pb.SetTechnologyRequest
+	(*PortRange)(nil),                       // 20: pb.PortRange
+	(*SetAllowlistSubnetRequest)(nil),       // 21: pb.SetAllowlistSubnetRequest
+	(*SetAllowlistPortsRequest)(nil),        // 22: pb.SetAllowlistPortsRequest
+	(*SetAllowlistDomainRequest)(nil),       // 23: pb.SetAllowlistDomainRequest
+	(*SetAllowlistRequest)(nil),             // 24: pb.SetAllowlistRequest
+	(*SetLANDiscoveryRequest)(nil),          // 25: pb.SetLANDiscoveryRequest
+	(*SetLANDiscoveryResponse)(nil),         // 26: pb.SetLANDiscoveryResponse
+	(config.Protocol)(0),                    // 27: config.Protocol
+	(config.Technology)(0),                  // 28: config.Technology
 }
 var file_set_proto_depIdxs = []int32{
 	0,  // 0: pb.SetThreatProtectionLiteResponse.error_code:type_name -> pb.SetErrorCode
 	1,  // 1: pb.SetThreatProtectionLiteResponse.set_threat_protection_lite_status:type_name -> pb.SetThreatProtectionLiteStatus
 	0,  // 2: pb.SetDNSResponse.error_code:type_name -> pb.SetErrorCode
 	2,  // 3: pb.SetDNSResponse.set_dns_status:type_name -> pb.SetDNSStatus
-	24, // 4: pb.SetProtocolRequest.protocol:type_name -> config.Protocol
+	27, // 4: pb.SetProtocolRequest.protocol:type_name -> config.Protocol
 	0,  // 5: pb.SetProtocolResponse.error_code:type_name -> pb.SetErrorCode
 	3,  // 6: pb.SetProtocolResponse.set_protocol_status:type_name -> pb.SetProtocolStatus
-	25, // 7: pb.SetTechnologyRequest.technology:type_name -> config.Technology
-	18, // 8: pb.SetAllowlistPortsRequest.port_range:type_name -> pb.PortRange
-	19, // 9: pb.SetAllowlistRequest.set_allowlist_subnet_request:type_name -> pb.SetAllowlistSubnetRequest
-	20, // 10: pb.SetAllowlistRequest.set_allowlist_ports_request:type_name -> pb.SetAllowlistPortsRequest
-	0,  // 11: pb.SetLANDiscoveryResponse.error_code:type_name -> pb.SetErrorCode
-	4,  // 12: pb.SetLANDiscoveryResponse.set_lan_discovery_status:type_name -> pb.SetLANDiscoveryStatus
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	28, // 7: pb.SetTechnologyRequest.technology:type_name -> config.Technology
+	20, // 8: pb.SetAllowlistPortsRequest.port_range:type_name -> pb.PortRange
+	21, // 9: pb.SetAllowlistRequest.set_allowlist_subnet_request:type_name -> pb.SetAllowlistSubnetRequest
+	22, // 10: pb.SetAllowlistRequest.set_allowlist_ports_request:type_name -> pb.SetAllowlistPortsRequest
+	23, // 11: pb.SetAllowlistRequest.set_allowlist_domain_request:type_name -> pb.SetAllowlistDomainRequest
+	0,  // 12: pb.SetLANDiscoveryResponse.error_code:type_name -> pb.SetErrorCode
+	4,  // 13: pb.SetLANDiscoveryResponse.set_lan_discovery_status:type_name -> pb.SetLANDiscoveryStatus
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_set_proto_init() }
@@ -1574,23 +1795,25 @@ func file_set_proto_init() {
 	if File_set_proto != nil {
 		return
 	}
-	file_set_proto_msgTypes[4].OneofWrappers = []any{
+	file_set_proto_msgTypes[3].OneofWrappers = []any{}
+	file_set_proto_msgTypes[6].OneofWrappers = []any{
 		(*SetThreatProtectionLiteResponse_ErrorCode)(nil),
 		(*SetThreatProtectionLiteResponse_SetThreatProtectionLiteStatus)(nil),
 	}
-	file_set_proto_msgTypes[6].OneofWrappers = []any{
+	file_set_proto_msgTypes[8].OneofWrappers = []any{
 		(*SetDNSResponse_ErrorCode)(nil),
 		(*SetDNSResponse_SetDnsStatus)(nil),
 	}
-	file_set_proto_msgTypes[11].OneofWrappers = []any{
+	file_set_proto_msgTypes[13].OneofWrappers = []any{
 		(*SetProtocolResponse_ErrorCode)(nil),
 		(*SetProtocolResponse_SetProtocolStatus)(nil),
 	}
-	file_set_proto_msgTypes[16].OneofWrappers = []any{
+	file_set_proto_msgTypes[19].OneofWrappers = []any{
 		(*SetAllowlistRequest_SetAllowlistSubnetRequest)(nil),
 		(*SetAllowlistRequest_SetAllowlistPortsRequest)(nil),
+		(*SetAllowlistRequest_SetAllowlistDomainRequest)(nil),
 	}
-	file_set_proto_msgTypes[18].OneofWrappers = []any{
+	file_set_proto_msgTypes[21].OneofWrappers = []any{
 		(*SetLANDiscoveryResponse_ErrorCode)(nil),
 		(*SetLANDiscoveryResponse_SetLanDiscoveryStatus)(nil),
 	}
@@ -1600,7 +1823,7 @@ func file_set_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_set_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   19,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,358 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v3.21.6
+// source: split_tunnel.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SplitTunnelAppType int32
+
+const (
+	SplitTunnelAppType_PATH   SplitTunnelAppType = 0
+	SplitTunnelAppType_PID    SplitTunnelAppType = 1
+	SplitTunnelAppType_CGROUP SplitTunnelAppType = 2
+)
+
+// Enum value maps for SplitTunnelAppType.
+var (
+	SplitTunnelAppType_name = map[int32]string{
+		0: "PATH",
+		1: "PID",
+		2: "CGROUP",
+	}
+	SplitTunnelAppType_value = map[string]int32{
+		"PATH":   0,
+		"PID":    1,
+		"CGROUP": 2,
+	}
+)
+
+func (x SplitTunnelAppType) Enum() *SplitTunnelAppType {
+	p := new(SplitTunnelAppType)
+	*p = x
+	return p
+}
+
+func (x SplitTunnelAppType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SplitTunnelAppType) Descriptor() protoreflect.EnumDescriptor {
+	return file_split_tunnel_proto_enumTypes[0].Descriptor()
+}
+
+func (SplitTunnelAppType) Type() protoreflect.EnumType {
+	return &file_split_tunnel_proto_enumTypes[0]
+}
+
+func (x SplitTunnelAppType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SplitTunnelAppType.Descriptor instead.
+func (SplitTunnelAppType) EnumDescriptor() ([]byte, []int) {
+	return file_split_tunnel_proto_rawDescGZIP(), []int{0}
+}
+
+type SplitTunnelMode int32
+
+const (
+	SplitTunnelMode_EXCLUDE SplitTunnelMode = 0
+	SplitTunnelMode_INCLUDE SplitTunnelMode = 1
+)
+
+// Enum value maps for SplitTunnelMode.
+var (
+	SplitTunnelMode_name = map[int32]string{
+		0: "EXCLUDE",
+		1: "INCLUDE",
+	}
+	SplitTunnelMode_value = map[string]int32{
+		"EXCLUDE": 0,
+		"INCLUDE": 1,
+	}
+)
+
+func (x SplitTunnelMode) Enum() *SplitTunnelMode {
+	p := new(SplitTunnelMode)
+	*p = x
+	return p
+}
+
+func (x SplitTunnelMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SplitTunnelMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_split_tunnel_proto_enumTypes[1].Descriptor()
+}
+
+func (SplitTunnelMode) Type() protoreflect.EnumType {
+	return &file_split_tunnel_proto_enumTypes[1]
+}
+
+func (x SplitTunnelMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SplitTunnelMode.Descriptor instead.
+func (SplitTunnelMode) EnumDescriptor() ([]byte, []int) {
+	return file_split_tunnel_proto_rawDescGZIP(), []int{1}
+}
+
+type SplitTunnelApp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type             SplitTunnelAppType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.SplitTunnelAppType" json:"type,omitempty"`
+	Value            string             `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	KillSwitchExempt bool               `protobuf:"varint,3,opt,name=kill_switch_exempt,json=killSwitchExempt,proto3" json:"kill_switch_exempt,omitempty"`
+}
+
+func (x *SplitTunnelApp) Reset() {
+	*x = SplitTunnelApp{}
+	mi := &file_split_tunnel_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitTunnelApp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnelApp) ProtoMessage() {}
+
+func (x *SplitTunnelApp) ProtoReflect() protoreflect.Message {
+	mi := &file_split_tunnel_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnelApp.ProtoReflect.Descriptor instead.
+func (*SplitTunnelApp) Descriptor() ([]byte, []int) {
+	return file_split_tunnel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SplitTunnelApp) GetType() SplitTunnelAppType {
+	if x != nil {
+		return x.Type
+	}
+	return SplitTunnelAppType_PATH
+}
+
+func (x *SplitTunnelApp) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SplitTunnelApp) GetKillSwitchExempt() bool {
+	if x != nil {
+		return x.KillSwitchExempt
+	}
+	return false
+}
+
+type SetSplitTunnelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	App *SplitTunnelApp `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+}
+
+func (x *SetSplitTunnelRequest) Reset() {
+	*x = SetSplitTunnelRequest{}
+	mi := &file_split_tunnel_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSplitTunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSplitTunnelRequest) ProtoMessage() {}
+
+func (x *SetSplitTunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_split_tunnel_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSplitTunnelRequest.ProtoReflect.Descriptor instead.
+func (*SetSplitTunnelRequest) Descriptor() ([]byte, []int) {
+	return file_split_tunnel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetSplitTunnelRequest) GetApp() *SplitTunnelApp {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+type SetSplitTunnelModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode SplitTunnelMode `protobuf:"varint,1,opt,name=mode,proto3,enum=pb.SplitTunnelMode" json:"mode,omitempty"`
+}
+
+func (x *SetSplitTunnelModeRequest) Reset() {
+	*x = SetSplitTunnelModeRequest{}
+	mi := &file_split_tunnel_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSplitTunnelModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSplitTunnelModeRequest) ProtoMessage() {}
+
+func (x *SetSplitTunnelModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_split_tunnel_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSplitTunnelModeRequest.ProtoReflect.Descriptor instead.
+func (*SetSplitTunnelModeRequest) Descriptor() ([]byte, []int) {
+	return file_split_tunnel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetSplitTunnelModeRequest) GetMode() SplitTunnelMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SplitTunnelMode_EXCLUDE
+}
+
+var File_split_tunnel_proto protoreflect.FileDescriptor
+
+var file_split_tunnel_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x12, 0x2a, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x65, 0x78, 0x65,
+	0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6b, 0x69, 0x6c, 0x6c, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x22, 0x3d, 0x0a, 0x15, 0x53,
+	0x65, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x41, 0x70, 0x70, 0x52, 0x03, 0x61, 0x70, 0x70, 0x22, 0x44, 0x0a, 0x19, 0x53, 0x65,
+	0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x2a, 0x33, 0x0a, 0x12, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41,
+	0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x54, 0x48, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x10, 0x02, 0x2a, 0x2b, 0x0a, 0x0f, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x43, 0x4c,
+	0x55, 0x44, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45,
+	0x10, 0x01, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f,
+	0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_split_tunnel_proto_rawDescOnce sync.Once
+	file_split_tunnel_proto_rawDescData = file_split_tunnel_proto_rawDesc
+)
+
+func file_split_tunnel_proto_rawDescGZIP() []byte {
+	file_split_tunnel_proto_rawDescOnce.Do(func() {
+		file_split_tunnel_proto_rawDescData = protoimpl.X.CompressGZIP(file_split_tunnel_proto_rawDescData)
+	})
+	return file_split_tunnel_proto_rawDescData
+}
+
+var file_split_tunnel_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_split_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_split_tunnel_proto_goTypes = []any{
+	(SplitTunnelAppType)(0),           // 0: pb.SplitTunnelAppType
+	(SplitTunnelMode)(0),              // 1: pb.SplitTunnelMode
+	(*SplitTunnelApp)(nil),            // 2: pb.SplitTunnelApp
+	(*SetSplitTunnelRequest)(nil),     // 3: pb.SetSplitTunnelRequest
+	(*SetSplitTunnelModeRequest)(nil), // 4: pb.SetSplitTunnelModeRequest
+}
+var file_split_tunnel_proto_depIdxs = []int32{
+	0, // 0: pb.SplitTunnelApp.type:type_name -> pb.SplitTunnelAppType
+	2, // 1: pb.SetSplitTunnelRequest.app:type_name -> pb.SplitTunnelApp
+	1, // 2: pb.SetSplitTunnelModeRequest.mode:type_name -> pb.SplitTunnelMode
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_split_tunnel_proto_init() }
+func file_split_tunnel_proto_init() {
+	if File_split_tunnel_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_split_tunnel_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_split_tunnel_proto_goTypes,
+		DependencyIndexes: file_split_tunnel_proto_depIdxs,
+		EnumInfos:         file_split_tunnel_proto_enumTypes,
+		MessageInfos:      file_split_tunnel_proto_msgTypes,
+	}.Build()
+	File_split_tunnel_proto = out.File
+	file_split_tunnel_proto_rawDesc = nil
+	file_split_tunnel_proto_goTypes = nil
+	file_split_tunnel_proto_depIdxs = nil
+}
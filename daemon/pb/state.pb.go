@@ -112,8 +112,9 @@ func (LoginEventType) EnumDescriptor() ([]byte, []int) {
 type UpdateEvent int32
 
 const (
-	UpdateEvent_SERVERS_LIST_UPDATE UpdateEvent = 0
-	UpdateEvent_RECENTS_LIST_UPDATE UpdateEvent = 1
+	UpdateEvent_SERVERS_LIST_UPDATE   UpdateEvent = 0
+	UpdateEvent_RECENTS_LIST_UPDATE   UpdateEvent = 1
+	UpdateEvent_FAVORITES_LIST_UPDATE UpdateEvent = 2
 )
 
 // Enum value maps for UpdateEvent.
@@ -121,10 +122,12 @@ var (
 	UpdateEvent_name = map[int32]string{
 		0: "SERVERS_LIST_UPDATE",
 		1: "RECENTS_LIST_UPDATE",
+		2: "FAVORITES_LIST_UPDATE",
 	}
 	UpdateEvent_value = map[string]int32{
-		"SERVERS_LIST_UPDATE": 0,
-		"RECENTS_LIST_UPDATE": 1,
+		"SERVERS_LIST_UPDATE":   0,
+		"RECENTS_LIST_UPDATE":   1,
+		"FAVORITES_LIST_UPDATE": 2,
 	}
 )
 
@@ -158,16 +161,25 @@ func (UpdateEvent) EnumDescriptor() ([]byte, []int) {
 type PauseEventType int32
 
 const (
-	PauseEventType_RECONNECT_FAILED PauseEventType = 0
+	PauseEventType_RECONNECT_FAILED             PauseEventType = 0
+	PauseEventType_SCHEDULED_PAUSE_STARTED      PauseEventType = 1
+	PauseEventType_SCHEDULED_DISCONNECT_STARTED PauseEventType = 2
+	PauseEventType_SCHEDULE_ENDED               PauseEventType = 3
 )
 
 // Enum value maps for PauseEventType.
 var (
 	PauseEventType_name = map[int32]string{
 		0: "RECONNECT_FAILED",
+		1: "SCHEDULED_PAUSE_STARTED",
+		2: "SCHEDULED_DISCONNECT_STARTED",
+		3: "SCHEDULE_ENDED",
 	}
 	PauseEventType_value = map[string]int32{
-		"RECONNECT_FAILED": 0,
+		"RECONNECT_FAILED":             0,
+		"SCHEDULED_PAUSE_STARTED":      1,
+		"SCHEDULED_DISCONNECT_STARTED": 2,
+		"SCHEDULE_ENDED":               3,
 	}
 )
 
@@ -198,6 +210,55 @@ func (PauseEventType) EnumDescriptor() ([]byte, []int) {
 	return file_state_proto_rawDescGZIP(), []int{3}
 }
 
+type FailoverReason int32
+
+const (
+	FailoverReason_HANDSHAKE_TIMEOUT FailoverReason = 0
+	FailoverReason_PACKET_LOSS       FailoverReason = 1
+	FailoverReason_HIGH_LATENCY      FailoverReason = 2
+)
+
+// Enum value maps for FailoverReason.
+var (
+	FailoverReason_name = map[int32]string{
+		0: "HANDSHAKE_TIMEOUT",
+		1: "PACKET_LOSS",
+		2: "HIGH_LATENCY",
+	}
+	FailoverReason_value = map[string]int32{
+		"HANDSHAKE_TIMEOUT": 0,
+		"PACKET_LOSS":       1,
+		"HIGH_LATENCY":      2,
+	}
+)
+
+func (x FailoverReason) Enum() *FailoverReason {
+	p := new(FailoverReason)
+	*p = x
+	return p
+}
+
+func (x FailoverReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FailoverReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_state_proto_enumTypes[4].Descriptor()
+}
+
+func (FailoverReason) Type() protoreflect.EnumType {
+	return &file_state_proto_enumTypes[4]
+}
+
+func (x FailoverReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FailoverReason.Descriptor instead.
+func (FailoverReason) EnumDescriptor() ([]byte, []int) {
+	return file_state_proto_rawDescGZIP(), []int{4}
+}
+
 type LoginEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -339,6 +400,10 @@ type PauseEvent struct {
 	unknownFields protoimpl.UnknownFields
 
 	Type PauseEventType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.PauseEventType" json:"type,omitempty"`
+	// id of the schedule rule which caused the event, empty for the manual pause
+	ScheduleRuleId string `protobuf:"bytes,2,opt,name=schedule_rule_id,json=scheduleRuleId,proto3" json:"schedule_rule_id,omitempty"`
+	// end of the scheduled window in HH:MM format
+	ScheduleEnd string `protobuf:"bytes,3,opt,name=schedule_end,json=scheduleEnd,proto3" json:"schedule_end,omitempty"`
 }
 
 func (x *PauseEvent) Reset() {
@@ -378,6 +443,83 @@ func (x *PauseEvent) GetType() PauseEventType {
 	return PauseEventType_RECONNECT_FAILED
 }
 
+func (x *PauseEvent) GetScheduleRuleId() string {
+	if x != nil {
+		return x.ScheduleRuleId
+	}
+	return ""
+}
+
+func (x *PauseEvent) GetScheduleEnd() string {
+	if x != nil {
+		return x.ScheduleEnd
+	}
+	return ""
+}
+
+// FailoverEvent is sent after the connection quality monitor reconnected to a different server
+type FailoverEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason       FailoverReason `protobuf:"varint,1,opt,name=reason,proto3,enum=pb.FailoverReason" json:"reason,omitempty"`
+	FromHostname string         `protobuf:"bytes,2,opt,name=from_hostname,json=fromHostname,proto3" json:"from_hostname,omitempty"`
+	// empty if the reconnect failed
+	ToHostname string `protobuf:"bytes,3,opt,name=to_hostname,json=toHostname,proto3" json:"to_hostname,omitempty"`
+}
+
+func (x *FailoverEvent) Reset() {
+	*x = FailoverEvent{}
+	mi := &file_state_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailoverEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailoverEvent) ProtoMessage() {}
+
+func (x *FailoverEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_state_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailoverEvent.ProtoReflect.Descriptor instead.
+func (*FailoverEvent) Descriptor() ([]byte, []int) {
+	return file_state_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FailoverEvent) GetReason() FailoverReason {
+	if x != nil {
+		return x.Reason
+	}
+	return FailoverReason_HANDSHAKE_TIMEOUT
+}
+
+func (x *FailoverEvent) GetFromHostname() string {
+	if x != nil {
+		return x.FromHostname
+	}
+	return ""
+}
+
+func (x *FailoverEvent) GetToHostname() string {
+	if x != nil {
+		return x.ToHostname
+	}
+	return ""
+}
+
 type AppState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -393,12 +535,13 @@ type AppState struct {
 	//	*AppState_AccountModification
 	//	*AppState_VersionHealth
 	//	*AppState_PauseEvent
+	//	*AppState_FailoverEvent
 	State isAppState_State `protobuf_oneof:"state"`
 }
 
 func (x *AppState) Reset() {
 	*x = AppState{}
-	mi := &file_state_proto_msgTypes[4]
+	mi := &file_state_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -410,7 +553,7 @@ func (x *AppState) String() string {
 func (*AppState) ProtoMessage() {}
 
 func (x *AppState) ProtoReflect() protoreflect.Message {
-	mi := &file_state_proto_msgTypes[4]
+	mi := &file_state_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,7 +566,7 @@ func (x *AppState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppState.ProtoReflect.Descriptor instead.
 func (*AppState) Descriptor() ([]byte, []int) {
-	return file_state_proto_rawDescGZIP(), []int{4}
+	return file_state_proto_rawDescGZIP(), []int{5}
 }
 
 func (m *AppState) GetState() isAppState_State {
@@ -489,6 +632,13 @@ func (x *AppState) GetPauseEvent() *PauseEvent {
 	return nil
 }
 
+func (x *AppState) GetFailoverEvent() *FailoverEvent {
+	if x, ok := x.GetState().(*AppState_FailoverEvent); ok {
+		return x.FailoverEvent
+	}
+	return nil
+}
+
 type isAppState_State interface {
 	isAppState_State()
 }
@@ -525,6 +675,10 @@ type AppState_PauseEvent struct {
 	PauseEvent *PauseEvent `protobuf:"bytes,8,opt,name=pause_event,json=pauseEvent,proto3,oneof"`
 }
 
+type AppState_FailoverEvent struct {
+	FailoverEvent *FailoverEvent `protobuf:"bytes,9,opt,name=failover_event,json=failoverEvent,proto3,oneof"`
+}
+
 func (*AppState_Error) isAppState_State() {}
 
 func (*AppState_ConnectionStatus) isAppState_State() {}
@@ -541,6 +695,8 @@ func (*AppState_VersionHealth) isAppState_State() {}
 
 func (*AppState_PauseEvent) isAppState_State() {}
 
+func (*AppState_FailoverEvent) isAppState_State() {}
+
 var File_state_proto protoreflect.FileDescriptor
 
 var file_state_proto_rawDesc = []byte{
@@ -560,57 +716,85 @@ var file_state_proto_rawDesc = []byte{
 	0x65, 0x73, 0x5f, 0x61, 0x74, 0x22, 0x36, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
 	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
 	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x34, 0x0a,
-	0x0a, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x22, 0xe6, 0x03, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x11, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31,
-	0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x37, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x4c, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x13, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40,
-	0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48,
-	0x00, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x12, 0x31, 0x0a, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2a, 0x26, 0x0a, 0x0d,
-	0x41, 0x70, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x15, 0x0a,
-	0x11, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x55,
-	0x49, 0x44, 0x10, 0x00, 0x2a, 0x27, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f, 0x47, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x2a, 0x3f, 0x0a,
-	0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x13,
-	0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x53, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x55, 0x50, 0x44,
-	0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x43, 0x45, 0x4e, 0x54, 0x53,
-	0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x2a, 0x26,
-	0x0a, 0x0e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x41,
-	0x49, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f,
-	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x81, 0x01,
+	0x0a, 0x0a, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x6e,
+	0x64, 0x22, 0x81, 0x01, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x48, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x48, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa2, 0x04, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x41, 0x0a,
+	0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x31, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x0c,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x13, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x40, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x48, 0x00, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x31, 0x0a, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65,
+	0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x42, 0x07, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2a, 0x26, 0x0a, 0x0d, 0x41, 0x70,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x15, 0x0a, 0x11, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x55, 0x49, 0x44,
+	0x10, 0x00, 0x2a, 0x27, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x4c, 0x4f, 0x47, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x2a, 0x5a, 0x0a, 0x0b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45,
+	0x52, 0x56, 0x45, 0x52, 0x53, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x43, 0x45, 0x4e, 0x54, 0x53, 0x5f, 0x4c,
+	0x49, 0x53, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15,
+	0x46, 0x41, 0x56, 0x4f, 0x52, 0x49, 0x54, 0x45, 0x53, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x79, 0x0a, 0x0e, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x5f, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c,
+	0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e,
+	0x4e, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44,
+	0x10, 0x03, 0x2a, 0x4a, 0x0a, 0x0e, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x41, 0x4e, 0x44, 0x53, 0x48, 0x41, 0x4b,
+	0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50,
+	0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
+	0x48, 0x49, 0x47, 0x48, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x02, 0x42, 0x31,
+	0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72,
+	0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70,
+	0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -625,37 +809,41 @@ func file_state_proto_rawDescGZIP() []byte {
 	return file_state_proto_rawDescData
 }
 
-var file_state_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_state_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_state_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_state_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_state_proto_goTypes = []any{
 	(AppStateError)(0),          // 0: pb.AppStateError
 	(LoginEventType)(0),         // 1: pb.LoginEventType
 	(UpdateEvent)(0),            // 2: pb.UpdateEvent
 	(PauseEventType)(0),         // 3: pb.PauseEventType
-	(*LoginEvent)(nil),          // 4: pb.LoginEvent
-	(*AccountModification)(nil), // 5: pb.AccountModification
-	(*VersionHealthStatus)(nil), // 6: pb.VersionHealthStatus
-	(*PauseEvent)(nil),          // 7: pb.PauseEvent
-	(*AppState)(nil),            // 8: pb.AppState
-	(*StatusResponse)(nil),      // 9: pb.StatusResponse
-	(*Settings)(nil),            // 10: pb.Settings
+	(FailoverReason)(0),         // 4: pb.FailoverReason
+	(*LoginEvent)(nil),          // 5: pb.LoginEvent
+	(*AccountModification)(nil), // 6: pb.AccountModification
+	(*VersionHealthStatus)(nil), // 7: pb.VersionHealthStatus
+	(*PauseEvent)(nil),          // 8: pb.PauseEvent
+	(*FailoverEvent)(nil),       // 9: pb.FailoverEvent
+	(*AppState)(nil),            // 10: pb.AppState
+	(*StatusResponse)(nil),      // 11: pb.StatusResponse
+	(*Settings)(nil),            // 12: pb.Settings
 }
 var file_state_proto_depIdxs = []int32{
 	1,  // 0: pb.LoginEvent.type:type_name -> pb.LoginEventType
 	3,  // 1: pb.PauseEvent.type:type_name -> pb.PauseEventType
-	0,  // 2: pb.AppState.error:type_name -> pb.AppStateError
-	9,  // 3: pb.AppState.connection_status:type_name -> pb.StatusResponse
-	4,  // 4: pb.AppState.login_event:type_name -> pb.LoginEvent
-	10, // 5: pb.AppState.settings_change:type_name -> pb.Settings
-	2,  // 6: pb.AppState.update_event:type_name -> pb.UpdateEvent
-	5,  // 7: pb.AppState.account_modification:type_name -> pb.AccountModification
-	6,  // 8: pb.AppState.version_health:type_name -> pb.VersionHealthStatus
-	7,  // 9: pb.AppState.pause_event:type_name -> pb.PauseEvent
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	4,  // 2: pb.FailoverEvent.reason:type_name -> pb.FailoverReason
+	0,  // 3: pb.AppState.error:type_name -> pb.AppStateError
+	11, // 4: pb.AppState.connection_status:type_name -> pb.StatusResponse
+	5,  // 5: pb.AppState.login_event:type_name -> pb.LoginEvent
+	12, // 6: pb.AppState.settings_change:type_name -> pb.Settings
+	2,  // 7: pb.AppState.update_event:type_name -> pb.UpdateEvent
+	6,  // 8: pb.AppState.account_modification:type_name -> pb.AccountModification
+	7,  // 9: pb.AppState.version_health:type_name -> pb.VersionHealthStatus
+	8,  // 10: pb.AppState.pause_event:type_name -> pb.PauseEvent
+	9,  // 11: pb.AppState.failover_event:type_name -> pb.FailoverEvent
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_state_proto_init() }
@@ -666,7 +854,7 @@ func file_state_proto_init() {
 	file_settings_proto_init()
 	file_status_proto_init()
 	file_state_proto_msgTypes[1].OneofWrappers = []any{}
-	file_state_proto_msgTypes[4].OneofWrappers = []any{
+	file_state_proto_msgTypes[5].OneofWrappers = []any{
 		(*AppState_Error)(nil),
 		(*AppState_ConnectionStatus)(nil),
 		(*AppState_LoginEvent)(nil),
@@ -675,14 +863,15 @@ func file_state_proto_init() {
 		(*AppState_AccountModification)(nil),
 		(*AppState_VersionHealth)(nil),
 		(*AppState_PauseEvent)(nil),
+		(*AppState_FailoverEvent)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_state_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   5,
+			NumEnums:      5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
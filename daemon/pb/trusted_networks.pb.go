@@ -0,0 +1,404 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v3.21.6
+// source: trusted_networks.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TrustedNetworkAction int32
+
+const (
+	TrustedNetworkAction_TRUSTED_DISCONNECT   TrustedNetworkAction = 0
+	TrustedNetworkAction_TRUSTED_MESHNET_ONLY TrustedNetworkAction = 1
+)
+
+// Enum value maps for TrustedNetworkAction.
+var (
+	TrustedNetworkAction_name = map[int32]string{
+		0: "TRUSTED_DISCONNECT",
+		1: "TRUSTED_MESHNET_ONLY",
+	}
+	TrustedNetworkAction_value = map[string]int32{
+		"TRUSTED_DISCONNECT":   0,
+		"TRUSTED_MESHNET_ONLY": 1,
+	}
+)
+
+func (x TrustedNetworkAction) Enum() *TrustedNetworkAction {
+	p := new(TrustedNetworkAction)
+	*p = x
+	return p
+}
+
+func (x TrustedNetworkAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrustedNetworkAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_trusted_networks_proto_enumTypes[0].Descriptor()
+}
+
+func (TrustedNetworkAction) Type() protoreflect.EnumType {
+	return &file_trusted_networks_proto_enumTypes[0]
+}
+
+func (x TrustedNetworkAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrustedNetworkAction.Descriptor instead.
+func (TrustedNetworkAction) EnumDescriptor() ([]byte, []int) {
+	return file_trusted_networks_proto_rawDescGZIP(), []int{0}
+}
+
+type TrustedNetwork struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ssid       string               `protobuf:"bytes,2,opt,name=ssid,proto3" json:"ssid,omitempty"`
+	GatewayMac string               `protobuf:"bytes,3,opt,name=gateway_mac,json=gatewayMac,proto3" json:"gateway_mac,omitempty"`
+	Action     TrustedNetworkAction `protobuf:"varint,4,opt,name=action,proto3,enum=pb.TrustedNetworkAction" json:"action,omitempty"`
+}
+
+func (x *TrustedNetwork) Reset() {
+	*x = TrustedNetwork{}
+	mi := &file_trusted_networks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrustedNetwork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustedNetwork) ProtoMessage() {}
+
+func (x *TrustedNetwork) ProtoReflect() protoreflect.Message {
+	mi := &file_trusted_networks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustedNetwork.ProtoReflect.Descriptor instead.
+func (*TrustedNetwork) Descriptor() ([]byte, []int) {
+	return file_trusted_networks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TrustedNetwork) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrustedNetwork) GetSsid() string {
+	if x != nil {
+		return x.Ssid
+	}
+	return ""
+}
+
+func (x *TrustedNetwork) GetGatewayMac() string {
+	if x != nil {
+		return x.GatewayMac
+	}
+	return ""
+}
+
+func (x *TrustedNetwork) GetAction() TrustedNetworkAction {
+	if x != nil {
+		return x.Action
+	}
+	return TrustedNetworkAction_TRUSTED_DISCONNECT
+}
+
+type AddTrustedNetworkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network *TrustedNetwork `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+}
+
+func (x *AddTrustedNetworkRequest) Reset() {
+	*x = AddTrustedNetworkRequest{}
+	mi := &file_trusted_networks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTrustedNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTrustedNetworkRequest) ProtoMessage() {}
+
+func (x *AddTrustedNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trusted_networks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTrustedNetworkRequest.ProtoReflect.Descriptor instead.
+func (*AddTrustedNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_trusted_networks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddTrustedNetworkRequest) GetNetwork() *TrustedNetwork {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+type RemoveTrustedNetworkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveTrustedNetworkRequest) Reset() {
+	*x = RemoveTrustedNetworkRequest{}
+	mi := &file_trusted_networks_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTrustedNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTrustedNetworkRequest) ProtoMessage() {}
+
+func (x *RemoveTrustedNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trusted_networks_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTrustedNetworkRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTrustedNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_trusted_networks_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RemoveTrustedNetworkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CurrentNetworkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       int64  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Interface  string `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Ssid       string `protobuf:"bytes,3,opt,name=ssid,proto3" json:"ssid,omitempty"`
+	GatewayMac string `protobuf:"bytes,4,opt,name=gateway_mac,json=gatewayMac,proto3" json:"gateway_mac,omitempty"`
+	// id of the matching trusted network, empty if the network is not trusted
+	TrustedNetworkId string `protobuf:"bytes,5,opt,name=trusted_network_id,json=trustedNetworkId,proto3" json:"trusted_network_id,omitempty"`
+}
+
+func (x *CurrentNetworkResponse) Reset() {
+	*x = CurrentNetworkResponse{}
+	mi := &file_trusted_networks_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrentNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentNetworkResponse) ProtoMessage() {}
+
+func (x *CurrentNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trusted_networks_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentNetworkResponse.ProtoReflect.Descriptor instead.
+func (*CurrentNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_trusted_networks_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CurrentNetworkResponse) GetType() int64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *CurrentNetworkResponse) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *CurrentNetworkResponse) GetSsid() string {
+	if x != nil {
+		return x.Ssid
+	}
+	return ""
+}
+
+func (x *CurrentNetworkResponse) GetGatewayMac() string {
+	if x != nil {
+		return x.GatewayMac
+	}
+	return ""
+}
+
+func (x *CurrentNetworkResponse) GetTrustedNetworkId() string {
+	if x != nil {
+		return x.TrustedNetworkId
+	}
+	return ""
+}
+
+var File_trusted_networks_proto protoreflect.FileDescriptor
+
+var file_trusted_networks_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x87, 0x01, 0x0a,
+	0x0e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
+	0x73, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x6d,
+	0x61, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x4d, 0x61, 0x63, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x22, 0x2d, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0xad, 0x01, 0x0a, 0x16, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x73, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x73, 0x69, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x6d, 0x61, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4d, 0x61,
+	0x63, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x2a,
+	0x48, 0x0a, 0x14, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x52, 0x55, 0x53, 0x54,
+	0x45, 0x44, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12,
+	0x18, 0x0a, 0x14, 0x54, 0x52, 0x55, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x53, 0x48, 0x4e,
+	0x45, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e,
+	0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_trusted_networks_proto_rawDescOnce sync.Once
+	file_trusted_networks_proto_rawDescData = file_trusted_networks_proto_rawDesc
+)
+
+func file_trusted_networks_proto_rawDescGZIP() []byte {
+	file_trusted_networks_proto_rawDescOnce.Do(func() {
+		file_trusted_networks_proto_rawDescData = protoimpl.X.CompressGZIP(file_trusted_networks_proto_rawDescData)
+	})
+	return file_trusted_networks_proto_rawDescData
+}
+
+var file_trusted_networks_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_trusted_networks_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_trusted_networks_proto_goTypes = []any{
+	(TrustedNetworkAction)(0),           // 0: pb.TrustedNetworkAction
+	(*TrustedNetwork)(nil),              // 1: pb.TrustedNetwork
+	(*AddTrustedNetworkRequest)(nil),    // 2: pb.AddTrustedNetworkRequest
+	(*RemoveTrustedNetworkRequest)(nil), // 3: pb.RemoveTrustedNetworkRequest
+	(*CurrentNetworkResponse)(nil),      // 4: pb.CurrentNetworkResponse
+}
+var file_trusted_networks_proto_depIdxs = []int32{
+	0, // 0: pb.TrustedNetwork.action:type_name -> pb.TrustedNetworkAction
+	1, // 1: pb.AddTrustedNetworkRequest.network:type_name -> pb.TrustedNetwork
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_trusted_networks_proto_init() }
+func file_trusted_networks_proto_init() {
+	if File_trusted_networks_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_trusted_networks_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_trusted_networks_proto_goTypes,
+		DependencyIndexes: file_trusted_networks_proto_depIdxs,
+		EnumInfos:         file_trusted_networks_proto_enumTypes,
+		MessageInfos:      file_trusted_networks_proto_msgTypes,
+	}.Build()
+	File_trusted_networks_proto = out.File
+	file_trusted_networks_proto_rawDesc = nil
+	file_trusted_networks_proto_goTypes = nil
+	file_trusted_networks_proto_depIdxs = nil
+}
@@ -1,6 +1,9 @@
 package daemon
 
-import "math"
+import (
+	"math"
+	"time"
+)
 
 const (
 	Alpha  = 0.7
@@ -41,6 +44,12 @@ func obfuscationPenalty(obfuscated bool, timestamp, timestampMin, timestampMax i
 	return 0
 }
 
+// latencyPenalty grows faster than the latency, so that distant servers are not picked
+// only because of the lower load
+func latencyPenalty(rtt time.Duration) float64 {
+	return math.Pow(float64(rtt)/float64(10*time.Millisecond), 1.5)
+}
+
 func penalty(
 	obfuscated bool,
 	distance, distanceMin, distanceMax float64,
@@ -156,3 +156,22 @@ func TestPenalty(t *testing.T) {
 		}
 	}
 }
+
+func TestLatencyPenalty(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		rtt      time.Duration
+		expected float64
+	}{
+		{0, 0},
+		{10 * time.Millisecond, 1},
+		{40 * time.Millisecond, 8},
+		{100 * time.Millisecond, 31.623},
+	}
+
+	for _, item := range tests {
+		got := latencyPenalty(item.rtt)
+		assert.LessOrEqual(t, math.Abs(item.expected-got), PenaltyDelta)
+	}
+}
@@ -0,0 +1,86 @@
+package daemon
+
+import (
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// EnforcePolicy applies the settings pinned by the policy file to the config.
+func EnforcePolicy(cm config.Manager, loader *config.PolicyLoader) error {
+	policy, err := loader.Load()
+	if err != nil {
+		return fmt.Errorf("loading policy: %w", err)
+	}
+
+	var enforceErr error
+	if err := cm.SaveWith(func(c config.Config) config.Config {
+		enforced, err := policy.Enforce(c)
+		if err != nil {
+			enforceErr = err
+			return c
+		}
+		return enforced
+	}); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+	return enforceErr
+}
+
+// checkPolicy returns the settings locked by the policy which would be modified by the change
+// and false if the change is not allowed. Changes are refused if the policy can not be read,
+// since it is not known what the administrator allows.
+func (r *RPC) checkPolicy(cfg config.Config, change func(config.Config) config.Config) ([]string, bool) {
+	if r.policy == nil {
+		return nil, true
+	}
+	policy, err := r.policy.Load()
+	if err != nil {
+		log.Error("loading policy:", err)
+		return nil, false
+	}
+	locked, err := policy.Locked(cfg, change(cfg))
+	if err != nil {
+		log.Error("checking policy:", err)
+		return nil, false
+	}
+	return locked, len(locked) == 0
+}
+
+// lockedSettings returns the settings restricted by the policy
+func (r *RPC) lockedSettings() []string {
+	if r.policy == nil {
+		return nil
+	}
+	policy, err := r.policy.Load()
+	if err != nil {
+		log.Error("loading policy:", err)
+		return nil
+	}
+	locked, err := policy.LockedSettings()
+	if err != nil {
+		log.Error("listing locked settings:", err)
+		return nil
+	}
+	return locked
+}
+
+// pinnedSettings returns the settings pinned by the policy
+func (r *RPC) pinnedSettings() config.SettingsFile {
+	if r.policy == nil {
+		return config.SettingsFile{}
+	}
+	policy, err := r.policy.Load()
+	if err != nil {
+		log.Error("loading policy:", err)
+		return config.SettingsFile{}
+	}
+	return policy.Pin
+}
+
+func policyLockedPayload(locked []string) *pb.Payload {
+	return &pb.Payload{Type: internal.CodePolicyLocked, Data: locked}
+}
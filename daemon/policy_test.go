@@ -0,0 +1,148 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/fs"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+)
+
+const testPolicyPath = "/etc/nordvpn/policy.yaml"
+
+func newTestPolicyLoader(t *testing.T, policy string) *config.PolicyLoader {
+	t.Helper()
+	fsMock := fs.NewSystemFileHandleMock(t)
+	fsMock.AddFile(testPolicyPath, []byte(policy))
+	return config.NewPolicyLoader(testPolicyPath, &fsMock)
+}
+
+func TestSetKillSwitch_PolicyLocked(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := newMockConfigManager()
+	cm.c.KillSwitch = true
+	netw := &networker.Mock{}
+	rpc := RPC{
+		cm:     cm,
+		netw:   netw,
+		policy: newTestPolicyLoader(t, "version: 1\npin:\n  killswitch: true\n"),
+	}
+
+	resp, err := rpc.SetKillSwitch(context.Background(), &pb.SetKillSwitchRequest{KillSwitch: false})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodePolicyLocked, resp.Type)
+	assert.Equal(t, []string{"killswitch"}, resp.Data)
+	assert.True(t, cm.c.KillSwitch)
+}
+
+func TestSetDNS_PolicyLocked(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := newMockConfigManager()
+	rpc := RPC{
+		cm:     cm,
+		netw:   &networker.Mock{},
+		policy: newTestPolicyLoader(t, "version: 1\nallow:\n  dns: [1.1.1.1]\n"),
+	}
+
+	resp, err := rpc.SetDNS(context.Background(), &pb.SetDNSRequest{Dns: []string{"8.8.8.8"}})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.SetErrorCode_POLICY_LOCKED, resp.GetErrorCode())
+	assert.Empty(t, cm.c.AutoConnectData.DNS)
+}
+
+func TestAddDNSRoute_PolicyLocked(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := newMockConfigManager()
+	netw := &networker.Mock{}
+	rpc := RPC{
+		cm:     cm,
+		netw:   netw,
+		policy: newTestPolicyLoader(t, "version: 1\npin:\n  dns: [1.1.1.1]\n"),
+	}
+
+	resp, err := rpc.AddDNSRoute(context.Background(), &pb.AddDNSRouteRequest{
+		Route: &pb.DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodePolicyLocked, resp.Type)
+	assert.Equal(t, []string{"dns"}, resp.Data)
+	assert.Empty(t, cm.c.AutoConnectData.DNSRoutes)
+	assert.Empty(t, netw.DNSRoutes)
+}
+
+func TestCheckPolicy_UnreadablePolicy(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fsMock := fs.NewSystemFileHandleMock(t)
+	fsMock.ReadErr = errors.New("permission denied")
+	rpc := RPC{policy: config.NewPolicyLoader(testPolicyPath, &fsMock)}
+
+	_, ok := rpc.checkPolicy(config.Config{}, func(c config.Config) config.Config {
+		c.LanDiscovery = true
+		return c
+	})
+	assert.False(t, ok)
+}
+
+func TestConnectWithOverrides_PolicyLocked(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name      string
+		policy    string
+		overrides connectionOverrides
+		locked    []string
+	}{
+		{
+			name:      "dns not allowed",
+			policy:    "version: 1\nallow:\n  dns: [1.1.1.1]\n",
+			overrides: connectionOverrides{dns: config.DNS{"8.8.8.8"}},
+			locked:    []string{"dns"},
+		},
+		{
+			name:      "technology not allowed",
+			policy:    "version: 1\nallow:\n  technology: [nordlynx]\n",
+			overrides: connectionOverrides{technology: config.Technology_OPENVPN},
+			locked:    []string{"technology"},
+		},
+		{
+			name:      "pinned setting",
+			policy:    "version: 1\npin:\n  threat_protection_lite: true\n",
+			overrides: connectionOverrides{dns: config.DNS{"1.1.1.1"}},
+			locked:    []string{"threat_protection_lite"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rpc := testRPCLocal(t)
+			rpc.policy = newTestPolicyLoader(t, test.policy)
+			_ = rpc.cm.SaveWith(func(c config.Config) config.Config {
+				c.Technology = config.Technology_NORDLYNX
+				c.AutoConnectData.Protocol = config.Protocol_UDP
+				c.AutoConnectData.ThreatProtectionLite = true
+				return c
+			})
+			server := &mockRPCServer{}
+
+			failed, err := rpc.connectWithParameters(context.Background(), &pb.ConnectRequest{},
+				test.overrides, server, pb.ConnectionSource_MANUAL, "", events.VPNConnectionReasonNone)
+			assert.True(t, failed)
+			assert.NoError(t, err)
+			assert.Equal(t, internal.CodePolicyLocked, server.msg.Type)
+			assert.Equal(t, test.locked, server.msg.Data)
+			assert.True(t, rpc.lastOverrides.isEmpty())
+		})
+	}
+}
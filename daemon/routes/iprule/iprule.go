@@ -67,6 +67,7 @@ func (r *Router) SetupRoutingRules(
 		if err := removeFwmarkRule(r.fwmark); err != nil {
 			log.Error(err)
 		}
+		removeIPv6Rules(r.fwmark)
 		r.removeAllowSubnetRules()
 	}()
 
@@ -113,6 +114,12 @@ func (r *Router) SetupRoutingRules(
 		return fmt.Errorf("adding allowlist rules: %w", err)
 	}
 
+	// IPv6 can be disabled in the kernel, the rules are needed only when the VPN tunnel
+	// carries IPv6 traffic
+	if err := setupIPv6Rules(r.fwmark, routingTableID, enableLocal, lanDiscovery); err != nil {
+		log.Warn("setting up IPv6 routing rules:", err)
+	}
+
 	return nil
 }
 
@@ -184,6 +191,8 @@ func (r *Router) CleanupRouting() error {
 		log.Warn(err)
 	}
 
+	removeIPv6Rules(r.fwmark)
+
 	// Remove allowlist subnet routing rules
 	r.removeAllowSubnetRules()
 
@@ -247,6 +256,11 @@ func (r *Router) TableID() uint {
 // For now we don't do that. We will return the highest prio (lowest value) closer to
 // main rule (from all lookup main). In the above case 32765. We need to rethink this mechanism.
 func calculateRulePriority() (uint, error) {
+	return calculateFamilyRulePriority(netlink.FAMILY_V4)
+}
+
+// calculateFamilyRulePriority is calculateRulePriority for the rules of the given address family
+func calculateFamilyRulePriority(family int) (uint, error) {
 	// # get rule priority:
 	// CDM "ip rule show" PARSE OUTPUT, LOOKUP "from all lookup main":
 	// # sample output:
@@ -255,7 +269,7 @@ func calculateRulePriority() (uint, error) {
 	// 32767:	from all lookup default
 	// # EXPECTED RESULT: 32766 - 1 = 32765 (check if priority/id is not in use)
 
-	rules, err := netlink.RuleList(netlink.FAMILY_V4)
+	rules, err := netlink.RuleList(family)
 	if err != nil {
 		return 0, fmt.Errorf("listing ip rules: %w", err)
 	}
@@ -0,0 +1,56 @@
+package iprule
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/vishvananda/netlink"
+)
+
+// setupIPv6Rules mirrors the fwmark and suppress rules for IPv6, so that the IPv6 traffic is
+// routed to the same table as IPv4. Previous rules are replaced.
+func setupIPv6Rules(fwmark uint32, tableID uint, enableLocal bool, skipGroup bool) error {
+	if fwmark == 0 {
+		return fmt.Errorf("fwmark cannot be 0")
+	}
+	if tableID > math.MaxInt {
+		return fmt.Errorf("table id %d exceeds max int value", tableID)
+	}
+
+	removeIPv6Rules(fwmark)
+
+	// CMD: ip -6 rule add priority $PRIOID not from all fwmark $FWMRK lookup $TBLID
+	prioID, err := calculateFamilyRulePriority(netlink.FAMILY_V6)
+	if err != nil {
+		return err
+	}
+	if err := netlink.RuleAdd(ipv6Rule(fwmarkRule(int(prioID), fwmark, int(tableID)))); err != nil { // #nosec G115
+		return fmt.Errorf("adding IPv6 fwmark rule: %w", err)
+	}
+
+	if !enableLocal {
+		return nil
+	}
+
+	// CMD: ip -6 rule add priority $PRIOID from all lookup main suppress_prefixlength 0 suppress_ifgroup 444
+	prioID, err = calculateFamilyRulePriority(netlink.FAMILY_V6)
+	if err != nil {
+		return err
+	}
+	if err := netlink.RuleAdd(ipv6Rule(suppressRule(int(prioID), skipGroup))); err != nil { // #nosec G115
+		return fmt.Errorf("adding IPv6 suppress rule: %w", err)
+	}
+	return nil
+}
+
+// removeIPv6Rules removes the rules added by setupIPv6Rules. Errors are ignored as the rules
+// might have not been added.
+func removeIPv6Rules(fwmark uint32) {
+	_ = netlink.RuleDel(ipv6Rule(fwmarkRule(-1, fwmark, -1)))
+	_ = netlink.RuleDel(ipv6Rule(suppressRule(-1, true)))
+}
+
+func ipv6Rule(rule *netlink.Rule) *netlink.Rule {
+	rule.Family = netlink.FAMILY_V6
+	return rule
+}
@@ -10,8 +10,12 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/config/remote"
 	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/allowlist"
 	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
 	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/favorites"
+	"github.com/NordSecurity/nordvpn-linux/daemon/history"
+	"github.com/NordSecurity/nordvpn-linux/daemon/netstate"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/daemon/recents"
 	"github.com/NordSecurity/nordvpn-linux/daemon/serverpicker"
@@ -42,8 +46,12 @@ type RPC struct {
 	repo                *RepoAPI
 	authentication      core.Authentication
 	lastServerSelection serverpicker.ServerSelection
-	version             string
-	events              *daemonevents.Events
+	// lastOverrides are kept for reconnecting to the same target
+	lastOverrides connectionOverrides
+	// technologyOverridden is set while the VPN implementation differs from the configured technology
+	technologyOverridden bool
+	version              string
+	events               *daemonevents.Events
 	// factory picks which VPN implementation to use
 	factory                   FactoryFunc
 	endpoint                  network.Endpoint
@@ -65,7 +73,20 @@ type RPC struct {
 	dataUpdateEvents          *daemonevents.DataUpdateEvents
 	initialLoginType          *atomicLoginType // memorize what action started: Login or Signup (Register) - thread-safe
 	pauseManager              ReconnectScheduler
+	pauseEvents               *daemonevents.PauseEvents
+	schedule                  scheduleState
+	networkIdentity           netstate.IdentityResolver
+	trustedNetworks           trustedNetworksState
+	connectionHistory         *history.Store
+	policy                    *config.PolicyLoader
+	latency                   *serverpicker.LatencyMeter
+	favorites                 *favorites.Store
+	encryptedDNS              EncryptedDNSResolver
+	metrics                   MetricsServer
 	dedicatedServerKeyManager devicekey.DedicatedServersKeyManager
+	splitTunnel               SplitTunnelManager
+	allowlistDomains          *allowlist.DomainResolver
+	meshPeers                 MeshPeerPermissions
 	pb.UnimplementedDaemonServer
 }
 
@@ -99,6 +120,14 @@ func NewRPC(
 	dataUpdateEvents *daemonevents.DataUpdateEvents,
 	pauseEvents *daemonevents.PauseEvents,
 	dedicatedServersKeyManager devicekey.DedicatedServersKeyManager,
+	splitTunnel SplitTunnelManager,
+	allowlistDomains *allowlist.DomainResolver,
+	networkIdentity netstate.IdentityResolver,
+	connectionHistory *history.Store,
+	policy *config.PolicyLoader,
+	latency *serverpicker.LatencyMeter,
+	favorites *favorites.Store,
+	encryptedDNS EncryptedDNSResolver,
 ) *RPC {
 	scheduler, _ := gocron.NewScheduler(gocron.WithLocation(time.UTC))
 	r := &RPC{
@@ -130,7 +159,16 @@ func NewRPC(
 		consentChecker:            consentChecker,
 		recentVPNConnStore:        recentVPNConnStore,
 		dataUpdateEvents:          dataUpdateEvents,
+		pauseEvents:               pauseEvents,
 		dedicatedServerKeyManager: dedicatedServersKeyManager,
+		splitTunnel:               splitTunnel,
+		allowlistDomains:          allowlistDomains,
+		networkIdentity:           networkIdentity,
+		connectionHistory:         connectionHistory,
+		policy:                    policy,
+		latency:                   latency,
+		favorites:                 favorites,
+		encryptedDNS:              encryptedDNS,
 		initialLoginType:          NewAtomicLoginType(),
 	}
 	reconnectScheduler := NewReconnectScheduler(r.ConnectFromLastSelection, connectionInfo, pauseEvents)
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
@@ -12,6 +14,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/core"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/daemon/serverpicker"
+	"github.com/NordSecurity/nordvpn-linux/daemon/state/types"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/features"
@@ -31,8 +34,14 @@ func determineServerGroupIDs(server *core.Server) []config.ServerGroup {
 
 // Connect initiates and handles the VPN connection process
 func (r *RPC) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) (retErr error) {
+	overrides, err := connectionOverridesFromProtobuf(in.GetOverrides())
+	if err != nil {
+		log.Warn("invalid connection overrides:", err)
+		return srv.Send(&pb.Payload{Type: internal.CodeInvalidConnectionOverrides})
+	}
 	return r.executeConnect(srv, func(ctx context.Context) (bool, error) {
-		return r.connectWithParameters(ctx, in, srv, pb.ConnectionSource_MANUAL, "", events.VPNConnectionReasonNone)
+		return r.connectWithParameters(ctx, in, overrides, srv,
+			pb.ConnectionSource_MANUAL, "", events.VPNConnectionReasonNone)
 	})
 }
 
@@ -58,6 +67,27 @@ func (r *RPC) ReconnectOnServerMaintenanceEvent(endpointFromEvent string) (exitE
 	})
 }
 
+// FailoverToDifferentServer reconnects to a different server with the same connection
+// parameters when the connection to the hostname degrades.
+func (r *RPC) FailoverToDifferentServer(hostname string) (exitErr error) {
+	srv := &connectServer{}
+	defer func() {
+		if exitErr != nil || srv.err != nil {
+			exitErr = errors.Join(exitErr, srv.err)
+		}
+	}()
+
+	return r.executeConnect(srv, func(ctx context.Context) (bool, error) {
+		_, isCurrConnActive := r.netw.GetConnectionParameters()
+		currentServer := r.lastServerSelection.Server
+		if !isCurrConnActive || currentServer == nil || currentServer.Hostname != hostname {
+			log.Debug("ignoring failover, connection has changed since the health check")
+			return false, nil
+		}
+		return r.reconnectToDifferentServer(ctx, srv, events.VPNConnectionReasonFailover)
+	})
+}
+
 // executeConnect - ensures that no two connect functions are executed in the same time
 func (r *RPC) executeConnect(srv pb.Daemon_ConnectServer, fn func(context.Context) (bool, error)) error {
 	var err error
@@ -97,6 +127,16 @@ func (r *RPC) reconnectOnServerMaintenance(
 		return false, nil
 	}
 
+	return r.reconnectToDifferentServer(ctx, srv, events.VPNConnectionReasonServerMaintenance)
+}
+
+// reconnectToDifferentServer reconnects with the requested connection parameters excluding
+// the current server. Entry server of the multi-hop connection is kept.
+func (r *RPC) reconnectToDifferentServer(
+	ctx context.Context,
+	srv pb.Daemon_ConnectServer,
+	reason events.VPNConnectionReason,
+) (bool, error) {
 	reqParams := r.RequestedConnParams.Get()
 	currentServer := r.lastServerSelection.Server
 	log.ENS.Debug(reqParams, currentServer)
@@ -124,8 +164,12 @@ func (r *RPC) reconnectOnServerMaintenance(
 		ServerGroup: group,
 		ServerTag:   serverTag,
 	}
+	// keep the same entry server, only the exit server is replaced
+	if entry := r.lastServerSelection.Entry; entry != nil {
+		req.Via = strings.Split(entry.Hostname, ".")[0]
+	}
 
-	return r.connectWithParameters(ctx, &req, srv, pb.ConnectionSource_AUTO, hostname, events.VPNConnectionReasonServerMaintenance)
+	return r.connectWithParameters(ctx, &req, r.lastOverrides, srv, pb.ConnectionSource_AUTO, hostname, reason)
 }
 
 func locationTag(code, city string) string {
@@ -210,6 +254,18 @@ func (r *RPC) connectWithStoredServerSelection(
 		log.Error(err)
 		return false, fmt.Errorf("reading config: %w", err)
 	}
+	// resume the paused connection with the same overrides, unless the policy has changed since
+	persistedTechnology := cfg.Technology
+	if !r.lastOverrides.isEmpty() {
+		if locked, ok := r.checkPolicy(cfg, r.lastOverrides.apply); !ok {
+			return true, srv.Send(policyLockedPayload(locked))
+		}
+	}
+	cfg = r.lastOverrides.apply(cfg)
+	if err := r.useTechnology(persistedTechnology, cfg.Technology); err != nil {
+		log.Error(err)
+		return false, internal.ErrUnhandled
+	}
 	r.connectionInfo.SetInitialConnecting()
 
 	if core.IsServerDedicated(*r.lastServerSelection.Server) {
@@ -247,6 +303,7 @@ func (r *RPC) connectWithStoredServerSelection(
 
 func (r *RPC) connectWithParameters(ctx context.Context,
 	in *pb.ConnectRequest,
+	overrides connectionOverrides,
 	srv pb.Daemon_ConnectServer,
 	source pb.ConnectionSource,
 	excludedServer string,
@@ -264,6 +321,18 @@ func (r *RPC) connectWithParameters(ctx context.Context,
 	if err := r.cm.Load(&cfg); err != nil {
 		log.Error(err)
 	}
+	persistedTechnology := cfg.Technology
+	if !overrides.isEmpty() {
+		// overrides are not persisted, but they must not bypass the policy either
+		if locked, ok := r.checkPolicy(cfg, overrides.apply); !ok {
+			return true, srv.Send(policyLockedPayload(locked))
+		}
+		cfg = overrides.apply(cfg)
+		if err := validateConnectionSettings(cfg); err != nil {
+			log.Warn("invalid connection overrides:", err)
+			return true, srv.Send(&pb.Payload{Type: internal.CodeInvalidConnectionOverrides})
+		}
+	}
 	prelimParams := serverpicker.GetServerParameters(in.GetServerTag(), in.GetServerGroup(), r.dm.GetCountryData().Countries)
 	r.RequestedConnParams.Set(source, serverpicker.ServerParameters{Group: prelimParams.Group})
 	r.connectionInfo.SetInitialConnecting()
@@ -298,6 +367,16 @@ func (r *RPC) connectWithParameters(ctx context.Context,
 		return true, srv.Send(&pb.Payload{Type: internal.CodeTechnologyDisabled})
 	}
 
+	if in.GetVia() != "" && !isMultiHopSupported(cfg) {
+		return true, srv.Send(&pb.Payload{Type: internal.CodeMultiHopUnsupported})
+	}
+
+	if err := r.useTechnology(persistedTechnology, cfg.Technology); err != nil {
+		log.Error(err)
+		return false, internal.ErrUnhandled
+	}
+	r.lastOverrides = overrides
+
 	insights := r.dm.GetInsightsData().Insights
 
 	// Measure the time it takes to obtain recommended servers list as the connection attempt event duration
@@ -308,29 +387,42 @@ func (r *RPC) connectWithParameters(ctx context.Context,
 	log.Debugf("picking servers for %v technology, input serverTag=%q serverGroup=%q, server excluded from lookup=%q",
 		cfg.Technology, in.GetServerTag(), in.GetServerGroup(), excludedServer)
 
-	serverSelection, err := selectServer(r, &insights, cfg, inputServerTag, in.GetServerGroup(), excludedServer)
+	searchParams := serverpicker.NewSearchParams(inputServerTag, in.GetServerGroup(), excludedServer)
+	searchParams.Fastest = in.GetFastest()
+	serverSelection, err := pickServer(r, &insights, cfg, searchParams)
 	if err != nil {
 		var errorCode *internal.ErrorWithCode
-		if errors.As(err, &errorCode) {
-			if errorCode.Code == internal.CodeDedicatedServersNotReady {
-				r.publishDedicatedServerStatus(serverSelection.DedicatedServerStatus)
-			}
-			return true, srv.Send(&pb.Payload{Type: errorCode.Code})
+		if errors.As(err, &errorCode) && errorCode.Code == internal.CodeDedicatedServersNotReady {
+			r.publishDedicatedServerStatus(serverSelection.DedicatedServerStatus)
 		}
-
-		if errors.Is(err, core.ErrUnauthorized) {
-			return true, srv.Send(&pb.Payload{Type: internal.CodeRevokedAccessToken})
+		if code := serverSelectionErrorCode(err); code != 0 {
+			return true, srv.Send(&pb.Payload{Type: code})
 		}
-
-		if errors.Is(err, internal.ErrServerIsUnavailable) {
-			return true, srv.Send(&pb.Payload{Type: internal.CodeServerUnavailable})
+		return false, err
+	}
+	if len(serverSelection.Latencies) > 0 {
+		if err := srv.Send(&pb.Payload{
+			Type: internal.CodeServerLatencies,
+			Data: latenciesToPayloadData(serverSelection.Latencies),
+		}); err != nil {
+			log.Error(err)
 		}
+	}
 
-		if errors.Is(err, internal.ErrVirtualServerSelected) {
-			return true, srv.Send(&pb.Payload{Type: internal.CodeVirtualLocationDisabled})
+	if in.GetVia() != "" {
+		// the exit server is excluded, so that both hops are different servers
+		entrySelection, err := selectServer(r, &insights, cfg,
+			internal.RemoveNonAlphanumeric(in.GetVia()), "", serverSelection.Server.Hostname)
+		if err != nil {
+			if code := serverSelectionErrorCode(err); code != 0 {
+				return true, srv.Send(&pb.Payload{Type: code})
+			}
+			return false, err
 		}
-
-		return false, err
+		if core.IsServerDedicated(*entrySelection.Server) || core.IsServerDedicated(*serverSelection.Server) {
+			return true, srv.Send(&pb.Payload{Type: internal.CodeMultiHopUnsupported})
+		}
+		serverSelection.Entry = entrySelection.Server
 	}
 	r.lastServerSelection = serverSelection
 
@@ -355,6 +447,11 @@ func (r *RPC) connect(
 	if err != nil {
 		log.Error(err)
 	}
+	// settings may have been changed since the multi-hop server selection was made
+	if serverSelection.Entry != nil && !isMultiHopSupported(cfg) {
+		return true, srv.Send(&pb.Payload{Type: internal.CodeMultiHopUnsupported})
+	}
+
 	tokenData := cfg.TokensData[cfg.AutoConnectData.ID]
 	creds := vpn.Credentials{
 		OpenVPNUsername:    tokenData.OpenVPNUsername,
@@ -419,6 +516,15 @@ func (r *RPC) connect(
 		DedicatedServerPort: serverSelection.Server.DedicatedServersPort,
 	}
 
+	var entryServer *types.MultiHopEntry
+	if serverSelection.Entry != nil {
+		entryServer, serverData.Entry, err = multiHopEntryData(serverSelection.Entry)
+		if err != nil {
+			log.Error(err)
+			return false, internal.ErrUnhandled
+		}
+	}
+
 	allowlist := cfg.AutoConnectData.Allowlist
 
 	city := country.City.Name
@@ -453,6 +559,8 @@ func (r *RPC) connect(
 		PauseInterval:           pauseDuration,
 		UnpausedByUser:          pauseInterrupted,
 		VPNConnReason:           vpnConnReason,
+		EntryServer:             entryServer,
+		OverriddenSettings:      r.lastOverrides.names(),
 	}
 
 	// Send the connection attempt event
@@ -496,14 +604,17 @@ func (r *RPC) connect(
 		VPNConnReason:        vpnConnReason,
 	}, r.events.Service.Disconnect.Publish)
 
+	// overridden nameservers replace the encrypted DNS for this connection
+	if err := r.applyEncryptedDNS(cfg.AutoConnectData.EncryptedDNS); err != nil {
+		log.Error("applying encrypted DNS:", err)
+	}
+
 	err = r.netw.Start(
 		ctx,
 		creds,
 		serverData,
 		allowlist,
-		cfg.AutoConnectData.DNS.Or(r.nameservers.Get(
-			cfg.AutoConnectData.ThreatProtectionLite,
-		)),
+		r.systemNameservers(cfg),
 		true, // here vpn connect - enable routing to local LAN
 		disconnectSender.PublishDisconnect,
 	)
@@ -556,6 +667,53 @@ func (r *RPC) connect(
 	return false, nil
 }
 
+// serverSelectionErrorCode returns the response code for the server selection error or 0
+// if the error is not expected
+func serverSelectionErrorCode(err error) int64 {
+	var errorCode *internal.ErrorWithCode
+	switch {
+	case errors.As(err, &errorCode):
+		return errorCode.Code
+	case errors.Is(err, core.ErrUnauthorized):
+		return internal.CodeRevokedAccessToken
+	case errors.Is(err, internal.ErrServerIsUnavailable):
+		return internal.CodeServerUnavailable
+	case errors.Is(err, internal.ErrVirtualServerSelected):
+		return internal.CodeVirtualLocationDisabled
+	}
+	return 0
+}
+
+// isMultiHopSupported reports whether two hops can be chained with the current settings.
+// Hops are chained only by NordLynx and post-quantum keys can't be negotiated through the entry server.
+func isMultiHopSupported(cfg config.Config) bool {
+	return cfg.Technology == config.Technology_NORDLYNX && !cfg.AutoConnectData.PostquantumVpn
+}
+
+func multiHopEntryData(server *core.Server) (*types.MultiHopEntry, *vpn.ServerData, error) {
+	ip, err := server.IPv4()
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing the entry server IP: %w", err)
+	}
+	country, err := server.Locations.Country()
+	if err != nil {
+		log.Error(err)
+	}
+	entry := &types.MultiHopEntry{
+		IP:       ip,
+		Name:     server.Name,
+		Hostname: server.Hostname,
+		Country:  country.Name,
+		City:     country.City.Name,
+	}
+	serverData := &vpn.ServerData{
+		IP:                ip,
+		Hostname:          server.Hostname,
+		NordLynxPublicKey: server.NordLynxPublicKey,
+	}
+	return entry, serverData, nil
+}
+
 func (r *RPC) publishDedicatedServerStatus(status core.DedicatedServerStatus) {
 	if status != "" {
 		r.events.Service.DedicatedServerStatus.Publish(
@@ -593,3 +751,17 @@ func determineTargetServerGroup(server *core.Server, parameters serverpicker.Ser
 }
 
 type FactoryFunc func(config.Technology) (vpn.VPN, error)
+
+// latenciesToPayloadData formats the latencies as hostname:milliseconds, milliseconds are
+// empty for the unreachable servers
+func latenciesToPayloadData(latencies []serverpicker.ServerLatency) []string {
+	data := make([]string, 0, len(latencies))
+	for _, l := range latencies {
+		var rtt string
+		if l.RTT != 0 {
+			rtt = strconv.FormatInt(l.RTT.Milliseconds(), 10)
+		}
+		data = append(data, l.Server.Hostname+":"+rtt)
+	}
+	return data
+}
@@ -1474,7 +1474,7 @@ func TestReconnectOnServerMaintenance(t *testing.T) {
 	rpc.events.Service.Connect = connectEvents
 
 	// connect to a specific server name, it1
-	failed, err := rpc.connectWithParameters(ctx, &pb.ConnectRequest{ServerTag: "it1"}, server, pb.ConnectionSource_MANUAL, "", events.VPNConnectionReasonNone)
+	failed, err := rpc.connectWithParameters(ctx, &pb.ConnectRequest{ServerTag: "it1"}, connectionOverrides{}, server, pb.ConnectionSource_MANUAL, "", events.VPNConnectionReasonNone)
 	assert.False(t, failed)
 	assert.NoError(t, err)
 	assert.Equal(t, "it1.nordvpn.com", rpc.lastServerSelection.Server.Hostname)
@@ -1579,7 +1579,7 @@ func TestReconnectOnServerMaintenance_CountryOnly(t *testing.T) {
 		}
 	})
 
-	failed, err := rpc.connectWithParameters(ctx, &pb.ConnectRequest{ServerTag: "it"}, server, pb.ConnectionSource_MANUAL, "", events.VPNConnectionReasonNone)
+	failed, err := rpc.connectWithParameters(ctx, &pb.ConnectRequest{ServerTag: "it"}, connectionOverrides{}, server, pb.ConnectionSource_MANUAL, "", events.VPNConnectionReasonNone)
 	assert.False(t, failed)
 	assert.NoError(t, err)
 
@@ -1651,3 +1651,27 @@ func TestLocationTag(t *testing.T) {
 		})
 	}
 }
+
+func TestIsMultiHopSupported(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name        string
+		technology  config.Technology
+		postQuantum bool
+		expected    bool
+	}{
+		{name: "nordlynx", technology: config.Technology_NORDLYNX, expected: true},
+		{name: "nordlynx with post-quantum", technology: config.Technology_NORDLYNX, postQuantum: true},
+		{name: "openvpn", technology: config.Technology_OPENVPN},
+		{name: "nordwhisper", technology: config.Technology_NORDWHISPER},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Config{Technology: tt.technology}
+			cfg.AutoConnectData.PostquantumVpn = tt.postQuantum
+			assert.Equal(t, tt.expected, isMultiHopSupported(cfg))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package daemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/history"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetConnectionHistory returns VPN connection sessions which were active after the requested time.
+func (r *RPC) GetConnectionHistory(
+	ctx context.Context,
+	in *pb.ConnectionHistoryRequest,
+) (*pb.ConnectionHistoryResponse, error) {
+	if r.connectionHistory == nil {
+		return &pb.ConnectionHistoryResponse{Type: internal.CodeFeatureHidden}, nil
+	}
+
+	var since time.Time
+	if in.GetSince() != nil {
+		since = in.GetSince().AsTime()
+	}
+
+	sessions := r.connectionHistory.Sessions(since)
+	// active session counters are stored periodically, show the current ones instead
+	status := r.connectionInfo.Status()
+	resp := &pb.ConnectionHistoryResponse{Type: internal.CodeSuccess}
+	for i, session := range sessions {
+		if i == len(sessions)-1 && session.IsActive() && status.State == pb.ConnectionState_CONNECTED {
+			session.Rx = max(session.Rx, status.Rx)
+			session.Tx = max(session.Tx, status.Tx)
+		}
+		resp.Sessions = append(resp.Sessions, connectionSessionToPb(session))
+	}
+	return resp, nil
+}
+
+func connectionSessionToPb(session history.Session) *pb.ConnectionSession {
+	s := &pb.ConnectionSession{
+		Start:       timestamppb.New(session.Start),
+		Server:      session.Server,
+		ServerName:  session.ServerName,
+		Ip:          session.IP,
+		Country:     session.Country,
+		CountryCode: session.CountryCode,
+		City:        session.City,
+		Technology:  session.Technology,
+		Protocol:    session.Protocol,
+		Rx:          session.Rx,
+		Tx:          session.Tx,
+		ReasonCode:  int32(session.Reason),
+		Paused:      session.Paused,
+		Interrupted: session.Interrupted,
+	}
+	if session.End != nil {
+		s.End = timestamppb.New(*session.End)
+	}
+	if session.Error != nil {
+		s.Error = session.Error.String()
+	}
+	return s
+}
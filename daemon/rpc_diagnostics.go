@@ -156,14 +156,16 @@ func createDiagnosticsZip(outputDir string) (*os.File, error) {
 }
 
 // appState bundles the daemon's view of itself for inclusion in
-// system-info.txt. Captured up front so addSystemInfo doesn't depend on *RPC.
+// system-info.txt and leaktest.txt. Captured up front so the collection steps
+// don't depend on *RPC.
 type appState struct {
 	version  string
 	status   string
 	settings string
+	leakTest string
 }
 
-// collectAppState pulls the daemon's version, status, and settings via the
+// collectAppState pulls the daemon's version, status, settings, and leak test via the
 // existing in-process RPC handlers, formatted as multi-line text blocks
 // (prototext.Format). Errors are rendered inline so the corresponding block
 // is never silently empty.
@@ -181,9 +183,24 @@ func (r *RPC) collectAppState(ctx context.Context) appState {
 	} else {
 		out.settings = prototext.Format(settings)
 	}
+
+	out.leakTest = r.collectLeakTest(ctx)
 	return out
 }
 
+// collectLeakTest runs the leak test and formats its results the same way
+// as the rest of the app state.
+func (r *RPC) collectLeakTest(ctx context.Context) string {
+	leakTest, err := r.LeakTest(ctx, &pb.Empty{})
+	if err != nil {
+		return fmt.Sprintf("leak test error: %v\n", err)
+	}
+	if leakTest.Type == internal.CodeVPNNotRunning {
+		return "not performed, VPN is not connected\n"
+	}
+	return prototext.Format(leakTest)
+}
+
 // daemonSupervisor identifies how the nordvpn daemon is being managed on the
 // host, so addDaemonLogs can pick the matching log source. Detection runs
 // once at collection time (detectDaemonSupervisor); addDaemonLogs itself is a
@@ -382,6 +399,9 @@ func collectDiagnosticsData(
 		{"Collecting firewall rules...", func() error {
 			return addFirewallInfo(zipWriter, logf)
 		}, false},
+		{"Running leak test...", func() error {
+			return addLeakTestInfo(zipWriter, state)
+		}, false},
 	}
 
 	total := len(steps)
@@ -903,6 +923,17 @@ func addFirewallInfo(zipWriter *zip.Writer, logf logFunc) error {
 	return nil
 }
 
+// addLeakTestInfo writes the leak test results captured with the app state to leaktest.txt.
+func addLeakTestInfo(zipWriter *zip.Writer, state appState) error {
+	w, err := zipWriter.Create("leaktest.txt")
+	if err != nil {
+		return err
+	}
+	writeBlock(w, "nordvpn leaktest", state.leakTest)
+
+	return nil
+}
+
 // addDNSInfo writes DNS-related diagnostics (resolv.conf, systemd-resolved,
 // NetworkManager DNS state) to dns-info.txt inside the archive. DNS is a
 // frequent support topic, so these blocks live in their own entry to keep
@@ -14,8 +14,10 @@ import (
 	"syscall"
 	"testing"
 
+	"github.com/NordSecurity/nordvpn-linux/daemon/leaktest"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/test/category"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -374,6 +376,30 @@ func TestWriteLogExtractionReport(t *testing.T) {
 	assert.Equal(t, "line1\nline2\n", entries["log_extraction_report.log"])
 }
 
+func TestAddLeakTestInfo(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rpc := &RPC{netw: &testnetworker.Mock{LeakTestReport: leaktest.Report{
+		{Name: leaktest.CheckIPv6, Status: leaktest.StatusFail, Detail: "IPv6 traffic is routed through eth0 outside of the tunnel"},
+	}}}
+	assert.Equal(t, "not performed, VPN is not connected\n", rpc.collectLeakTest(context.Background()))
+
+	rpc.netw = &testnetworker.Mock{VpnActive: true, LeakTestReport: leaktest.Report{
+		{Name: leaktest.CheckIPv6, Status: leaktest.StatusFail, Detail: "IPv6 traffic is routed through eth0 outside of the tunnel"},
+	}}
+	state := appState{leakTest: rpc.collectLeakTest(context.Background())}
+
+	var zbuf bytes.Buffer
+	zw := zip.NewWriter(&zbuf)
+	require.NoError(t, addLeakTestInfo(zw, state))
+	require.NoError(t, zw.Close())
+
+	entries := readZipEntries(t, zbuf.Bytes())
+	assert.Contains(t, entries["leaktest.txt"], "=== nordvpn leaktest ===")
+	assert.Contains(t, entries["leaktest.txt"], "LEAK_TEST_FAIL")
+	assert.Contains(t, entries["leaktest.txt"], "IPv6 traffic is routed through eth0 outside of the tunnel")
+}
+
 func TestStreamFileToWriter(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -50,7 +50,12 @@ func (r *RPC) doDisconnect(pauseInterval time.Duration) (bool, error) {
 	var recommendationUUID string
 	// Not sure if it can be nil in the real scenarios
 	if r.connectionInfo != nil {
-		recommendationUUID = r.connectionInfo.Status().RecommendationUUID
+		status := r.connectionInfo.Status()
+		recommendationUUID = status.RecommendationUUID
+		// transfer counters are lost once the tunnel is closed
+		if err := r.updateConnectionHistory(status); err != nil {
+			log.Warn("updating connection history:", err)
+		}
 	} else {
 		log.Warn("connection info is nil and it shouldn't be")
 	}
@@ -0,0 +1,120 @@
+package daemon
+
+import (
+	"context"
+	"slices"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// AddDNSRoute sends the queries of the domain to the nameserver outside of the VPN tunnel. Existing
+// route of the same domain is replaced.
+func (r *RPC) AddDNSRoute(ctx context.Context, in *pb.AddDNSRouteRequest) (*pb.Payload, error) {
+	route, err := config.NewDNSRoute(in.GetRoute().GetDomain(), in.GetRoute().GetNameserver())
+	if err != nil {
+		return &pb.Payload{Type: internal.CodeDNSRouteInvalid}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if slices.Contains(cfg.AutoConnectData.DNSRoutes, route) {
+		return &pb.Payload{Type: internal.CodeDNSRouteNoop}, nil
+	}
+
+	// the route replaces the existing route of the domain
+	addRoute := func(c config.Config) config.Config {
+		routes := slices.DeleteFunc(slices.Clone(c.AutoConnectData.DNSRoutes), func(existing config.DNSRoute) bool {
+			return existing.Domain == route.Domain
+		})
+		c.AutoConnectData.DNSRoutes = append(routes, route)
+		return c
+	}
+	if locked, ok := r.checkPolicy(cfg, addRoute); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		cfg = addRoute(c)
+		return cfg
+	}); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if err := r.applyDNSRoutes(cfg.AutoConnectData.DNSRoutes); err != nil {
+		log.Error("applying DNS routes:", err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// RemoveDNSRoute removes the route of the domain.
+func (r *RPC) RemoveDNSRoute(ctx context.Context, in *pb.RemoveDNSRouteRequest) (*pb.Payload, error) {
+	domain := dns.NormalizeRouteDomain(in.GetDomain())
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	isDomain := func(route config.DNSRoute) bool { return route.Domain == domain }
+	if !slices.ContainsFunc(cfg.AutoConnectData.DNSRoutes, isDomain) {
+		return &pb.Payload{Type: internal.CodeDNSRouteNoop}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.AutoConnectData.DNSRoutes = slices.DeleteFunc(slices.Clone(c.AutoConnectData.DNSRoutes), isDomain)
+		cfg = c
+		return c
+	}); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if err := r.applyDNSRoutes(cfg.AutoConnectData.DNSRoutes); err != nil {
+		log.Error("applying DNS routes:", err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// StartDNSRoutes applies the configured DNS routes
+func (r *RPC) StartDNSRoutes() {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return
+	}
+	if len(cfg.AutoConnectData.DNSRoutes) == 0 {
+		return
+	}
+	if err := r.applyDNSRoutes(cfg.AutoConnectData.DNSRoutes); err != nil {
+		log.Error("applying DNS routes:", err)
+	}
+}
+
+// applyDNSRoutes passes the routes to the networker. They are applied to the system DNS and the
+// firewall immediately if VPN is connected, otherwise on the next connect.
+func (r *RPC) applyDNSRoutes(routes []config.DNSRoute) error {
+	dnsRoutes := make([]dns.Route, 0, len(routes))
+	for _, route := range routes {
+		dnsRoutes = append(dnsRoutes, dns.Route{Domain: route.Domain, Nameserver: route.Nameserver})
+	}
+	return r.netw.SetDNSRoutes(dnsRoutes)
+}
+
+func dnsRouteToPb(route config.DNSRoute) *pb.DNSRoute {
+	return &pb.DNSRoute{
+		Domain:     route.Domain,
+		Nameserver: route.Nameserver,
+	}
+}
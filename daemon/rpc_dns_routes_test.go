@@ -0,0 +1,120 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddDNSRoute(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	corp := config.DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"}
+
+	tests := []struct {
+		name           string
+		route          *pb.DNSRoute
+		current        []config.DNSRoute
+		expectedRoutes []config.DNSRoute
+		expectedCode   int64
+	}{
+		{
+			name:           "add route",
+			route:          &pb.DNSRoute{Domain: "Corp.Example.", Nameserver: "10.0.0.53"},
+			expectedRoutes: []config.DNSRoute{corp},
+			expectedCode:   internal.CodeSuccess,
+		},
+		{
+			name:    "replace nameserver",
+			route:   &pb.DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.54"},
+			current: []config.DNSRoute{corp, {Domain: "lab.example", Nameserver: "10.1.0.53"}},
+			expectedRoutes: []config.DNSRoute{
+				{Domain: "lab.example", Nameserver: "10.1.0.53"},
+				{Domain: "corp.example", Nameserver: "10.0.0.54"},
+			},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:           "route already added",
+			route:          &pb.DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"},
+			current:        []config.DNSRoute{corp},
+			expectedRoutes: []config.DNSRoute{corp},
+			expectedCode:   internal.CodeDNSRouteNoop,
+		},
+		{
+			name:         "invalid domain",
+			route:        &pb.DNSRoute{Domain: "corp_example", Nameserver: "10.0.0.53"},
+			expectedCode: internal.CodeDNSRouteInvalid,
+		},
+		{
+			name:         "invalid nameserver",
+			route:        &pb.DNSRoute{Domain: "corp.example", Nameserver: "fd00::53"},
+			expectedCode: internal.CodeDNSRouteInvalid,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.AutoConnectData.DNSRoutes = test.current
+			netw := &testnetworker.Mock{}
+			r := RPC{cm: cm, netw: netw}
+
+			resp, err := r.AddDNSRoute(context.Background(), &pb.AddDNSRouteRequest{Route: test.route})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedRoutes, cm.Cfg.AutoConnectData.DNSRoutes)
+			if test.expectedCode == internal.CodeSuccess {
+				assert.Len(t, netw.DNSRoutes, len(test.expectedRoutes))
+			}
+		})
+	}
+}
+
+func TestRemoveDNSRoute(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.AutoConnectData.DNSRoutes = []config.DNSRoute{
+		{Domain: "corp.example", Nameserver: "10.0.0.53"},
+		{Domain: "lab.example", Nameserver: "10.1.0.53"},
+	}
+	netw := &testnetworker.Mock{}
+	r := RPC{cm: cm, netw: netw}
+
+	resp, err := r.RemoveDNSRoute(context.Background(), &pb.RemoveDNSRouteRequest{Domain: "Corp.Example"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Equal(t, []config.DNSRoute{{Domain: "lab.example", Nameserver: "10.1.0.53"}},
+		cm.Cfg.AutoConnectData.DNSRoutes)
+	assert.Equal(t, []dns.Route{{Domain: "lab.example", Nameserver: "10.1.0.53"}}, netw.DNSRoutes)
+
+	resp, err = r.RemoveDNSRoute(context.Background(), &pb.RemoveDNSRouteRequest{Domain: "corp.example"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeDNSRouteNoop, resp.Type)
+}
+
+func TestAddDNSRoute_NetworkerFails(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	r := RPC{cm: cm, netw: testnetworker.Failing{}}
+
+	resp, err := r.AddDNSRoute(context.Background(), &pb.AddDNSRouteRequest{
+		Route: &pb.DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeFailure, resp.Type)
+	// route is kept to be applied on the next connect
+	assert.Equal(t, []config.DNSRoute{{Domain: "corp.example", Nameserver: "10.0.0.53"}},
+		cm.Cfg.AutoConnectData.DNSRoutes)
+}
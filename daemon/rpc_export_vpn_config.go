@@ -0,0 +1,115 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/serverpicker"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/openvpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// ExportVPNConfig picks a server the same way as connect does and generates a config for it,
+// which can be used with wg-quick or OpenVPN on devices where the daemon is not running.
+func (r *RPC) ExportVPNConfig(ctx context.Context, in *pb.ExportVPNConfigRequest) (*pb.ExportVPNConfigResponse, error) {
+	// credentials are renewed by the session stores as a part of the login check
+	if ok, err := r.ac.IsLoggedIn(); !ok {
+		if errors.Is(err, core.ErrUnauthorized) {
+			return &pb.ExportVPNConfigResponse{Type: internal.CodeRevokedAccessToken}, nil
+		}
+		return nil, internal.ErrNotLoggedIn
+	}
+
+	if code := r.isVPNExpired(); code != internal.CodeSuccess {
+		return &pb.ExportVPNConfigResponse{Type: code}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.ExportVPNConfigResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	// dedicated servers use per device keys which can not be exported
+	if serverpicker.IsDedicatedServer(in.GetServerTag(), in.GetServerGroup()) {
+		return &pb.ExportVPNConfigResponse{Type: internal.CodeVPNConfigExportUnsupported}, nil
+	}
+
+	// servers are picked for the exported technology, not for the one currently in use
+	if in.GetTechnology() != config.Technology_UNKNOWN_TECHNOLOGY {
+		cfg.Technology = in.GetTechnology()
+	}
+	switch cfg.Technology {
+	case config.Technology_NORDLYNX:
+		cfg.AutoConnectData.Protocol = config.Protocol_UDP
+	case config.Technology_OPENVPN:
+		switch in.GetProtocol() {
+		case config.Protocol_UNKNOWN_PROTOCOL, config.Protocol_UDP:
+			cfg.AutoConnectData.Protocol = config.Protocol_UDP
+		case config.Protocol_TCP:
+			cfg.AutoConnectData.Protocol = config.Protocol_TCP
+		case config.Protocol_Webtunnel:
+			return &pb.ExportVPNConfigResponse{Type: internal.CodeVPNConfigExportUnsupported}, nil
+		}
+	case config.Technology_NORDWHISPER, config.Technology_UNKNOWN_TECHNOLOGY:
+		return &pb.ExportVPNConfigResponse{Type: internal.CodeVPNConfigExportUnsupported}, nil
+	}
+	// obfuscated and post-quantum connections need the daemon
+	cfg.AutoConnectData.Obfuscate = false
+	cfg.AutoConnectData.PostquantumVpn = false
+
+	insights := r.dm.GetInsightsData().Insights
+	serverTag := internal.RemoveNonAlphanumeric(in.GetServerTag())
+	serverSelection, err := selectServer(r, &insights, cfg, serverTag, in.GetServerGroup(), "")
+	if err != nil {
+		if code := serverSelectionErrorCode(err); code != 0 {
+			return &pb.ExportVPNConfigResponse{Type: code}, nil
+		}
+		return nil, err
+	}
+	if core.IsServerDedicated(*serverSelection.Server) {
+		return &pb.ExportVPNConfigResponse{Type: internal.CodeVPNConfigExportUnsupported}, nil
+	}
+
+	ip, err := serverSelection.Server.IPv4()
+	if err != nil {
+		log.Error(err)
+		return nil, internal.ErrUnhandled
+	}
+
+	tokenData := cfg.TokensData[cfg.AutoConnectData.ID]
+	var vpnConfig string
+	switch cfg.Technology {
+	case config.Technology_NORDLYNX:
+		vpnConfig = nordlynx.StandaloneConfig(
+			tokenData.NordLynxPrivateKey,
+			serverSelection.Server.NordLynxPublicKey,
+			ip,
+			cfg.AutoConnectData.DNS.Or(r.nameservers.Get(cfg.AutoConnectData.ThreatProtectionLite)),
+		)
+	case config.Technology_OPENVPN:
+		out, err := openvpn.StandaloneConfig(
+			cfg.AutoConnectData.Protocol,
+			ip,
+			tokenData.OpenVPNUsername,
+			tokenData.OpenVPNPassword,
+		)
+		if err != nil {
+			log.Error("generating OpenVPN config:", err)
+			return &pb.ExportVPNConfigResponse{Type: internal.CodeFailure}, nil
+		}
+		vpnConfig = string(out)
+	}
+
+	return &pb.ExportVPNConfigResponse{
+		Type:       internal.CodeSuccess,
+		Config:     vpnConfig,
+		Hostname:   serverSelection.Server.Hostname,
+		Technology: cfg.Technology,
+	}, nil
+}
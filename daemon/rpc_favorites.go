@@ -0,0 +1,165 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/favorites"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/serverpicker"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// ListFavorites returns stored favorites in the order they were added.
+func (r *RPC) ListFavorites(ctx context.Context, in *pb.Empty) (*pb.FavoritesResponse, error) {
+	resp := &pb.FavoritesResponse{}
+	if r.favorites == nil {
+		return resp, nil
+	}
+	for _, favorite := range r.favorites.List() {
+		resp.Favorites = append(resp.Favorites, favoriteToProtobuf(favorite))
+	}
+	return resp, nil
+}
+
+// AddFavorite resolves the connection target the same way as Connect does and stores it
+// together with the overrides.
+func (r *RPC) AddFavorite(ctx context.Context, in *pb.AddFavoriteRequest) (*pb.Payload, error) {
+	if r.favorites == nil {
+		return &pb.Payload{Type: internal.CodeFeatureHidden}, nil
+	}
+	if err := favorites.ValidateName(in.GetName()); err != nil {
+		return &pb.Payload{Type: internal.CodeFavoriteInvalidName}, nil
+	}
+
+	params := serverpicker.GetServerParameters(in.GetServerTag(), in.GetServerGroup(), r.dm.GetCountryData().Countries)
+	if in.GetServerGroup() != "" && params.Group == config.ServerGroup_UNDEFINED {
+		return &pb.Payload{Type: internal.CodeGroupNonexisting}, nil
+	}
+	rule := determineServerSelectionRule(params)
+	if rule == config.ServerSelectionRule_NONE {
+		return &pb.Payload{Type: internal.CodeTagNonexisting}, nil
+	}
+
+	favorite := favorites.Favorite{
+		Name:           in.GetName(),
+		Country:        params.Country,
+		City:           params.City,
+		Group:          params.Group,
+		CountryCode:    params.CountryCode,
+		SpecificServer: params.ServerName,
+		ConnectionType: rule,
+		Technology:     in.GetTechnology(),
+		Protocol:       in.GetProtocol(),
+		Obfuscate:      in.Obfuscate,
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+	// global settings may change later, but obviously wrong combinations are rejected early
+	if overrides := favoriteOverrides(favorite); !overrides.isEmpty() {
+		if err := validateConnectionSettings(overrides.apply(cfg)); err != nil {
+			log.Warn("invalid favorite overrides:", err)
+			return &pb.Payload{Type: internal.CodeInvalidConnectionOverrides}, nil
+		}
+	}
+
+	if err := r.favorites.Add(favorite); err != nil {
+		return &pb.Payload{Type: favoriteErrorCode(err)}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// RemoveFavorite deletes the favorite with the requested name.
+func (r *RPC) RemoveFavorite(ctx context.Context, in *pb.FavoriteRequest) (*pb.Payload, error) {
+	if r.favorites == nil {
+		return &pb.Payload{Type: internal.CodeFeatureHidden}, nil
+	}
+	if err := r.favorites.Remove(in.GetName()); err != nil {
+		return &pb.Payload{Type: favoriteErrorCode(err)}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// ConnectFavorite connects to the favorite target using its overrides instead of the global
+// settings for this connection only.
+func (r *RPC) ConnectFavorite(in *pb.FavoriteRequest, srv pb.Daemon_ConnectFavoriteServer) error {
+	if r.favorites == nil {
+		return srv.Send(&pb.Payload{Type: internal.CodeFeatureHidden})
+	}
+	favorite, err := r.favorites.Get(in.GetName())
+	if err != nil {
+		return srv.Send(&pb.Payload{Type: favoriteErrorCode(err)})
+	}
+
+	req := favoriteConnectRequest(favorite)
+	return r.executeConnect(srv, func(ctx context.Context) (bool, error) {
+		return r.connectWithParameters(ctx, req, favoriteOverrides(favorite), srv,
+			pb.ConnectionSource_MANUAL, "", events.VPNConnectionReasonNone)
+	})
+}
+
+// favoriteConnectRequest builds the request in the same form as for reconnecting to the
+// requested target.
+func favoriteConnectRequest(favorite favorites.Favorite) *pb.ConnectRequest {
+	req := &pb.ConnectRequest{ServerTag: favorite.SpecificServer}
+	if req.ServerTag == "" {
+		req.ServerTag = locationTag(favorite.CountryCode, favorite.City)
+	}
+	if favorite.Group != config.ServerGroup_UNDEFINED {
+		req.ServerGroup = favorite.Group.String()
+	}
+	return req
+}
+
+func favoriteOverrides(favorite favorites.Favorite) connectionOverrides {
+	return connectionOverrides{
+		technology: favorite.Technology,
+		protocol:   favorite.Protocol,
+		obfuscate:  favorite.Obfuscate,
+	}
+}
+
+func favoriteErrorCode(err error) int64 {
+	switch {
+	case errors.Is(err, favorites.ErrNotFound):
+		return internal.CodeFavoriteNotFound
+	case errors.Is(err, favorites.ErrExists):
+		return internal.CodeFavoriteExists
+	case errors.Is(err, favorites.ErrInvalidName):
+		return internal.CodeFavoriteInvalidName
+	case errors.Is(err, favorites.ErrLimitReached):
+		return internal.CodeFavoritesLimitReached
+	default:
+		log.Error(err)
+		return internal.CodeConfigError
+	}
+}
+
+func favoriteToProtobuf(favorite favorites.Favorite) *pb.Favorite {
+	f := &pb.Favorite{
+		Name:           favorite.Name,
+		Country:        favorite.Country,
+		City:           favorite.City,
+		Group:          favorite.Group,
+		CountryCode:    favorite.CountryCode,
+		SpecificServer: favorite.SpecificServer,
+		ConnectionType: favorite.ConnectionType,
+		Obfuscate:      favorite.Obfuscate,
+	}
+	if favorite.Technology != config.Technology_UNKNOWN_TECHNOLOGY {
+		technology := favorite.Technology
+		f.Technology = &technology
+	}
+	if favorite.Protocol != config.Protocol_UNKNOWN_PROTOCOL {
+		protocol := favorite.Protocol
+		f.Protocol = &protocol
+	}
+	return f
+}
@@ -0,0 +1,307 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/favorites"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	core_test "github.com/NordSecurity/nordvpn-linux/test/mock/core"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/fs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddFavorite(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	openVPN := config.Technology_OPENVPN
+	nordLynx := config.Technology_NORDLYNX
+	tcp := config.Protocol_TCP
+	obfuscate := true
+
+	tests := []struct {
+		name             string
+		request          *pb.AddFavoriteRequest
+		expectedCode     int64
+		expectedFavorite *pb.Favorite
+	}{
+		{
+			name: "country with group and overrides",
+			request: &pb.AddFavoriteRequest{
+				Name:        "work-eu",
+				ServerTag:   "germany",
+				ServerGroup: "p2p",
+				Technology:  &openVPN,
+				Protocol:    &tcp,
+				Obfuscate:   &obfuscate,
+			},
+			expectedCode: internal.CodeSuccess,
+			expectedFavorite: &pb.Favorite{
+				Name:           "work-eu",
+				Country:        "Germany",
+				CountryCode:    "DE",
+				Group:          config.ServerGroup_P2P,
+				ConnectionType: config.ServerSelectionRule_COUNTRY_WITH_GROUP,
+				Technology:     &openVPN,
+				Protocol:       &tcp,
+				Obfuscate:      &obfuscate,
+			},
+		},
+		{
+			name:         "city",
+			request:      &pb.AddFavoriteRequest{Name: "home", ServerTag: "lt vilnius"},
+			expectedCode: internal.CodeSuccess,
+			expectedFavorite: &pb.Favorite{
+				Name:           "home",
+				Country:        "Lithuania",
+				City:           "Vilnius",
+				CountryCode:    "LT",
+				ConnectionType: config.ServerSelectionRule_CITY,
+			},
+		},
+		{
+			name:         "specific server",
+			request:      &pb.AddFavoriteRequest{Name: "server", ServerTag: "lt10"},
+			expectedCode: internal.CodeSuccess,
+			expectedFavorite: &pb.Favorite{
+				Name:           "server",
+				SpecificServer: "lt10",
+				ConnectionType: config.ServerSelectionRule_SPECIFIC_SERVER,
+			},
+		},
+		{
+			name:         "invalid name",
+			request:      &pb.AddFavoriteRequest{Name: "my home", ServerTag: "lt"},
+			expectedCode: internal.CodeFavoriteInvalidName,
+		},
+		{
+			name:         "nonexisting group",
+			request:      &pb.AddFavoriteRequest{Name: "group", ServerGroup: "nonexisting"},
+			expectedCode: internal.CodeGroupNonexisting,
+		},
+		{
+			name:         "obfuscation with nordlynx",
+			request:      &pb.AddFavoriteRequest{Name: "obfuscated", Technology: &nordLynx, Obfuscate: &obfuscate},
+			expectedCode: internal.CodeInvalidConnectionOverrides,
+		},
+		{
+			name:         "tcp with the global nordlynx",
+			request:      &pb.AddFavoriteRequest{Name: "tcp", Protocol: &tcp},
+			expectedCode: internal.CodeInvalidConnectionOverrides,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fsMock := fs.NewSystemFileHandleMock(t)
+			dm := testNewDataManager()
+			require.NoError(t, dm.SetCountryData(time.Now(), core_test.CountriesList(), ""))
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = config.Technology_NORDLYNX
+			cm.Cfg.AutoConnectData.Protocol = config.Protocol_UDP
+			rpc := RPC{
+				cm:        cm,
+				dm:        dm,
+				favorites: favorites.NewStore("/test/favorites", &fsMock, nil),
+			}
+
+			resp, err := rpc.AddFavorite(context.Background(), test.request)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+
+			list, err := rpc.ListFavorites(context.Background(), &pb.Empty{})
+			require.NoError(t, err)
+			if test.expectedFavorite == nil {
+				assert.Empty(t, list.Favorites)
+				return
+			}
+			require.Len(t, list.Favorites, 1)
+			assert.Equal(t, test.expectedFavorite.String(), list.Favorites[0].String())
+		})
+	}
+}
+
+func TestRemoveFavorite(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fsMock := fs.NewSystemFileHandleMock(t)
+	store := favorites.NewStore("/test/favorites", &fsMock, nil)
+	require.NoError(t, store.Add(favorites.Favorite{Name: "home"}))
+	rpc := RPC{favorites: store}
+
+	resp, err := rpc.RemoveFavorite(context.Background(), &pb.FavoriteRequest{Name: "HOME"})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+
+	resp, err = rpc.RemoveFavorite(context.Background(), &pb.FavoriteRequest{Name: "home"})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeFavoriteNotFound, resp.Type)
+}
+
+func TestFavoriteConnectRequest(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		favorite favorites.Favorite
+		expected *pb.ConnectRequest
+	}{
+		{
+			name:     "recommended",
+			expected: &pb.ConnectRequest{},
+		},
+		{
+			name:     "city",
+			favorite: favorites.Favorite{Country: "Lithuania", CountryCode: "LT", City: "Vilnius"},
+			expected: &pb.ConnectRequest{ServerTag: "lt vilnius"},
+		},
+		{
+			name:     "country with group",
+			favorite: favorites.Favorite{Country: "Germany", CountryCode: "DE", Group: config.ServerGroup_P2P},
+			expected: &pb.ConnectRequest{ServerTag: "de", ServerGroup: "P2P"},
+		},
+		{
+			name:     "specific server",
+			favorite: favorites.Favorite{SpecificServer: "lt10"},
+			expected: &pb.ConnectRequest{ServerTag: "lt10"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected.String(), favoriteConnectRequest(test.favorite).String())
+		})
+	}
+}
+
+func TestConnectionOverrides(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	obfuscate := true
+	cfg := config.Config{Technology: config.Technology_NORDLYNX}
+	cfg.AutoConnectData.Protocol = config.Protocol_UDP
+
+	tests := []struct {
+		name       string
+		overrides  connectionOverrides
+		expectErr  bool
+		technology config.Technology
+		protocol   config.Protocol
+		obfuscate  bool
+	}{
+		{
+			name:       "no overrides",
+			technology: config.Technology_NORDLYNX,
+			protocol:   config.Protocol_UDP,
+		},
+		{
+			name: "openvpn tcp obfuscated",
+			overrides: connectionOverrides{
+				technology: config.Technology_OPENVPN,
+				protocol:   config.Protocol_TCP,
+				obfuscate:  &obfuscate,
+			},
+			technology: config.Technology_OPENVPN,
+			protocol:   config.Protocol_TCP,
+			obfuscate:  true,
+		},
+		{
+			name:       "nordwhisper uses webtunnel",
+			overrides:  connectionOverrides{technology: config.Technology_NORDWHISPER},
+			technology: config.Technology_NORDWHISPER,
+			protocol:   config.Protocol_Webtunnel,
+		},
+		{
+			name:       "tcp with nordlynx",
+			overrides:  connectionOverrides{protocol: config.Protocol_TCP},
+			expectErr:  true,
+			technology: config.Technology_NORDLYNX,
+			protocol:   config.Protocol_TCP,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			applied := test.overrides.apply(cfg)
+			assert.Equal(t, test.technology, applied.Technology)
+			assert.Equal(t, test.protocol, applied.AutoConnectData.Protocol)
+			assert.Equal(t, test.obfuscate, applied.AutoConnectData.Obfuscate)
+			if test.expectErr {
+				assert.Error(t, validateConnectionSettings(applied))
+			} else {
+				assert.NoError(t, validateConnectionSettings(applied))
+			}
+		})
+	}
+	assert.Equal(t, config.Technology_NORDLYNX, cfg.Technology, "original config must not be modified")
+}
+
+func TestConnectionOverridesFromProtobuf(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	postQuantum := true
+	tests := []struct {
+		name      string
+		in        *pb.ConnectionOverrides
+		expectErr bool
+		names     []string
+	}{
+		{
+			name: "no overrides",
+		},
+		{
+			name: "post-quantum and dns",
+			in: &pb.ConnectionOverrides{
+				PostQuantum: &postQuantum,
+				Dns:         []string{"1.1.1.1", "8.8.8.8"},
+			},
+			names: []string{"post-quantum", "dns"},
+		},
+		{
+			name:      "invalid nameserver",
+			in:        &pb.ConnectionOverrides{Dns: []string{"nordvpn.com"}},
+			expectErr: true,
+		},
+		{
+			name:      "too many nameservers",
+			in:        &pb.ConnectionOverrides{Dns: []string{"1.1.1.1", "1.0.0.1", "8.8.8.8", "8.8.4.4"}},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			overrides, err := connectionOverridesFromProtobuf(test.in)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.names, overrides.names())
+			assert.Equal(t, len(test.names) == 0, overrides.isEmpty())
+		})
+	}
+}
+
+func TestConnectionOverridesDNS(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := config.Config{Technology: config.Technology_NORDLYNX}
+	cfg.AutoConnectData.ThreatProtectionLite = true
+
+	applied := connectionOverrides{dns: config.DNS{"1.1.1.1"}}.apply(cfg)
+	assert.Equal(t, config.DNS{"1.1.1.1"}, applied.AutoConnectData.DNS)
+	assert.False(t, applied.AutoConnectData.ThreatProtectionLite)
+	assert.True(t, cfg.AutoConnectData.ThreatProtectionLite, "original config must not be modified")
+
+	postQuantum := true
+	cfg.Mesh = true
+	applied = connectionOverrides{postQuantum: &postQuantum}.apply(cfg)
+	assert.Error(t, validateConnectionSettings(applied))
+}
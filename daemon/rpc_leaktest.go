@@ -0,0 +1,39 @@
+package daemon
+
+import (
+	"context"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/leaktest"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// LeakTest checks that DNS, IPv6 and the rest of the traffic of the VPN connection do not bypass
+// the tunnel
+func (r *RPC) LeakTest(ctx context.Context, in *pb.Empty) (*pb.LeakTestResponse, error) {
+	if !r.netw.IsVPNActive() {
+		return &pb.LeakTestResponse{Type: internal.CodeVPNNotRunning}, nil
+	}
+
+	report := r.netw.LeakTest()
+	checks := make([]*pb.LeakTestCheck, 0, len(report))
+	for _, check := range report {
+		checks = append(checks, &pb.LeakTestCheck{
+			Name:   check.Name,
+			Status: leakTestStatusToPb(check.Status),
+			Detail: check.Detail,
+		})
+	}
+	return &pb.LeakTestResponse{Type: internal.CodeSuccess, Checks: checks}, nil
+}
+
+func leakTestStatusToPb(status leaktest.Status) pb.LeakTestStatus {
+	switch status {
+	case leaktest.StatusPass:
+		return pb.LeakTestStatus_LEAK_TEST_PASS
+	case leaktest.StatusFail:
+		return pb.LeakTestStatus_LEAK_TEST_FAIL
+	default:
+		return pb.LeakTestStatus_LEAK_TEST_SKIP
+	}
+}
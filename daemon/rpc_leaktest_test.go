@@ -0,0 +1,62 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/leaktest"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeakTest(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	report := leaktest.Report{
+		{Name: leaktest.CheckRouting, Status: leaktest.StatusPass, Detail: "internet traffic is routed through nordlynx"},
+		{Name: leaktest.CheckDNS, Status: leaktest.StatusFail, Detail: "192.168.1.1 via eth0 outside of the tunnel"},
+		{Name: leaktest.CheckFirewall, Status: leaktest.StatusSkip, Detail: "firewall is disabled"},
+	}
+
+	tests := []struct {
+		name           string
+		vpnActive      bool
+		expectedCode   int64
+		expectedChecks []*pb.LeakTestCheck
+	}{
+		{
+			name:         "not connected",
+			expectedCode: internal.CodeVPNNotRunning,
+		},
+		{
+			name:         "connected",
+			vpnActive:    true,
+			expectedCode: internal.CodeSuccess,
+			expectedChecks: []*pb.LeakTestCheck{
+				{Name: "Routing", Status: pb.LeakTestStatus_LEAK_TEST_PASS, Detail: "internet traffic is routed through nordlynx"},
+				{Name: "DNS", Status: pb.LeakTestStatus_LEAK_TEST_FAIL, Detail: "192.168.1.1 via eth0 outside of the tunnel"},
+				{Name: "Firewall", Status: pb.LeakTestStatus_LEAK_TEST_SKIP, Detail: "firewall is disabled"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := RPC{netw: &testnetworker.Mock{VpnActive: test.vpnActive, LeakTestReport: report}}
+
+			resp, err := r.LeakTest(context.Background(), &pb.Empty{})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Len(t, resp.Checks, len(test.expectedChecks))
+			for i, check := range test.expectedChecks {
+				assert.Equal(t, check.Name, resp.Checks[i].Name)
+				assert.Equal(t, check.Status, resp.Checks[i].Status)
+				assert.Equal(t, check.Detail, resp.Checks[i].Detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package daemon
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// AddScheduleRule adds a recurring pause or disconnect window. ID of the new rule is returned in the payload data.
+func (r *RPC) AddScheduleRule(ctx context.Context, in *pb.AddScheduleRuleRequest) (*pb.Payload, error) {
+	rule, ok := scheduleRuleFromPb(in.GetRule())
+	if !ok || rule.Validate() != nil {
+		return &pb.Payload{Type: internal.CodeScheduleInvalidRule}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if slices.ContainsFunc(cfg.Schedule, rule.Equal) {
+		return &pb.Payload{Type: internal.CodeScheduleRuleNoop}, nil
+	}
+
+	rule.ID = config.NextScheduleRuleID(cfg.Schedule)
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Schedule = append(c.Schedule, rule)
+		return c
+	}); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{rule.ID}}, nil
+}
+
+// RemoveScheduleRule removes the rule with the given ID. If the rule window is in progress, it ends on the
+// next schedule check.
+func (r *RPC) RemoveScheduleRule(ctx context.Context, in *pb.RemoveScheduleRuleRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	isRule := func(rule config.ScheduleRule) bool { return rule.ID == in.GetId() }
+	if !slices.ContainsFunc(cfg.Schedule, isRule) {
+		return &pb.Payload{Type: internal.CodeScheduleRuleNoop}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Schedule = slices.DeleteFunc(slices.Clone(c.Schedule), isRule)
+		return c
+	}); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func scheduleRuleFromPb(rule *pb.ScheduleRule) (config.ScheduleRule, bool) {
+	if rule == nil {
+		return config.ScheduleRule{}, false
+	}
+
+	var action config.ScheduleAction
+	switch rule.GetAction() {
+	case pb.ScheduleAction_SCHEDULE_PAUSE:
+		action = config.SchedulePause
+	case pb.ScheduleAction_SCHEDULE_DISCONNECT:
+		action = config.ScheduleDisconnect
+	default:
+		return config.ScheduleRule{}, false
+	}
+
+	var days []time.Weekday
+	for _, day := range rule.GetDays() {
+		if day > uint32(time.Saturday) {
+			return config.ScheduleRule{}, false
+		}
+		days = append(days, time.Weekday(day))
+	}
+	slices.Sort(days)
+
+	return config.ScheduleRule{
+		Action: action,
+		Days:   slices.Compact(days),
+		Start:  rule.GetStart(),
+		End:    rule.GetEnd(),
+	}, true
+}
+
+func scheduleRuleToPb(rule config.ScheduleRule) *pb.ScheduleRule {
+	action := pb.ScheduleAction_SCHEDULE_PAUSE
+	if rule.Action == config.ScheduleDisconnect {
+		action = pb.ScheduleAction_SCHEDULE_DISCONNECT
+	}
+
+	days := make([]uint32, 0, len(rule.Days))
+	for _, day := range rule.Days {
+		days = append(days, uint32(day)) // #nosec G115 -- weekday is validated
+	}
+
+	return &pb.ScheduleRule{
+		Id:     rule.ID,
+		Action: action,
+		Days:   days,
+		Start:  rule.Start,
+		End:    rule.End,
+	}
+}
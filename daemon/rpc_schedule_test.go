@@ -0,0 +1,130 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddScheduleRule(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	lunch := config.ScheduleRule{
+		ID:     "1",
+		Action: config.SchedulePause,
+		Days:   []time.Weekday{time.Monday, time.Friday},
+		Start:  "12:00",
+		End:    "12:30",
+	}
+
+	tests := []struct {
+		name          string
+		rule          *pb.ScheduleRule
+		current       []config.ScheduleRule
+		expectedRules []config.ScheduleRule
+		expectedCode  int64
+		expectedData  []string
+	}{
+		{
+			name: "add pause rule",
+			rule: &pb.ScheduleRule{
+				Action: pb.ScheduleAction_SCHEDULE_PAUSE,
+				Days:   []uint32{5, 1, 5},
+				Start:  "12:00",
+				End:    "12:30",
+			},
+			expectedRules: []config.ScheduleRule{lunch},
+			expectedCode:  internal.CodeSuccess,
+			expectedData:  []string{"1"},
+		},
+		{
+			name: "add disconnect rule over midnight",
+			rule: &pb.ScheduleRule{
+				Action: pb.ScheduleAction_SCHEDULE_DISCONNECT,
+				Start:  "23:00",
+				End:    "02:00",
+			},
+			current: []config.ScheduleRule{lunch},
+			expectedRules: []config.ScheduleRule{
+				lunch,
+				{ID: "2", Action: config.ScheduleDisconnect, Start: "23:00", End: "02:00"},
+			},
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"2"},
+		},
+		{
+			name: "same rule already exists",
+			rule: &pb.ScheduleRule{
+				Action: pb.ScheduleAction_SCHEDULE_PAUSE,
+				Days:   []uint32{1, 5},
+				Start:  "12:00",
+				End:    "12:30",
+			},
+			current:       []config.ScheduleRule{lunch},
+			expectedRules: []config.ScheduleRule{lunch},
+			expectedCode:  internal.CodeScheduleRuleNoop,
+		},
+		{
+			name:         "invalid time",
+			rule:         &pb.ScheduleRule{Start: "25:00", End: "12:30"},
+			expectedCode: internal.CodeScheduleInvalidRule,
+		},
+		{
+			name:         "empty window",
+			rule:         &pb.ScheduleRule{Start: "12:00", End: "12:00"},
+			expectedCode: internal.CodeScheduleInvalidRule,
+		},
+		{
+			name:         "invalid day",
+			rule:         &pb.ScheduleRule{Days: []uint32{7}, Start: "12:00", End: "12:30"},
+			expectedCode: internal.CodeScheduleInvalidRule,
+		},
+		{
+			name:         "missing rule",
+			expectedCode: internal.CodeScheduleInvalidRule,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Schedule = test.current
+			r := RPC{cm: cm}
+
+			resp, err := r.AddScheduleRule(context.Background(), &pb.AddScheduleRuleRequest{Rule: test.rule})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expectedRules, cm.Cfg.Schedule)
+		})
+	}
+}
+
+func TestRemoveScheduleRule(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Schedule = []config.ScheduleRule{
+		{ID: "1", Action: config.SchedulePause, Start: "12:00", End: "12:30"},
+		{ID: "2", Action: config.ScheduleDisconnect, Start: "01:00", End: "05:00"},
+	}
+	r := RPC{cm: cm}
+
+	resp, err := r.RemoveScheduleRule(context.Background(), &pb.RemoveScheduleRuleRequest{Id: "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Equal(t, []config.ScheduleRule{
+		{ID: "2", Action: config.ScheduleDisconnect, Start: "01:00", End: "05:00"},
+	}, cm.Cfg.Schedule)
+
+	resp, err = r.RemoveScheduleRule(context.Background(), &pb.RemoveScheduleRuleRequest{Id: "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeScheduleRuleNoop, resp.Type)
+}
@@ -121,6 +121,15 @@ func (r *RPC) getNewAllowlist(req *pb.SetAllowlistRequest, forceRemoveNarrower,
 			return config.Allowlist{}, internal.CodeAllowlistInvalidSubnet
 		}
 		return allowlist, errorCode
+	case *pb.SetAllowlistRequest_SetAllowlistDomainRequest:
+		domain, ok := config.NormalizeDomain(request.SetAllowlistDomainRequest.GetDomain())
+		if !ok {
+			return config.Allowlist{}, internal.CodeAllowlistInvalidDomain
+		}
+
+		if !allowlist.UpdateDomains(domain, remove) {
+			return config.Allowlist{}, internal.CodeAllowlistDomainNoop
+		}
 	case *pb.SetAllowlistRequest_SetAllowlistPortsRequest:
 		if request.SetAllowlistPortsRequest.IsUdp {
 			portRange := request.SetAllowlistPortsRequest.GetPortRange()
@@ -145,6 +154,18 @@ func (r *RPC) getNewAllowlist(req *pb.SetAllowlistRequest, forceRemoveNarrower,
 }
 
 func (r *RPC) handleNewAllowlist(allowlist config.Allowlist) int64 {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return internal.CodeConfigError
+	}
+	if _, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.AutoConnectData.Allowlist = allowlist
+		return c
+	}); !ok {
+		return internal.CodePolicyLocked
+	}
+
 	if err := r.netw.SetAllowlist(allowlist); err != nil {
 		log.Error(err)
 		return internal.CodeFailure
@@ -158,10 +179,16 @@ func (r *RPC) handleNewAllowlist(allowlist config.Allowlist) int64 {
 		return internal.CodeConfigError
 	}
 
+	if err := r.refreshAllowlistDomains(allowlist.Domains); err != nil {
+		log.Error(err)
+		return internal.CodeFailure
+	}
+
 	r.events.Settings.Allowlist.Publish(events.DataAllowlist{
 		TCPPorts: allowlist.GetTCPPorts(),
 		UDPPorts: allowlist.GetUDPPorts(),
 		Subnets:  allowlist.Subnets,
+		Domains:  allowlist.Domains,
 	})
 
 	return internal.CodeSuccess
@@ -244,6 +271,7 @@ func (r *RPC) emitAllowlistSnapshot() {
 		TCPPorts: cfg.AutoConnectData.Allowlist.GetTCPPorts(),
 		UDPPorts: cfg.AutoConnectData.Allowlist.GetUDPPorts(),
 		Subnets:  cfg.AutoConnectData.Allowlist.Subnets,
+		Domains:  cfg.AutoConnectData.Allowlist.Domains,
 	})
 
 	r.events.Debugger.DebuggerEvents.Publish(*snapshot.ToDebuggerEvent())
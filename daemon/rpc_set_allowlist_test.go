@@ -3,9 +3,11 @@ package daemon
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"testing"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/allowlist"
 	"github.com/NordSecurity/nordvpn-linux/daemon/events"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -766,3 +768,82 @@ func TestUnsetAllAllowlist_Ports(t *testing.T) {
 	assert.Equal(t, response.Type, internal.CodeSuccess,
 		"Invalid return code after setting allowlist.")
 }
+
+func TestSetAllowlist_Domain(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	addr := netip.MustParseAddr("10.10.10.10")
+	tests := []struct {
+		name               string
+		domain             string
+		remove             bool
+		currentDomains     []string
+		expectedDomains    []string
+		expectedAddrs      []netip.Addr
+		expectedReturnCode int64
+	}{
+		{
+			name:               "add domain success",
+			domain:             "Intranet.Example.com.",
+			expectedDomains:    []string{"intranet.example.com"},
+			expectedAddrs:      []netip.Addr{addr},
+			expectedReturnCode: internal.CodeSuccess,
+		},
+		{
+			name:               "add invalid domain",
+			domain:             "1.2.3.4",
+			expectedReturnCode: internal.CodeAllowlistInvalidDomain,
+		},
+		{
+			name:               "add already added domain",
+			domain:             "intranet.example.com",
+			currentDomains:     []string{"intranet.example.com"},
+			expectedDomains:    []string{"intranet.example.com"},
+			expectedReturnCode: internal.CodeAllowlistDomainNoop,
+		},
+		{
+			name:               "remove domain success",
+			domain:             "intranet.example.com",
+			remove:             true,
+			currentDomains:     []string{"intranet.example.com"},
+			expectedDomains:    []string{},
+			expectedReturnCode: internal.CodeSuccess,
+		},
+		{
+			name:               "remove not added domain",
+			domain:             "intranet.example.com",
+			remove:             true,
+			expectedReturnCode: internal.CodeAllowlistDomainNoop,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			current := config.NewAllowlist(nil, nil, nil)
+			current.Domains = test.currentDomains
+			cm, r := newMockedRPC(current, nil)
+			r.allowlistDomains = allowlist.NewDomainResolver(&mockDomainResolver{
+				addrs: map[string][]netip.Addr{"intranet.example.com": {addr}},
+			})
+
+			request := &pb.SetAllowlistRequest{
+				Request: &pb.SetAllowlistRequest_SetAllowlistDomainRequest{
+					SetAllowlistDomainRequest: &pb.SetAllowlistDomainRequest{Domain: test.domain},
+				},
+			}
+			var resp *pb.Payload
+			var err error
+			if test.remove {
+				resp, err = r.UnsetAllowlist(context.Background(), request)
+			} else {
+				resp, err = r.SetAllowlist(context.Background(), request)
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedReturnCode, resp.Type)
+			assert.Equal(t, test.expectedDomains, cm.Cfg.AutoConnectData.Allowlist.Domains)
+			if test.expectedReturnCode == internal.CodeSuccess {
+				assert.Equal(t, test.expectedAddrs, r.netw.(*networker.Mock).AllowlistDomains)
+			}
+		})
+	}
+}
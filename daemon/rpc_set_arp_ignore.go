@@ -25,6 +25,13 @@ func (r *RPC) SetARPIgnore(ctx context.Context, in *pb.SetGenericRequest) (*pb.P
 		}, nil
 	}
 
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.ARPIgnore.Set(in.Enabled)
+		return c
+	}); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
 	if err := r.netw.SetARPIgnore(in.Enabled); err != nil {
 		log.Error("failed to set ARP ignore:", err)
 		return &pb.Payload{
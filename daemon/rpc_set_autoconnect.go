@@ -32,6 +32,16 @@ func (r *RPC) SetAutoConnect(ctx context.Context, in *pb.SetAutoconnectRequest)
 		}, nil
 	}
 
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.AutoConnect = in.GetEnabled()
+		if in.GetEnabled() {
+			c.AutoConnectData.ServerTag = in.GetServerTag()
+		}
+		return c
+	}); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
 	if in.GetEnabled() && serverpicker.IsDedicatedServer(in.ServerTag, in.ServerGroup) {
 		if !r.remoteConfigGetter.IsFeatureEnabled(remote.FeatureDedicatedServer) {
 			return &pb.Payload{Type: internal.CodeGroupNonexisting}, nil
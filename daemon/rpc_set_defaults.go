@@ -21,11 +21,16 @@ func (r *RPC) SetDefaults(ctx context.Context, in *pb.SetDefaultsRequest) (*pb.P
 		return &pb.Payload{Type: internal.CodeFailure}, nil
 	}
 
-	if in.OffKillswitch && cfg.KillSwitch {
+	// killswitch pinned by the policy is kept, so the traffic does not leak during the reset
+	pinned := r.pinnedSettings()
+	killSwitchPinned := pinned.KillSwitch != nil && *pinned.KillSwitch
+	killSwitchActive := cfg.KillSwitch
+	if in.OffKillswitch && cfg.KillSwitch && !killSwitchPinned {
 		if err := r.netw.UnsetKillSwitch(); err != nil {
 			log.Error("error while disabling killswitch:", err)
 			return &pb.Payload{Type: internal.CodeFailure}, nil
 		}
+		killSwitchActive = false
 	}
 
 	// No error check in case mesh isn't even turned on
@@ -56,10 +61,23 @@ func (r *RPC) SetDefaults(ctx context.Context, in *pb.SetDefaultsRequest) (*pb.P
 		}, nil
 	}
 
+	// settings pinned by the policy are not reset
+	if r.policy != nil {
+		if err := EnforcePolicy(r.cm, r.policy); err != nil {
+			log.Error("enforcing policy:", err)
+		}
+	}
+
 	if err := r.cm.Load(&cfg); err != nil {
 		log.Error(err)
 	}
 
+	if cfg.KillSwitch && !killSwitchActive {
+		if err := r.netw.SetKillSwitch(); err != nil {
+			log.Warn("enabling killswitch required by the policy failed:", err)
+		}
+	}
+
 	v, err := r.factory(cfg.Technology)
 	if err != nil {
 		log.Error(err)
@@ -76,6 +94,12 @@ func (r *RPC) SetDefaults(ctx context.Context, in *pb.SetDefaultsRequest) (*pb.P
 	if err = r.netw.SetAllowlist(cfg.AutoConnectData.Allowlist); err != nil {
 		log.Warn("resetting allowlist failed:", err)
 	}
+	if err = r.applyEncryptedDNS(cfg.AutoConnectData.EncryptedDNS); err != nil {
+		log.Warn("resetting encrypted DNS failed:", err)
+	}
+	if err = r.applyDNSRoutes(cfg.AutoConnectData.DNSRoutes); err != nil {
+		log.Warn("resetting DNS routes failed:", err)
+	}
 
 	r.events.Settings.Defaults.Publish(nil)
 	r.events.Settings.Publish(cfg)
@@ -119,3 +119,42 @@ func TestSetDefaults_ResetsNetworkerLanDiscoveryAndAllowlist(t *testing.T) {
 	assert.False(t, netw.LanDiscovery)
 	assert.Equal(t, config.Allowlist{}, netw.Allowlist)
 }
+
+type killSwitchNetworker struct {
+	*networker.Mock
+	unsetCalls int
+	setCalls   int
+}
+
+func (n *killSwitchNetworker) SetKillSwitch() error {
+	n.setCalls++
+	return nil
+}
+
+func (n *killSwitchNetworker) UnsetKillSwitch() error {
+	n.unsetCalls++
+	return nil
+}
+
+func TestSetDefaults_KeepsKillSwitchPinnedByPolicy(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	netw := &killSwitchNetworker{Mock: &networker.Mock{}}
+	rpc := testRPC()
+	rpc.netw = netw
+	rpc.policy = newTestPolicyLoader(t, "version: 1\npin:\n  firewall: true\n  killswitch: true\n")
+	assert.NoError(t, rpc.cm.SaveWith(func(c config.Config) config.Config {
+		c.Firewall = true
+		c.KillSwitch = true
+		return c
+	}))
+
+	_, err := rpc.SetDefaults(context.Background(), &pb.SetDefaultsRequest{NoLogout: true, OffKillswitch: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, netw.unsetCalls)
+	assert.Equal(t, 0, netw.setCalls)
+
+	var cfg config.Config
+	assert.NoError(t, rpc.cm.Load(&cfg))
+	assert.True(t, cfg.KillSwitch)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns/stub"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -26,7 +27,8 @@ func (r *RPC) SetDNS(ctx context.Context, in *pb.SetDNSRequest) (*pb.SetDNSRespo
 	}
 
 	nameserverCheck := slices.Clone(nameservers)
-	autoConnectDataCheck := slices.Clone(cfg.AutoConnectData.DNS)
+	// only one of the lists is set at a time
+	autoConnectDataCheck := append(slices.Clone(cfg.AutoConnectData.DNS), cfg.AutoConnectData.EncryptedDNS...)
 	slices.Sort(nameserverCheck)
 	slices.Sort(autoConnectDataCheck)
 	if slices.Equal(nameserverCheck, autoConnectDataCheck) {
@@ -35,13 +37,32 @@ func (r *RPC) SetDNS(ctx context.Context, in *pb.SetDNSRequest) (*pb.SetDNSRespo
 		}, nil
 	}
 
+	var plainDNS config.DNS
+	var encryptedDNS []string
 	for _, address := range nameservers {
+		if stub.IsEncryptedEndpoint(address) {
+			if err := stub.ValidateEndpoint(address); err != nil {
+				log.Warn("invalid encrypted DNS endpoint:", err)
+				return &pb.SetDNSResponse{
+					Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_ADDRESS},
+				}, nil
+			}
+			encryptedDNS = append(encryptedDNS, address)
+			continue
+		}
 		// Do not allow IPv6 servers
 		if !internal.IsAddressValidAsDNSServer(address) {
 			return &pb.SetDNSResponse{
 				Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_ADDRESS},
 			}, nil
 		}
+		plainDNS = append(plainDNS, address)
+	}
+	// plain nameservers would be a fallback leaking the queries
+	if len(plainDNS) > 0 && len(encryptedDNS) > 0 {
+		return &pb.SetDNSResponse{
+			Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_ADDRESS},
+		}, nil
 	}
 
 	newThreatProtectionLiteStatus := cfg.AutoConnectData.ThreatProtectionLite
@@ -50,7 +71,27 @@ func (r *RPC) SetDNS(ctx context.Context, in *pb.SetDNSRequest) (*pb.SetDNSRespo
 		newThreatProtectionLiteStatus = false
 	}
 
-	if nameservers == nil {
+	if _, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.AutoConnectData.ThreatProtectionLite = newThreatProtectionLiteStatus
+		c.AutoConnectData.DNS = plainDNS
+		c.AutoConnectData.EncryptedDNS = encryptedDNS
+		return c
+	}); !ok {
+		return &pb.SetDNSResponse{
+			Response: &pb.SetDNSResponse_ErrorCode{ErrorCode: pb.SetErrorCode_POLICY_LOCKED},
+		}, nil
+	}
+
+	if err := r.applyEncryptedDNS(encryptedDNS); err != nil {
+		log.Error(err)
+		return &pb.SetDNSResponse{
+			Response: &pb.SetDNSResponse_ErrorCode{ErrorCode: pb.SetErrorCode_FAILURE},
+		}, nil
+	}
+
+	if len(encryptedDNS) > 0 {
+		nameservers = []string{stub.Address}
+	} else if nameservers == nil {
 		nameservers = r.nameservers.Get(newThreatProtectionLiteStatus)
 	}
 
@@ -63,7 +104,8 @@ func (r *RPC) SetDNS(ctx context.Context, in *pb.SetDNSRequest) (*pb.SetDNSRespo
 
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
 		c.AutoConnectData.ThreatProtectionLite = newThreatProtectionLiteStatus
-		c.AutoConnectData.DNS = in.GetDns()
+		c.AutoConnectData.DNS = plainDNS
+		c.AutoConnectData.EncryptedDNS = encryptedDNS
 		return c
 	}); err != nil {
 		log.Error(err)
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns/stub"
 	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/events"
@@ -184,6 +185,20 @@ func TestSetDNS_Errors(t *testing.T) {
 				Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_ADDRESS},
 			},
 		},
+		{
+			name:         "plain and encrypted nameservers",
+			requestedDNS: config.DNS{"1.1.1.1", "tls://1.1.1.1"},
+			expectedResponse: &pb.SetDNSResponse{
+				Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_ADDRESS},
+			},
+		},
+		{
+			name:         "encrypted endpoint with domain name",
+			requestedDNS: config.DNS{"https://dns.quad9.net/dns-query"},
+			expectedResponse: &pb.SetDNSResponse{
+				Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_ADDRESS},
+			},
+		},
 		{
 			name:         "network error",
 			requestedDNS: dnsMock,
@@ -240,3 +255,75 @@ func TestSetDNS_Errors(t *testing.T) {
 		})
 	}
 }
+
+type mockEncryptedDNSResolver struct {
+	endpoints []string
+	startErr  error
+}
+
+func (m *mockEncryptedDNSResolver) Start(endpoints []string) error {
+	if m.startErr != nil {
+		return m.startErr
+	}
+	m.endpoints = endpoints
+	return nil
+}
+
+func (m *mockEncryptedDNSResolver) Stop() error {
+	m.endpoints = nil
+	return nil
+}
+
+func TestSetDNS_Encrypted(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	endpoints := []string{"https://1.1.1.1/dns-query", "tls://9.9.9.9"}
+
+	uuid, _ := uuid.NewUUID()
+	filesystem := fs.NewSystemFileHandleMock(t)
+	configManager := config.NewFilesystemConfigManager(
+		"/location", "/vault", "",
+		&machineIDGetterMock{machineID: uuid},
+		&filesystem,
+		nil)
+	configManager.SaveWith(func(c config.Config) config.Config {
+		c.AutoConnectData = config.AutoConnectData{DNS: dnsMock}
+		return c
+	})
+
+	networker := networker.Mock{}
+	resolver := mockEncryptedDNSResolver{}
+	rpc := RPC{
+		cm:           configManager,
+		netw:         &networker,
+		nameservers:  &mock.DNSGetter{},
+		events:       &daemonevents.Events{Settings: &daemonevents.SettingsEvents{DNS: &mockPublisherSubscriberDNS{}}},
+		encryptedDNS: &resolver,
+	}
+
+	resp, err := rpc.SetDNS(context.Background(), &pb.SetDNSRequest{Dns: endpoints})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.SetDNSStatus_DNS_CONFIGURED, resp.GetSetDnsStatus())
+	assert.Equal(t, endpoints, resolver.endpoints)
+	assert.Equal(t, []string{stub.Address}, networker.Dns)
+	assert.True(t, networker.BlockPlainDNS)
+
+	var cfg config.Config
+	assert.NoError(t, configManager.Load(&cfg))
+	assert.Nil(t, cfg.AutoConnectData.DNS)
+	assert.Equal(t, endpoints, cfg.AutoConnectData.EncryptedDNS)
+
+	resp, err = rpc.SetDNS(context.Background(), &pb.SetDNSRequest{Dns: endpoints})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.SetErrorCode_ALREADY_SET, resp.GetErrorCode())
+
+	resp, err = rpc.SetDNS(context.Background(), &pb.SetDNSRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.SetDNSStatus_DNS_CONFIGURED, resp.GetSetDnsStatus())
+	assert.Nil(t, resolver.endpoints)
+	assert.Equal(t, mock.DefaultNameserversV4, config.DNS(networker.Dns))
+	assert.False(t, networker.BlockPlainDNS)
+
+	assert.NoError(t, configManager.Load(&cfg))
+	assert.Nil(t, cfg.AutoConnectData.EncryptedDNS)
+}
@@ -52,6 +52,13 @@ func (r *RPC) SetECH(ctx context.Context, in *pb.SetGenericRequest) (*pb.Payload
 		}, nil
 	}
 
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.AutoConnectData.ECH.Set(in.GetEnabled())
+		return c
+	}); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
 		c.AutoConnectData.ECH.Set(in.GetEnabled())
 		return c
@@ -0,0 +1,58 @@
+package daemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// SetFailover enables or disables the connection quality monitor. Thresholds are changed only
+// if they are set in the request.
+func (r *RPC) SetFailover(ctx context.Context, in *pb.SetFailoverRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	failover := cfg.Failover
+	failover.Enabled = in.GetEnabled()
+	if in.MaxHandshakeAgeSec != nil {
+		failover.MaxHandshakeAge = time.Duration(in.GetMaxHandshakeAgeSec()) * time.Second
+	}
+	if in.MaxPacketLoss != nil {
+		failover.MaxPacketLoss = in.GetMaxPacketLoss()
+	}
+	if in.MaxLatencyMs != nil {
+		failover.MaxLatency = time.Duration(in.GetMaxLatencyMs()) * time.Millisecond
+	}
+	if err := failover.Validate(); err != nil {
+		return &pb.Payload{Type: internal.CodeFailoverInvalidThreshold}, nil
+	}
+	if failover == cfg.Failover {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Failover = failover
+		return c
+	}); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func failoverToProtobuf(failover config.Failover) *pb.FailoverSettings {
+	failover = failover.WithDefaults()
+	return &pb.FailoverSettings{
+		Enabled:            failover.Enabled,
+		MaxHandshakeAgeSec: int64(failover.MaxHandshakeAge / time.Second),
+		MaxPacketLoss:      failover.MaxPacketLoss,
+		MaxLatencyMs:       failover.MaxLatency.Milliseconds(),
+	}
+}
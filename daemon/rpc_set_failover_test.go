@@ -0,0 +1,94 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFailover(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	loss := uint32(20)
+	zeroLoss := uint32(0)
+	handshakeAge := int64(600)
+	shortHandshakeAge := int64(60)
+	latency := int64(500)
+
+	tests := []struct {
+		name             string
+		current          config.Failover
+		request          *pb.SetFailoverRequest
+		expectedCode     int64
+		expectedFailover config.Failover
+	}{
+		{
+			name:             "enable with defaults",
+			request:          &pb.SetFailoverRequest{Enabled: true},
+			expectedCode:     internal.CodeSuccess,
+			expectedFailover: config.Failover{Enabled: true},
+		},
+		{
+			name: "enable with thresholds",
+			request: &pb.SetFailoverRequest{
+				Enabled:            true,
+				MaxHandshakeAgeSec: &handshakeAge,
+				MaxPacketLoss:      &loss,
+				MaxLatencyMs:       &latency,
+			},
+			expectedCode: internal.CodeSuccess,
+			expectedFailover: config.Failover{
+				Enabled:         true,
+				MaxHandshakeAge: 10 * time.Minute,
+				MaxPacketLoss:   20,
+				MaxLatency:      500 * time.Millisecond,
+			},
+		},
+		{
+			name:             "disable keeps thresholds",
+			current:          config.Failover{Enabled: true, MaxPacketLoss: 20},
+			request:          &pb.SetFailoverRequest{Enabled: false},
+			expectedCode:     internal.CodeSuccess,
+			expectedFailover: config.Failover{MaxPacketLoss: 20},
+		},
+		{
+			name:             "zero resets threshold",
+			current:          config.Failover{Enabled: true, MaxPacketLoss: 20},
+			request:          &pb.SetFailoverRequest{Enabled: true, MaxPacketLoss: &zeroLoss},
+			expectedCode:     internal.CodeSuccess,
+			expectedFailover: config.Failover{Enabled: true},
+		},
+		{
+			name:             "already enabled",
+			current:          config.Failover{Enabled: true},
+			request:          &pb.SetFailoverRequest{Enabled: true},
+			expectedCode:     internal.CodeNothingToDo,
+			expectedFailover: config.Failover{Enabled: true},
+		},
+		{
+			name:         "handshake age shorter than rekey",
+			request:      &pb.SetFailoverRequest{Enabled: true, MaxHandshakeAgeSec: &shortHandshakeAge},
+			expectedCode: internal.CodeFailoverInvalidThreshold,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Failover = test.current
+			rpc := RPC{cm: cm}
+
+			resp, err := rpc.SetFailover(context.Background(), test.request)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedFailover, cm.Cfg.Failover)
+		})
+	}
+}
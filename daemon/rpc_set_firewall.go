@@ -25,6 +25,13 @@ func (r *RPC) SetFirewall(ctx context.Context, in *pb.SetGenericRequest) (*pb.Pa
 		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
 	}
 
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.Firewall = in.GetEnabled()
+		return c
+	}); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
 	if cfg.KillSwitch && !in.GetEnabled() {
 		return &pb.Payload{Type: internal.CodeDependencyError}, nil
 	}
@@ -63,6 +70,13 @@ func (r *RPC) SetFirewallMark(ctx context.Context, in *pb.SetUint32Request) (*pb
 		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
 	}
 
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.FirewallMark = in.GetValue()
+		return c
+	}); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
 		c.FirewallMark = in.GetValue()
 		return c
@@ -25,6 +25,13 @@ func (r *RPC) SetKillSwitch(ctx context.Context, in *pb.SetKillSwitchRequest) (*
 		}, nil
 	}
 
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.KillSwitch = in.GetKillSwitch()
+		return c
+	}); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
 	if in.KillSwitch {
 		if err := r.netw.SetKillSwitch(); err != nil {
 			log.Error("enabling killswitch:", err)
@@ -29,6 +29,17 @@ func (r *RPC) SetLANDiscovery(ctx context.Context, in *pb.SetLANDiscoveryRequest
 		}, nil
 	}
 
+	if _, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.LanDiscovery = in.GetEnabled()
+		return c
+	}); !ok {
+		return &pb.SetLANDiscoveryResponse{
+			Response: &pb.SetLANDiscoveryResponse_ErrorCode{
+				ErrorCode: pb.SetErrorCode_POLICY_LOCKED,
+			},
+		}, nil
+	}
+
 	subnets := cfg.AutoConnectData.Allowlist.Subnets
 	allowlist := cfg.AutoConnectData.Allowlist
 	status := pb.SetLANDiscoveryStatus_DISCOVERY_CONFIGURED
@@ -81,6 +92,7 @@ func (r *RPC) SetLANDiscovery(ctx context.Context, in *pb.SetLANDiscoveryRequest
 			TCPPorts: cfg.AutoConnectData.Allowlist.Ports.TCP.ToSlice(),
 			UDPPorts: cfg.AutoConnectData.Allowlist.Ports.UDP.ToSlice(),
 			Subnets:  subnets,
+			Domains:  cfg.AutoConnectData.Allowlist.Domains,
 		})
 	}
 
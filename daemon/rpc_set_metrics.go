@@ -0,0 +1,90 @@
+package daemon
+
+import (
+	"context"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// MetricsServer serves OpenMetrics exposition on a local address.
+type MetricsServer interface {
+	Start(network string, address string) error
+	Stop()
+}
+
+// StartMetrics starts serving metrics if they are enabled in the config.
+func (r *RPC) StartMetrics(server MetricsServer) {
+	r.metrics = server
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return
+	}
+	if !cfg.Metrics.Enabled {
+		return
+	}
+	network, address, err := cfg.Metrics.Address()
+	if err != nil {
+		log.Error("invalid metrics address:", err)
+		return
+	}
+	if err := server.Start(network, address); err != nil {
+		log.Error(err)
+	}
+}
+
+// StopMetrics stops serving metrics.
+func (r *RPC) StopMetrics() {
+	if r.metrics != nil {
+		r.metrics.Stop()
+	}
+}
+
+// SetMetrics enables or disables the local metrics exporter. Listen address is changed only if
+// it is set in the request.
+func (r *RPC) SetMetrics(ctx context.Context, in *pb.SetMetricsRequest) (*pb.Payload, error) {
+	if r.metrics == nil {
+		return &pb.Payload{Type: internal.CodeFeatureHidden}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	metrics := cfg.Metrics
+	metrics.Enabled = in.GetEnabled()
+	if in.GetListen() != "" {
+		metrics.Listen = in.GetListen()
+	}
+	network, address, err := metrics.Address()
+	if err != nil {
+		return &pb.Payload{Type: internal.CodeMetricsInvalidListen}, nil
+	}
+	if metrics == cfg.Metrics {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if metrics.Enabled {
+		if err := r.metrics.Start(network, address); err != nil {
+			log.Error(err)
+			return &pb.Payload{Type: internal.CodeMetricsListenFailed, Data: []string{address}}, nil
+		}
+	} else {
+		r.metrics.Stop()
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Metrics = metrics
+		return c
+	}); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{address}}, nil
+}
@@ -0,0 +1,91 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMetrics(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name            string
+		current         config.Metrics
+		request         *pb.SetMetricsRequest
+		startErr        error
+		expectedCode    int64
+		expectedMetrics config.Metrics
+		expectedRunning bool
+		expectedAddress string
+	}{
+		{
+			name:            "enable on default socket",
+			request:         &pb.SetMetricsRequest{Enabled: true},
+			expectedCode:    internal.CodeSuccess,
+			expectedMetrics: config.Metrics{Enabled: true},
+			expectedRunning: true,
+			expectedAddress: internal.MetricsSocket,
+		},
+		{
+			name:            "enable on loopback port",
+			request:         &pb.SetMetricsRequest{Enabled: true, Listen: "127.0.0.1:9188"},
+			expectedCode:    internal.CodeSuccess,
+			expectedMetrics: config.Metrics{Enabled: true, Listen: "127.0.0.1:9188"},
+			expectedRunning: true,
+			expectedAddress: "127.0.0.1:9188",
+		},
+		{
+			name:            "disable keeps listen address",
+			current:         config.Metrics{Enabled: true, Listen: "127.0.0.1:9188"},
+			request:         &pb.SetMetricsRequest{Enabled: false},
+			expectedCode:    internal.CodeSuccess,
+			expectedMetrics: config.Metrics{Listen: "127.0.0.1:9188"},
+		},
+		{
+			name:            "already enabled",
+			current:         config.Metrics{Enabled: true},
+			request:         &pb.SetMetricsRequest{Enabled: true},
+			expectedCode:    internal.CodeNothingToDo,
+			expectedMetrics: config.Metrics{Enabled: true},
+		},
+		{
+			name:            "remote address",
+			request:         &pb.SetMetricsRequest{Enabled: true, Listen: "0.0.0.0:9188"},
+			expectedCode:    internal.CodeMetricsInvalidListen,
+			expectedMetrics: config.Metrics{},
+		},
+		{
+			name:            "address in use",
+			request:         &pb.SetMetricsRequest{Enabled: true, Listen: "127.0.0.1:9188"},
+			startErr:        errors.New("address already in use"),
+			expectedCode:    internal.CodeMetricsListenFailed,
+			expectedMetrics: config.Metrics{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Metrics = test.current
+			server := &mockMetricsServer{startErr: test.startErr}
+			rpc := RPC{cm: cm, metrics: server}
+
+			resp, err := rpc.SetMetrics(context.Background(), test.request)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedMetrics, cm.Cfg.Metrics)
+			assert.Equal(t, test.expectedRunning, server.running)
+			if test.expectedRunning {
+				assert.Equal(t, test.expectedAddress, server.address)
+			}
+		})
+	}
+}
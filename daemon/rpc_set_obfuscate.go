@@ -21,6 +21,13 @@ func (r *RPC) SetObfuscate(ctx context.Context, in *pb.SetGenericRequest) (*pb.P
 		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
 	}
 
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.AutoConnectData.Obfuscate = in.GetEnabled()
+		return c
+	}); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
 	if cfg.AutoConnect {
 		switch core.IsServerObfuscated(r.dm.GetServersData().Servers, cfg.AutoConnectData.ServerTag) {
 		case core.ServerNotObfuscated:
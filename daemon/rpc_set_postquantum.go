@@ -20,6 +20,13 @@ func (r *RPC) SetPostQuantum(ctx context.Context, in *pb.SetGenericRequest) (*pb
 		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
 	}
 
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.AutoConnectData.PostquantumVpn = in.GetEnabled()
+		return c
+	}); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
 	if cfg.Mesh && in.GetEnabled() {
 		return &pb.Payload{Type: internal.CodePqAndMeshnetSimultaneously}, nil
 	}
@@ -22,6 +22,17 @@ func (r *RPC) SetProtocol(ctx context.Context, in *pb.SetProtocolRequest) (*pb.S
 		}, nil
 	}
 
+	if _, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.AutoConnectData.Protocol = in.GetProtocol()
+		return c
+	}); !ok {
+		return &pb.SetProtocolResponse{
+			Response: &pb.SetProtocolResponse_ErrorCode{
+				ErrorCode: pb.SetErrorCode_POLICY_LOCKED,
+			},
+		}, nil
+	}
+
 	if cfg.Technology != config.Technology_OPENVPN {
 		return &pb.SetProtocolResponse{
 			Response: &pb.SetProtocolResponse_SetProtocolStatus{
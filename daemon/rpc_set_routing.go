@@ -25,6 +25,13 @@ func (r *RPC) SetRouting(ctx context.Context, in *pb.SetGenericRequest) (*pb.Pay
 		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
 	}
 
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.Routing.Set(in.GetEnabled())
+		return c
+	}); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
 	if cfg.Mesh && !in.GetEnabled() {
 		return &pb.Payload{Type: internal.CodeDependencyError}, nil
 	}
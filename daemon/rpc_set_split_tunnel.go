@@ -0,0 +1,215 @@
+package daemon
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// SplitTunnelManager places the split tunnel applications into cgroups
+type SplitTunnelManager interface {
+	IsSupported() bool
+	CgroupExists(path string) bool
+	ProcessExists(pid int) bool
+	Sync(apps []config.SplitTunnelApp) ([]firewall.SplitTunnelCgroup, error)
+}
+
+func splitTunnelAppFromPb(app *pb.SplitTunnelApp) (config.SplitTunnelApp, bool) {
+	var appType config.SplitTunnelAppType
+	switch app.GetType() {
+	case pb.SplitTunnelAppType_PATH:
+		appType = config.SplitTunnelAppPath
+	case pb.SplitTunnelAppType_PID:
+		appType = config.SplitTunnelAppPID
+	case pb.SplitTunnelAppType_CGROUP:
+		appType = config.SplitTunnelAppCgroup
+	default:
+		return config.SplitTunnelApp{}, false
+	}
+
+	value := app.GetValue()
+	if appType != config.SplitTunnelAppPID {
+		value = filepath.Clean(value)
+	}
+
+	return config.SplitTunnelApp{
+		Type:             appType,
+		Value:            value,
+		KillSwitchExempt: app.GetKillSwitchExempt(),
+	}, true
+}
+
+func splitTunnelAppToPb(app config.SplitTunnelApp) *pb.SplitTunnelApp {
+	var appType pb.SplitTunnelAppType
+	switch app.Type {
+	case config.SplitTunnelAppPath:
+		appType = pb.SplitTunnelAppType_PATH
+	case config.SplitTunnelAppPID:
+		appType = pb.SplitTunnelAppType_PID
+	case config.SplitTunnelAppCgroup:
+		appType = pb.SplitTunnelAppType_CGROUP
+	}
+
+	return &pb.SplitTunnelApp{
+		Type:             appType,
+		Value:            app.Value,
+		KillSwitchExempt: app.KillSwitchExempt,
+	}
+}
+
+// isSplitTunnelAppValid checks if the application exists on the system
+func (r *RPC) isSplitTunnelAppValid(app config.SplitTunnelApp) bool {
+	switch app.Type {
+	case config.SplitTunnelAppPath:
+		if !filepath.IsAbs(app.Value) {
+			return false
+		}
+		info, err := os.Stat(app.Value)
+		return err == nil && info.Mode().IsRegular() && info.Mode().Perm()&0111 != 0
+	case config.SplitTunnelAppPID:
+		pid, err := strconv.Atoi(app.Value)
+		return err == nil && pid > 1 && r.splitTunnel.ProcessExists(pid)
+	case config.SplitTunnelAppCgroup:
+		return filepath.IsAbs(app.Value) && app.Value != "/" && r.splitTunnel.CgroupExists(app.Value)
+	}
+	return false
+}
+
+func (r *RPC) SetSplitTunnel(ctx context.Context, in *pb.SetSplitTunnelRequest) (*pb.Payload, error) {
+	if !r.splitTunnel.IsSupported() {
+		return &pb.Payload{Type: internal.CodeSplitTunnelNotSupported}, nil
+	}
+
+	app, ok := splitTunnelAppFromPb(in.GetApp())
+	if !ok || !r.isSplitTunnelAppValid(app) {
+		return &pb.Payload{Type: internal.CodeSplitTunnelInvalidApp}, nil
+	}
+
+	return r.updateSplitTunnel(func(st *config.SplitTunnel) bool {
+		return st.Add(app)
+	}), nil
+}
+
+func (r *RPC) UnsetSplitTunnel(ctx context.Context, in *pb.SetSplitTunnelRequest) (*pb.Payload, error) {
+	app, ok := splitTunnelAppFromPb(in.GetApp())
+	if !ok {
+		return &pb.Payload{Type: internal.CodeSplitTunnelInvalidApp}, nil
+	}
+
+	return r.updateSplitTunnel(func(st *config.SplitTunnel) bool {
+		return st.Remove(app.Type, app.Value)
+	}), nil
+}
+
+// updateSplitTunnel applies the modification to the split tunnel configuration, saves it and
+// configures the system accordingly
+func (r *RPC) updateSplitTunnel(modify func(*config.SplitTunnel) bool) *pb.Payload {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error("loading config:", err)
+		return &pb.Payload{Type: internal.CodeConfigError}
+	}
+
+	splitTunnel := cfg.SplitTunnel
+	// do not modify the loaded config slice in place
+	splitTunnel.Apps = append([]config.SplitTunnelApp{}, splitTunnel.Apps...)
+	if !modify(&splitTunnel) {
+		return &pb.Payload{Type: internal.CodeSplitTunnelAppNoop}
+	}
+
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.SplitTunnel = splitTunnel
+		return c
+	}); !ok {
+		return policyLockedPayload(locked)
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.SplitTunnel = splitTunnel
+		return c
+	}); err != nil {
+		log.Error("saving config:", err)
+		return &pb.Payload{Type: internal.CodeConfigError}
+	}
+
+	if err := r.applySplitTunnel(splitTunnel); err != nil {
+		log.Error("applying split tunnel:", err)
+		return &pb.Payload{Type: internal.CodeFailure}
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}
+}
+
+func (r *RPC) SetSplitTunnelMode(ctx context.Context, in *pb.SetSplitTunnelModeRequest) (*pb.Payload, error) {
+	if !r.splitTunnel.IsSupported() {
+		return &pb.Payload{Type: internal.CodeSplitTunnelNotSupported}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error("loading config:", err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	mode := splitTunnelModeFromPb(in.GetMode())
+	if cfg.SplitTunnel.IsIncludeOnly() == (mode == config.SplitTunnelModeInclude) {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.SplitTunnel.Mode = mode
+		return c
+	}); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.SplitTunnel.Mode = mode
+		return c
+	}); err != nil {
+		log.Error("saving config:", err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	cfg.SplitTunnel.Mode = mode
+	if err := r.applySplitTunnel(cfg.SplitTunnel); err != nil {
+		log.Error("applying split tunnel mode:", err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func splitTunnelModeFromPb(mode pb.SplitTunnelMode) config.SplitTunnelMode {
+	if mode == pb.SplitTunnelMode_INCLUDE {
+		return config.SplitTunnelModeInclude
+	}
+	return config.SplitTunnelModeExclude
+}
+
+func splitTunnelModeToPb(splitTunnel config.SplitTunnel) pb.SplitTunnelMode {
+	if splitTunnel.IsIncludeOnly() {
+		return pb.SplitTunnelMode_INCLUDE
+	}
+	return pb.SplitTunnelMode_EXCLUDE
+}
+
+func (r *RPC) applySplitTunnel(splitTunnel config.SplitTunnel) error {
+	cgroups, err := r.splitTunnel.Sync(splitTunnel.Apps)
+	if err != nil {
+		return err
+	}
+
+	mode := firewall.SplitTunnelExclude
+	if splitTunnel.IsIncludeOnly() {
+		mode = firewall.SplitTunnelInclude
+	}
+	return r.netw.SetSplitTunnel(mode, cgroups)
+}
@@ -0,0 +1,235 @@
+package daemon
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetSplitTunnel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	executable, err := os.Executable()
+	assert.NoError(t, err)
+	pid := os.Getpid()
+
+	tests := []struct {
+		name         string
+		app          *pb.SplitTunnelApp
+		current      []config.SplitTunnelApp
+		notSupported bool
+		expectedApps []config.SplitTunnelApp
+		expectedCode int64
+	}{
+		{
+			name:         "add executable path",
+			app:          &pb.SplitTunnelApp{Type: pb.SplitTunnelAppType_PATH, Value: executable},
+			expectedApps: []config.SplitTunnelApp{{Type: config.SplitTunnelAppPath, Value: executable}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name: "add pid exempted from kill switch",
+			app: &pb.SplitTunnelApp{
+				Type: pb.SplitTunnelAppType_PID, Value: strconv.Itoa(pid), KillSwitchExempt: true,
+			},
+			expectedApps: []config.SplitTunnelApp{
+				{Type: config.SplitTunnelAppPID, Value: strconv.Itoa(pid), KillSwitchExempt: true},
+			},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "add cgroup",
+			app:          &pb.SplitTunnelApp{Type: pb.SplitTunnelAppType_CGROUP, Value: "/user.slice/app.scope"},
+			expectedApps: []config.SplitTunnelApp{{Type: config.SplitTunnelAppCgroup, Value: "/user.slice/app.scope"}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "relative path is rejected",
+			app:          &pb.SplitTunnelApp{Type: pb.SplitTunnelAppType_PATH, Value: "curl"},
+			expectedCode: internal.CodeSplitTunnelInvalidApp,
+		},
+		{
+			name:         "not running pid is rejected",
+			app:          &pb.SplitTunnelApp{Type: pb.SplitTunnelAppType_PID, Value: "999999"},
+			expectedCode: internal.CodeSplitTunnelInvalidApp,
+		},
+		{
+			name:         "missing cgroup is rejected",
+			app:          &pb.SplitTunnelApp{Type: pb.SplitTunnelAppType_CGROUP, Value: "/missing.scope"},
+			expectedCode: internal.CodeSplitTunnelInvalidApp,
+		},
+		{
+			name:         "already added app",
+			app:          &pb.SplitTunnelApp{Type: pb.SplitTunnelAppType_PATH, Value: executable},
+			current:      []config.SplitTunnelApp{{Type: config.SplitTunnelAppPath, Value: executable}},
+			expectedApps: []config.SplitTunnelApp{{Type: config.SplitTunnelAppPath, Value: executable}},
+			expectedCode: internal.CodeSplitTunnelAppNoop,
+		},
+		{
+			name:         "cgroup v2 not available",
+			app:          &pb.SplitTunnelApp{Type: pb.SplitTunnelAppType_PATH, Value: executable},
+			notSupported: true,
+			expectedCode: internal.CodeSplitTunnelNotSupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.SplitTunnel.Apps = test.current
+			netw := &networker.Mock{}
+			splitTunnel := &mockSplitTunnelManager{
+				notSupported: test.notSupported,
+				cgroups:      []string{"/user.slice/app.scope"},
+				pids:         []int{pid},
+			}
+			r := RPC{cm: cm, netw: netw, events: events.NewEventsEmpty(), splitTunnel: splitTunnel}
+
+			resp, err := r.SetSplitTunnel(context.Background(), &pb.SetSplitTunnelRequest{App: test.app})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedApps, cm.Cfg.SplitTunnel.Apps)
+			if test.expectedCode == internal.CodeSuccess {
+				assert.Equal(t, test.expectedApps, splitTunnel.synced)
+				assert.Len(t, netw.SplitTunnel, len(test.expectedApps))
+			}
+		})
+	}
+}
+
+func TestUnsetSplitTunnel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.SplitTunnel.Apps = []config.SplitTunnelApp{
+		{Type: config.SplitTunnelAppPath, Value: "/usr/bin/curl"},
+		{Type: config.SplitTunnelAppPID, Value: "42"},
+	}
+	netw := &networker.Mock{}
+	splitTunnel := &mockSplitTunnelManager{}
+	r := RPC{cm: cm, netw: netw, events: events.NewEventsEmpty(), splitTunnel: splitTunnel}
+
+	resp, err := r.UnsetSplitTunnel(context.Background(), &pb.SetSplitTunnelRequest{
+		App: &pb.SplitTunnelApp{Type: pb.SplitTunnelAppType_PATH, Value: "/usr/bin/curl"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Equal(t, []config.SplitTunnelApp{{Type: config.SplitTunnelAppPID, Value: "42"}}, cm.Cfg.SplitTunnel.Apps)
+	assert.Len(t, netw.SplitTunnel, 1)
+
+	resp, err = r.UnsetSplitTunnel(context.Background(), &pb.SetSplitTunnelRequest{
+		App: &pb.SplitTunnelApp{Type: pb.SplitTunnelAppType_PATH, Value: "/usr/bin/curl"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSplitTunnelAppNoop, resp.Type)
+}
+
+func TestJobSplitTunnel_RemovesExitedProcesses(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.SplitTunnel.Apps = []config.SplitTunnelApp{
+		{Type: config.SplitTunnelAppPID, Value: "42"},
+		{Type: config.SplitTunnelAppPID, Value: "43"},
+	}
+	netw := &networker.Mock{}
+	splitTunnel := &mockSplitTunnelManager{pids: []int{43}}
+	r := RPC{cm: cm, netw: netw, splitTunnel: splitTunnel}
+
+	assert.NoError(t, JobSplitTunnel(&r)())
+	expected := []config.SplitTunnelApp{{Type: config.SplitTunnelAppPID, Value: "43"}}
+	assert.Equal(t, expected, cm.Cfg.SplitTunnel.Apps)
+	assert.Equal(t, expected, splitTunnel.synced)
+}
+
+func TestSetSplitTunnelMode(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.SplitTunnel.Apps = []config.SplitTunnelApp{{Type: config.SplitTunnelAppPID, Value: "42"}}
+	netw := &networker.Mock{}
+	r := RPC{cm: cm, netw: netw, events: events.NewEventsEmpty(), splitTunnel: &mockSplitTunnelManager{}}
+
+	resp, err := r.SetSplitTunnelMode(context.Background(),
+		&pb.SetSplitTunnelModeRequest{Mode: pb.SplitTunnelMode_EXCLUDE})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeNothingToDo, resp.Type)
+
+	resp, err = r.SetSplitTunnelMode(context.Background(),
+		&pb.SetSplitTunnelModeRequest{Mode: pb.SplitTunnelMode_INCLUDE})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.True(t, cm.Cfg.SplitTunnel.IsIncludeOnly())
+	assert.Equal(t, firewall.SplitTunnelInclude, netw.SplitTunnelMode)
+	assert.Len(t, netw.SplitTunnel, 1)
+
+	resp, err = r.SetSplitTunnelMode(context.Background(),
+		&pb.SetSplitTunnelModeRequest{Mode: pb.SplitTunnelMode_EXCLUDE})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.False(t, cm.Cfg.SplitTunnel.IsIncludeOnly())
+	assert.Equal(t, firewall.SplitTunnelExclude, netw.SplitTunnelMode)
+}
+
+func TestSplitTunnel_PolicyLocked(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.KillSwitch = true
+	netw := &networker.Mock{}
+	r := RPC{
+		cm:          cm,
+		netw:        netw,
+		events:      events.NewEventsEmpty(),
+		splitTunnel: &mockSplitTunnelManager{cgroups: []string{"/user.slice/app.scope"}},
+		policy:      newTestPolicyLoader(t, "version: 1\npin:\n  killswitch: true\n"),
+	}
+
+	// pinned kill switch can not be bypassed
+	resp, err := r.SetSplitTunnel(context.Background(), &pb.SetSplitTunnelRequest{
+		App: &pb.SplitTunnelApp{
+			Type: pb.SplitTunnelAppType_CGROUP, Value: "/user.slice/app.scope", KillSwitchExempt: true,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodePolicyLocked, resp.Type)
+	assert.Equal(t, []string{"split_tunnel"}, resp.Data)
+	assert.Empty(t, cm.Cfg.SplitTunnel.Apps)
+
+	resp, err = r.SetSplitTunnelMode(context.Background(),
+		&pb.SetSplitTunnelModeRequest{Mode: pb.SplitTunnelMode_INCLUDE})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodePolicyLocked, resp.Type)
+	assert.False(t, cm.Cfg.SplitTunnel.IsIncludeOnly())
+
+	resp, err = r.SetSplitTunnel(context.Background(), &pb.SetSplitTunnelRequest{
+		App: &pb.SplitTunnelApp{Type: pb.SplitTunnelAppType_CGROUP, Value: "/user.slice/app.scope"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+
+	// split tunnel is not allowed at all
+	r.policy = newTestPolicyLoader(t, "version: 1\nallow:\n  split_tunnel: false\n")
+	resp, err = r.SetSplitTunnelMode(context.Background(),
+		&pb.SetSplitTunnelModeRequest{Mode: pb.SplitTunnelMode_INCLUDE})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodePolicyLocked, resp.Type)
+
+	resp, err = r.UnsetSplitTunnel(context.Background(), &pb.SetSplitTunnelRequest{
+		App: &pb.SplitTunnelApp{Type: pb.SplitTunnelAppType_CGROUP, Value: "/user.slice/app.scope"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Empty(t, cm.Cfg.SplitTunnel.Apps)
+}
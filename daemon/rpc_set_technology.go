@@ -81,6 +81,16 @@ func (r *RPC) SetTechnology(ctx context.Context, in *pb.SetTechnologyRequest) (*
 		}, nil
 	}
 
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.Technology = in.GetTechnology()
+		c.AutoConnectData.Protocol = protocol
+		c.AutoConnectData.Obfuscate = obfuscate
+		c.AutoConnectData.ECH = ech
+		return c
+	}); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
 		c.Technology = in.GetTechnology()
 		c.AutoConnectData.Protocol = protocol
@@ -25,6 +25,21 @@ func (r *RPC) SetThreatProtectionLite(
 		}, nil
 	}
 
+	if _, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.AutoConnectData.ThreatProtectionLite = threatProtectionLite
+		c.AutoConnectData.DNS = nil
+		c.AutoConnectData.EncryptedDNS = nil
+		return c
+	}); !ok {
+		return &pb.SetThreatProtectionLiteResponse{
+			Response: &pb.SetThreatProtectionLiteResponse_ErrorCode{ErrorCode: pb.SetErrorCode_POLICY_LOCKED},
+		}, nil
+	}
+
+	if err := r.applyEncryptedDNS(nil); err != nil {
+		log.Warn("stopping encrypted DNS:", err)
+	}
+
 	nameservers := r.nameservers.Get(threatProtectionLite)
 
 	if err := r.netw.SetDNS(nameservers); err != nil {
@@ -37,6 +52,7 @@ func (r *RPC) SetThreatProtectionLite(
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
 		c.AutoConnectData.ThreatProtectionLite = threatProtectionLite
 		c.AutoConnectData.DNS = nil
+		c.AutoConnectData.EncryptedDNS = nil
 		return c
 	}); err != nil {
 		log.Error(err)
@@ -46,7 +62,8 @@ func (r *RPC) SetThreatProtectionLite(
 	}
 	r.events.Settings.ThreatProtectionLite.Publish(in.GetThreatProtectionLite())
 
-	if cfg.AutoConnectData.DNS != nil && threatProtectionLite {
+	customDNS := cfg.AutoConnectData.DNS != nil || len(cfg.AutoConnectData.EncryptedDNS) > 0
+	if customDNS && threatProtectionLite {
 		return &pb.SetThreatProtectionLiteResponse{
 			Response: &pb.SetThreatProtectionLiteResponse_SetThreatProtectionLiteStatus{
 				SetThreatProtectionLiteStatus: pb.SetThreatProtectionLiteStatus_TPL_CONFIGURED_DNS_RESET},
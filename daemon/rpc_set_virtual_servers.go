@@ -21,6 +21,13 @@ func (r *RPC) SetVirtualLocation(ctx context.Context, in *pb.SetGenericRequest)
 		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
 	}
 
+	if locked, ok := r.checkPolicy(cfg, func(c config.Config) config.Config {
+		c.VirtualLocation.Set(in.Enabled)
+		return c
+	}); !ok {
+		return policyLockedPayload(locked), nil
+	}
+
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
 		c.VirtualLocation.Set(in.Enabled)
 		return c
@@ -64,6 +64,7 @@ func (r *RPC) Settings(ctx context.Context, in *pb.Empty) (*pb.SettingsResponse,
 	cfg.AutoConnectData.ECH = r.getECHEnabledField(cfg)
 
 	settings := configToProtobuf(&cfg, uid)
+	settings.LockedSettings = r.lockedSettings()
 
 	return &pb.SettingsResponse{
 		Type: internal.CodeSuccess,
@@ -0,0 +1,336 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/config/remote"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns/stub"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/serverpicker"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/features"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+	"github.com/NordSecurity/nordvpn-linux/meshnet"
+)
+
+// MeshPeerPermissions reads and updates the permissions granted to the meshnet peers
+type MeshPeerPermissions interface {
+	// PeerPermissions returns the hostname of this machine and the permissions of its peers
+	PeerPermissions() (string, []config.SettingsFileMeshPeer, error)
+	SetPeerPermissions([]config.SettingsFileMeshPeer) error
+}
+
+// SetMeshPeerPermissions enables the meshnet peer permissions in the settings file. Must be
+// called before the RPC is served.
+func (r *RPC) SetMeshPeerPermissions(meshPeers MeshPeerPermissions) {
+	r.meshPeers = meshPeers
+}
+
+// ExportSettings returns the user controllable settings as a settings file.
+func (r *RPC) ExportSettings(ctx context.Context, in *pb.Empty) (*pb.ExportSettingsResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.ExportSettingsResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	file := config.NewSettingsFile(cfg)
+	if cfg.Mesh && r.meshPeers != nil {
+		_, peers, err := r.meshPeers.PeerPermissions()
+		if err != nil {
+			log.Error("listing meshnet peer permissions:", err)
+			return &pb.ExportSettingsResponse{Type: internal.CodeFailure}, nil
+		}
+		if len(peers) > 0 {
+			file.Meshnet = &config.SettingsFileMeshnet{Peers: peers}
+		}
+	}
+
+	document, err := file.Marshal()
+	if err != nil {
+		log.Error("marshaling settings file:", err)
+		return &pb.ExportSettingsResponse{Type: internal.CodeFailure}, nil
+	}
+	return &pb.ExportSettingsResponse{Type: internal.CodeSuccess, Document: string(document)}, nil
+}
+
+// ApplySettings applies the whole settings file in a single config save and then brings the
+// networker in line with the new settings.
+func (r *RPC) ApplySettings(ctx context.Context, in *pb.ApplySettingsRequest) (*pb.ApplySettingsResponse, error) {
+	file, err := config.ParseSettingsFile([]byte(in.GetDocument()))
+	if err != nil {
+		return &pb.ApplySettingsResponse{Type: internal.CodeSettingsFileInvalid, Error: err.Error()}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.ApplySettingsResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	newCfg, err := r.settingsFromFile(cfg, file)
+	if err != nil {
+		return settingsFileErrorToResponse(err)
+	}
+
+	configChanges, err := config.DiffSettings(cfg, newCfg)
+	if err != nil {
+		log.Error("comparing settings:", err)
+		return &pb.ApplySettingsResponse{Type: internal.CodeFailure}, nil
+	}
+	peerUpdates, peerChanges, err := r.meshPeersFromFile(cfg, file)
+	if err != nil {
+		return settingsFileErrorToResponse(err)
+	}
+	changes := slices.Concat(configChanges, peerChanges)
+	if len(changes) == 0 {
+		return &pb.ApplySettingsResponse{Type: internal.CodeNothingToDo, Changes: changes}, nil
+	}
+	if locked, ok := r.checkPolicy(cfg, func(config.Config) config.Config { return newCfg }); !ok {
+		return &pb.ApplySettingsResponse{Type: internal.CodePolicyLocked, Changes: changes, LockedSettings: locked}, nil
+	}
+	if in.GetDryRun() {
+		return &pb.ApplySettingsResponse{Type: internal.CodeSuccess, Changes: changes}, nil
+	}
+
+	applied := true
+	if len(configChanges) > 0 {
+		resp, ok := r.applySettingsFile(cfg, newCfg, file)
+		if resp != nil {
+			resp.Changes = changes
+			return resp, nil
+		}
+		applied = ok
+	}
+	if len(peerUpdates) > 0 {
+		if err := r.meshPeers.SetPeerPermissions(peerUpdates); err != nil {
+			log.Error("setting meshnet peer permissions:", err)
+			applied = false
+		}
+	}
+
+	if !applied {
+		return &pb.ApplySettingsResponse{Type: internal.CodeSettingsNotApplied, Changes: changes}, nil
+	}
+	return &pb.ApplySettingsResponse{Type: internal.CodeSuccess, Changes: changes}, nil
+}
+
+// applySettingsFile saves the config with the settings from the file and reconfigures the
+// networker. The response is returned if the config was not saved, otherwise it returns false if
+// the networker could not be reconfigured.
+func (r *RPC) applySettingsFile(
+	cfg config.Config,
+	newCfg config.Config,
+	file config.SettingsFile,
+) (*pb.ApplySettingsResponse, bool) {
+	var newVPN vpn.VPN
+	if newCfg.Technology != cfg.Technology {
+		var err error
+		if newVPN, err = r.factory(newCfg.Technology); err != nil {
+			log.Error(err)
+			return &pb.ApplySettingsResponse{Type: internal.CodeConfigError}, false
+		}
+	}
+
+	// config could have been changed since it was loaded, so the file is applied again on
+	// top of the config being saved. Checks which read the config can not be repeated while
+	// it is being saved, their results are reused instead.
+	resolved := newCfg.AutoConnectData
+	var saveErr error
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		applied, err := file.Apply(c)
+		if err != nil {
+			saveErr = err
+			return c
+		}
+		applied.AutoConnectData.ECH = resolved.ECH
+		applied.AutoConnectData.Country = resolved.Country
+		applied.AutoConnectData.City = resolved.City
+		applied.AutoConnectData.Group = resolved.Group
+		newCfg = applied
+		return applied
+	}); err != nil {
+		log.Error(err)
+		return &pb.ApplySettingsResponse{Type: internal.CodeConfigError}, false
+	}
+	if saveErr != nil {
+		resp, _ := settingsFileErrorToResponse(saveErr)
+		return resp, false
+	}
+
+	if newVPN != nil {
+		r.netw.SetVPN(newVPN)
+	}
+	r.events.Settings.Publish(newCfg)
+
+	return nil, r.applyNetworkerSettings(cfg, newCfg)
+}
+
+// meshPeersFromFile returns the meshnet peer permissions changed by the file and the changes
+func (r *RPC) meshPeersFromFile(
+	cfg config.Config,
+	file config.SettingsFile,
+) ([]config.SettingsFileMeshPeer, []string, error) {
+	if file.Meshnet == nil {
+		return nil, nil, nil
+	}
+	errMeshnetRequired := fmt.Errorf("%w: meshnet peers require meshnet to be enabled", config.ErrSettingsFileInvalid)
+	if !cfg.Mesh || r.meshPeers == nil {
+		return nil, nil, errMeshnetRequired
+	}
+
+	self, current, err := r.meshPeers.PeerPermissions()
+	if err != nil {
+		if errors.Is(err, meshnet.ErrMeshnetNotEnabled) {
+			return nil, nil, errMeshnetRequired
+		}
+		return nil, nil, fmt.Errorf("listing meshnet peer permissions: %w", err)
+	}
+	return file.Meshnet.Apply(self, current)
+}
+
+// settingsFromFile applies the file to the config and performs the checks which depend on the
+// daemon state, i.e. availability of the features and the autoconnect server
+func (r *RPC) settingsFromFile(cfg config.Config, file config.SettingsFile) (config.Config, error) {
+	newCfg, err := file.Apply(cfg)
+	if err != nil {
+		return cfg, err
+	}
+
+	if file.EncryptedDNS != nil {
+		for _, endpoint := range *file.EncryptedDNS {
+			if !stub.IsEncryptedEndpoint(endpoint) || stub.ValidateEndpoint(endpoint) != nil {
+				return cfg, fmt.Errorf("%w: invalid encrypted_dns value '%s'", config.ErrSettingsFileInvalid, endpoint)
+			}
+		}
+	}
+
+	if newCfg.Technology != cfg.Technology {
+		if newCfg.Technology == config.Technology_NORDWHISPER && !features.NordWhisperEnabled {
+			return cfg, internal.NewErrorWithCode(internal.CodeFeatureHidden)
+		}
+		if newCfg.Technology != config.Technology_NORDWHISPER {
+			newCfg.AutoConnectData.ECH = r.resetECHEnabledField()
+		}
+	}
+
+	if file.AutoConnect == nil || !newCfg.AutoConnect {
+		return newCfg, nil
+	}
+	if ok, err := r.ac.IsLoggedIn(); !ok {
+		if err != nil {
+			log.Error("checking login status:", err)
+		}
+		return cfg, internal.ErrNotLoggedIn
+	}
+
+	serverTag, serverGroup := file.AutoConnect.Server, file.AutoConnect.Group
+	if serverpicker.IsDedicatedServer(serverTag, serverGroup) {
+		if !r.remoteConfigGetter.IsFeatureEnabled(remote.FeatureDedicatedServer) {
+			return cfg, internal.NewErrorWithCode(internal.CodeGroupNonexisting)
+		}
+		if newCfg.Technology != config.Technology_NORDLYNX {
+			return cfg, internal.NewErrorWithCode(internal.CodeDedicatedServersNoNordlynx)
+		}
+		if newCfg.AutoConnectData.PostquantumVpn {
+			return cfg, internal.NewErrorWithCode(internal.CodeDedicatedServersPq)
+		}
+	}
+	insights := r.dm.GetInsightsData().Insights
+	if _, err := selectServer(r, &insights, newCfg, serverTag, serverGroup, ""); err != nil {
+		log.Error("no server found for autoconnect", serverTag, err)
+		return cfg, err
+	}
+	parameters := serverpicker.GetServerParameters(serverTag, serverGroup, r.dm.GetCountryData().Countries)
+	newCfg.AutoConnectData.Country = parameters.CountryCode
+	newCfg.AutoConnectData.City = parameters.City
+	newCfg.AutoConnectData.Group = parameters.Group
+	return newCfg, nil
+}
+
+func settingsFileErrorToResponse(err error) (*pb.ApplySettingsResponse, error) {
+	var errorCode *internal.ErrorWithCode
+	switch {
+	case errors.Is(err, config.ErrSettingsFileInvalid):
+		return &pb.ApplySettingsResponse{Type: internal.CodeSettingsFileInvalid, Error: err.Error()}, nil
+	case errors.As(err, &errorCode):
+		return &pb.ApplySettingsResponse{Type: errorCode.Code}, nil
+	case errors.Is(err, internal.ErrNotLoggedIn):
+		return nil, err
+	default:
+		log.Error("applying settings file:", err)
+		return &pb.ApplySettingsResponse{Type: internal.CodeFailure}, nil
+	}
+}
+
+// applyNetworkerSettings reconfigures the networker once for all of the changed settings.
+// Returns false if any of the settings could not be applied.
+func (r *RPC) applyNetworkerSettings(oldCfg config.Config, newCfg config.Config) bool {
+	ok := true
+	fail := func(what string, err error) {
+		log.Error(what+":", err)
+		ok = false
+	}
+
+	if oldCfg.Firewall != newCfg.Firewall {
+		if newCfg.Firewall {
+			if err := r.netw.EnableFirewall(); err != nil {
+				fail("enabling firewall", err)
+			}
+		} else if err := r.netw.DisableFirewall(); err != nil {
+			fail("disabling firewall", err)
+		}
+	}
+
+	if oldCfg.Routing.Get() != newCfg.Routing.Get() {
+		if newCfg.Routing.Get() {
+			r.netw.EnableRouting()
+		} else {
+			r.netw.DisableRouting()
+		}
+	}
+
+	if oldCfg.KillSwitch != newCfg.KillSwitch {
+		if newCfg.KillSwitch {
+			if err := r.netw.SetKillSwitch(); err != nil {
+				fail("enabling killswitch", err)
+			}
+		} else if err := r.netw.UnsetKillSwitch(); err != nil {
+			fail("disabling killswitch", err)
+		}
+	}
+
+	if !slices.Equal(oldCfg.AutoConnectData.DNS, newCfg.AutoConnectData.DNS) ||
+		!slices.Equal(oldCfg.AutoConnectData.EncryptedDNS, newCfg.AutoConnectData.EncryptedDNS) ||
+		oldCfg.AutoConnectData.ThreatProtectionLite != newCfg.AutoConnectData.ThreatProtectionLite {
+		if err := r.applyEncryptedDNS(newCfg.AutoConnectData.EncryptedDNS); err != nil {
+			fail("setting encrypted DNS", err)
+		}
+		if err := r.netw.SetDNS(r.systemNameservers(newCfg)); err != nil {
+			fail("setting DNS", err)
+		}
+	}
+
+	if !reflect.DeepEqual(oldCfg.AutoConnectData.Allowlist, newCfg.AutoConnectData.Allowlist) {
+		if err := r.netw.SetAllowlist(newCfg.AutoConnectData.Allowlist); err != nil {
+			fail("setting allowlist", err)
+		}
+	}
+
+	if oldCfg.LanDiscovery != newCfg.LanDiscovery {
+		r.netw.SetLanDiscovery(newCfg.LanDiscovery)
+	}
+
+	if oldCfg.ARPIgnore.Get() != newCfg.ARPIgnore.Get() {
+		if err := r.netw.SetARPIgnore(newCfg.ARPIgnore.Get()); err != nil {
+			fail("setting arp ignore", err)
+		}
+	}
+	return ok
+}
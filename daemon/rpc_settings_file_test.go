@@ -0,0 +1,98 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type mockMeshPeerPermissions struct {
+	peers   []config.SettingsFileMeshPeer
+	updated []config.SettingsFileMeshPeer
+}
+
+func (m *mockMeshPeerPermissions) PeerPermissions() (string, []config.SettingsFileMeshPeer, error) {
+	return "self-everest.nord", m.peers, nil
+}
+
+func (m *mockMeshPeerPermissions) SetPeerPermissions(peers []config.SettingsFileMeshPeer) error {
+	m.updated = peers
+	return nil
+}
+
+func TestSettingsFile_MeshPeerPermissions(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	allowed, denied := true, false
+	meshPeers := &mockMeshPeerPermissions{peers: []config.SettingsFileMeshPeer{{
+		Hostname:          "peer-everest.nord",
+		AllowIncoming:     &allowed,
+		AllowRouting:      &denied,
+		AllowLocalNetwork: &denied,
+		AllowFileshare:    &denied,
+		AlwaysAcceptFiles: &denied,
+	}}}
+	r := testRPC()
+	r.SetMeshPeerPermissions(meshPeers)
+	document := "version: 1\nmeshnet:\n  peers:\n    - hostname: peer-everest.nord\n      allow_fileshare: true\n"
+
+	setMeshnet := func(enabled bool) {
+		require.NoError(t, r.cm.SaveWith(func(c config.Config) config.Config {
+			c.Mesh = enabled
+			return c
+		}))
+	}
+
+	// peer permissions are kept by the meshnet
+	setMeshnet(false)
+	resp, err := r.ApplySettings(context.Background(), &pb.ApplySettingsRequest{Document: document})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeSettingsFileInvalid, resp.Type)
+
+	setMeshnet(true)
+
+	exported, err := r.ExportSettings(context.Background(), &pb.Empty{})
+	require.NoError(t, err)
+	var file config.SettingsFile
+	require.NoError(t, yaml.Unmarshal([]byte(exported.Document), &file))
+	require.NotNil(t, file.Meshnet)
+	assert.Equal(t, meshPeers.peers, file.Meshnet.Peers)
+
+	expectedChanges := []string{"meshnet.peers.peer-everest.nord.allow_fileshare: false -> true"}
+	resp, err = r.ApplySettings(context.Background(), &pb.ApplySettingsRequest{Document: document, DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Equal(t, expectedChanges, resp.Changes)
+	assert.Empty(t, meshPeers.updated)
+
+	resp, err = r.ApplySettings(context.Background(), &pb.ApplySettingsRequest{Document: document})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Equal(t, expectedChanges, resp.Changes)
+	require.Len(t, meshPeers.updated, 1)
+	assert.True(t, *meshPeers.updated[0].AllowFileshare)
+	assert.True(t, *meshPeers.updated[0].AllowIncoming)
+}
+
+func TestSettingsFile_InvalidEncryptedDNS(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, endpoint := range []string{"1.1.1.1", "tls://example.com", "ftp://1.1.1.1"} {
+		t.Run(endpoint, func(t *testing.T) {
+			r := testRPC()
+			resp, err := r.ApplySettings(context.Background(), &pb.ApplySettingsRequest{
+				Document: "version: 1\ndns: []\nencrypted_dns: [" + endpoint + "]\n",
+				DryRun:   true,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, internal.CodeSettingsFileInvalid, resp.Type)
+		})
+	}
+}
@@ -22,6 +22,32 @@ func configToProtobuf(cfg *config.Config, uid int64) *pb.Settings {
 	subnets := []string{}
 	subnets = append(subnets, cfg.AutoConnectData.Allowlist.Subnets...)
 
+	splitTunnelApps := make([]*pb.SplitTunnelApp, 0, len(cfg.SplitTunnel.Apps))
+	for _, app := range cfg.SplitTunnel.Apps {
+		splitTunnelApps = append(splitTunnelApps, splitTunnelAppToPb(app))
+	}
+
+	scheduleRules := make([]*pb.ScheduleRule, 0, len(cfg.Schedule))
+	for _, rule := range cfg.Schedule {
+		scheduleRules = append(scheduleRules, scheduleRuleToPb(rule))
+	}
+
+	trustedNetworks := make([]*pb.TrustedNetwork, 0, len(cfg.TrustedNetworks.Networks))
+	for _, network := range cfg.TrustedNetworks.Networks {
+		trustedNetworks = append(trustedNetworks, trustedNetworkToPb(network))
+	}
+
+	dnsRoutes := make([]*pb.DNSRoute, 0, len(cfg.AutoConnectData.DNSRoutes))
+	for _, route := range cfg.AutoConnectData.DNSRoutes {
+		dnsRoutes = append(dnsRoutes, dnsRouteToPb(route))
+	}
+
+	// address of the invalid config is still shown to be fixed by the user
+	_, metricsListen, err := cfg.Metrics.Address()
+	if err != nil {
+		metricsListen = cfg.Metrics.Listen
+	}
+
 	notifyOff := cfg.UsersData.NotifyOff[uid]
 	trayOff := cfg.UsersData.TrayOff[uid]
 
@@ -39,13 +65,14 @@ func configToProtobuf(cfg *config.Config, uid int64) *pb.Settings {
 			ServerGroup: cfg.AutoConnectData.Group,
 		},
 		Meshnet:              cfg.Mesh,
-		Dns:                  cfg.AutoConnectData.DNS,
+		Dns:                  cfg.AutoConnectData.DNS.Or(cfg.AutoConnectData.EncryptedDNS),
 		ThreatProtectionLite: cfg.AutoConnectData.ThreatProtectionLite,
 		Protocol:             cfg.AutoConnectData.Protocol,
 		LanDiscovery:         cfg.LanDiscovery,
 		Allowlist: &pb.Allowlist{
 			Ports:   &ports,
 			Subnets: subnets,
+			Domains: cfg.AutoConnectData.Allowlist.Domains,
 		},
 		Obfuscate:       cfg.AutoConnectData.Obfuscate,
 		VirtualLocation: cfg.VirtualLocation.Get(),
@@ -54,9 +81,19 @@ func configToProtobuf(cfg *config.Config, uid int64) *pb.Settings {
 			Notify: !notifyOff,
 			Tray:   !trayOff,
 		},
-		PostquantumVpn: cfg.AutoConnectData.PostquantumVpn,
-		ArpIgnore:      cfg.ARPIgnore.Get(),
-		Ech:            cfg.AutoConnectData.ECH.Get(),
+		PostquantumVpn:  cfg.AutoConnectData.PostquantumVpn,
+		ArpIgnore:       cfg.ARPIgnore.Get(),
+		Ech:             cfg.AutoConnectData.ECH.Get(),
+		SplitTunnelApps: splitTunnelApps,
+		SplitTunnelMode: splitTunnelModeToPb(cfg.SplitTunnel),
+		ScheduleRules:   scheduleRules,
+		TrustedNetworks: trustedNetworks,
+
+		TrustedNetworksConnectUntrusted: cfg.TrustedNetworks.ConnectUntrusted,
+		Metrics:                         cfg.Metrics.Enabled,
+		MetricsListen:                   metricsListen,
+		Failover:                        failoverToProtobuf(cfg.Failover),
+		DnsRoutes:                       dnsRoutes,
 	}
 
 	return &settings
@@ -164,6 +201,11 @@ func statusStream(stateChan <-chan any,
 					&pb.AppState{State: &pb.AppState_PauseEvent{PauseEvent: e}}); err != nil {
 					log.Error("pause event failed to send state update:", err)
 				}
+			case *pb.FailoverEvent:
+				if err := srv.Send(
+					&pb.AppState{State: &pb.AppState_FailoverEvent{FailoverEvent: e}}); err != nil {
+					log.Error("failover event failed to send state update:", err)
+				}
 			default:
 			}
 		}
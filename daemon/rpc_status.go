@@ -4,12 +4,28 @@ import (
 	"context"
 	"time"
 
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/state/types"
+	"github.com/NordSecurity/nordvpn-linux/log"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Status of daemon and connection
 func (r *RPC) Status(context.Context, *pb.Empty) (*pb.StatusResponse, error) {
+	resp := r.connectionStatus()
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error("loading config:", err)
+		return resp, nil
+	}
+	resp.SplitTunnelMode = splitTunnelModeToPb(cfg.SplitTunnel)
+	resp.SplitTunnelApps = uint32(len(cfg.SplitTunnel.Apps)) // #nosec G115 -- list is small
+	return resp, nil
+}
+
+func (r *RPC) connectionStatus() *pb.StatusResponse {
 	status := r.connectionInfo.Status()
 	//exhaustive:ignore
 	switch status.State {
@@ -19,17 +35,17 @@ func (r *RPC) Status(context.Context, *pb.Empty) (*pb.StatusResponse, error) {
 			Uptime:                    -1,
 			PausedAt:                  timestamppb.New(status.PausedAt),
 			PauseRemainingDurationSec: status.PauseRemainingTimeSec,
-		}, nil
+		}
 	case pb.ConnectionState_UNKNOWN_STATE, pb.ConnectionState_DISCONNECTED:
 		return &pb.StatusResponse{
 			State:  pb.ConnectionState_DISCONNECTED,
 			Uptime: -1,
-		}, nil
+		}
 	case pb.ConnectionState_CONNECTING:
 		return &pb.StatusResponse{
 			State:  pb.ConnectionState_CONNECTING,
 			Uptime: -1,
-		}, nil
+		}
 	}
 
 	requestedConnParams := r.RequestedConnParams.Get()
@@ -63,7 +79,22 @@ func (r *RPC) Status(context.Context, *pb.Empty) (*pb.StatusResponse, error) {
 		PausedAt:                  timestamppb.New(status.PausedAt),
 		PauseRemainingDurationSec: status.PauseRemainingTimeSec,
 		IsMeshPeer:                status.IsMeshnetPeer,
-	}, nil
+		Entry:                     multiHopEntryToProtobuf(status.Entry),
+		OverriddenSettings:        status.OverriddenSettings,
+	}
+}
+
+func multiHopEntryToProtobuf(entry *types.MultiHopEntry) *pb.MultiHopEntry {
+	if entry == nil {
+		return nil
+	}
+	return &pb.MultiHopEntry{
+		Ip:       entry.IP.String(),
+		Hostname: entry.Hostname,
+		Name:     entry.Name,
+		Country:  entry.Country,
+		City:     entry.City,
+	}
 }
 
 func calculateUptime(startTime *time.Time) int64 {
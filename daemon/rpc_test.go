@@ -124,6 +124,14 @@ func testRPC() *RPC {
 		daemonEvents.NewDataUpdateEvents(),
 		daemonEvents.NewPauseEvents(),
 		&devicekey.DeviceKeyManagerImpl{},
+		&mockSplitTunnelManager{},
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 }
 
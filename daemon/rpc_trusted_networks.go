@@ -0,0 +1,160 @@
+package daemon
+
+import (
+	"context"
+	"slices"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// AddTrustedNetwork adds a network where VPN is disconnected automatically. ID of the new entry
+// is returned in the payload data.
+func (r *RPC) AddTrustedNetwork(ctx context.Context, in *pb.AddTrustedNetworkRequest) (*pb.Payload, error) {
+	network, ok := trustedNetworkFromPb(in.GetNetwork())
+	if !ok || network.Validate() != nil {
+		return &pb.Payload{Type: internal.CodeTrustedNetworkInvalid}, nil
+	}
+	if network.GatewayMAC != "" {
+		network.GatewayMAC, _ = config.NormalizeMAC(network.GatewayMAC)
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if slices.ContainsFunc(cfg.TrustedNetworks.Networks, network.SameNetwork) {
+		return &pb.Payload{Type: internal.CodeTrustedNetworkNoop}, nil
+	}
+
+	network.ID = cfg.TrustedNetworks.NextID()
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.TrustedNetworks.Networks = append(slices.Clone(c.TrustedNetworks.Networks), network)
+		return c
+	}); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	go r.checkTrustedNetwork(true)
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{network.ID}}, nil
+}
+
+// RemoveTrustedNetwork removes the trusted network with the given ID.
+func (r *RPC) RemoveTrustedNetwork(ctx context.Context, in *pb.RemoveTrustedNetworkRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	isNetwork := func(network config.TrustedNetwork) bool { return network.ID == in.GetId() }
+	if !slices.ContainsFunc(cfg.TrustedNetworks.Networks, isNetwork) {
+		return &pb.Payload{Type: internal.CodeTrustedNetworkNoop}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.TrustedNetworks.Networks = slices.DeleteFunc(slices.Clone(c.TrustedNetworks.Networks), isNetwork)
+		return c
+	}); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// SetTrustedNetworksConnectUntrusted controls whether VPN is connected automatically when the host joins
+// a network which is not trusted.
+func (r *RPC) SetTrustedNetworksConnectUntrusted(ctx context.Context, in *pb.SetGenericRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.TrustedNetworks.ConnectUntrusted == in.GetEnabled() {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.TrustedNetworks.ConnectUntrusted = in.GetEnabled()
+		return c
+	}); err != nil {
+		log.Error(err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if in.GetEnabled() {
+		go r.checkTrustedNetwork(true)
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// CurrentNetwork returns identity of the network the host is connected to and whether it is trusted.
+func (r *RPC) CurrentNetwork(ctx context.Context, in *pb.Empty) (*pb.CurrentNetworkResponse, error) {
+	if r.networkIdentity == nil {
+		return &pb.CurrentNetworkResponse{Type: internal.CodeFailure}, nil
+	}
+
+	identity, err := r.networkIdentity.Identity()
+	if err != nil || identity.IsEmpty() {
+		log.Warn("identifying current network:", err)
+		return &pb.CurrentNetworkResponse{Type: internal.CodeFailure}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error(err)
+		return &pb.CurrentNetworkResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	network, _ := cfg.TrustedNetworks.Match(identity.SSID, identity.GatewayMAC)
+	return &pb.CurrentNetworkResponse{
+		Type:             internal.CodeSuccess,
+		Interface:        identity.Interface,
+		Ssid:             identity.SSID,
+		GatewayMac:       identity.GatewayMAC,
+		TrustedNetworkId: network.ID,
+	}, nil
+}
+
+func trustedNetworkFromPb(network *pb.TrustedNetwork) (config.TrustedNetwork, bool) {
+	if network == nil {
+		return config.TrustedNetwork{}, false
+	}
+
+	var action config.TrustedNetworkAction
+	switch network.GetAction() {
+	case pb.TrustedNetworkAction_TRUSTED_DISCONNECT:
+		action = config.TrustedNetworkDisconnect
+	case pb.TrustedNetworkAction_TRUSTED_MESHNET_ONLY:
+		action = config.TrustedNetworkMeshnetOnly
+	default:
+		return config.TrustedNetwork{}, false
+	}
+
+	return config.TrustedNetwork{
+		SSID:       network.GetSsid(),
+		GatewayMAC: network.GetGatewayMac(),
+		Action:     action,
+	}, true
+}
+
+func trustedNetworkToPb(network config.TrustedNetwork) *pb.TrustedNetwork {
+	action := pb.TrustedNetworkAction_TRUSTED_DISCONNECT
+	if network.Action == config.TrustedNetworkMeshnetOnly {
+		action = pb.TrustedNetworkAction_TRUSTED_MESHNET_ONLY
+	}
+
+	return &pb.TrustedNetwork{
+		Id:         network.ID,
+		Ssid:       network.SSID,
+		GatewayMac: network.GatewayMAC,
+		Action:     action,
+	}
+}
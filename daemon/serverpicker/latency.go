@@ -0,0 +1,105 @@
+package serverpicker
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal/caching"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+const (
+	// FastestCandidates is the number of the best ranked servers probed in the fastest mode
+	FastestCandidates = 10
+	latencyCacheTTL   = 5 * time.Minute
+	pingTimeout       = time.Second
+	pingsPerServer    = 3
+)
+
+// ErrServerUnreachable is returned when none of the pings to the server were answered.
+var ErrServerUnreachable = errors.New("server did not answer")
+
+// Pinger measures the round trip time to the address.
+type Pinger interface {
+	Ping(ctx context.Context, addr netip.Addr) (time.Duration, error)
+}
+
+// ServerLatency is the round trip time to the server measured from this host. Zero RTT
+// means the server was unreachable.
+type ServerLatency struct {
+	Server core.Server
+	RTT    time.Duration
+}
+
+// LatencyMeter pings the servers in parallel and caches the results per server.
+type LatencyMeter struct {
+	pinger Pinger
+	mu     sync.Mutex
+	caches map[string]*caching.Cache[time.Duration]
+}
+
+func NewLatencyMeter(pinger Pinger) *LatencyMeter {
+	return &LatencyMeter{
+		pinger: pinger,
+		caches: map[string]*caching.Cache[time.Duration]{},
+	}
+}
+
+// Measure returns the latencies of the servers in the same order.
+func (m *LatencyMeter) Measure(servers []core.Server) []ServerLatency {
+	latencies := make([]ServerLatency, len(servers))
+	var wg sync.WaitGroup
+	for idx, server := range servers {
+		latencies[idx].Server = server
+		cache := m.cache(server)
+		wg.Go(func() {
+			rtt, err := cache.Get()
+			if err != nil {
+				log.ServerSel.Debug("measuring latency of", server.Hostname, err)
+				return
+			}
+			latencies[idx].RTT = rtt
+		})
+	}
+	wg.Wait()
+	return latencies
+}
+
+func (m *LatencyMeter) cache(server core.Server) *caching.Cache[time.Duration] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if cache, ok := m.caches[server.Hostname]; ok {
+		return cache
+	}
+	cache := caching.NewCacheWithTTL(latencyCacheTTL, func() (time.Duration, error) {
+		return m.ping(server)
+	})
+	m.caches[server.Hostname] = cache
+	return cache
+}
+
+// ping returns the lowest round trip time of several pings.
+func (m *LatencyMeter) ping(server core.Server) (time.Duration, error) {
+	addr, err := server.IPv4()
+	if err != nil {
+		return 0, err
+	}
+	var best time.Duration
+	for range pingsPerServer {
+		ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+		rtt, err := m.pinger.Ping(ctx, addr)
+		cancel()
+		if err == nil && (best == 0 || rtt < best) {
+			best = rtt
+		}
+	}
+	if best == 0 {
+		return 0, ErrServerUnreachable
+	}
+	return best, nil
+}
@@ -0,0 +1,58 @@
+package serverpicker
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPinger struct {
+	mu    sync.Mutex
+	rtts  map[netip.Addr]time.Duration
+	calls int
+}
+
+func (m *mockPinger) Ping(_ context.Context, addr netip.Addr) (time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	rtt, ok := m.rtts[addr]
+	if !ok {
+		return 0, errors.New("timeout")
+	}
+	return rtt, nil
+}
+
+func TestLatencyMeter_Measure(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	pinger := &mockPinger{rtts: map[netip.Addr]time.Duration{
+		netip.MustParseAddr("1.1.1.1"): 30 * time.Millisecond,
+		netip.MustParseAddr("2.2.2.2"): 10 * time.Millisecond,
+	}}
+	servers := []core.Server{
+		{Hostname: "de1.nordvpn.com", Station: "1.1.1.1"},
+		{Hostname: "de2.nordvpn.com", Station: "2.2.2.2"},
+		{Hostname: "de3.nordvpn.com", Station: "3.3.3.3"},
+	}
+	meter := NewLatencyMeter(pinger)
+
+	latencies := meter.Measure(servers)
+	assert.Equal(t, []ServerLatency{
+		{Server: servers[0], RTT: 30 * time.Millisecond},
+		{Server: servers[1], RTT: 10 * time.Millisecond},
+		{Server: servers[2]},
+	}, latencies)
+	assert.Equal(t, len(servers)*pingsPerServer, pinger.calls)
+
+	// answered servers are cached, unreachable ones are pinged again
+	meter.Measure(servers)
+	assert.Equal(t, (len(servers)+1)*pingsPerServer, pinger.calls)
+}
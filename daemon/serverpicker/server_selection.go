@@ -29,12 +29,20 @@ type ServerSelection struct {
 	RecommendationUUID    RecommendationUUID
 	Remote                bool
 	DedicatedServerStatus core.DedicatedServerStatus
+	// Entry server of the multi-hop connection, nil for direct connections
+	Entry *core.Server
+	// Candidates are the best ranked local servers, set only in the fastest mode
+	Candidates []core.Server
+	// Latencies are the measured round trip times of the candidates
+	Latencies []ServerLatency
 }
 
 type SearchParams struct {
 	Tag            string
 	Group          string
 	ExcludedServer string
+	// Fastest skips the recommendations API and returns the candidates to be probed
+	Fastest bool
 }
 
 func NewSearchParams(tag, group string, excludedServer string) SearchParams {
@@ -106,7 +114,7 @@ func PickServer(
 		}
 		// for other errors, local servers will be used, so set it to unknown to have a valid value
 		serverTag = core.ServerTag{Action: core.ServerByUnknown}
-	} else {
+	} else if !input.Fastest {
 		// fetch from the API only if serverTag is valid
 		apiFilter := core.ServersFilter{
 			Group: serverGroup,
@@ -119,7 +127,9 @@ func PickServer(
 
 	if len(selectedServers) == 0 {
 		// if no servers were received from the API, try from locally cached servers
-		log.ServerSel.Error("failed to select server from remote", err)
+		if !input.Fastest {
+			log.ServerSel.Error("failed to select server from remote", err)
+		}
 		remote = false
 		selectedServers, err = findServersLocally(servers, serverTag, filterServersFn)
 	}
@@ -148,10 +158,17 @@ func PickServer(
 	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
 	selectedServer = &selectedServers[rng.Int63n(int64(len(selectedServers)))]
 
+	var candidates []core.Server
+	if input.Fastest {
+		// local servers are sorted by the penalty score
+		candidates = selectedServers[:min(len(selectedServers), FastestCandidates)]
+	}
+
 	return ServerSelection{
 		Server:             selectedServer,
 		RecommendationUUID: recommendationUUID,
 		Remote:             remote,
+		Candidates:         candidates,
 	}, nil
 }
 
@@ -590,8 +590,10 @@ func TestPickServer(t *testing.T) {
 		tag                  string
 		onlyPhysicServers    bool
 		excludedServer       string
+		fastest              bool
 		expectedServerName   string
 		expectedRemoteServer bool
+		expectedCandidates   int
 		expectedError        error
 	}{
 		{
@@ -643,6 +645,16 @@ func TestPickServer(t *testing.T) {
 			expectedServerName:   "Germany #3",
 			expectedRemoteServer: true,
 		},
+		{
+			name:               "fastest mode uses local servers as candidates",
+			api:                core_test.NewMockServersAPI(),
+			servers:            core_test.ServersList(),
+			tech:               config.Technology_NORDLYNX,
+			tag:                "de3",
+			fastest:            true,
+			expectedServerName: "Germany #3",
+			expectedCandidates: 1,
+		},
 		{
 			name:              "find server when virtual locations are disabled",
 			api:               core_test.NewMockFailingServersAPI(errors.New("500")),
@@ -689,17 +701,20 @@ func TestPickServer(t *testing.T) {
 				cfg.VirtualLocation.Set(false)
 			}
 
+			searchParams := NewSearchParams(test.tag, "", test.excludedServer)
+			searchParams.Fastest = test.fastest
 			serverSelection, err := PickServer(
 				test.api,
 				test.servers,
 				core_test.CountriesList(),
 				core.Insights{},
 				cfg,
-				NewSearchParams(test.tag, "", test.excludedServer),
+				searchParams,
 			)
 
 			assert.Equal(t, test.expectedError, err)
 			assert.Equal(t, test.expectedRemoteServer, serverSelection.Remote)
+			assert.Len(t, serverSelection.Candidates, test.expectedCandidates)
 			if len(test.expectedServerName) > 0 {
 				assert.Equal(t, test.expectedServerName, serverSelection.Server.Name)
 			}
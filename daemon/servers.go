@@ -2,6 +2,7 @@ package daemon
 
 import (
 	"errors"
+	"math"
 
 	"github.com/NordSecurity/nordvpn-linux/auth"
 	"github.com/NordSecurity/nordvpn-linux/config"
@@ -19,9 +20,17 @@ func selectServer(
 	tag string,
 	groupFlag string,
 	excludedServer string,
+) (serverpicker.ServerSelection, error) {
+	return pickServer(r, insights, cfg, serverpicker.NewSearchParams(tag, groupFlag, excludedServer))
+}
+
+func pickServer(
+	r *RPC,
+	insights *core.Insights,
+	cfg config.Config,
+	searchParams serverpicker.SearchParams,
 ) (serverpicker.ServerSelection, error) {
 	serversList := r.dm.GetServersData().Servers
-	searchParams := serverpicker.NewSearchParams(tag, groupFlag, excludedServer)
 	selection, err := serverpicker.PickServer(
 		r.serversAPI,
 		serversList,
@@ -62,6 +71,10 @@ func selectServer(
 		}
 	}
 
+	if len(selection.Candidates) > 0 && r.latency != nil {
+		selection = selectFastest(r.latency, selection)
+	}
+
 	log.Info("server", selection.Server.Hostname, "remote", selection.Remote)
 
 	if core.IsDedicatedIP(*selection.Server) {
@@ -72,3 +85,24 @@ func selectServer(
 
 	return selection, nil
 }
+
+// selectFastest measures the latencies of the candidates and picks the server with the lowest
+// penalty score including the latency. Random pick is kept if none of the candidates answered.
+func selectFastest(
+	latency *serverpicker.LatencyMeter,
+	selection serverpicker.ServerSelection,
+) serverpicker.ServerSelection {
+	selection.Latencies = latency.Measure(selection.Candidates)
+	bestScore := math.Inf(1)
+	for _, l := range selection.Latencies {
+		if l.RTT == 0 {
+			continue
+		}
+		if score := l.Server.Penalty + latencyPenalty(l.RTT); score < bestScore {
+			server := l.Server
+			selection.Server = &server
+			bestScore = score
+		}
+	}
+	return selection
+}
@@ -0,0 +1,86 @@
+package daemon
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/serverpicker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPinger struct {
+	rtts map[netip.Addr]time.Duration
+}
+
+func (m mockPinger) Ping(_ context.Context, addr netip.Addr) (time.Duration, error) {
+	if rtt, ok := m.rtts[addr]; ok {
+		return rtt, nil
+	}
+	return 0, serverpicker.ErrServerUnreachable
+}
+
+func TestSelectFastest(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	lowLoadFar := core.Server{Hostname: "de1.nordvpn.com", Station: "1.1.1.1", Penalty: 1}
+	highLoadNear := core.Server{Hostname: "de2.nordvpn.com", Station: "2.2.2.2", Penalty: 3}
+	unreachable := core.Server{Hostname: "de3.nordvpn.com", Station: "3.3.3.3", Penalty: 0}
+
+	tests := []struct {
+		name     string
+		rtts     map[netip.Addr]time.Duration
+		expected string
+	}{
+		{
+			name: "lower latency outweighs higher load",
+			rtts: map[netip.Addr]time.Duration{
+				netip.MustParseAddr("1.1.1.1"): 80 * time.Millisecond,
+				netip.MustParseAddr("2.2.2.2"): 10 * time.Millisecond,
+			},
+			expected: highLoadNear.Hostname,
+		},
+		{
+			name: "similar latency keeps lower penalty",
+			rtts: map[netip.Addr]time.Duration{
+				netip.MustParseAddr("1.1.1.1"): 20 * time.Millisecond,
+				netip.MustParseAddr("2.2.2.2"): 20 * time.Millisecond,
+			},
+			expected: lowLoadFar.Hostname,
+		},
+		{
+			name:     "random pick is kept when none answered",
+			rtts:     map[netip.Addr]time.Duration{},
+			expected: unreachable.Hostname,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			random := unreachable
+			selection := serverpicker.ServerSelection{
+				Server:     &random,
+				Candidates: []core.Server{lowLoadFar, highLoadNear, unreachable},
+			}
+			meter := serverpicker.NewLatencyMeter(mockPinger{rtts: test.rtts})
+
+			selection = selectFastest(meter, selection)
+			assert.Equal(t, test.expected, selection.Server.Hostname)
+			assert.Len(t, selection.Latencies, 3)
+			assert.Zero(t, selection.Latencies[2].RTT)
+		})
+	}
+}
+
+func TestLatenciesToPayloadData(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	data := latenciesToPayloadData([]serverpicker.ServerLatency{
+		{Server: core.Server{Hostname: "de1.nordvpn.com"}, RTT: 23 * time.Millisecond},
+		{Server: core.Server{Hostname: "de2.nordvpn.com"}},
+	})
+	assert.Equal(t, []string{"de1.nordvpn.com:23", "de2.nordvpn.com:"}, data)
+}
@@ -0,0 +1,329 @@
+// Package splittunnel moves the processes of the selected applications to dedicated
+// cgroups so that firewall can route their traffic outside of the VPN tunnel.
+package splittunnel
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+const (
+	defaultCgroupRoot = "/sys/fs/cgroup"
+	defaultProcRoot   = "/proc"
+	// BypassCgroup holds the processes which traffic bypasses the VPN tunnel
+	BypassCgroup = "nordvpn-bypass"
+	// KillSwitchExemptCgroup holds the processes which traffic bypasses both VPN tunnel and kill switch
+	KillSwitchExemptCgroup = "nordvpn-bypass-ks-exempt"
+	cgroupProcsFile        = "cgroup.procs"
+	cgroupControllersFile  = "cgroup.controllers"
+	// maxAncestry limits the parent process lookup in case of broken process tree
+	maxAncestry = 64
+)
+
+// ErrNotSupported is returned when cgroup v2 is not mounted on the system.
+var ErrNotSupported = errors.New("cgroup v2 is not available")
+
+// Manager keeps the split tunnel cgroups in sync with the configured applications.
+// Thread safe.
+type Manager struct {
+	cgroupRoot string
+	procRoot   string
+	// origins stores the original cgroup of the moved processes, so that they
+	// could be moved back when application is removed from split tunnel
+	origins map[int]string
+	mu      sync.Mutex
+}
+
+// NewManager creates split tunnel manager working on the system cgroup v2 hierarchy.
+func NewManager() *Manager {
+	return newManager(defaultCgroupRoot, defaultProcRoot)
+}
+
+func newManager(cgroupRoot string, procRoot string) *Manager {
+	return &Manager{
+		cgroupRoot: cgroupRoot,
+		procRoot:   procRoot,
+		origins:    map[int]string{},
+	}
+}
+
+// IsSupported returns true if unified cgroup v2 hierarchy is mounted.
+func (m *Manager) IsSupported() bool {
+	_, err := os.Stat(filepath.Join(m.cgroupRoot, cgroupControllersFile))
+	return err == nil
+}
+
+// CgroupExists returns true if given cgroup path exists in the cgroup v2 hierarchy.
+func (m *Manager) CgroupExists(path string) bool {
+	info, err := os.Stat(filepath.Join(m.cgroupRoot, filepath.Clean("/"+path)))
+	return err == nil && info.IsDir()
+}
+
+// Sync moves the processes of given applications to the split tunnel cgroups and
+// moves processes of no longer configured applications back. Returned cgroups
+// should be passed to the firewall.
+func (m *Manager) Sync(apps []config.SplitTunnelApp) ([]firewall.SplitTunnelCgroup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.IsSupported() {
+		return nil, ErrNotSupported
+	}
+
+	if len(apps) == 0 {
+		m.restoreAll()
+		return nil, nil
+	}
+
+	for _, name := range []string{BypassCgroup, KillSwitchExemptCgroup} {
+		if err := os.MkdirAll(filepath.Join(m.cgroupRoot, name), 0755); err != nil {
+			return nil, fmt.Errorf("creating cgroup %s: %w", name, err)
+		}
+	}
+
+	procs := m.processes()
+	for pid, proc := range procs {
+		target := ""
+		if app, ok := matchApp(apps, procs, pid); ok {
+			target = cgroupFor(app)
+		}
+
+		switch {
+		case target == proc.cgroup:
+		case target != "":
+			if !isSplitTunnelCgroup(proc.cgroup) {
+				m.origins[pid] = proc.cgroup
+			}
+			m.move(pid, target)
+		case isSplitTunnelCgroup(proc.cgroup):
+			m.restore(pid)
+		}
+	}
+
+	for pid := range m.origins {
+		if _, ok := procs[pid]; !ok {
+			delete(m.origins, pid)
+		}
+	}
+
+	return m.cgroups(apps), nil
+}
+
+// ProcessExists returns true if process with given ID is running.
+func (m *Manager) ProcessExists(pid int) bool {
+	_, err := os.Stat(filepath.Join(m.procRoot, strconv.Itoa(pid)))
+	return err == nil
+}
+
+// restoreAll moves all processes back to their original cgroups and removes the split tunnel cgroups.
+func (m *Manager) restoreAll() {
+	if !m.CgroupExists(BypassCgroup) && !m.CgroupExists(KillSwitchExemptCgroup) {
+		return
+	}
+	for pid, proc := range m.processes() {
+		if isSplitTunnelCgroup(proc.cgroup) {
+			m.restore(pid)
+		}
+	}
+	for _, name := range []string{BypassCgroup, KillSwitchExemptCgroup} {
+		err := syscall.Rmdir(filepath.Join(m.cgroupRoot, name))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.SplitTunnel.Warnf("removing cgroup %s: %s", name, err)
+		}
+	}
+}
+
+func (m *Manager) cgroups(apps []config.SplitTunnelApp) []firewall.SplitTunnelCgroup {
+	var cgroups []firewall.SplitTunnelCgroup
+	var bypass, exempt bool
+	for _, app := range apps {
+		if app.Type != config.SplitTunnelAppCgroup {
+			bypass = bypass || !app.KillSwitchExempt
+			exempt = exempt || app.KillSwitchExempt
+			continue
+		}
+		cgroup, err := m.cgroup(app.Value, app.KillSwitchExempt)
+		if err != nil {
+			log.SplitTunnel.Warnf("skipping cgroup %s: %s", app.Value, err)
+			continue
+		}
+		cgroups = append(cgroups, cgroup)
+	}
+
+	if bypass {
+		if cgroup, err := m.cgroup(BypassCgroup, false); err == nil {
+			cgroups = append(cgroups, cgroup)
+		}
+	}
+	if exempt {
+		if cgroup, err := m.cgroup(KillSwitchExemptCgroup, true); err == nil {
+			cgroups = append(cgroups, cgroup)
+		}
+	}
+	return cgroups
+}
+
+func (m *Manager) cgroup(path string, killSwitchExempt bool) (firewall.SplitTunnelCgroup, error) {
+	path = strings.Trim(filepath.Clean("/"+path), "/")
+	info, err := os.Stat(filepath.Join(m.cgroupRoot, path))
+	if err != nil {
+		return firewall.SplitTunnelCgroup{}, err
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return firewall.SplitTunnelCgroup{}, fmt.Errorf("unexpected stat type %T", info.Sys())
+	}
+	return firewall.SplitTunnelCgroup{
+		ID:               stat.Ino,
+		Level:            uint32(len(strings.Split(path, "/"))), // #nosec G115 -- depth is small
+		KillSwitchExempt: killSwitchExempt,
+	}, nil
+}
+
+func (m *Manager) restore(pid int) {
+	target, ok := m.origins[pid]
+	if !ok || !m.CgroupExists(target) {
+		target = "/"
+	}
+	m.move(pid, target)
+	delete(m.origins, pid)
+}
+
+func (m *Manager) move(pid int, cgroup string) {
+	procs := filepath.Join(m.cgroupRoot, cgroup, cgroupProcsFile)
+	// #nosec G304 -- path is constructed from the known cgroup root
+	file, err := os.OpenFile(procs, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		log.SplitTunnel.Warnf("opening %s: %s", procs, err)
+		return
+	}
+	defer file.Close()
+
+	// process might have already exited, it is not an error
+	if _, err := file.WriteString(strconv.Itoa(pid)); err != nil && !errors.Is(err, syscall.ESRCH) {
+		log.SplitTunnel.Warnf("moving process %d to cgroup %s: %s", pid, cgroup, err)
+	}
+}
+
+type process struct {
+	exe    string
+	ppid   int
+	cgroup string
+}
+
+// processes returns the currently running processes. Kernel threads and processes
+// which cannot be inspected are omitted.
+func (m *Manager) processes() map[int]process {
+	entries, err := os.ReadDir(m.procRoot)
+	if err != nil {
+		log.SplitTunnel.Warnf("listing processes: %s", err)
+		return nil
+	}
+
+	procs := map[int]process{}
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(m.procRoot, entry.Name())
+		cgroup, err := readCgroup(filepath.Join(dir, "cgroup"))
+		if err != nil {
+			continue
+		}
+		exe, _ := os.Readlink(filepath.Join(dir, "exe"))
+		procs[pid] = process{
+			exe:    strings.TrimSuffix(exe, " (deleted)"),
+			ppid:   readPPID(filepath.Join(dir, "stat")),
+			cgroup: cgroup,
+		}
+	}
+	return procs
+}
+
+// matchApp finds the split tunnel application given process belongs to.
+func matchApp(apps []config.SplitTunnelApp, procs map[int]process, pid int) (config.SplitTunnelApp, bool) {
+	for _, app := range apps {
+		switch app.Type {
+		case config.SplitTunnelAppPath:
+			if procs[pid].exe == app.Value {
+				return app, true
+			}
+		case config.SplitTunnelAppPID:
+			appPID, err := strconv.Atoi(app.Value)
+			if err != nil {
+				continue
+			}
+			for i, current := 0, pid; i < maxAncestry && current > 0; i++ {
+				if current == appPID {
+					return app, true
+				}
+				current = procs[current].ppid
+			}
+		case config.SplitTunnelAppCgroup:
+			// whole cgroup is matched by the firewall directly
+		}
+	}
+	return config.SplitTunnelApp{}, false
+}
+
+func cgroupFor(app config.SplitTunnelApp) string {
+	if app.KillSwitchExempt {
+		return "/" + KillSwitchExemptCgroup
+	}
+	return "/" + BypassCgroup
+}
+
+func isSplitTunnelCgroup(cgroup string) bool {
+	return cgroup == "/"+BypassCgroup || cgroup == "/"+KillSwitchExemptCgroup
+}
+
+// readCgroup returns cgroup v2 path of the process from /proc/<pid>/cgroup
+func readCgroup(path string) (string, error) {
+	// #nosec G304 -- path is constructed from the known proc root
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if cgroup, ok := strings.CutPrefix(scanner.Text(), "0::"); ok {
+			return cgroup, nil
+		}
+	}
+	return "", fmt.Errorf("cgroup v2 entry not found in %s", path)
+}
+
+// readPPID returns parent process ID from /proc/<pid>/stat
+func readPPID(path string) int {
+	// #nosec G304 -- path is constructed from the known proc root
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	// process name is in parenthesis and may contain spaces
+	idx := strings.LastIndexByte(string(data), ')')
+	if idx == -1 {
+		return 0
+	}
+	fields := strings.Fields(string(data[idx+1:]))
+	if len(fields) < 2 {
+		return 0
+	}
+	ppid, _ := strconv.Atoi(fields[1])
+	return ppid
+}
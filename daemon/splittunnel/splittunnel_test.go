@@ -0,0 +1,132 @@
+package splittunnel
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProcess struct {
+	pid    int
+	ppid   int
+	exe    string
+	cgroup string
+}
+
+func newFakeSystem(t *testing.T, procs []fakeProcess) (cgroupRoot string, procRoot string) {
+	t.Helper()
+	cgroupRoot = t.TempDir()
+	procRoot = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(cgroupRoot, cgroupControllersFile), nil, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(cgroupRoot, cgroupProcsFile), nil, 0600))
+	for _, name := range []string{BypassCgroup, KillSwitchExemptCgroup} {
+		require.NoError(t, os.Mkdir(filepath.Join(cgroupRoot, name), 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(cgroupRoot, name, cgroupProcsFile), nil, 0600))
+	}
+
+	for _, proc := range procs {
+		dir := filepath.Join(procRoot, strconv.Itoa(proc.pid))
+		require.NoError(t, os.Mkdir(dir, 0700))
+		require.NoError(t, os.Symlink(proc.exe, filepath.Join(dir, "exe")))
+		stat := strconv.Itoa(proc.pid) + " (some app) S " + strconv.Itoa(proc.ppid) + " 1 1"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "stat"), []byte(stat), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "cgroup"), []byte("0::"+proc.cgroup+"\n"), 0600))
+	}
+	return cgroupRoot, procRoot
+}
+
+func readProcs(t *testing.T, cgroupRoot string, cgroup string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(cgroupRoot, cgroup, cgroupProcsFile))
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestManager_Sync(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	procs := []fakeProcess{
+		{pid: 1, exe: "/sbin/init", cgroup: "/init.scope"},
+		{pid: 10, ppid: 1, exe: "/usr/bin/curl", cgroup: "/user.slice"},
+		{pid: 20, ppid: 1, exe: "/usr/bin/bash", cgroup: "/user.slice"},
+		{pid: 21, ppid: 20, exe: "/usr/bin/wget", cgroup: "/user.slice"},
+		{pid: 30, ppid: 1, exe: "/usr/bin/ssh", cgroup: "/" + BypassCgroup},
+	}
+	cgroupRoot, procRoot := newFakeSystem(t, procs)
+	manager := newManager(cgroupRoot, procRoot)
+
+	cgroups, err := manager.Sync([]config.SplitTunnelApp{
+		{Type: config.SplitTunnelAppPath, Value: "/usr/bin/curl"},
+		{Type: config.SplitTunnelAppPID, Value: "20", KillSwitchExempt: true},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "10", readProcs(t, cgroupRoot, BypassCgroup))
+	// processes are iterated in random order
+	assert.Contains(t, []string{"2021", "2120"}, readProcs(t, cgroupRoot, KillSwitchExemptCgroup))
+	// process left in the bypass cgroup from previous run is moved out
+	assert.Equal(t, "30", readProcs(t, cgroupRoot, "/"))
+
+	require.Len(t, cgroups, 2)
+	assert.Equal(t, uint32(1), cgroups[0].Level)
+	assert.False(t, cgroups[0].KillSwitchExempt)
+	assert.True(t, cgroups[1].KillSwitchExempt)
+}
+
+func TestManager_SyncCgroupApp(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cgroupRoot, procRoot := newFakeSystem(t, nil)
+	require.NoError(t, os.MkdirAll(filepath.Join(cgroupRoot, "user.slice", "app.scope"), 0700))
+	manager := newManager(cgroupRoot, procRoot)
+
+	cgroups, err := manager.Sync([]config.SplitTunnelApp{
+		{Type: config.SplitTunnelAppCgroup, Value: "/user.slice/app.scope"},
+		{Type: config.SplitTunnelAppCgroup, Value: "/missing.scope"},
+	})
+	require.NoError(t, err)
+	require.Len(t, cgroups, 1)
+	assert.Equal(t, uint32(2), cgroups[0].Level)
+}
+
+func TestManager_NotSupported(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	manager := newManager(t.TempDir(), t.TempDir())
+	assert.False(t, manager.IsSupported())
+	_, err := manager.Sync([]config.SplitTunnelApp{{Type: config.SplitTunnelAppPID, Value: "1"}})
+	assert.ErrorIs(t, err, ErrNotSupported)
+}
+
+func TestReadPPID(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	path := filepath.Join(t.TempDir(), "stat")
+	require.NoError(t, os.WriteFile(path, []byte("42 (my (weird) app) S 7 42 42 0"), 0600))
+	assert.Equal(t, 7, readPPID(path))
+	assert.Equal(t, 0, readPPID(filepath.Join(t.TempDir(), "missing")))
+}
+
+func TestManager_SyncEmptyRemovesCgroups(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cgroupRoot, procRoot := newFakeSystem(t, []fakeProcess{
+		{pid: 1, exe: "/sbin/init", cgroup: "/init.scope"},
+		{pid: 10, ppid: 1, exe: "/usr/bin/curl", cgroup: "/" + BypassCgroup},
+	})
+	manager := newManager(cgroupRoot, procRoot)
+	assert.True(t, manager.ProcessExists(10))
+	assert.False(t, manager.ProcessExists(11))
+
+	cgroups, err := manager.Sync(nil)
+	require.NoError(t, err)
+	assert.Empty(t, cgroups)
+	assert.Equal(t, "10", readProcs(t, cgroupRoot, "/"))
+}
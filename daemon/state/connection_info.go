@@ -146,6 +146,8 @@ func (c *ConnectionInfo) ConnectionStatusNotifyConnect(e events.DataConnect) err
 		IsObfuscated:       e.IsObfuscated,
 		IsMeshnetPeer:      e.IsMeshnetPeer,
 		RecommendationUUID: e.RecommendationUUID,
+		Entry:              e.EntryServer,
+		OverriddenSettings: e.OverriddenSettings,
 	}
 
 	c.setStatus(status, fullyConnected)
@@ -70,6 +70,15 @@ func (s *StatePublisher) NotifyRecentsChanged(e events.DataRecentsChanged) error
 	return nil
 }
 
+func (s *StatePublisher) NotifyFavoritesChanged(e events.DataFavoritesChanged) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	log.Info("notifying about favorites change")
+	s.notify(pb.UpdateEvent_FAVORITES_LIST_UPDATE)
+	return nil
+}
+
 func (s *StatePublisher) notifyLoginLogout(status events.TypeEventStatus, eventType pb.LoginEventType) {
 	// skip any event types other than success, as subscribers(GUI) do not care about them
 	if status != events.StatusSuccess {
@@ -153,6 +162,16 @@ func (s *StatePublisher) NotifyPauseEvent(pauseEvent *pb.PauseEvent) error {
 	return nil
 }
 
+func (s *StatePublisher) NotifyFailover(failoverEvent *pb.FailoverEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	log.Infof("notifying about failover from %s: %s", failoverEvent.FromHostname, failoverEvent.Reason)
+	s.notify(failoverEvent)
+
+	return nil
+}
+
 func (s *StatePublisher) AddSubscriber() (<-chan any, chan<- struct{}) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -52,4 +52,17 @@ type ConnectionStatus struct {
 	PausedAt time.Time
 	// Remaining pause time in seconds
 	PauseRemainingTimeSec uint32
+	// Entry server of the multi-hop connection, nil for direct connections
+	Entry *MultiHopEntry
+	// Names of the settings overridden for this connection only
+	OverriddenSettings []string
+}
+
+// MultiHopEntry describes the entry server of the multi-hop connection
+type MultiHopEntry struct {
+	IP       netip.Addr
+	Name     string
+	Hostname string
+	Country  string
+	City     string
 }
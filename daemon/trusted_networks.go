@@ -0,0 +1,123 @@
+package daemon
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/netstate"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/log"
+	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+// trustedNetworksSettleDelay gives the network some time to settle after the change, e.g. for the
+// gateway to appear in the neighbor table and for NetworkManager to associate with the access point
+const trustedNetworksSettleDelay = 3 * time.Second
+
+// MeshnetEnabler turns meshnet on when joining a trusted network with meshnet only action
+type MeshnetEnabler interface {
+	EnableMeshnet(context.Context, *meshpb.Empty) (*meshpb.MeshnetResponse, error)
+}
+
+type trustedNetworksState struct {
+	mu      sync.Mutex
+	timerMu sync.Mutex
+	timer   *time.Timer
+	meshnet MeshnetEnabler
+	// last is the last network which trusted networks were applied for
+	last netstate.NetworkIdentity
+}
+
+// StartTrustedNetworks applies trusted networks for the current network and on every network change.
+// Must be called before the monitor is started.
+func (r *RPC) StartTrustedNetworks(monitor *netstate.NetlinkMonitor, meshnet MeshnetEnabler) {
+	r.trustedNetworks.mu.Lock()
+	r.trustedNetworks.meshnet = meshnet
+	r.trustedNetworks.mu.Unlock()
+
+	monitor.AddListener(r)
+	go r.checkTrustedNetwork(false)
+}
+
+// NetworkChanged schedules trusted networks check once the network settles.
+func (r *RPC) NetworkChanged() {
+	r.trustedNetworks.timerMu.Lock()
+	defer r.trustedNetworks.timerMu.Unlock()
+
+	if r.trustedNetworks.timer == nil {
+		r.trustedNetworks.timer = time.AfterFunc(trustedNetworksSettleDelay, func() { r.checkTrustedNetwork(false) })
+		return
+	}
+	r.trustedNetworks.timer.Reset(trustedNetworksSettleDelay)
+}
+
+// checkTrustedNetwork applies trusted networks if the host joined a different network since the
+// last check. Check is forced after the trusted networks configuration changes.
+func (r *RPC) checkTrustedNetwork(force bool) {
+	if r.networkIdentity == nil {
+		return
+	}
+
+	r.trustedNetworks.mu.Lock()
+	defer r.trustedNetworks.mu.Unlock()
+
+	identity, err := r.networkIdentity.Identity()
+	if err != nil {
+		log.Debug("identifying current network:", err)
+		return
+	}
+	if identity.IsEmpty() || (!force && identity == r.trustedNetworks.last) {
+		return
+	}
+	r.trustedNetworks.last = identity
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Error("loading config for trusted networks:", err)
+		return
+	}
+	r.applyTrustedNetworks(cfg.TrustedNetworks, identity, r.trustedNetworks.meshnet)
+}
+
+func (r *RPC) applyTrustedNetworks(
+	trustedNetworks config.TrustedNetworks,
+	identity netstate.NetworkIdentity,
+	meshnet MeshnetEnabler,
+) {
+	if !trustedNetworks.IsEnabled() {
+		return
+	}
+
+	network, trusted := trustedNetworks.Match(identity.SSID, identity.GatewayMAC)
+	if trusted {
+		log.Info("joined trusted network", network.ID, "on", identity.Interface)
+		if r.netw.IsVPNActive() || r.connectionInfo.IsPaused() {
+			if _, err := r.DoDisconnect(); err != nil {
+				log.Error("disconnecting on trusted network:", err)
+			}
+		}
+		if network.Action == config.TrustedNetworkMeshnetOnly && !r.netw.IsMeshnetActive() && meshnet != nil {
+			resp, err := meshnet.EnableMeshnet(context.Background(), &meshpb.Empty{})
+			if err != nil || resp.GetEmpty() == nil {
+				log.Error("enabling meshnet on trusted network:", err, resp.GetResponse())
+			}
+		}
+		return
+	}
+
+	if !trustedNetworks.ConnectUntrusted || r.netw.IsVPNActive() || r.connectionInfo.IsPaused() {
+		return
+	}
+	if loggedIn, _ := r.ac.IsLoggedIn(); !loggedIn {
+		return
+	}
+
+	log.Info("joined untrusted network on", identity.Interface, "connecting to VPN")
+	connServer := connectServer{}
+	err := r.ConnectFromLastSelection(&connServer, pb.ConnectionSource_AUTO, 0)
+	if err != nil || connServer.err != nil {
+		log.Error("connecting on untrusted network: connection error:", err, "server error:", connServer.err)
+	}
+}
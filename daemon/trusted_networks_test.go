@@ -0,0 +1,215 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	daemonEvents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/netstate"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/recents"
+	"github.com/NordSecurity/nordvpn-linux/daemon/state"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyTrustedNetworks(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	office := config.TrustedNetwork{ID: "1", SSID: "office", Action: config.TrustedNetworkDisconnect}
+	home := config.TrustedNetwork{ID: "2", GatewayMAC: "aa:bb:cc:dd:ee:ff", Action: config.TrustedNetworkMeshnetOnly}
+
+	tests := []struct {
+		name                string
+		trustedNetworks     config.TrustedNetworks
+		identity            netstate.NetworkIdentity
+		isVPNActive         bool
+		isMeshActive        bool
+		expectedVPNState    bool
+		expectedMeshEnabled bool
+	}{
+		{
+			name:             "trusted ssid disconnects",
+			trustedNetworks:  config.TrustedNetworks{Networks: []config.TrustedNetwork{office, home}},
+			identity:         netstate.NetworkIdentity{Interface: "wlan0", SSID: "office"},
+			isVPNActive:      true,
+			expectedVPNState: false,
+		},
+		{
+			name:                "trusted gateway switches to meshnet only",
+			trustedNetworks:     config.TrustedNetworks{Networks: []config.TrustedNetwork{office, home}},
+			identity:            netstate.NetworkIdentity{Interface: "eth0", GatewayMAC: "AA:BB:CC:DD:EE:FF"},
+			isVPNActive:         true,
+			expectedVPNState:    false,
+			expectedMeshEnabled: true,
+		},
+		{
+			name:             "meshnet already active",
+			trustedNetworks:  config.TrustedNetworks{Networks: []config.TrustedNetwork{home}},
+			identity:         netstate.NetworkIdentity{Interface: "eth0", GatewayMAC: "aa:bb:cc:dd:ee:ff"},
+			isVPNActive:      true,
+			isMeshActive:     true,
+			expectedVPNState: false,
+		},
+		{
+			name:             "untrusted network without auto connect",
+			trustedNetworks:  config.TrustedNetworks{Networks: []config.TrustedNetwork{office}},
+			identity:         netstate.NetworkIdentity{Interface: "wlan0", SSID: "cafe"},
+			isVPNActive:      true,
+			expectedVPNState: true,
+		},
+		{
+			name:             "untrusted network when already connected",
+			trustedNetworks:  config.TrustedNetworks{ConnectUntrusted: true},
+			identity:         netstate.NetworkIdentity{Interface: "wlan0", SSID: "cafe"},
+			isVPNActive:      true,
+			expectedVPNState: true,
+		},
+		{
+			name:             "trusted networks are not configured",
+			identity:         netstate.NetworkIdentity{Interface: "wlan0", SSID: "office"},
+			isVPNActive:      true,
+			expectedVPNState: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			networkerMock := testnetworker.Mock{VpnActive: test.isVPNActive, MeshActive: test.isMeshActive}
+			connectionInfo := state.NewConnectionInfo()
+			connectionInfo.ConnectionStatusNotifyConnect(events.DataConnect{})
+			meshnet := &mockMeshnetEnabler{}
+
+			r := RPC{
+				netw:               &networkerMock,
+				cm:                 newMockConfigManager(),
+				events:             daemonEvents.NewEventsEmpty(),
+				recentVPNConnStore: recents.NewRecentConnectionsStore(TestdataPath+TestRecentConnFile, &internal.StdFilesystemHandle{}, nil),
+				pauseManager:       &mock.PauseSchedulerMock{},
+				connectionInfo:     connectionInfo,
+			}
+
+			r.applyTrustedNetworks(test.trustedNetworks, test.identity, meshnet)
+			assert.Equal(t, test.expectedVPNState, networkerMock.VpnActive)
+			assert.Equal(t, test.expectedMeshEnabled, meshnet.enabled)
+		})
+	}
+}
+
+func TestAddTrustedNetwork(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	office := config.TrustedNetwork{ID: "1", SSID: "office", Action: config.TrustedNetworkDisconnect}
+
+	tests := []struct {
+		name             string
+		network          *pb.TrustedNetwork
+		current          []config.TrustedNetwork
+		expectedNetworks []config.TrustedNetwork
+		expectedCode     int64
+		expectedData     []string
+	}{
+		{
+			name:             "add ssid",
+			network:          &pb.TrustedNetwork{Ssid: "office"},
+			expectedNetworks: []config.TrustedNetwork{office},
+			expectedCode:     internal.CodeSuccess,
+			expectedData:     []string{"1"},
+		},
+		{
+			name: "add gateway mac for meshnet only",
+			network: &pb.TrustedNetwork{
+				GatewayMac: "AA-BB-CC-DD-EE-FF",
+				Action:     pb.TrustedNetworkAction_TRUSTED_MESHNET_ONLY,
+			},
+			current: []config.TrustedNetwork{office},
+			expectedNetworks: []config.TrustedNetwork{
+				office,
+				{ID: "2", GatewayMAC: "aa:bb:cc:dd:ee:ff", Action: config.TrustedNetworkMeshnetOnly},
+			},
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"2"},
+		},
+		{
+			name:             "network already added",
+			network:          &pb.TrustedNetwork{Ssid: "office", Action: pb.TrustedNetworkAction_TRUSTED_MESHNET_ONLY},
+			current:          []config.TrustedNetwork{office},
+			expectedNetworks: []config.TrustedNetwork{office},
+			expectedCode:     internal.CodeTrustedNetworkNoop,
+		},
+		{
+			name:         "no identity",
+			network:      &pb.TrustedNetwork{},
+			expectedCode: internal.CodeTrustedNetworkInvalid,
+		},
+		{
+			name:         "invalid mac",
+			network:      &pb.TrustedNetwork{GatewayMac: "gateway"},
+			expectedCode: internal.CodeTrustedNetworkInvalid,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.TrustedNetworks.Networks = test.current
+			r := RPC{cm: cm}
+
+			resp, err := r.AddTrustedNetwork(context.Background(), &pb.AddTrustedNetworkRequest{Network: test.network})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expectedNetworks, cm.Cfg.TrustedNetworks.Networks)
+		})
+	}
+}
+
+func TestRemoveTrustedNetwork(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.TrustedNetworks.Networks = []config.TrustedNetwork{
+		{ID: "1", SSID: "office", Action: config.TrustedNetworkDisconnect},
+		{ID: "2", SSID: "home", Action: config.TrustedNetworkMeshnetOnly},
+	}
+	r := RPC{cm: cm}
+
+	resp, err := r.RemoveTrustedNetwork(context.Background(), &pb.RemoveTrustedNetworkRequest{Id: "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Equal(t, []config.TrustedNetwork{{ID: "2", SSID: "home", Action: config.TrustedNetworkMeshnetOnly}},
+		cm.Cfg.TrustedNetworks.Networks)
+
+	resp, err = r.RemoveTrustedNetwork(context.Background(), &pb.RemoveTrustedNetworkRequest{Id: "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeTrustedNetworkNoop, resp.Type)
+}
+
+func TestCurrentNetwork(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.TrustedNetworks.Networks = []config.TrustedNetwork{
+		{ID: "3", GatewayMAC: "aa:bb:cc:dd:ee:ff", Action: config.TrustedNetworkDisconnect},
+	}
+
+	r := RPC{cm: cm, networkIdentity: &mockIdentityResolver{
+		identity: netstate.NetworkIdentity{Interface: "eth0", GatewayMAC: "aa:bb:cc:dd:ee:ff"},
+	}}
+	resp, err := r.CurrentNetwork(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Equal(t, "eth0", resp.Interface)
+	assert.Equal(t, "3", resp.TrustedNetworkId)
+
+	r.networkIdentity = &mockIdentityResolver{err: netstate.ErrNoDefaultRoute}
+	resp, err = r.CurrentNetwork(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeFailure, resp.Type)
+}
@@ -5,4 +5,5 @@ import "errors"
 var (
 	ErrVPNAIsAlreadyStarted = errors.New("vpn is already started")
 	ErrTunnelAlreadyExists  = errors.New("tunnel already exists")
+	ErrMultiHopNotSupported = errors.New("multi-hop is not supported")
 )
@@ -7,6 +7,7 @@ import (
 	"net/netip"
 	"os/exec"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
@@ -21,6 +22,7 @@ type KernelSpace struct {
 	active          bool
 	fwmark          uint32
 	tun             *tunnel.Tunnel
+	entryTun        *tunnel.Tunnel
 	eventsPublisher *vpn.Events
 	serverData      vpn.ServerData
 	sync.Mutex
@@ -59,20 +61,30 @@ func (k *KernelSpace) Start(
 		return vpn.ErrTunnelAlreadyExists
 	}
 
+	// traffic to the exit server is encapsulated once more by the entry tunnel
+	headerSize := WireguardHeaderSize
+	if serverData.Entry != nil {
+		if err := k.startEntry(creds, *serverData.Entry, serverData.IP); err != nil {
+			return fmt.Errorf("starting the entry tunnel: %w", err)
+		}
+		headerSize += WireguardHeaderSize
+	}
+
 	// add wireguard interface
 	if err := upWGInterface(InterfaceName); err != nil {
+		k.stopEntry()
 		return fmt.Errorf("turning on nordlynx: %w", err)
 	}
 
 	iface, err := net.InterfaceByName(InterfaceName)
 	if err != nil {
-		if err := k.Stop(); err != nil {
+		if err := k.stop(); err != nil {
 			log.Error(err)
 		}
 		return err
 	}
 
-	tun := tunnel.New(*iface, DefaultPrefix)
+	tun := tunnel.NewWithIPv6(*iface, DefaultPrefix, serverData.TunnelIPv6)
 	k.tun = tun
 
 	event := vpn.ConnectEvent{Status: events.StatusAttempt, TunnelName: InterfaceName}
@@ -107,7 +119,7 @@ func (k *KernelSpace) Start(
 		return err
 	}
 
-	if err := vpn.SetMTU(tun.Interface(), WireguardHeaderSize); err != nil {
+	if err := vpn.SetMTU(tun.Interface(), headerSize); err != nil {
 		if err := k.stop(); err != nil {
 			log.Warn(err)
 		}
@@ -148,14 +160,81 @@ func (k *KernelSpace) State() vpn.State {
 	return k.state
 }
 
+// EntryTun returns the tunnel to the entry server of the multi-hop connection
+func (k *KernelSpace) EntryTun() tunnel.T {
+	k.Lock()
+	defer k.Unlock()
+	if k.entryTun == nil {
+		return nil
+	}
+	return k.entryTun
+}
+
 func (k *KernelSpace) GetConnectionParameters() (vpn.ServerData, bool) {
 	k.Lock()
 	defer k.Unlock()
 	return k.serverData, k.active
 }
 
+// startEntry brings up the tunnel to the entry server, which carries only the traffic
+// of the exit tunnel
+func (k *KernelSpace) startEntry(creds vpn.Credentials, entry vpn.ServerData, exitIP netip.Addr) error {
+	if _, err := exec.Command("ip", "link", "show", "dev", EntryInterfaceName).Output(); err == nil {
+		return vpn.ErrTunnelAlreadyExists
+	}
+
+	if err := upWGInterface(EntryInterfaceName); err != nil {
+		return fmt.Errorf("turning on nordlynx entry: %w", err)
+	}
+
+	iface, err := net.InterfaceByName(EntryInterfaceName)
+	if err != nil {
+		if _, err := removeDevice(EntryInterfaceName); err != nil {
+			log.Warn(err)
+		}
+		return err
+	}
+
+	// the address is the same as for the exit tunnel, so the prefix is narrowed
+	// down in order not to have conflicting routes
+	k.entryTun = tunnel.New(*iface, netip.PrefixFrom(DefaultPrefix.Addr(), 32))
+
+	conf := entryConfig(creds.NordLynxPrivateKey, k.fwmark, entry.NordLynxPublicKey, entry.IP, exitIP)
+	if err := pushConfig(k.entryTun.Interface(), conf); err != nil {
+		k.stopEntry()
+		return fmt.Errorf("setting nordlynx entry server to connect to: %w", err)
+	}
+
+	if err := k.entryTun.AddAddrs(); err != nil {
+		k.stopEntry()
+		return err
+	}
+
+	if err := k.entryTun.Up(); err != nil {
+		k.stopEntry()
+		return err
+	}
+
+	if err := vpn.SetMTU(k.entryTun.Interface(), WireguardHeaderSize); err != nil {
+		k.stopEntry()
+		return fmt.Errorf("setting MTU for nordlynx entry interface: %w", err)
+	}
+	return nil
+}
+
+func (k *KernelSpace) stopEntry() {
+	if k.entryTun == nil {
+		return
+	}
+	if err := deleteInterface(k.entryTun.Interface()); err != nil {
+		log.Warn(err)
+	}
+	k.entryTun = nil
+}
+
 // stop is used on errors
 func (k *KernelSpace) stop() error {
+	k.stopEntry()
 	if k.tun != nil {
 		err := deleteInterface(k.tun.Interface())
 		if err != nil {
@@ -219,3 +298,69 @@ func wgQuickConfig(
 		),
 	)
 }
+
+// entryTemplate is a template for the entry tunnel of the multi-hop connection
+const entryTemplate = `[Interface]
+PrivateKey = %s
+Fwmark = %#x
+[Peer]
+PublicKey = %s
+AllowedIPs = %s
+Endpoint = %s
+PersistentKeepalive = 25`
+
+func entryConfig(
+	privateKey string,
+	fwmark uint32,
+	publicKey string,
+	serverIP netip.Addr,
+	exitIP netip.Addr,
+) string {
+	return fmt.Sprintf(
+		entryTemplate,
+		privateKey,
+		fwmark,
+		publicKey,
+		netip.PrefixFrom(exitIP, exitIP.BitLen()),
+		net.JoinHostPort(
+			serverIP.String(),
+			strconv.Itoa(defaultPort),
+		),
+	)
+}
+
+// standaloneTemplate is a template for WG-Quick config usable without the daemon
+const standaloneTemplate = `[Interface]
+PrivateKey = %s
+Address = %s
+%s
+[Peer]
+PublicKey = %s
+AllowedIPs = 0.0.0.0/0,::/0
+Endpoint = %s
+PersistentKeepalive = 25
+`
+
+// StandaloneConfig generates a WG-Quick config which can be used without the daemon
+func StandaloneConfig(
+	privateKey string,
+	publicKey string,
+	serverIP netip.Addr,
+	nameservers []string,
+) string {
+	var dns string
+	if len(nameservers) > 0 {
+		dns = "DNS = " + strings.Join(nameservers, ", ")
+	}
+	return fmt.Sprintf(
+		standaloneTemplate,
+		privateKey,
+		netip.PrefixFrom(DefaultPrefix.Addr(), 32),
+		dns,
+		publicKey,
+		net.JoinHostPort(
+			serverIP.String(),
+			strconv.Itoa(defaultPort),
+		),
+	)
+}
@@ -235,6 +235,8 @@ type Libtelio struct {
 	cancelConnectionMonitoring func()
 	active                     bool
 	tun                        tunnel.T
+	// prefix is the IPv4 address of the tunnel
+	prefix netip.Prefix
 	// This must be the one given from the public interface and
 	// retrieved from the API
 	currentServer     vpn.ServerData
@@ -397,9 +399,17 @@ func (l *Libtelio) Start(
 
 	log.Info("libtelio version:", teliogo.GetVersionTag())
 
+	// libtelio manages a single exit node, so two hops can't be chained
+	if serverData.Entry != nil {
+		return vpn.ErrMultiHopNotSupported
+	}
+
 	if err = l.openTunnel(nordlynx.DefaultPrefix, creds.NordLynxPrivateKey); err != nil {
 		return fmt.Errorf("opening the tunnel: %w", err)
 	}
+	if err = l.setTunnelIPv6(serverData.TunnelIPv6); err != nil {
+		return fmt.Errorf("setting the tunnel IPv6: %w", err)
+	}
 
 	l.currentServer = serverData
 	if err = l.connect(ctx, serverData.IP, serverData.DedicatedServerPort, serverData.NordLynxPublicKey, serverData.PostQuantum); err != nil {
@@ -505,6 +515,11 @@ func (l *Libtelio) Stop() error {
 		if err := l.closeTunnel(); err != nil {
 			return fmt.Errorf("closing the tunnel: %w", err)
 		}
+		return nil
+	}
+	// tunnel is kept for meshnet, which does not use the IPv6 of the VPN server
+	if err := l.setTunnelIPv6(netip.Prefix{}); err != nil {
+		return fmt.Errorf("removing the tunnel IPv6: %w", err)
 	}
 	return nil
 }
@@ -788,6 +803,26 @@ func (l *Libtelio) openTunnel(prefix netip.Prefix, privateKey string) (err error
 		return fmt.Errorf("setting mtu for the interface: %w", err)
 	}
 
+	l.tun = tun
+	l.prefix = prefix
+	return nil
+}
+
+// setTunnelIPv6 replaces the IPv6 address of the tunnel, invalid prefix removes it
+func (l *Libtelio) setTunnelIPv6(prefix6 netip.Prefix) error {
+	if l.tun == nil {
+		return nil
+	}
+	if _, ok := l.tun.IPv6(); !ok && !prefix6.IsValid() {
+		return nil
+	}
+	if err := l.tun.DelAddrs(); err != nil {
+		return fmt.Errorf("deleting interface addrs: %w", err)
+	}
+	tun := tunnel.NewWithIPv6(l.tun.Interface(), l.prefix, prefix6)
+	if err := tun.AddAddrs(); err != nil {
+		return fmt.Errorf("adding interface addrs: %w", err)
+	}
 	l.tun = tun
 	return nil
 }
@@ -807,7 +842,8 @@ func (l *Libtelio) updateTunnel(privateKey string, prefix netip.Prefix) error {
 	if err := l.tun.DelAddrs(); err != nil {
 		return fmt.Errorf("deleting interface addrs: %w", err)
 	}
-	tun := tunnel.New(l.tun.Interface(), prefix)
+	// tunnel is updated only while connected to the VPN server, so its IPv6 is kept
+	tun := tunnel.NewWithIPv6(l.tun.Interface(), prefix, l.currentServer.TunnelIPv6)
 	if err := tun.AddAddrs(); err != nil {
 		return fmt.Errorf("adding interface addrs: %w", err)
 	}
@@ -817,6 +853,7 @@ func (l *Libtelio) updateTunnel(privateKey string, prefix netip.Prefix) error {
 	}
 
 	l.tun = tun
+	l.prefix = prefix
 	return nil
 }
 
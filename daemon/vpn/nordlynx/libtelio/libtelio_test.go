@@ -61,6 +61,7 @@ type mockTunnel struct{}
 func (mockTunnel) TransferRates() (tunnel.Statistics, error) { return tunnel.Statistics{}, nil }
 func (mockTunnel) Interface() net.Interface                  { return net.Interface{Name: "nordlynx"} }
 func (mockTunnel) IP() (netip.Addr, bool)                    { return netip.Addr{}, true }
+func (mockTunnel) IPv6() (netip.Addr, bool)                  { return netip.Addr{}, false }
 func (mockTunnel) AddAddrs() error                           { return nil }
 func (mockTunnel) DelAddrs() error                           { return nil }
 
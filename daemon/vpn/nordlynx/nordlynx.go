@@ -15,7 +15,9 @@ import (
 
 const (
 	// InterfaceName for various NordLynx implementations
-	InterfaceName       = "nordlynx"
+	InterfaceName = "nordlynx"
+	// EntryInterfaceName is used for the entry tunnel of the multi-hop connection
+	EntryInterfaceName  = "nordlynx-entry"
 	defaultPort         = 51820
 	WireguardHeaderSize = 80
 )
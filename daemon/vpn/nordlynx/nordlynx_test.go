@@ -63,3 +63,44 @@ func TestRemoveDevice(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestStandaloneConfig(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	expected := `[Interface]
+PrivateKey = private
+Address = 10.5.0.2/32
+DNS = 103.86.96.100, 103.86.99.100
+[Peer]
+PublicKey = public
+AllowedIPs = 0.0.0.0/0,::/0
+Endpoint = 1.2.3.4:51820
+PersistentKeepalive = 25
+`
+	assert.Equal(t, expected, StandaloneConfig(
+		"private",
+		"public",
+		netip.MustParseAddr("1.2.3.4"),
+		[]string{"103.86.96.100", "103.86.99.100"},
+	))
+}
+
+func TestEntryConfig(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	expected := `[Interface]
+PrivateKey = private
+Fwmark = 0xe1f1
+[Peer]
+PublicKey = public
+AllowedIPs = 5.6.7.8/32
+Endpoint = 1.2.3.4:51820
+PersistentKeepalive = 25`
+	assert.Equal(t, expected, entryConfig(
+		"private",
+		0xe1f1,
+		"public",
+		netip.MustParseAddr("1.2.3.4"),
+		netip.MustParseAddr("5.6.7.8"),
+	))
+}
@@ -0,0 +1,43 @@
+package openvpn
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// StandaloneConfig generates an OpenVPN config which can be used without the daemon.
+// Credentials are embedded in the config, inline auth-user-pass requires OpenVPN 2.6 or newer.
+func StandaloneConfig(protocol config.Protocol, serverIP netip.Addr, username, password string) ([]byte, error) {
+	identifier, err := getConfigIdentifier(protocol, false)
+	if err != nil {
+		return nil, fmt.Errorf("getting config identifier: %w", err)
+	}
+
+	template, err := internal.FileRead(internal.OvpnTemplatePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading ovpn template file: %w", err)
+	}
+
+	out, err := generateConfig(serverIP, identifier, template)
+	if err != nil {
+		return nil, fmt.Errorf("generating OpenVPN config: %w", err)
+	}
+	return inlineCredentials(out, username, password), nil
+}
+
+// inlineCredentials replaces auth-user-pass directives with the inline credentials block
+func inlineCredentials(data []byte, username, password string) []byte {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "auth-user-pass") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, "<auth-user-pass>", username, password, "</auth-user-pass>")
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
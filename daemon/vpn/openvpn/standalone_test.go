@@ -0,0 +1,17 @@
+package openvpn
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInlineCredentials(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	config := "client\ndev tun\nauth-user-pass\nverb 3\n"
+	expected := "client\ndev tun\nverb 3\n<auth-user-pass>\nuser\npass\n</auth-user-pass>\n"
+	assert.Equal(t, expected, string(inlineCredentials([]byte(config), "user", "pass")))
+}
@@ -22,6 +22,12 @@ type VPN interface {
 	GetConnectionParameters() (ServerData, bool)
 }
 
+// MultiHop is implemented by VPNs which can chain the connection through an entry server.
+type MultiHop interface {
+	// EntryTun returns the tunnel to the entry server or nil if the connection is direct.
+	EntryTun() tunnel.T
+}
+
 // Credentials define a possible set of credentials required to
 // connect to the VPN server
 type Credentials struct {
@@ -48,6 +54,11 @@ type ServerData struct {
 	PostQuantum         bool
 	NordWhisperPort     int64
 	DedicatedServerPort int64
+	// TunnelIPv6 is the IPv6 address of the tunnel assigned by the server. IPv6 stays blocked
+	// if it is invalid. The API does not provide it yet, so it is never set.
+	TunnelIPv6 netip.Prefix
+	// Entry server of the multi-hop connection, nil for direct connections
+	Entry *ServerData
 }
 
 func (s ServerData) EndpointEqual(other string) bool {
@@ -35,6 +35,7 @@ type DataAllowlist struct {
 	Subnets  []string
 	TCPPorts []int64
 	UDPPorts []int64
+	Domains  []string
 }
 
 type DataDNS struct {
@@ -109,6 +110,8 @@ const (
 	VPNConnectionReasonServerMaintenance
 	// VPNConnectionReasonAutoConnect is set when the app auto-connects based on the user's auto-connect setting
 	VPNConnectionReasonAutoConnect
+	// VPNConnectionReasonFailover is set when reconnects after the connection quality monitor detects a degraded connection
+	VPNConnectionReasonFailover
 )
 
 type TypeLoginType int
@@ -144,6 +147,10 @@ type DataConnect struct {
 	PauseInterval           time.Duration
 	UnpausedByUser          bool
 	VPNConnReason           VPNConnectionReason
+	// EntryServer of the multi-hop connection, nil for direct connections
+	EntryServer *types.MultiHopEntry
+	// OverriddenSettings are the names of the settings overridden for this connection only
+	OverriddenSettings []string
 }
 
 // DataConnectChangeNotif is used to provide notifications for internal listeners of ConnectionStatus
@@ -278,6 +285,8 @@ type UiItemsAction struct {
 
 type DataRecentsChanged struct{}
 
+type DataFavoritesChanged struct{}
+
 type DataPauseCancelled struct {
 	Interval            time.Duration
 	ServerFromAPI       bool
@@ -810,12 +810,13 @@ func (s *Subscriber) NotifyProtocol(data config.Protocol) error {
 }
 
 func (s *Subscriber) NotifyAllowlist(data events.DataAllowlist) error {
-	enabled := len(data.UDPPorts) != 0 || len(data.TCPPorts) != 0 || len(data.Subnets) != 0
+	enabled := len(data.UDPPorts) != 0 || len(data.TCPPorts) != 0 || len(data.Subnets) != 0 || len(data.Domains) != 0
 	if err := s.response(moose.NordvpnappSetContextApplicationNordvpnappConfigUserPreferencesSplitTunnelingEnabledMeta(
-		fmt.Sprintf(`{"udp_ports":%d,"tcp_ports":%d,"subnets":%d}`, len(data.UDPPorts), len(data.TCPPorts), len(data.Subnets)),
+		fmt.Sprintf(`{"udp_ports":%d,"tcp_ports":%d,"subnets":%d,"domains":%d}`,
+			len(data.UDPPorts), len(data.TCPPorts), len(data.Subnets), len(data.Domains)),
 	)); err != nil {
-		return fmt.Errorf("setting allowlist metadata (udp=%d, tcp=%d, subnets=%d): %w",
-			len(data.UDPPorts), len(data.TCPPorts), len(data.Subnets), err)
+		return fmt.Errorf("setting allowlist metadata (udp=%d, tcp=%d, subnets=%d, domains=%d): %w",
+			len(data.UDPPorts), len(data.TCPPorts), len(data.Subnets), len(data.Domains), err)
 	}
 	if err := s.response(moose.NordvpnappSetContextApplicationNordvpnappConfigUserPreferencesSplitTunnelingEnabledValue(enabled)); err != nil {
 		return fmt.Errorf("setting allowlist enabled value (enabled=%v): %w", enabled, err)
@@ -1661,6 +1662,12 @@ func vpnConnReasonToMoose(t events.VPNConnectionReason) mooseConnReasonAttrs {
 			exceptionCode: -1,
 			eventTrigger:  moose.NordvpnappEventTriggerApp,
 		}
+	case events.VPNConnectionReasonFailover:
+		return mooseConnReasonAttrs{
+			trigger:       moose.NordvpnappVpnConnectionTriggerNone,
+			exceptionCode: -1,
+			eventTrigger:  moose.NordvpnappEventTriggerApp,
+		}
 	default:
 		return mooseConnReasonAttrs{
 			trigger:       moose.NordvpnappVpnConnectionTriggerNone,
@@ -0,0 +1,171 @@
+package fileshare
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// ChecksumStore records content hashes of the transferred files on both sides of the transfer,
+// so they can be compared between the peers by the users. The hashes are not exchanged and not
+// checked by the daemon, the only verification of the downloaded files is done by norddrop, which
+// reports FILE_CHECKSUM_MISMATCH. Hashes are calculated in the background, because
+// files can be large, and persisted in a file, so they are kept together with the transfer
+// history.
+// Thread safe.
+type ChecksumStore struct {
+	mutex       sync.Mutex
+	storagePath string
+	// Key is transfer ID and then file ID, value is hex encoded SHA-256
+	checksums map[string]map[string]string
+	// files of the transfer still being hashed, key is transfer ID
+	pending map[string]*sync.WaitGroup
+}
+
+// NewChecksumStore creates a checksum store persisting the checksums at storagePath
+func NewChecksumStore(storagePath string) *ChecksumStore {
+	return &ChecksumStore{
+		storagePath: storagePath,
+		checksums:   map[string]map[string]string{},
+		pending:     map[string]*sync.WaitGroup{},
+	}
+}
+
+// Load the persisted checksums. Must be called before using the store.
+func (cs *ChecksumStore) Load() error {
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	data, err := os.ReadFile(filepath.Clean(cs.storagePath))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("loading checksums file: %w", err)
+	}
+	if err := json.Unmarshal(data, &cs.checksums); err != nil {
+		return fmt.Errorf("unmarshalling checksums: %w", err)
+	}
+	return nil
+}
+
+// Record calculates the checksum of the file at path in the background. Checksums are persisted
+// once the transfer is finished, see Wait.
+func (cs *ChecksumStore) Record(transferID string, fileID string, path string) {
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	wg, ok := cs.pending[transferID]
+	if !ok {
+		wg = &sync.WaitGroup{}
+		cs.pending[transferID] = wg
+	}
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		checksum, err := calculateChecksum(path)
+		if err != nil {
+			log.Warnf("calculating checksum of file %s in transfer %s: %s", fileID, transferID, err)
+			return
+		}
+
+		cs.mutex.Lock()
+		defer cs.mutex.Unlock()
+		if _, ok := cs.checksums[transferID]; !ok {
+			cs.checksums[transferID] = map[string]string{}
+		}
+		cs.checksums[transferID][fileID] = checksum
+	}()
+}
+
+// Wait until all files of the transfer recorded so far are hashed and persist their checksums
+func (cs *ChecksumStore) Wait(transferID string) {
+	cs.mutex.Lock()
+	wg, ok := cs.pending[transferID]
+	delete(cs.pending, transferID)
+	cs.mutex.Unlock()
+
+	if !ok {
+		return
+	}
+	wg.Wait()
+
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+	if err := cs.save(); err != nil {
+		log.Errorf("saving checksums of transfer %s: %s", transferID, err)
+	}
+}
+
+// Retain only the checksums of the given transfers, e.g. once the older transfers are purged
+func (cs *ChecksumStore) Retain(transferIDs []string) error {
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	retained := make(map[string]map[string]string, len(transferIDs))
+	for _, transferID := range transferIDs {
+		if checksums, ok := cs.checksums[transferID]; ok {
+			retained[transferID] = checksums
+		}
+	}
+	if len(retained) == len(cs.checksums) {
+		return nil
+	}
+	cs.checksums = retained
+	return cs.save()
+}
+
+// Apply the recorded checksums to the transfer files
+func (cs *ChecksumStore) Apply(transfer *pb.Transfer) {
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	checksums, ok := cs.checksums[transfer.Id]
+	if !ok {
+		return
+	}
+
+	for _, file := range transfer.Files {
+		if checksum, ok := checksums[file.Id]; ok {
+			file.Checksum = checksum
+		}
+	}
+}
+
+func (cs *ChecksumStore) save() error {
+	data, err := json.Marshal(cs.checksums)
+	if err != nil {
+		return fmt.Errorf("marshalling checksums: %w", err)
+	}
+	if err := os.WriteFile(filepath.Clean(cs.storagePath), data, internal.PermUserRW); err != nil {
+		return fmt.Errorf("saving checksums file: %w", err)
+	}
+	return nil
+}
+
+// calculateChecksum returns hex encoded SHA-256 of the file content
+func calculateChecksum(path string) (string, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,107 @@
+package fileshare
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestChecksumStore(t *testing.T) {
+	category.Set(t, category.File)
+
+	dir := t.TempDir()
+	content := []byte("file content")
+	// sha256 of "file content"
+	expectedChecksum := "e0ac3601005dfa1864f5392aabaf7d898b1b5bab854f1acb4491bcd806b76b0c"
+	for _, name := range []string{"uploaded", "downloaded"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), content, 0600))
+	}
+
+	storagePath := filepath.Join(t.TempDir(), "checksums.json")
+	checksums := NewChecksumStore(storagePath)
+	require.NoError(t, checksums.Load())
+
+	checksums.Record("out", "uploaded", filepath.Join(dir, "uploaded"))
+	checksums.Record("out", "missing", filepath.Join(dir, "missing"))
+	checksums.Record("in", "downloaded", filepath.Join(dir, "downloaded"))
+	checksums.Wait("in")
+
+	// checksums are persisted only once the transfer is finished
+	restarted := NewChecksumStore(storagePath)
+	require.NoError(t, restarted.Load())
+	outgoing := &pb.Transfer{Id: "out", Files: []*pb.File{{Id: "uploaded"}, {Id: "missing"}}}
+	restarted.Apply(outgoing)
+	assert.Empty(t, outgoing.Files[0].Checksum)
+
+	checksums.Wait("out")
+
+	// checksums survive the restarts
+	checksums = NewChecksumStore(storagePath)
+	require.NoError(t, checksums.Load())
+
+	outgoing = &pb.Transfer{
+		Id:     "out",
+		Status: pb.Status_SUCCESS,
+		Files: []*pb.File{
+			{Id: "uploaded", Status: pb.Status_SUCCESS},
+			{Id: "missing", Status: pb.Status_SUCCESS},
+		},
+	}
+	checksums.Apply(outgoing)
+	assert.Equal(t, pb.Status_SUCCESS, outgoing.Status)
+	assert.Equal(t, expectedChecksum, outgoing.Files[0].Checksum)
+	assert.Equal(t, pb.Status_SUCCESS, outgoing.Files[0].Status)
+	// unreadable files have no checksum
+	assert.Empty(t, outgoing.Files[1].Checksum)
+	assert.Equal(t, pb.Status_SUCCESS, outgoing.Files[1].Status)
+
+	incoming := &pb.Transfer{
+		Id:     "in",
+		Status: pb.Status_SUCCESS,
+		Files:  []*pb.File{{Id: "downloaded", Status: pb.Status_SUCCESS}},
+	}
+	checksums.Apply(incoming)
+	assert.Equal(t, pb.Status_SUCCESS, incoming.Status)
+	assert.Equal(t, expectedChecksum, incoming.Files[0].Checksum)
+}
+
+func TestPurgeTransfersUntil_PrunesChecksums(t *testing.T) {
+	category.Set(t, category.File)
+
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, []byte("file content"), 0600))
+
+	storagePath := filepath.Join(t.TempDir(), "checksums.json")
+	checksums := NewChecksumStore(storagePath)
+	require.NoError(t, checksums.Load())
+	for _, transferID := range []string{"old", "new"} {
+		checksums.Record(transferID, "file", file)
+		checksums.Wait(transferID)
+	}
+
+	now := time.Now()
+	eventManager := NewEventManager(false, &mockMeshClient{}, &mockEventManagerOsInfo{}, &mockEventManagerFilesystem{}, "")
+	eventManager.SetStorage(&mockStorage{transfers: map[string]*pb.Transfer{
+		"old": {Id: "old", Created: timestamppb.New(now.Add(-time.Hour))},
+		"new": {Id: "new", Created: timestamppb.New(now)},
+	}})
+	eventManager.SetChecksumStore(checksums)
+	require.NoError(t, eventManager.PurgeTransfersUntil(now.Add(-time.Minute)))
+
+	// purged transfers are removed from the checksums file
+	checksums = NewChecksumStore(storagePath)
+	require.NoError(t, checksums.Load())
+	oldTransfer := &pb.Transfer{Id: "old", Files: []*pb.File{{Id: "file"}}}
+	checksums.Apply(oldTransfer)
+	assert.Empty(t, oldTransfer.Files[0].Checksum)
+	newTransfer := &pb.Transfer{Id: "new", Files: []*pb.File{{Id: "file"}}}
+	checksums.Apply(newTransfer)
+	assert.NotEmpty(t, newTransfer.Files[0].Checksum)
+}
@@ -6,10 +6,12 @@ import (
 	"io/fs"
 	"os"
 	"os/user"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
 	"github.com/NordSecurity/nordvpn-linux/log"
@@ -32,6 +34,8 @@ var (
 	ErrNotificationsAlreadyDisabled   = errors.New("notifications already disabled")
 	ErrTransferCanceledByPeer         = errors.New("transfer has been canceled by peer")
 	ErrTransferCanceledByUs           = errors.New("transfer has been canceled by us")
+	ErrTransferAlreadyFinished        = errors.New("transfer has already finished")
+	ErrTransferNotResumable           = errors.New("transfer can't be resumed")
 )
 
 // EventManager is responsible for libdrop event handling.
@@ -49,12 +53,18 @@ type EventManager struct {
 	// removed by Unsubscribe when TransferFinished event is received
 	transferSubscriptions map[string]chan TransferProgressInfo
 	storage               Storage
+	checksums             *ChecksumStore
+	texts                 *TextStore
 	meshClient            meshpb.MeshnetClient
 	fileshare             Fileshare
 	osInfo                OsInfo
 	filesystem            Filesystem
 	notificationManager   *NotificationManager
 	defaultDownloadDir    string
+	// incoming text transfers being downloaded, value is the sender hostname
+	textTransfers map[string]string
+	// called asynchronously with ID of every finalized transfer
+	transferFinishedFuncs []func(transferID string)
 
 	events chan []Event
 }
@@ -71,6 +81,7 @@ func NewEventManager(
 		isProd:                isProd,
 		liveTransfers:         map[string]*LiveTransfer{},
 		transferSubscriptions: map[string]chan TransferProgressInfo{},
+		textTransfers:         map[string]string{},
 		meshClient:            meshClient,
 		osInfo:                osInfo,
 		filesystem:            filesystem,
@@ -130,6 +141,37 @@ func (em *EventManager) SetStorage(storage Storage) {
 	em.storage = storage
 }
 
+// SetChecksumStore used to record checksums of the transferred files
+func (em *EventManager) SetChecksumStore(checksums *ChecksumStore) {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	em.checksums = checksums
+}
+
+// SetTextStore used to receive text snippets and keep them in the transfer history
+func (em *EventManager) SetTextStore(texts *TextStore) {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	em.texts = texts
+}
+
+// SaveSentText keeps the text of the outgoing transfer in the transfer history
+func (em *EventManager) SaveSentText(transferID string, text string) error {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	if em.texts == nil {
+		return nil
+	}
+	return em.texts.Save(transferID, text)
+}
+
+// OnTransferFinished registers fn to be called when a transfer is finalized
+func (em *EventManager) OnTransferFinished(fn func(transferID string)) {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	em.transferFinishedFuncs = append(em.transferFinishedFuncs, fn)
+}
+
 func (em *EventManager) EnableNotifications(fileshare Fileshare) error {
 	em.mutex.Lock()
 	defer em.mutex.Unlock()
@@ -206,6 +248,16 @@ func (em *EventManager) handleRequestReceivedEvent(event EventKindRequestReceive
 		}
 		return
 	}
+	isText := len(event.Files) == 1 && IsTextFile(event.Files[0].Path, event.Files[0].Size)
+	if peer.AlwaysAcceptFiles && em.texts != nil && isText {
+		if err := em.acceptText(event.TransferId, event.Files[0].Id, peer.Hostname); err != nil {
+			log.Error("failed to autoaccept text:", err)
+			if em.notificationManager != nil {
+				em.notificationManager.NotifyAutoacceptFailed(event.TransferId, peer.Hostname, err)
+			}
+		}
+		return
+	}
 	if !peer.AlwaysAcceptFiles {
 		if em.notificationManager != nil {
 			em.notificationManager.NotifyNewTransfer(event.TransferId, peer.Hostname)
@@ -239,6 +291,39 @@ func (em *EventManager) handleRequestReceivedEvent(event EventKindRequestReceive
 	}
 }
 
+// acceptText downloads the text snippet into memory, it is shown to the user once received
+func (em *EventManager) acceptText(transferID string, fileID string, peer string) error {
+	dir, err := em.texts.DownloadDir()
+	if err != nil {
+		return fmt.Errorf("accepting text transfer %s: %w", transferID, err)
+	}
+	if err := em.fileshare.Accept(transferID, dir, fileID); err != nil {
+		return fmt.Errorf("accepting text transfer %s: %w", transferID, err)
+	}
+	em.textTransfers[transferID] = peer
+	return nil
+}
+
+// receiveText moves the downloaded snippet into the text store and shows it to the user
+func (em *EventManager) receiveText(transferID string, path string, peer string) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		log.Errorf("reading text transfer %s: %s", transferID, err)
+		return
+	}
+	if err := os.Remove(path); err != nil {
+		log.Warnf("removing downloaded text of transfer %s: %s", transferID, err)
+	}
+
+	text := string(data)
+	if err := em.texts.Save(transferID, text); err != nil {
+		log.Errorf("saving text transfer %s: %s", transferID, err)
+	}
+	if em.notificationManager != nil {
+		em.notificationManager.NotifyText(transferID, peer, text)
+	}
+}
+
 func (em *EventManager) withProgressCh(transferID string, fn func(ch chan TransferProgressInfo)) {
 	if ch, ok := em.transferSubscriptions[transferID]; ok {
 		fn(ch)
@@ -300,6 +385,14 @@ func (em *EventManager) handleFileDownloadedEvent(event EventKindFileDownloaded)
 		return
 	}
 	file.Finished = true
+	if peer, ok := em.textTransfers[transfer.ID]; ok {
+		delete(em.textTransfers, transfer.ID)
+		em.receiveText(transfer.ID, event.FinalPath, peer)
+		return
+	}
+	if em.checksums != nil {
+		em.checksums.Record(transfer.ID, file.ID, event.FinalPath)
+	}
 
 	fileStatusInNotification := pb.Status_SUCCESS
 	if em.notificationManager != nil && file != nil {
@@ -325,6 +418,10 @@ func (em *EventManager) handleFileUploadedEvent(event EventKindFileUploaded) {
 		return
 	}
 	file.Finished = true
+	// text snippets are sent from memory and have no path
+	if em.checksums != nil && file.FullPath != "" {
+		em.checksums.Record(transfer.ID, file.ID, file.FullPath)
+	}
 
 	fileStatusInNotification := pb.Status_SUCCESS
 	if em.notificationManager != nil && file != nil {
@@ -427,6 +524,11 @@ func (em *EventManager) finalizeTransfer(transfer *LiveTransfer, status pb.Statu
 
 	delete(em.transferSubscriptions, transfer.ID)
 	delete(em.liveTransfers, transfer.ID)
+
+	for _, fn := range em.transferFinishedFuncs {
+		// called outside of the event processing, because fn may start new transfers
+		go fn(transfer.ID)
+	}
 }
 
 // GetTransfers is used for listing transfers.
@@ -443,6 +545,12 @@ func (em *EventManager) GetTransfers() ([]*pb.Transfer, error) {
 	transfers := make([]*pb.Transfer, 0, len(storageTransfers))
 	for _, storageTransfer := range storageTransfers {
 		updatedTransfer := updateTransferWithLiveData(storageTransfer, em.liveTransfers)
+		if em.checksums != nil {
+			em.checksums.Apply(updatedTransfer)
+		}
+		if em.texts != nil {
+			em.texts.Apply(updatedTransfer)
+		}
 		transfers = append(transfers, updatedTransfer)
 	}
 
@@ -466,6 +574,40 @@ func (em *EventManager) CancelLiveTransfers() {
 	}
 }
 
+// PurgeTransfersUntil removes the transfers created before until from the history together with
+// their checksums and texts
+func (em *EventManager) PurgeTransfersUntil(until time.Time) error {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+
+	if err := em.storage.PurgeTransfersUntil(until); err != nil {
+		return err
+	}
+	if em.checksums == nil && em.texts == nil {
+		return nil
+	}
+
+	transfers, err := em.storage.Load()
+	if err != nil {
+		return fmt.Errorf("loading transfers from storage: %w", err)
+	}
+	transferIDs := make([]string, 0, len(transfers))
+	for transferID := range transfers {
+		transferIDs = append(transferIDs, transferID)
+	}
+	if em.checksums != nil {
+		if err := em.checksums.Retain(transferIDs); err != nil {
+			return fmt.Errorf("pruning checksums: %w", err)
+		}
+	}
+	if em.texts != nil {
+		if err := em.texts.Retain(transferIDs); err != nil {
+			return fmt.Errorf("pruning texts: %w", err)
+		}
+	}
+	return nil
+}
+
 // GetTransfer by ID.
 func (em *EventManager) GetTransfer(transferID string) (*pb.Transfer, error) {
 	em.mutex.Lock()
@@ -479,6 +621,12 @@ func (em *EventManager) getTransfer(transferID string) (*pb.Transfer, error) {
 		return nil, err
 	}
 	transfer = updateTransferWithLiveData(transfer, em.liveTransfers)
+	if em.checksums != nil {
+		em.checksums.Apply(transfer)
+	}
+	if em.texts != nil {
+		em.texts.Apply(transfer)
+	}
 	return transfer, nil
 }
 
@@ -521,9 +669,31 @@ func (em *EventManager) AcceptTransfer(
 ) (*pb.Transfer, error) {
 	em.mutex.Lock()
 	defer em.mutex.Unlock()
+	if em.texts != nil {
+		if transfer, err := em.getTransfer(transferID); err == nil && transfer.Type == pb.TransferType_TEXT {
+			return em.acceptTextTransfer(transfer)
+		}
+	}
 	return em.acceptTransfer(transferID, path, filePaths)
 }
 
+// acceptTextTransfer starts downloading the text snippet into memory, independently of the
+// download directory. Callers must not accept the files of the returned transfer again.
+func (em *EventManager) acceptTextTransfer(transfer *pb.Transfer) (*pb.Transfer, error) {
+	if err := checkTransferAcceptable(transfer); err != nil {
+		return nil, err
+	}
+
+	peerName := transfer.Peer
+	if peer, err := getPeerByIP(em.meshClient, transfer.Peer); err == nil {
+		peerName = peer.Hostname
+	}
+	if err := em.acceptText(transfer.Id, transfer.Files[0].Id, peerName); err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
 func (em *EventManager) acceptTransfer(
 	transferID string,
 	path string,
@@ -562,17 +732,8 @@ func (em *EventManager) acceptTransfer(
 	if err != nil {
 		return nil, err
 	}
-	if transfer.Direction != pb.Direction_INCOMING {
-		return nil, ErrTransferAcceptOutgoing
-	}
-	if transfer.Status == pb.Status_CANCELED_BY_PEER {
-		return nil, ErrTransferCanceledByPeer
-	}
-	if transfer.Status == pb.Status_CANCELED {
-		return nil, ErrTransferCanceledByUs
-	}
-	if transfer.Status != pb.Status_REQUESTED {
-		return nil, ErrTransferAlreadyAccepted
+	if err := checkTransferAcceptable(transfer); err != nil {
+		return nil, err
 	}
 
 	var files []*pb.File
@@ -607,6 +768,52 @@ func (em *EventManager) acceptTransfer(
 	return transfer, nil
 }
 
+func checkTransferAcceptable(transfer *pb.Transfer) error {
+	if transfer.Direction != pb.Direction_INCOMING {
+		return ErrTransferAcceptOutgoing
+	}
+	if transfer.Status == pb.Status_CANCELED_BY_PEER {
+		return ErrTransferCanceledByPeer
+	}
+	if transfer.Status == pb.Status_CANCELED {
+		return ErrTransferCanceledByUs
+	}
+	if transfer.Status != pb.Status_REQUESTED {
+		return ErrTransferAlreadyAccepted
+	}
+	return nil
+}
+
+// ResumeTransfer validates the transfer to ensure it can be resumed and drops its live state, so
+// that progress is tracked from the data persisted by the storage
+func (em *EventManager) ResumeTransfer(transferID string) (*pb.Transfer, error) {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+
+	transfer, err := em.getTransfer(transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	//exhaustive:ignore
+	switch transfer.Status {
+	case pb.Status_CANCELED_BY_PEER:
+		return nil, ErrTransferCanceledByPeer
+	case pb.Status_CANCELED:
+		return nil, ErrTransferCanceledByUs
+	case pb.Status_SUCCESS, pb.Status_FINISHED_WITH_ERRORS:
+		return nil, ErrTransferAlreadyFinished
+	case pb.Status_REQUESTED:
+		// incoming transfers which were never accepted have nothing to resume
+		if transfer.Direction == pb.Direction_INCOMING {
+			return nil, ErrTransferNotResumable
+		}
+	}
+
+	delete(em.liveTransfers, transferID)
+	return transfer, nil
+}
+
 func isFileWriteable(fileInfo fs.FileInfo, user *user.User, gids []string) bool {
 	var ownerUID int
 	var ownerGID int
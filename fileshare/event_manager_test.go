@@ -115,6 +115,11 @@ func (*mockEventManagerFileshare) Send(peer netip.Addr, paths []string) (string,
 	return "", nil
 }
 
+// SendText sends the text snippet to provided peer and returns transfer ID
+func (*mockEventManagerFileshare) SendText(peer netip.Addr, text string) (string, error) {
+	return "", nil
+}
+
 // Accept accepts provided files from provided request and starts download process
 func (mfs *mockEventManagerFileshare) Accept(transferID, dstPath string, fileID string) error {
 	mfs.acceptedTransferIDS = append(mfs.acceptedTransferIDS, transferID)
@@ -132,6 +137,11 @@ func (*mockEventManagerFileshare) CancelFile(transferID string, fileID string) e
 	return nil
 }
 
+// Resume re-establishes an interrupted transfer
+func (*mockEventManagerFileshare) Resume(transferID string) error {
+	return nil
+}
+
 func (mfs *mockEventManagerFileshare) getLastAcceptedTransferID() string {
 	length := len(mfs.acceptedTransferIDS)
 	if length == 0 {
@@ -228,6 +238,11 @@ func (m *mockStorage) Load() (map[string]*pb.Transfer, error) {
 }
 
 func (m *mockStorage) PurgeTransfersUntil(until time.Time) error {
+	for id, transfer := range m.transfers {
+		if transfer.Created.AsTime().Before(until) {
+			delete(m.transfers, id)
+		}
+	}
 	return nil
 }
 
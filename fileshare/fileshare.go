@@ -25,12 +25,16 @@ type Fileshare interface {
 	Disable() error
 	// Send sends the provided file or dir to provided peer and returns transfer ID
 	Send(peer netip.Addr, paths []string) (string, error)
+	// SendText sends the text snippet to provided peer and returns transfer ID
+	SendText(peer netip.Addr, text string) (string, error)
 	// Accept accepts provided files from provided request and starts download process
 	Accept(transferID, dstPath string, fileID string) error
 	// Finalize file transfer by ID.
 	Finalize(transferID string) error
 	// CancelFile id in a transfer
 	CancelFile(transferID string, fileID string) error
+	// Resume re-establishes an interrupted transfer, files which already completed are skipped
+	Resume(transferID string) error
 }
 
 // Storage is used for filesharing history persistence
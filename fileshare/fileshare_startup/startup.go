@@ -2,6 +2,7 @@ package fileshare_startup
 
 import (
 	"net"
+	"path/filepath"
 	"time"
 
 	"google.golang.org/grpc"
@@ -15,10 +16,13 @@ import (
 
 const transferHistoryChunkSize = 10000
 const disableTimeout = 5 * time.Second
+const watchBatchTimeout = 5 * time.Second
+const watchRetryTimeout = time.Minute
 
 type FileshareHandle struct {
 	shutdownChan            <-chan struct{}
 	eventManager            *fileshare.EventManager
+	watchManager            *fileshare.WatchManager
 	grpcServer              *grpc.Server
 	fileshareImplementation fileshare.Fileshare
 	grpcConn                *grpc.ClientConn
@@ -32,6 +36,7 @@ func (f *FileshareHandle) GetShutdownChan() <-chan struct{} {
 
 // Shutdown performs graceful shutdown
 func (f *FileshareHandle) Shutdown() {
+	f.watchManager.Stop()
 	f.eventManager.CancelLiveTransfers()
 
 	f.grpcServer.Stop()
@@ -67,9 +72,32 @@ func Startup(storagePath string,
 ) FileshareHandle {
 	shutdownChan := make(chan struct{})
 
+	watchManager := fileshare.NewWatchManager(
+		filepath.Join(filepath.Dir(storagePath), internal.FileshareWatchesFileName),
+		filepath.Join(filepath.Dir(storagePath), internal.FileshareWatchStagingDirName),
+		watchBatchTimeout,
+		watchRetryTimeout,
+	)
+	transferQueue := fileshare.NewTransferQueue(
+		filepath.Join(filepath.Dir(storagePath), internal.FileshareLimitsFileName),
+	)
+	checksums := fileshare.NewChecksumStore(
+		filepath.Join(filepath.Dir(storagePath), internal.FileshareChecksumsFileName),
+	)
+	hook := fileshare.NewPostTransferHook(
+		filepath.Join(filepath.Dir(storagePath), internal.FileshareHookFileName),
+	)
+	texts := fileshare.NewTextStore(
+		filepath.Join(filepath.Dir(storagePath), internal.FileshareTextsFileName),
+		fileshare.TextDownloadDir(),
+	)
+
 	// Fileshare gRPC server init
 	fileshareServer := fileshare.NewServer(fileshareImpl,
 		eventManager,
+		watchManager,
+		transferQueue,
+		hook,
 		meshClient,
 		fileshare.NewStdFilesystem("/"),
 		fileshare.StdOsInfo{},
@@ -83,6 +111,38 @@ func Startup(storagePath string,
 
 	pb.RegisterFileshareServer(grpcServer, fileshareServer)
 
+	if err := transferQueue.Start(fileshareServer.StartTransfer); err != nil {
+		log.Error("starting transfer queue:", err)
+	}
+	eventManager.OnTransferFinished(transferQueue.Finished)
+
+	if err := checksums.Load(); err != nil {
+		log.Error("loading checksums:", err)
+	}
+	eventManager.SetChecksumStore(checksums)
+	if err := hook.Load(); err != nil {
+		log.Error("loading post-transfer hook:", err)
+	}
+	if err := texts.Load(); err != nil {
+		log.Error("loading texts:", err)
+	}
+	eventManager.SetTextStore(texts)
+	eventManager.OnTransferFinished(func(transferID string) {
+		// run the hook only once the checksums of the received files are recorded
+		checksums.Wait(transferID)
+		transfer, err := eventManager.GetTransfer(transferID)
+		if err != nil {
+			log.Errorf("getting finished transfer %s: %s", transferID, err)
+			return
+		}
+		watchManager.TransferFinished(transfer)
+		hook.Run(transfer)
+	})
+
+	if err := watchManager.Start(fileshareServer.SendWatched); err != nil {
+		log.Error("starting watches:", err)
+	}
+
 	go func() {
 		if err := grpcServer.Serve(serverListener); err != nil {
 			log.Fatal(err)
@@ -92,6 +152,7 @@ func Startup(storagePath string,
 	return FileshareHandle{
 		shutdownChan:            shutdownChan,
 		eventManager:            eventManager,
+		watchManager:            watchManager,
 		grpcServer:              grpcServer,
 		fileshareImplementation: fileshareImpl,
 		grpcConn:                grpcConn,
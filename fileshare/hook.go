@@ -0,0 +1,112 @@
+package fileshare
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+)
+
+// Environment variables passed to the post-transfer hook
+const (
+	HookEnvTransferID     = "NORDVPN_TRANSFER_ID"
+	HookEnvTransferPath   = "NORDVPN_TRANSFER_PATH"
+	HookEnvTransferStatus = "NORDVPN_TRANSFER_STATUS"
+	HookEnvTransferPeer   = "NORDVPN_TRANSFER_PEER"
+)
+
+type hookConfig struct {
+	Command string `json:"command"`
+}
+
+// PostTransferHook runs a user configured shell command after every finalized incoming transfer,
+// e.g. to unpack or ingest the received files. The command is persisted in a file, so it
+// survives the restarts of the fileshare process.
+// Thread safe.
+type PostTransferHook struct {
+	mutex       sync.Mutex
+	storagePath string
+	command     string
+}
+
+// NewPostTransferHook creates a hook persisting its command at storagePath
+func NewPostTransferHook(storagePath string) *PostTransferHook {
+	return &PostTransferHook{storagePath: storagePath}
+}
+
+// Load the persisted command. Must be called before using the hook.
+func (h *PostTransferHook) Load() error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	data, err := os.ReadFile(filepath.Clean(h.storagePath))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("loading hook file: %w", err)
+	}
+	var config hookConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("unmarshalling hook: %w", err)
+	}
+	h.command = config.Command
+	return nil
+}
+
+// Command returns the current command, empty if the hook is disabled
+func (h *PostTransferHook) Command() string {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.command
+}
+
+// SetCommand persists the command, empty command disables the hook
+func (h *PostTransferHook) SetCommand(command string) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	data, err := json.Marshal(hookConfig{Command: command})
+	if err != nil {
+		return fmt.Errorf("marshalling hook: %w", err)
+	}
+	if err := os.WriteFile(filepath.Clean(h.storagePath), data, internal.PermUserRW); err != nil {
+		return fmt.Errorf("saving hook file: %w", err)
+	}
+	h.command = command
+	return nil
+}
+
+// Run the command for the finalized transfer. Outgoing and text transfers are ignored. Blocks
+// until the command exits.
+func (h *PostTransferHook) Run(transfer *pb.Transfer) {
+	command := h.Command()
+	if command == "" || transfer.Direction != pb.Direction_INCOMING || transfer.Type == pb.TransferType_TEXT {
+		return
+	}
+
+	// #nosec G204 -- the command is configured by the user running the fileshare process
+	cmd := exec.Command("/bin/sh", "-c", command)
+	cmd.Env = append(os.Environ(), hookEnv(transfer)...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		log.Errorf("post-transfer hook for transfer %s failed: %s: %s", transfer.Id, err, out)
+		return
+	}
+	log.Infof("post-transfer hook for transfer %s finished", transfer.Id)
+}
+
+func hookEnv(transfer *pb.Transfer) []string {
+	return []string{
+		HookEnvTransferID + "=" + transfer.Id,
+		HookEnvTransferPath + "=" + transfer.Path,
+		HookEnvTransferStatus + "=" + transfer.Status.String(),
+		HookEnvTransferPeer + "=" + transfer.Peer,
+	}
+}
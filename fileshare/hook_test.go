@@ -0,0 +1,54 @@
+package fileshare
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostTransferHook(t *testing.T) {
+	category.Set(t, category.File)
+
+	storagePath := filepath.Join(t.TempDir(), "hook.json")
+	output := filepath.Join(t.TempDir(), "output")
+
+	hook := NewPostTransferHook(storagePath)
+	require.NoError(t, hook.Load())
+	assert.Empty(t, hook.Command())
+	require.NoError(t, hook.SetCommand(
+		`echo "$NORDVPN_TRANSFER_ID $NORDVPN_TRANSFER_PATH $NORDVPN_TRANSFER_STATUS $NORDVPN_TRANSFER_PEER" >> `+output))
+
+	// command survives the restarts
+	hook = NewPostTransferHook(storagePath)
+	require.NoError(t, hook.Load())
+
+	hook.Run(&pb.Transfer{
+		Id:        "outgoing",
+		Direction: pb.Direction_OUTGOING,
+		Path:      "/home/user/file",
+		Status:    pb.Status_SUCCESS,
+	})
+	hook.Run(&pb.Transfer{
+		Id:        "incoming",
+		Direction: pb.Direction_INCOMING,
+		Path:      "/home/user/Downloads",
+		Status:    pb.Status_SUCCESS,
+		Peer:      "peer.nord",
+	})
+
+	// hook runs only for the incoming transfers
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, "incoming /home/user/Downloads SUCCESS peer.nord\n", string(data))
+
+	require.NoError(t, hook.SetCommand(""))
+	hook.Run(&pb.Transfer{Id: "disabled", Direction: pb.Direction_INCOMING})
+	data, err = os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, "incoming /home/user/Downloads SUCCESS peer.nord\n", string(data))
+}
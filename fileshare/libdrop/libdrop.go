@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/netip"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/fileshare"
 	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
 	"github.com/NordSecurity/nordvpn-linux/log"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -21,6 +23,7 @@ import (
 // Thread safe.
 type Fileshare struct {
 	norddrop     *norddrop.NordDrop
+	texts        *textResolver
 	eventsDbPath string
 	storagePath  string
 	isProd       bool
@@ -67,9 +70,13 @@ func (dl defaultLogger) Level() norddrop.LogLevel {
 
 type libdropEventCallback struct {
 	eventCallback fileshare.EventCallback
+	texts         *textResolver
 }
 
 func (lec libdropEventCallback) OnEvent(nev norddrop.Event) {
+	if finalized, ok := nev.Kind.(norddrop.EventKindTransferFinalized); ok {
+		lec.texts.release(finalized.TransferId)
+	}
 	ev := libdropEventToInternalEvent(nev)
 	lec.eventCallback.Event(ev)
 }
@@ -197,14 +204,19 @@ func New(
 
 	logger := defaultLogger{logLevel}
 
-	eventCallback := libdropEventCallback{eventCb}
+	texts := newTextResolver()
+	eventCallback := libdropEventCallback{eventCb, texts}
 	norddrop, err := norddrop.NewNordDrop(eventCallback, keyStore, logger)
 	if err != nil {
 		return nil, fmt.Errorf("creating norddrop instance: %w", err)
 	}
+	if err := norddrop.SetFdResolver(texts); err != nil {
+		return nil, fmt.Errorf("setting norddrop fd resolver: %w", err)
+	}
 
 	return &Fileshare{
 		norddrop:     norddrop,
+		texts:        texts,
 		eventsDbPath: eventsDbPath,
 		storagePath:  storagePath,
 		isProd:       isProd,
@@ -301,6 +313,33 @@ func (f *Fileshare) Send(peer netip.Addr, paths []string) (string, error) {
 	return transfer, nil
 }
 
+// SendText to peer. The text is sent from memory as a single file named fileshare.TextFileName.
+// Returns transfer ID.
+func (f *Fileshare) SendText(peer netip.Addr, text string) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if !peer.Is4() {
+		return "", fmt.Errorf("peer %s must be an IPv4 address", peer.String())
+	}
+
+	contentURI := textContentURIPrefix + uuid.NewString()
+	if err := f.texts.add(contentURI, text); err != nil {
+		return "", err
+	}
+
+	transfer, err := f.norddrop.NewTransfer(peer.String(), []norddrop.TransferDescriptor{
+		norddrop.TransferDescriptorFd{Filename: fileshare.TextFileName, ContentUri: contentURI},
+	})
+	if err != nil {
+		f.texts.remove(contentURI)
+		return "", fmt.Errorf("transfer wasn't created")
+	}
+	f.texts.bind(transfer, contentURI)
+
+	return transfer, nil
+}
+
 // Accept starts downloading provided files into dstPath.
 // dstPath must be absolute.
 func (f *Fileshare) Accept(transferID, dstPath string, fileID string) error {
@@ -328,6 +367,62 @@ func (f *Fileshare) CancelFile(transferID string, fileID string) error {
 	return f.norddrop.RejectFile(transferID, fileID)
 }
 
+// Resume interrupted transfer. Incoming files which were accepted but didn't finish are downloaded
+// again into the same directory, norddrop continues the partial files from the last offset which
+// passes checksum verification. Outgoing files are continued once the peer is reconnected.
+func (f *Fileshare) Resume(transferID string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	since := time.Time{}.Unix()
+	norddropTransfers, err := f.norddrop.TransfersSince(since)
+	if err != nil {
+		return fmt.Errorf("getting transfers since %d: %w", since, err)
+	}
+
+	// transfers from the history file of the older app versions are unknown to norddrop
+	index := slices.IndexFunc(norddropTransfers, func(ti norddrop.TransferInfo) bool {
+		return ti.Id == transferID
+	})
+	if index == -1 {
+		return fileshare.ErrTransferNotResumable
+	}
+
+	if incoming, ok := norddropTransfers[index].Kind.(norddrop.TransferKindIncoming); ok {
+		for _, path := range incoming.Paths {
+			baseDir, ok := resumableIncomingPathBaseDir(path)
+			if !ok {
+				continue
+			}
+			if err := f.norddrop.DownloadFile(transferID, path.FileId, baseDir); err != nil {
+				return fmt.Errorf("resuming file %s: %w", path.FileId, err)
+			}
+		}
+	}
+
+	// kick-start the connection to the peer instead of waiting for the automatic retry
+	return f.norddrop.NetworkRefresh()
+}
+
+// resumableIncomingPathBaseDir returns the directory the file was accepted to if the file was
+// accepted and did not complete, fail or get rejected since
+func resumableIncomingPathBaseDir(inPath norddrop.IncomingPath) (string, bool) {
+	//exhaustive:ignore
+	switch statusFromIncomingPath(&inPath) {
+	case pb.Status_PENDING, pb.Status_ONGOING, pb.Status_PAUSED:
+	default:
+		return "", false
+	}
+
+	var baseDir string
+	for _, state := range inPath.States {
+		if st, ok := state.Kind.(norddrop.IncomingPathStateKindPending); ok {
+			baseDir = st.BaseDir
+		}
+	}
+	return baseDir, baseDir != ""
+}
+
 // Load transfers from fileshare implementation storage
 func (f *Fileshare) Load() (map[string]*pb.Transfer, error) {
 	f.mutex.Lock()
@@ -473,6 +568,9 @@ func determineFullOutgoingPath(outPath *norddrop.OutgoingPath) string {
 	switch pathSource := outPath.Source.(type) {
 	case norddrop.OutgoingFileSourceBasePath:
 		return filepath.Join(pathSource.BasePath, outPath.RelativePath)
+	case norddrop.OutgoingFileSourceContentUri:
+		// text snippets are sent from memory
+		return ""
 	default:
 		log.Warnf("unsupported path source: %T", outPath.Source)
 		return ""
@@ -0,0 +1,100 @@
+package libdrop
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/log"
+	"golang.org/x/sys/unix"
+)
+
+const textContentURIPrefix = "nordvpn-text://"
+
+// textResolver provides norddrop with the content of the text snippets being sent. Snippets are
+// kept in anonymous memory files, so they never touch the disk.
+// Thread safe.
+type textResolver struct {
+	mutex sync.Mutex
+	// Key is content URI
+	files map[string]*os.File
+	// Key is transfer ID, value is content URI
+	transfers map[string]string
+}
+
+func newTextResolver() *textResolver {
+	return &textResolver{
+		files:     map[string]*os.File{},
+		transfers: map[string]string{},
+	}
+}
+
+// add creates a memory file holding the text and registers it under the content URI
+func (tr *textResolver) add(contentURI string, text string) error {
+	fd, err := unix.MemfdCreate("nordvpn-text", unix.MFD_CLOEXEC)
+	if err != nil {
+		return fmt.Errorf("creating memory file: %w", err)
+	}
+	file := os.NewFile(uintptr(fd), contentURI) // #nosec G115 -- fd is never negative
+	if _, err := file.WriteString(text); err != nil {
+		file.Close()
+		return fmt.Errorf("writing memory file: %w", err)
+	}
+
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	tr.files[contentURI] = file
+	return nil
+}
+
+// bind the content URI to the transfer, so it is released once the transfer is finalized
+func (tr *textResolver) bind(transferID string, contentURI string) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	tr.transfers[transferID] = contentURI
+}
+
+// remove the memory file registered under the content URI
+func (tr *textResolver) remove(contentURI string) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	if file, ok := tr.files[contentURI]; ok {
+		if err := file.Close(); err != nil {
+			log.Warnf("closing text memory file: %s", err)
+		}
+		delete(tr.files, contentURI)
+	}
+}
+
+// release the memory file of the transfer
+func (tr *textResolver) release(transferID string) {
+	tr.mutex.Lock()
+	contentURI, ok := tr.transfers[transferID]
+	delete(tr.transfers, transferID)
+	tr.mutex.Unlock()
+
+	if ok {
+		tr.remove(contentURI)
+	}
+}
+
+// OnFd returns a new descriptor of the memory file, norddrop takes its ownership. Every
+// descriptor is opened separately, so it reads the text from the beginning.
+func (tr *textResolver) OnFd(contentURI string) *int32 {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	file, ok := tr.files[contentURI]
+	if !ok {
+		log.Warnf("text for %s not found", contentURI)
+		return nil
+	}
+	fd, err := unix.Open(fmt.Sprintf("/proc/self/fd/%d", file.Fd()), unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		log.Errorf("opening text memory file: %s", err)
+		return nil
+	}
+	fd32 := int32(fd) // #nosec G115 -- descriptors are far below the limit
+	return &fd32
+}
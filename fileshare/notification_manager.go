@@ -3,7 +3,9 @@ package fileshare
 import (
 	"errors"
 	"fmt"
+	"os"
 	"os/exec"
+	"strings"
 	"sync"
 
 	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
@@ -17,14 +19,19 @@ const (
 	actionKeyOpenFile       = "open-file"
 	actionKeyAcceptTransfer = "accept-transfer"
 	actionKeyCancelTransfer = "cancel-transfer"
+	actionKeyCopyText       = "copy-text"
 
 	transferAcceptAction = "Accept"
 	transferCancelAction = "Decline"
+	textCopyAction       = "Copy to clipboard"
 
 	notifyNewTransferSummary    = "New file transfer!"
 	notifyNewTransferBody       = "Transfer ID: %s\nFrom: %s"
 	notifyNewAutoacceptTransfer = "New transfer accepted automatically"
 	notifyAutoacceptFailed      = "Failed to autoaccept transfer"
+	notifyNewTextSummary        = "New text from %s"
+	copyTextFailedSummary       = "Failed to copy text to clipboard"
+	copyTextFailedBody          = "Install wl-clipboard, xclip or xsel to copy texts, or find the text with \"nordvpn fileshare list %s\"."
 
 	acceptFailedNotificationSummary     = "Failed to accept transfer"
 	acceptFileFailedNotificationSummary = "Failed to download file"
@@ -122,6 +129,8 @@ func newDbusNotifier(notificationManager *NotificationManager) (*DbusNotifier, e
 			notificationManager.AcceptTransfer(action.ID)
 		case actionKeyCancelTransfer:
 			notificationManager.CancelTransfer(action.ID)
+		case actionKeyCopyText:
+			notificationManager.CopyText(action.ID)
 		default:
 			log.Error("Unknown action key: ", action.ActionKey)
 		}
@@ -156,18 +165,43 @@ func openFileXdg(path string) {
 	}
 }
 
+// copyToClipboard copies the text with the clipboard tool of the running display server
+func copyToClipboard(text string) error {
+	commands := [][]string{
+		{"xclip", "-selection", "clipboard"},
+		{"xsel", "--clipboard", "--input"},
+	}
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		commands = append([][]string{{"wl-copy"}}, commands...)
+	}
+
+	for _, command := range commands {
+		if _, err := exec.LookPath(command[0]); err != nil {
+			continue
+		}
+		// #nosec G204 -- only the predefined commands are run
+		cmd := exec.Command(command[0], command[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		return cmd.Run()
+	}
+	return errors.New("no clipboard tool found")
+}
+
 type notificationsStorage struct {
 	// maps Open action id to file path for downloaded files
 	downloadedFiles map[uint32]string
 	// maps Accept action id to transfer id for incoming transfers
 	transfers map[uint32]string
-	mu        sync.Mutex
+	// maps Copy action id to transfer id for received texts
+	texts map[uint32]string
+	mu    sync.Mutex
 }
 
 func newNotificationStorage() notificationsStorage {
 	return notificationsStorage{
 		downloadedFiles: make(map[uint32]string),
 		transfers:       make(map[uint32]string),
+		texts:           make(map[uint32]string),
 	}
 }
 
@@ -193,6 +227,28 @@ func (ns *notificationsStorage) GetAndDeleteTransferNotification(notificationID
 	return transferID, ok
 }
 
+// AddTextNotification, thread safe
+func (ns *notificationsStorage) AddTextNotification(notificationID uint32, transferID string) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	ns.texts[notificationID] = transferID
+}
+
+// GetAndDeleteTextNotification, returns transfer id of the text associated with given notification
+// id and removes it from the storage. Second return value denotes if given notification id was
+// found in the storage. Thread safe.
+func (ns *notificationsStorage) GetAndDeleteTextNotification(notificationID uint32) (string, bool) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	transferID, ok := ns.texts[notificationID]
+
+	delete(ns.texts, notificationID)
+
+	return transferID, ok
+}
+
 // AddFileNotification, thread safe
 func (ns *notificationsStorage) AddFileNotification(notificationID uint32, file string) {
 	ns.mu.Lock()
@@ -222,6 +278,7 @@ type NotificationManager struct {
 	eventManager       *EventManager
 	fileshare          Fileshare
 	openFileFunc       func(string)
+	copyTextFunc       func(string) error
 	defaultDownloadDir string
 	// fileOperationLock guards OpenFile and NotifyFile operations, as they cannot be performed at the same time
 	fileOperationLock sync.Mutex
@@ -238,6 +295,7 @@ func NewNotificationManager(fileshare Fileshare, eventManager *EventManager) (*N
 		notifications:      newNotificationStorage(),
 		fileshare:          fileshare,
 		openFileFunc:       openFileXdg,
+		copyTextFunc:       copyToClipboard,
 		defaultDownloadDir: defaultDownloadDir,
 		eventManager:       eventManager,
 	}
@@ -370,6 +428,11 @@ func (nm *NotificationManager) AcceptTransfer(notificationID uint32) {
 		return
 	}
 
+	// text snippets are downloaded into memory by the event manager
+	if transfer.Type == pb.TransferType_TEXT {
+		return
+	}
+
 	for _, file := range transfer.Files {
 		if err = nm.fileshare.Accept(transferID, nm.defaultDownloadDir, file.Id); err != nil {
 			nm.sendGenericNotification(acceptFileFailedNotificationSummary, file.Id)
@@ -429,6 +492,40 @@ func (nm *NotificationManager) NotifyNewTransfer(transferID string, peer string)
 	nm.notifications.AddTransferNotification(notificationID, transferID)
 }
 
+// NotifyText creates a pop-up gui notification showing the received text
+func (nm *NotificationManager) NotifyText(transferID string, peer string, text string) {
+	notificationID, err := nm.notifier.SendNotification(
+		fmt.Sprintf(notifyNewTextSummary, peer),
+		text,
+		[]Action{{actionKeyCopyText, textCopyAction}})
+	if err != nil {
+		log.Error("failed to send notification for new text: ", err)
+		return
+	}
+
+	nm.notifications.AddTextNotification(notificationID, transferID)
+}
+
+// CopyText associated with notificationID to the clipboard, generates error notification on failure
+func (nm *NotificationManager) CopyText(notificationID uint32) {
+	transferID, ok := nm.notifications.GetAndDeleteTextNotification(notificationID)
+	if !ok {
+		return
+	}
+
+	transfer, err := nm.eventManager.GetTransfer(transferID)
+	if err != nil {
+		log.Error("Failed to get text transfer from notification manager: ", err)
+		nm.sendGenericNotification(copyTextFailedSummary, genericError)
+		return
+	}
+
+	if err := nm.copyTextFunc(transfer.Text); err != nil {
+		log.Error("Failed to copy text to clipboard: ", err)
+		nm.sendGenericNotification(copyTextFailedSummary, fmt.Sprintf(copyTextFailedBody, transferID))
+	}
+}
+
 // NotifyNewAutoacceptTransfer creates a pop-up gui notification
 func (nm *NotificationManager) NotifyNewAutoacceptTransfer(transferID string, peer string) {
 	body := fmt.Sprintf(notifyNewTransferBody, transferID, peer)
@@ -450,4 +547,5 @@ func (nm *NotificationManager) CloseNotification(notificationID uint32) {
 	defer nm.fileOperationLock.Unlock()
 	nm.notifications.GetAndDeleteFileNotification(notificationID)
 	nm.notifications.GetAndDeleteTransferNotification(notificationID)
+	nm.notifications.GetAndDeleteTextNotification(notificationID)
 }
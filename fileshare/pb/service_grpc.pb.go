@@ -22,11 +22,20 @@ const (
 	Fileshare_Ping_FullMethodName                = "/filesharepb.Fileshare/Ping"
 	Fileshare_Stop_FullMethodName                = "/filesharepb.Fileshare/Stop"
 	Fileshare_Send_FullMethodName                = "/filesharepb.Fileshare/Send"
+	Fileshare_SendText_FullMethodName            = "/filesharepb.Fileshare/SendText"
 	Fileshare_Accept_FullMethodName              = "/filesharepb.Fileshare/Accept"
+	Fileshare_Resume_FullMethodName              = "/filesharepb.Fileshare/Resume"
 	Fileshare_Cancel_FullMethodName              = "/filesharepb.Fileshare/Cancel"
 	Fileshare_List_FullMethodName                = "/filesharepb.Fileshare/List"
 	Fileshare_CancelFile_FullMethodName          = "/filesharepb.Fileshare/CancelFile"
 	Fileshare_SetNotifications_FullMethodName    = "/filesharepb.Fileshare/SetNotifications"
+	Fileshare_SetLimits_FullMethodName           = "/filesharepb.Fileshare/SetLimits"
+	Fileshare_GetLimits_FullMethodName           = "/filesharepb.Fileshare/GetLimits"
+	Fileshare_SetHook_FullMethodName             = "/filesharepb.Fileshare/SetHook"
+	Fileshare_GetHook_FullMethodName             = "/filesharepb.Fileshare/GetHook"
+	Fileshare_AddWatch_FullMethodName            = "/filesharepb.Fileshare/AddWatch"
+	Fileshare_RemoveWatch_FullMethodName         = "/filesharepb.Fileshare/RemoveWatch"
+	Fileshare_ListWatches_FullMethodName         = "/filesharepb.Fileshare/ListWatches"
 	Fileshare_PurgeTransfersUntil_FullMethodName = "/filesharepb.Fileshare/PurgeTransfersUntil"
 )
 
@@ -40,8 +49,12 @@ type FileshareClient interface {
 	Stop(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 	// Send a file to a peer
 	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusResponse], error)
+	// SendText sends a text snippet to a peer, which shows it in a notification
+	SendText(ctx context.Context, in *SendTextRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusResponse], error)
 	// Accept a request from another peer to send you a file
 	Accept(ctx context.Context, in *AcceptRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusResponse], error)
+	// Resume an interrupted transfer keeping its original ID
+	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusResponse], error)
 	// Reject a request from another peer to send you a file
 	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*Error, error)
 	// List all transfers
@@ -50,6 +63,20 @@ type FileshareClient interface {
 	CancelFile(ctx context.Context, in *CancelFileRequest, opts ...grpc.CallOption) (*Error, error)
 	// SetNotifications about transfer status changes
 	SetNotifications(ctx context.Context, in *SetNotificationsRequest, opts ...grpc.CallOption) (*SetNotificationsResponse, error)
+	// SetLimits of the concurrently running transfers
+	SetLimits(ctx context.Context, in *SetLimitsRequest, opts ...grpc.CallOption) (*Error, error)
+	// GetLimits of the concurrently running transfers
+	GetLimits(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetLimitsResponse, error)
+	// SetHook run after every finalized incoming transfer
+	SetHook(ctx context.Context, in *SetHookRequest, opts ...grpc.CallOption) (*Error, error)
+	// GetHook run after every finalized incoming transfer
+	GetHook(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetHookResponse, error)
+	// AddWatch starts sending new or modified files of a directory to a peer automatically
+	AddWatch(ctx context.Context, in *AddWatchRequest, opts ...grpc.CallOption) (*Error, error)
+	// RemoveWatch stops sending the changes of a directory
+	RemoveWatch(ctx context.Context, in *RemoveWatchRequest, opts ...grpc.CallOption) (*Error, error)
+	// ListWatches lists watched directories
+	ListWatches(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListWatchesResponse, error)
 	// PurgeTransfersUntil provided time from fileshare implementation storage
 	PurgeTransfersUntil(ctx context.Context, in *PurgeTransfersUntilRequest, opts ...grpc.CallOption) (*Error, error)
 }
@@ -101,9 +128,28 @@ func (c *fileshareClient) Send(ctx context.Context, in *SendRequest, opts ...grp
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Fileshare_SendClient = grpc.ServerStreamingClient[StatusResponse]
 
+func (c *fileshareClient) SendText(ctx context.Context, in *SendTextRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[1], Fileshare_SendText_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SendTextRequest, StatusResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Fileshare_SendTextClient = grpc.ServerStreamingClient[StatusResponse]
+
 func (c *fileshareClient) Accept(ctx context.Context, in *AcceptRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[1], Fileshare_Accept_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[2], Fileshare_Accept_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +166,25 @@ func (c *fileshareClient) Accept(ctx context.Context, in *AcceptRequest, opts ..
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Fileshare_AcceptClient = grpc.ServerStreamingClient[StatusResponse]
 
+func (c *fileshareClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[3], Fileshare_Resume_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ResumeRequest, StatusResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Fileshare_ResumeClient = grpc.ServerStreamingClient[StatusResponse]
+
 func (c *fileshareClient) Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*Error, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Error)
@@ -132,7 +197,7 @@ func (c *fileshareClient) Cancel(ctx context.Context, in *CancelRequest, opts ..
 
 func (c *fileshareClient) List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[2], Fileshare_List_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[4], Fileshare_List_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -169,6 +234,76 @@ func (c *fileshareClient) SetNotifications(ctx context.Context, in *SetNotificat
 	return out, nil
 }
 
+func (c *fileshareClient) SetLimits(ctx context.Context, in *SetLimitsRequest, opts ...grpc.CallOption) (*Error, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Error)
+	err := c.cc.Invoke(ctx, Fileshare_SetLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileshareClient) GetLimits(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLimitsResponse)
+	err := c.cc.Invoke(ctx, Fileshare_GetLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileshareClient) SetHook(ctx context.Context, in *SetHookRequest, opts ...grpc.CallOption) (*Error, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Error)
+	err := c.cc.Invoke(ctx, Fileshare_SetHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileshareClient) GetHook(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetHookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHookResponse)
+	err := c.cc.Invoke(ctx, Fileshare_GetHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileshareClient) AddWatch(ctx context.Context, in *AddWatchRequest, opts ...grpc.CallOption) (*Error, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Error)
+	err := c.cc.Invoke(ctx, Fileshare_AddWatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileshareClient) RemoveWatch(ctx context.Context, in *RemoveWatchRequest, opts ...grpc.CallOption) (*Error, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Error)
+	err := c.cc.Invoke(ctx, Fileshare_RemoveWatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileshareClient) ListWatches(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListWatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWatchesResponse)
+	err := c.cc.Invoke(ctx, Fileshare_ListWatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fileshareClient) PurgeTransfersUntil(ctx context.Context, in *PurgeTransfersUntilRequest, opts ...grpc.CallOption) (*Error, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Error)
@@ -189,8 +324,12 @@ type FileshareServer interface {
 	Stop(context.Context, *Empty) (*Empty, error)
 	// Send a file to a peer
 	Send(*SendRequest, grpc.ServerStreamingServer[StatusResponse]) error
+	// SendText sends a text snippet to a peer, which shows it in a notification
+	SendText(*SendTextRequest, grpc.ServerStreamingServer[StatusResponse]) error
 	// Accept a request from another peer to send you a file
 	Accept(*AcceptRequest, grpc.ServerStreamingServer[StatusResponse]) error
+	// Resume an interrupted transfer keeping its original ID
+	Resume(*ResumeRequest, grpc.ServerStreamingServer[StatusResponse]) error
 	// Reject a request from another peer to send you a file
 	Cancel(context.Context, *CancelRequest) (*Error, error)
 	// List all transfers
@@ -199,6 +338,20 @@ type FileshareServer interface {
 	CancelFile(context.Context, *CancelFileRequest) (*Error, error)
 	// SetNotifications about transfer status changes
 	SetNotifications(context.Context, *SetNotificationsRequest) (*SetNotificationsResponse, error)
+	// SetLimits of the concurrently running transfers
+	SetLimits(context.Context, *SetLimitsRequest) (*Error, error)
+	// GetLimits of the concurrently running transfers
+	GetLimits(context.Context, *Empty) (*GetLimitsResponse, error)
+	// SetHook run after every finalized incoming transfer
+	SetHook(context.Context, *SetHookRequest) (*Error, error)
+	// GetHook run after every finalized incoming transfer
+	GetHook(context.Context, *Empty) (*GetHookResponse, error)
+	// AddWatch starts sending new or modified files of a directory to a peer automatically
+	AddWatch(context.Context, *AddWatchRequest) (*Error, error)
+	// RemoveWatch stops sending the changes of a directory
+	RemoveWatch(context.Context, *RemoveWatchRequest) (*Error, error)
+	// ListWatches lists watched directories
+	ListWatches(context.Context, *Empty) (*ListWatchesResponse, error)
 	// PurgeTransfersUntil provided time from fileshare implementation storage
 	PurgeTransfersUntil(context.Context, *PurgeTransfersUntilRequest) (*Error, error)
 	mustEmbedUnimplementedFileshareServer()
@@ -220,9 +373,15 @@ func (UnimplementedFileshareServer) Stop(context.Context, *Empty) (*Empty, error
 func (UnimplementedFileshareServer) Send(*SendRequest, grpc.ServerStreamingServer[StatusResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (UnimplementedFileshareServer) SendText(*SendTextRequest, grpc.ServerStreamingServer[StatusResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SendText not implemented")
+}
 func (UnimplementedFileshareServer) Accept(*AcceptRequest, grpc.ServerStreamingServer[StatusResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method Accept not implemented")
 }
+func (UnimplementedFileshareServer) Resume(*ResumeRequest, grpc.ServerStreamingServer[StatusResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
 func (UnimplementedFileshareServer) Cancel(context.Context, *CancelRequest) (*Error, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
 }
@@ -235,6 +394,27 @@ func (UnimplementedFileshareServer) CancelFile(context.Context, *CancelFileReque
 func (UnimplementedFileshareServer) SetNotifications(context.Context, *SetNotificationsRequest) (*SetNotificationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetNotifications not implemented")
 }
+func (UnimplementedFileshareServer) SetLimits(context.Context, *SetLimitsRequest) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLimits not implemented")
+}
+func (UnimplementedFileshareServer) GetLimits(context.Context, *Empty) (*GetLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLimits not implemented")
+}
+func (UnimplementedFileshareServer) SetHook(context.Context, *SetHookRequest) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHook not implemented")
+}
+func (UnimplementedFileshareServer) GetHook(context.Context, *Empty) (*GetHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHook not implemented")
+}
+func (UnimplementedFileshareServer) AddWatch(context.Context, *AddWatchRequest) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWatch not implemented")
+}
+func (UnimplementedFileshareServer) RemoveWatch(context.Context, *RemoveWatchRequest) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWatch not implemented")
+}
+func (UnimplementedFileshareServer) ListWatches(context.Context, *Empty) (*ListWatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatches not implemented")
+}
 func (UnimplementedFileshareServer) PurgeTransfersUntil(context.Context, *PurgeTransfersUntilRequest) (*Error, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgeTransfersUntil not implemented")
 }
@@ -306,6 +486,17 @@ func _Fileshare_Send_Handler(srv interface{}, stream grpc.ServerStream) error {
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Fileshare_SendServer = grpc.ServerStreamingServer[StatusResponse]
 
+func _Fileshare_SendText_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SendTextRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FileshareServer).SendText(m, &grpc.GenericServerStream[SendTextRequest, StatusResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Fileshare_SendTextServer = grpc.ServerStreamingServer[StatusResponse]
+
 func _Fileshare_Accept_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(AcceptRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -317,6 +508,17 @@ func _Fileshare_Accept_Handler(srv interface{}, stream grpc.ServerStream) error
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Fileshare_AcceptServer = grpc.ServerStreamingServer[StatusResponse]
 
+func _Fileshare_Resume_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FileshareServer).Resume(m, &grpc.GenericServerStream[ResumeRequest, StatusResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Fileshare_ResumeServer = grpc.ServerStreamingServer[StatusResponse]
+
 func _Fileshare_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelRequest)
 	if err := dec(in); err != nil {
@@ -382,6 +584,132 @@ func _Fileshare_SetNotifications_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Fileshare_SetLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).SetLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fileshare_SetLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).SetLimits(ctx, req.(*SetLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileshare_GetLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).GetLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fileshare_GetLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).GetLimits(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileshare_SetHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).SetHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fileshare_SetHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).SetHook(ctx, req.(*SetHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileshare_GetHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).GetHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fileshare_GetHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).GetHook(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileshare_AddWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).AddWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fileshare_AddWatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).AddWatch(ctx, req.(*AddWatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileshare_RemoveWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).RemoveWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fileshare_RemoveWatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).RemoveWatch(ctx, req.(*RemoveWatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileshare_ListWatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).ListWatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fileshare_ListWatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).ListWatches(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Fileshare_PurgeTransfersUntil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PurgeTransfersUntilRequest)
 	if err := dec(in); err != nil {
@@ -427,6 +755,34 @@ var Fileshare_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetNotifications",
 			Handler:    _Fileshare_SetNotifications_Handler,
 		},
+		{
+			MethodName: "SetLimits",
+			Handler:    _Fileshare_SetLimits_Handler,
+		},
+		{
+			MethodName: "GetLimits",
+			Handler:    _Fileshare_GetLimits_Handler,
+		},
+		{
+			MethodName: "SetHook",
+			Handler:    _Fileshare_SetHook_Handler,
+		},
+		{
+			MethodName: "GetHook",
+			Handler:    _Fileshare_GetHook_Handler,
+		},
+		{
+			MethodName: "AddWatch",
+			Handler:    _Fileshare_AddWatch_Handler,
+		},
+		{
+			MethodName: "RemoveWatch",
+			Handler:    _Fileshare_RemoveWatch_Handler,
+		},
+		{
+			MethodName: "ListWatches",
+			Handler:    _Fileshare_ListWatches_Handler,
+		},
 		{
 			MethodName: "PurgeTransfersUntil",
 			Handler:    _Fileshare_PurgeTransfersUntil_Handler,
@@ -438,11 +794,21 @@ var Fileshare_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Fileshare_Send_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SendText",
+			Handler:       _Fileshare_SendText_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Accept",
 			Handler:       _Fileshare_Accept_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Resume",
+			Handler:       _Fileshare_Resume_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "List",
 			Handler:       _Fileshare_List_Handler,
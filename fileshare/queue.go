@@ -0,0 +1,271 @@
+package fileshare
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Limits of the outgoing transfers
+type Limits struct {
+	// MaxConcurrentTransfers over which the transfers are queued, 0 disables the limit
+	MaxConcurrentTransfers uint32 `json:"max_concurrent_transfers"`
+}
+
+// QueuedTransfer is an outgoing transfer waiting for a free slot
+type QueuedTransfer struct {
+	ID       string
+	Peer     *meshpb.Peer
+	Paths    []string
+	Priority pb.TransferPriority
+	Created  time.Time
+	// started receives ID of the started transfer, it is closed without a value if the transfer
+	// was removed from the queue or failed to start
+	started chan string
+}
+
+// StartFunc starts the transfer and returns its ID
+type StartFunc func(peer *meshpb.Peer, paths []string) (string, error)
+
+// TransferQueue limits the number of concurrently running outgoing transfers. Transfers over the
+// limit wait in the queue ordered by priority and then by the time they were queued. Queued
+// transfers get a new ID once they are started. Transfers are started without holding the lock,
+// since starting can take long and the finished transfers are reported back to the queue.
+// Thread safe.
+type TransferQueue struct {
+	mutex       sync.Mutex
+	storagePath string
+	limits      Limits
+	start       StartFunc
+	// IDs of the running transfers started through the queue
+	active map[string]bool
+	// number of the transfers being started, they hold a slot until they are active
+	starting int
+	// IDs of the transfers finished while other transfers were being started, so a transfer
+	// finished before it was marked active does not hold the slot
+	finishedWhileStarting map[string]bool
+	queued                []*QueuedTransfer
+}
+
+// NewTransferQueue creates a transfer queue persisting the limits at storagePath
+func NewTransferQueue(storagePath string) *TransferQueue {
+	return &TransferQueue{
+		storagePath:           storagePath,
+		active:                map[string]bool{},
+		finishedWhileStarting: map[string]bool{},
+	}
+}
+
+// Start loads the persisted limits. Must be called before using the queue.
+func (q *TransferQueue) Start(start StartFunc) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.start = start
+	data, err := os.ReadFile(filepath.Clean(q.storagePath))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("loading limits file: %w", err)
+	}
+	if err := json.Unmarshal(data, &q.limits); err != nil {
+		return fmt.Errorf("unmarshalling limits: %w", err)
+	}
+	return nil
+}
+
+// Limits returns the current limits
+func (q *TransferQueue) Limits() Limits {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.limits
+}
+
+// SetLimits persists the limits and starts the queued transfers fitting into them
+func (q *TransferQueue) SetLimits(limits Limits) error {
+	if err := q.setLimits(limits); err != nil {
+		return err
+	}
+	q.startQueued()
+	return nil
+}
+
+func (q *TransferQueue) setLimits(limits Limits) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	data, err := json.Marshal(limits)
+	if err != nil {
+		return fmt.Errorf("marshalling limits: %w", err)
+	}
+	if err := os.WriteFile(filepath.Clean(q.storagePath), data, internal.PermUserRW); err != nil {
+		return fmt.Errorf("saving limits file: %w", err)
+	}
+	q.limits = limits
+	return nil
+}
+
+// Send starts the transfer if the limits allow it, otherwise queues it. For the queued transfers
+// the returned channel receives ID of the transfer once it is started.
+func (q *TransferQueue) Send(
+	peer *meshpb.Peer,
+	paths []string,
+	priority pb.TransferPriority,
+) (string, <-chan string, error) {
+	q.mutex.Lock()
+	if q.hasFreeSlot() && len(q.queued) == 0 {
+		q.starting++
+		q.mutex.Unlock()
+
+		transferID, err := q.start(peer, paths)
+		q.startFinished(transferID, err)
+		// the slot is free again if the transfer failed or has already finished
+		q.startQueued()
+		if err != nil {
+			return "", nil, err
+		}
+		return transferID, nil, nil
+	}
+	defer q.mutex.Unlock()
+
+	transfer := &QueuedTransfer{
+		ID:       uuid.NewString(),
+		Peer:     peer,
+		Paths:    paths,
+		Priority: priority,
+		Created:  time.Now(),
+		started:  make(chan string, 1),
+	}
+	q.queued = append(q.queued, transfer)
+	sort.SliceStable(q.queued, func(i int, j int) bool {
+		return priorityWeight(q.queued[i].Priority) > priorityWeight(q.queued[j].Priority)
+	})
+	return transfer.ID, transfer.started, nil
+}
+
+// Finished frees the slot of the transfer and starts the next queued transfers
+func (q *TransferQueue) Finished(transferID string) {
+	q.mutex.Lock()
+	if !q.active[transferID] {
+		if q.starting > 0 {
+			q.finishedWhileStarting[transferID] = true
+		}
+		q.mutex.Unlock()
+		return
+	}
+	delete(q.active, transferID)
+	q.mutex.Unlock()
+
+	q.startQueued()
+}
+
+// Remove the transfer from the queue. Returns false if the transfer is not queued.
+func (q *TransferQueue) Remove(transferID string) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i, transfer := range q.queued {
+		if transfer.ID == transferID {
+			q.queued = append(q.queued[:i], q.queued[i+1:]...)
+			close(transfer.started)
+			return true
+		}
+	}
+	return false
+}
+
+// Transfers returns the queued transfers in the order they will be started
+func (q *TransferQueue) Transfers() []*pb.Transfer {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	transfers := make([]*pb.Transfer, 0, len(q.queued))
+	for i, transfer := range q.queued {
+		path := "multiple files"
+		if len(transfer.Paths) == 1 {
+			path = transfer.Paths[0]
+		}
+		transfers = append(transfers, &pb.Transfer{
+			Id:            transfer.ID,
+			Direction:     pb.Direction_OUTGOING,
+			Peer:          transfer.Peer.GetPubkey(),
+			Status:        pb.Status_QUEUED,
+			Created:       timestamppb.New(transfer.Created),
+			Path:          path,
+			QueuePosition: uint32(i + 1), // #nosec G115 -- queue length is far below the limit
+		})
+	}
+	return transfers
+}
+
+func (q *TransferQueue) hasFreeSlot() bool {
+	return q.limits.MaxConcurrentTransfers == 0 ||
+		uint32(len(q.active)+q.starting) < q.limits.MaxConcurrentTransfers // #nosec G115
+}
+
+// startQueued starts the queued transfers fitting into the limits. Must be called without
+// holding the lock.
+func (q *TransferQueue) startQueued() {
+	for {
+		q.mutex.Lock()
+		if !q.hasFreeSlot() || len(q.queued) == 0 {
+			q.mutex.Unlock()
+			return
+		}
+		transfer := q.queued[0]
+		q.queued = q.queued[1:]
+		q.starting++
+		q.mutex.Unlock()
+
+		transferID, err := q.start(transfer.Peer, transfer.Paths)
+		q.startFinished(transferID, err)
+		if err != nil {
+			log.Errorf("starting queued transfer %s: %s", transfer.ID, err)
+			close(transfer.started)
+			continue
+		}
+		transfer.started <- transferID
+		close(transfer.started)
+	}
+}
+
+// startFinished releases the slot reserved for starting the transfer and marks the started
+// transfer active
+func (q *TransferQueue) startFinished(transferID string, err error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.starting--
+	if err == nil && !q.finishedWhileStarting[transferID] {
+		q.active[transferID] = true
+	}
+	if q.starting == 0 {
+		clear(q.finishedWhileStarting)
+	}
+}
+
+func priorityWeight(priority pb.TransferPriority) int {
+	switch priority {
+	case pb.TransferPriority_PRIORITY_HIGH:
+		return 2
+	case pb.TransferPriority_PRIORITY_NORMAL:
+		return 1
+	case pb.TransferPriority_PRIORITY_LOW:
+		return 0
+	default:
+		return 1
+	}
+}
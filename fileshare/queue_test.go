@@ -0,0 +1,182 @@
+package fileshare
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStarter struct {
+	started []string
+	err     error
+}
+
+func (m *mockStarter) start(_ *meshpb.Peer, paths []string) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	m.started = append(m.started, paths[0])
+	return fmt.Sprintf("transfer-%s", paths[0]), nil
+}
+
+func newTestQueue(t *testing.T, starter *mockStarter, maxConcurrentTransfers uint32) *TransferQueue {
+	t.Helper()
+
+	queue := NewTransferQueue(filepath.Join(t.TempDir(), "limits.json"))
+	require.NoError(t, queue.Start(starter.start))
+	require.NoError(t, queue.SetLimits(Limits{MaxConcurrentTransfers: maxConcurrentTransfers}))
+	return queue
+}
+
+func TestTransferQueue_Unlimited(t *testing.T) {
+	category.Set(t, category.File)
+
+	starter := &mockStarter{}
+	queue := newTestQueue(t, starter, 0)
+
+	for _, path := range []string{"a", "b", "c"} {
+		transferID, started, err := queue.Send(&meshpb.Peer{}, []string{path}, pb.TransferPriority_PRIORITY_NORMAL)
+		assert.NoError(t, err)
+		assert.Nil(t, started)
+		assert.Equal(t, "transfer-"+path, transferID)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, starter.started)
+	assert.Empty(t, queue.Transfers())
+}
+
+func TestTransferQueue_StartsByPriority(t *testing.T) {
+	category.Set(t, category.File)
+
+	starter := &mockStarter{}
+	queue := newTestQueue(t, starter, 1)
+
+	_, started, err := queue.Send(&meshpb.Peer{}, []string{"running"}, pb.TransferPriority_PRIORITY_LOW)
+	require.NoError(t, err)
+	assert.Nil(t, started)
+
+	send := func(path string, priority pb.TransferPriority) <-chan string {
+		_, started, err := queue.Send(&meshpb.Peer{Pubkey: "pubkey"}, []string{path}, priority)
+		require.NoError(t, err)
+		require.NotNil(t, started)
+		return started
+	}
+	low := send("low", pb.TransferPriority_PRIORITY_LOW)
+	normal := send("normal", pb.TransferPriority_PRIORITY_NORMAL)
+	high1 := send("high1", pb.TransferPriority_PRIORITY_HIGH)
+	high2 := send("high2", pb.TransferPriority_PRIORITY_HIGH)
+
+	transfers := queue.Transfers()
+	require.Len(t, transfers, 4)
+	for i, path := range []string{"high1", "high2", "normal", "low"} {
+		assert.Equal(t, path, transfers[i].Path)
+		assert.Equal(t, pb.Status_QUEUED, transfers[i].Status)
+		assert.Equal(t, pb.Direction_OUTGOING, transfers[i].Direction)
+		assert.Equal(t, "pubkey", transfers[i].Peer)
+		assert.Equal(t, uint32(i+1), transfers[i].QueuePosition)
+	}
+
+	// transfers not started through the queue don't free the slots
+	queue.Finished("unknown")
+	assert.Equal(t, []string{"running"}, starter.started)
+
+	queue.Finished("transfer-running")
+	assert.Equal(t, "transfer-high1", <-high1)
+	queue.Finished("transfer-high1")
+	assert.Equal(t, "transfer-high2", <-high2)
+	queue.Finished("transfer-high2")
+	assert.Equal(t, "transfer-normal", <-normal)
+	queue.Finished("transfer-normal")
+	assert.Equal(t, "transfer-low", <-low)
+	assert.Empty(t, queue.Transfers())
+}
+
+func TestTransferQueue_Remove(t *testing.T) {
+	category.Set(t, category.File)
+
+	starter := &mockStarter{}
+	queue := newTestQueue(t, starter, 1)
+
+	_, _, err := queue.Send(&meshpb.Peer{}, []string{"running"}, pb.TransferPriority_PRIORITY_NORMAL)
+	require.NoError(t, err)
+	queuedID, started, err := queue.Send(&meshpb.Peer{}, []string{"queued"}, pb.TransferPriority_PRIORITY_NORMAL)
+	require.NoError(t, err)
+
+	assert.False(t, queue.Remove("transfer-running"))
+	assert.True(t, queue.Remove(queuedID))
+	_, ok := <-started
+	assert.False(t, ok)
+	assert.Empty(t, queue.Transfers())
+
+	queue.Finished("transfer-running")
+	assert.Equal(t, []string{"running"}, starter.started)
+}
+
+func TestTransferQueue_SetLimitsStartsQueued(t *testing.T) {
+	category.Set(t, category.File)
+
+	starter := &mockStarter{}
+	storagePath := filepath.Join(t.TempDir(), "limits.json")
+	queue := NewTransferQueue(storagePath)
+	require.NoError(t, queue.Start(starter.start))
+	require.NoError(t, queue.SetLimits(Limits{MaxConcurrentTransfers: 1}))
+
+	_, _, err := queue.Send(&meshpb.Peer{}, []string{"a"}, pb.TransferPriority_PRIORITY_NORMAL)
+	require.NoError(t, err)
+	_, started, err := queue.Send(&meshpb.Peer{}, []string{"b"}, pb.TransferPriority_PRIORITY_NORMAL)
+	require.NoError(t, err)
+	require.NotNil(t, started)
+
+	require.NoError(t, queue.SetLimits(Limits{MaxConcurrentTransfers: 2}))
+	assert.Equal(t, "transfer-b", <-started)
+
+	// limits survive the restarts
+	queue = NewTransferQueue(storagePath)
+	require.NoError(t, queue.Start(starter.start))
+	assert.Equal(t, Limits{MaxConcurrentTransfers: 2}, queue.Limits())
+}
+
+func TestTransferQueue_StartFailure(t *testing.T) {
+	category.Set(t, category.File)
+
+	starter := &mockStarter{}
+	queue := newTestQueue(t, starter, 1)
+
+	_, _, err := queue.Send(&meshpb.Peer{}, []string{"a"}, pb.TransferPriority_PRIORITY_NORMAL)
+	require.NoError(t, err)
+	_, started, err := queue.Send(&meshpb.Peer{}, []string{"b"}, pb.TransferPriority_PRIORITY_NORMAL)
+	require.NoError(t, err)
+
+	starter.err = errors.New("peer offline")
+	queue.Finished("transfer-a")
+	_, ok := <-started
+	assert.False(t, ok)
+	assert.Empty(t, queue.Transfers())
+}
+
+func TestTransferQueue_TransferFinishedWhileStarting(t *testing.T) {
+	category.Set(t, category.File)
+
+	queue := NewTransferQueue(filepath.Join(t.TempDir(), "limits.json"))
+	// the transfer is reported finished before the start returns, e.g. when all files are rejected
+	require.NoError(t, queue.Start(func(_ *meshpb.Peer, paths []string) (string, error) {
+		transferID := "transfer-" + paths[0]
+		queue.Finished(transferID)
+		return transferID, nil
+	}))
+	require.NoError(t, queue.SetLimits(Limits{MaxConcurrentTransfers: 1}))
+
+	for _, path := range []string{"a", "b"} {
+		transferID, started, err := queue.Send(&meshpb.Peer{}, []string{path}, pb.TransferPriority_PRIORITY_NORMAL)
+		require.NoError(t, err)
+		assert.Nil(t, started)
+		assert.Equal(t, "transfer-"+path, transferID)
+	}
+	assert.Empty(t, queue.Transfers())
+}
@@ -3,6 +3,7 @@ package fileshare
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/netip"
 	"strings"
 
@@ -36,6 +37,9 @@ type Server struct {
 	// Errors on Fileshare methods shouldn't be logged, because they are logged by the library itself.
 	fileshare     Fileshare
 	eventManager  *EventManager
+	watchManager  *WatchManager
+	queue         *TransferQueue
+	hook          *PostTransferHook
 	meshClient    meshpb.MeshnetClient
 	filesystem    Filesystem
 	osInfo        OsInfo
@@ -47,6 +51,9 @@ type Server struct {
 func NewServer(
 	fileshare Fileshare,
 	eventManager *EventManager,
+	watchManager *WatchManager,
+	queue *TransferQueue,
+	hook *PostTransferHook,
 	meshClient meshpb.MeshnetClient,
 	filesystem Filesystem,
 	osInfo OsInfo,
@@ -56,6 +63,9 @@ func NewServer(
 	return &Server{
 		fileshare:     fileshare,
 		eventManager:  eventManager,
+		watchManager:  watchManager,
+		queue:         queue,
+		hook:          hook,
 		meshClient:    meshClient,
 		filesystem:    filesystem,
 		osInfo:        osInfo,
@@ -201,21 +211,65 @@ func (s *Server) Send(req *pb.SendRequest, srv pb.Fileshare_SendServer) error {
 		}
 	}
 
-	peerPubkeyToPeer, peerNameToPeer, err := s.getPeers()
+	peer, peerErr := s.sendingPeer(req.Peer)
+	if peerErr != nil {
+		return srv.Send(&pb.StatusResponse{Error: peerErr})
+	}
+
+	transferID, started, err := s.queue.Send(peer, req.Paths, req.GetPriority())
 	if err != nil {
-		return srv.Send(&pb.StatusResponse{Error: serviceError(pb.ServiceErrorCode_INTERNAL_FAILURE)})
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_CREATED)})
 	}
 
-	peer, ok := peerPubkeyToPeer[req.Peer]
-	if !ok {
-		peer, ok = peerNameToPeer[strings.ToLower(req.Peer)]
-		if !ok {
-			return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_INVALID_PEER)})
+	if started != nil {
+		if err := srv.Send(&pb.StatusResponse{TransferId: transferID, Status: pb.Status_QUEUED}); err != nil {
+			return err
+		}
+
+		if req.GetSilent() { // queued transfer is started in the background, if asked
+			return nil
+		}
+
+		select {
+		case startedID, ok := <-started:
+			if !ok {
+				return srv.Send(&pb.StatusResponse{TransferId: transferID, Status: pb.Status_CANCELED})
+			}
+			transferID = startedID
+		case <-srv.Context().Done():
+			return nil
 		}
 	}
 
-	if peer.Status == meshpb.PeerStatus_DISCONNECTED {
-		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_PEER_DISCONNECTED)})
+	if err := srv.Send(&pb.StatusResponse{TransferId: transferID, Status: pb.Status_REQUESTED}); err != nil {
+		return err
+	}
+
+	if req.GetSilent() { // report no progress back, if asked
+		return nil
+	}
+
+	return s.startTransferStatusStream(srv, transferID)
+}
+
+// SendText rpc
+func (s *Server) SendText(req *pb.SendTextRequest, srv pb.Fileshare_SendTextServer) error {
+	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
+	if err != nil || !resp.GetStatus().GetValue() {
+		return srv.Send(&pb.StatusResponse{Error: serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED)})
+	}
+
+	if req.Text == "" {
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TEXT_EMPTY)})
+	}
+
+	if len(req.Text) > TextSizeLimit {
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TEXT_TOO_LONG)})
+	}
+
+	peer, peerErr := s.sendingPeer(req.Peer)
+	if peerErr != nil {
+		return srv.Send(&pb.StatusResponse{Error: peerErr})
 	}
 
 	parsedIP, err := netip.ParseAddr(peer.Ip)
@@ -223,25 +277,22 @@ func (s *Server) Send(req *pb.SendRequest, srv pb.Fileshare_SendServer) error {
 		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_INVALID_PEER)})
 	}
 
-	if !peer.IsFileshareAllowed {
-		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_SENDING_NOT_ALLOWED)})
-	}
-
-	transferID, err := s.fileshare.Send(parsedIP, req.Paths)
+	// texts are small, so they bypass the transfer queue
+	transferID, err := s.fileshare.SendText(parsedIP, req.Text)
 	if err != nil {
 		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_CREATED)})
 	}
 
-	// Ignore response here
-	fileName := ""
-	if len(req.Paths) == 1 {
-		fileName = req.Paths[0]
+	if err := s.eventManager.SaveSentText(transferID, req.Text); err != nil {
+		log.Errorf("saving text of transfer %s: %s", transferID, err)
 	}
+
+	// Ignore response here
 	go s.meshClient.NotifyNewTransfer(context.Background(), &meshpb.NewTransferNotification{
 		Identifier: peer.Identifier,
 		Os:         peer.Os,
-		FileName:   fileName,
-		FileCount:  int32(len(req.Paths)), // #nosec G115
+		FileName:   TextFileName,
+		FileCount:  1,
 		TransferId: transferID,
 	})
 
@@ -256,6 +307,88 @@ func (s *Server) Send(req *pb.SendRequest, srv pb.Fileshare_SendServer) error {
 	return s.startTransferStatusStream(srv, transferID)
 }
 
+// sendingPeer finds the peer by its pubkey or name and checks if it can receive transfers
+func (s *Server) sendingPeer(name string) (*meshpb.Peer, *pb.Error) {
+	peerPubkeyToPeer, peerNameToPeer, err := s.getPeers()
+	if err != nil {
+		return nil, serviceError(pb.ServiceErrorCode_INTERNAL_FAILURE)
+	}
+
+	peer, ok := peerPubkeyToPeer[name]
+	if !ok {
+		peer, ok = peerNameToPeer[strings.ToLower(name)]
+		if !ok {
+			return nil, fileshareError(pb.FileshareErrorCode_INVALID_PEER)
+		}
+	}
+
+	if peer.Status == meshpb.PeerStatus_DISCONNECTED {
+		return nil, fileshareError(pb.FileshareErrorCode_PEER_DISCONNECTED)
+	}
+
+	if _, err := netip.ParseAddr(peer.Ip); err != nil {
+		return nil, fileshareError(pb.FileshareErrorCode_INVALID_PEER)
+	}
+
+	if !peer.IsFileshareAllowed {
+		return nil, fileshareError(pb.FileshareErrorCode_SENDING_NOT_ALLOWED)
+	}
+
+	return peer, nil
+}
+
+// StartTransfer sends the paths to the peer and notifies the peer about the new transfer
+func (s *Server) StartTransfer(peer *meshpb.Peer, paths []string) (string, error) {
+	parsedIP, err := netip.ParseAddr(peer.Ip)
+	if err != nil {
+		return "", fmt.Errorf("parsing peer IP: %w", err)
+	}
+
+	transferID, err := s.fileshare.Send(parsedIP, paths)
+	if err != nil {
+		return "", err
+	}
+
+	// Ignore response here
+	fileName := ""
+	if len(paths) == 1 {
+		fileName = paths[0]
+	}
+	go s.meshClient.NotifyNewTransfer(context.Background(), &meshpb.NewTransferNotification{
+		Identifier: peer.Identifier,
+		Os:         peer.Os,
+		FileName:   fileName,
+		FileCount:  int32(len(paths)), // #nosec G115
+		TransferId: transferID,
+	})
+
+	return transferID, nil
+}
+
+// watchSendServer collects the response of Send called for the changes of the watched directories
+type watchSendServer struct {
+	pb.Fileshare_SendServer
+	response *pb.StatusResponse
+}
+
+func (w *watchSendServer) Send(resp *pb.StatusResponse) error {
+	w.response = resp
+	return nil
+}
+
+// SendWatched sends the changes of a watched directory to the peer in the background
+func (s *Server) SendWatched(peer string, paths []string) error {
+	srv := &watchSendServer{}
+	if err := s.Send(&pb.SendRequest{Peer: peer, Paths: paths, Silent: true}, srv); err != nil {
+		return err
+	}
+	if srv.response.GetError() != nil {
+		return fmt.Errorf("sending %d paths: %s", len(paths), srv.response.GetError())
+	}
+	log.Infof("sent %d paths to %s in transfer %s", len(paths), peer, srv.response.GetTransferId())
+	return nil
+}
+
 // Accept rpc
 func (s *Server) Accept(req *pb.AcceptRequest, srv pb.Fileshare_AcceptServer) error {
 	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
@@ -295,6 +428,27 @@ func (s *Server) Accept(req *pb.AcceptRequest, srv pb.Fileshare_AcceptServer) er
 		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_LIB_FAILURE)})
 	}
 
+	// text snippets are downloaded into memory by the event manager
+	transferStarted := transfer.Type == pb.TransferType_TEXT || s.acceptFiles(req, transfer)
+
+	if !transferStarted {
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_ACCEPT_ALL_FILES_FAILED)})
+	}
+
+	if err := srv.Send(&pb.StatusResponse{TransferId: transfer.Id, Status: pb.Status_REQUESTED}); err != nil {
+		return err
+	}
+
+	if req.GetSilent() { // report no progress back, if asked
+		return nil
+	}
+
+	return s.startTransferStatusStream(srv, transfer.Id)
+}
+
+// acceptFiles of the transfer requested by the user and cancels the remaining ones, returns false
+// if none of the files could be accepted
+func (s *Server) acceptFiles(req *pb.AcceptRequest, transfer *pb.Transfer) bool {
 	transferStarted := false
 	// if user has given command to accept only one (or some) file in whole transfer
 	// given files should be accepted, but other files has to be canceled for whole transfer to get processed at once
@@ -317,12 +471,38 @@ func (s *Server) Accept(req *pb.AcceptRequest, srv pb.Fileshare_AcceptServer) er
 			}
 		}
 	}
+	return transferStarted
+}
 
-	if !transferStarted {
-		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_ACCEPT_ALL_FILES_FAILED)})
+// Resume rpc
+func (s *Server) Resume(req *pb.ResumeRequest, srv pb.Fileshare_ResumeServer) error {
+	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
+	if err != nil || !resp.GetStatus().GetValue() {
+		return srv.Send(&pb.StatusResponse{Error: serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED)})
 	}
 
-	if err := srv.Send(&pb.StatusResponse{TransferId: transfer.Id, Status: pb.Status_REQUESTED}); err != nil {
+	transfer, err := s.eventManager.ResumeTransfer(req.TransferId)
+	if err == nil {
+		err = s.fileshare.Resume(transfer.Id)
+	}
+
+	switch {
+	case errors.Is(err, ErrTransferNotFound):
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_FOUND)})
+	case errors.Is(err, ErrTransferNotResumable):
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_RESUMABLE)})
+	case errors.Is(err, ErrTransferCanceledByUs),
+		errors.Is(err, ErrTransferCanceledByPeer),
+		errors.Is(err, ErrTransferAlreadyFinished):
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TRANSFER_INVALIDATED)})
+	case err == nil:
+		break
+	default:
+		log.Errorf("error while resuming transfer %s: %s", req.TransferId, err)
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_LIB_FAILURE)})
+	}
+
+	if err := srv.Send(&pb.StatusResponse{TransferId: transfer.Id, Status: pb.Status_ONGOING}); err != nil {
 		return err
 	}
 
@@ -343,6 +523,10 @@ func (s *Server) Cancel(
 		return serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED), nil
 	}
 
+	if s.queue.Remove(req.GetTransferId()) {
+		return empty(), nil
+	}
+
 	transfer, err := s.eventManager.GetTransfer(req.GetTransferId())
 	switch {
 	case errors.Is(err, ErrTransferNotFound):
@@ -365,6 +549,97 @@ func (s *Server) Cancel(
 	return empty(), nil
 }
 
+// AddWatch rpc
+func (s *Server) AddWatch(ctx context.Context, req *pb.AddWatchRequest) (*pb.Error, error) {
+	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
+	if err != nil || !resp.GetStatus().GetValue() {
+		return serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED), nil
+	}
+
+	isDirectory, err := s.isDirectory(req.Path)
+	if err != nil {
+		return fileshareError(pb.FileshareErrorCode_FILE_NOT_FOUND), nil
+	}
+	if !isDirectory {
+		return fileshareError(pb.FileshareErrorCode_WATCH_NOT_A_DIRECTORY), nil
+	}
+
+	peerPubkeyToPeer, peerNameToPeer, err := s.getPeers()
+	if err != nil {
+		return serviceError(pb.ServiceErrorCode_INTERNAL_FAILURE), nil
+	}
+
+	peer, ok := peerPubkeyToPeer[req.Peer]
+	if !ok {
+		peer, ok = peerNameToPeer[strings.ToLower(req.Peer)]
+		if !ok {
+			return fileshareError(pb.FileshareErrorCode_INVALID_PEER), nil
+		}
+	}
+
+	if !peer.IsFileshareAllowed {
+		return fileshareError(pb.FileshareErrorCode_SENDING_NOT_ALLOWED), nil
+	}
+
+	err = s.watchManager.Add(req.Path, peer.Pubkey)
+	switch {
+	case errors.Is(err, ErrWatchAlreadyExists):
+		return fileshareError(pb.FileshareErrorCode_WATCH_ALREADY_EXISTS), nil
+	case err == nil:
+		return empty(), nil
+	default:
+		log.Errorf("error while adding watch of %s: %s", req.Path, err)
+		return fileshareError(pb.FileshareErrorCode_WATCH_FAILURE), nil
+	}
+}
+
+// RemoveWatch rpc
+func (s *Server) RemoveWatch(ctx context.Context, req *pb.RemoveWatchRequest) (*pb.Error, error) {
+	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
+	if err != nil || !resp.GetStatus().GetValue() {
+		return serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED), nil
+	}
+
+	err = s.watchManager.Remove(req.Path)
+	switch {
+	case errors.Is(err, ErrWatchNotFound):
+		return fileshareError(pb.FileshareErrorCode_WATCH_NOT_FOUND), nil
+	case err == nil:
+		return empty(), nil
+	default:
+		log.Errorf("error while removing watch of %s: %s", req.Path, err)
+		return fileshareError(pb.FileshareErrorCode_WATCH_FAILURE), nil
+	}
+}
+
+// ListWatches rpc
+func (s *Server) ListWatches(ctx context.Context, _ *pb.Empty) (*pb.ListWatchesResponse, error) {
+	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
+	if err != nil || !resp.GetStatus().GetValue() {
+		return &pb.ListWatchesResponse{Error: serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED)}, nil
+	}
+
+	peerPubkeyToPeer, _, err := s.getPeers()
+	if err != nil {
+		return &pb.ListWatchesResponse{Error: serviceError(pb.ServiceErrorCode_INTERNAL_FAILURE)}, nil
+	}
+
+	var watches []*pb.Watch
+	for _, watch := range s.watchManager.List() {
+		peerName := watch.Peer
+		if peer, ok := peerPubkeyToPeer[watch.Peer]; ok {
+			if peer.Nickname != "" {
+				peerName = peer.Nickname
+			} else {
+				peerName = peer.Hostname
+			}
+		}
+		watches = append(watches, &pb.Watch{Path: watch.Path, Peer: peerName})
+	}
+
+	return &pb.ListWatchesResponse{Error: empty(), Watches: watches}, nil
+}
+
 // List rpc
 func (s *Server) List(_ *pb.Empty, srv pb.Fileshare_ListServer) error {
 	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
@@ -382,6 +657,7 @@ func (s *Server) List(_ *pb.Empty, srv pb.Fileshare_ListServer) error {
 		log.Errorf("getting transfer list: %s", err)
 		return srv.Send(&pb.ListResponse{Error: fileshareError(pb.FileshareErrorCode_LIB_FAILURE)})
 	}
+	transfers = append(transfers, s.queue.Transfers()...)
 	for _, transfer := range transfers {
 		peer, ok := peerPubkeyToPeer[transfer.Peer]
 		if !ok {
@@ -479,13 +755,65 @@ func (s *Server) SetNotifications(ctx context.Context, in *pb.SetNotificationsRe
 	}
 }
 
+// SetLimits rpc
+func (s *Server) SetLimits(ctx context.Context, req *pb.SetLimitsRequest) (*pb.Error, error) {
+	// upload rates are enforced by the firewall of the daemon, libdrop does not throttle the
+	// transfers
+	resp, err := s.meshClient.SetFileshareRateLimit(context.Background(), &meshpb.FileshareRateLimit{
+		Total: req.GetMaxUploadRate(),
+		Peer:  req.GetMaxPeerUploadRate(),
+	})
+	if err != nil || resp.GetEmpty() == nil {
+		log.Errorf("error while setting upload rate limits: %s, %s", err, resp)
+		return fileshareError(pb.FileshareErrorCode_LIMITS_FAILURE), nil
+	}
+
+	err = s.queue.SetLimits(Limits{MaxConcurrentTransfers: req.GetMaxConcurrentTransfers()})
+	if err != nil {
+		log.Errorf("error while setting limits: %s", err)
+		return fileshareError(pb.FileshareErrorCode_LIMITS_FAILURE), nil
+	}
+	return empty(), nil
+}
+
+// GetLimits rpc
+func (s *Server) GetLimits(ctx context.Context, _ *pb.Empty) (*pb.GetLimitsResponse, error) {
+	resp, err := s.meshClient.GetFileshareRateLimit(context.Background(), &meshpb.Empty{})
+	if err != nil || resp.GetRateLimit() == nil {
+		log.Errorf("error while getting upload rate limits: %s, %s", err, resp)
+		return &pb.GetLimitsResponse{Error: fileshareError(pb.FileshareErrorCode_LIMITS_FAILURE)}, nil
+	}
+
+	limits := s.queue.Limits()
+	return &pb.GetLimitsResponse{
+		Error:                  empty(),
+		MaxConcurrentTransfers: limits.MaxConcurrentTransfers,
+		MaxUploadRate:          resp.GetRateLimit().GetTotal(),
+		MaxPeerUploadRate:      resp.GetRateLimit().GetPeer(),
+	}, nil
+}
+
+// SetHook rpc
+func (s *Server) SetHook(ctx context.Context, req *pb.SetHookRequest) (*pb.Error, error) {
+	if err := s.hook.SetCommand(strings.TrimSpace(req.GetCommand())); err != nil {
+		log.Errorf("error while setting post-transfer hook: %s", err)
+		return fileshareError(pb.FileshareErrorCode_HOOK_FAILURE), nil
+	}
+	return empty(), nil
+}
+
+// GetHook rpc
+func (s *Server) GetHook(ctx context.Context, _ *pb.Empty) (*pb.GetHookResponse, error) {
+	return &pb.GetHookResponse{Error: empty(), Command: s.hook.Command()}, nil
+}
+
 func (s *Server) PurgeTransfersUntil(ctx context.Context, req *pb.PurgeTransfersUntilRequest) (*pb.Error, error) {
 	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
 	if err != nil || !resp.GetStatus().GetValue() {
 		return serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED), nil
 	}
 
-	err = s.eventManager.storage.PurgeTransfersUntil(req.Until.AsTime())
+	err = s.eventManager.PurgeTransfersUntil(req.Until.AsTime())
 	if err != nil {
 		log.Errorf("error while purging transfers: %s", err)
 		return fileshareError(pb.FileshareErrorCode_PURGE_FAILURE), nil
@@ -8,10 +8,13 @@ import (
 	"net/netip"
 	"os"
 	"os/user"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"syscall"
 	"testing"
 	"testing/fstest"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
 	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
@@ -32,6 +35,9 @@ type mockServerFileshare struct {
 	destinationPeer        string
 	acceptedFiles          []string
 	canceledFiles          []string
+	resumeReturnValue      error
+	resumedTransfers       []string
+	sentTexts              []string
 }
 
 func isFileListEqual(t *testing.T, lhs []string, rhs []string) bool {
@@ -64,6 +70,12 @@ func (m *mockServerFileshare) Send(peer netip.Addr, paths []string) (string, err
 	return "", nil
 }
 
+func (m *mockServerFileshare) SendText(peer netip.Addr, text string) (string, error) {
+	m.destinationPeer = peer.String()
+	m.sentTexts = append(m.sentTexts, text)
+	return "", nil
+}
+
 func (m *mockServerFileshare) Accept(transferID, dstPath string, fileID string) error {
 	m.acceptedFiles = append(m.acceptedFiles, fileID)
 
@@ -72,6 +84,11 @@ func (m *mockServerFileshare) Accept(transferID, dstPath string, fileID string)
 	return err
 }
 
+func (m *mockServerFileshare) Resume(transferID string) error {
+	m.resumedTransfers = append(m.resumedTransfers, transferID)
+	return m.resumeReturnValue
+}
+
 type mockAcceptServer struct {
 	pb.Fileshare_AcceptServer
 	serverError error
@@ -156,6 +173,7 @@ type mockMeshClient struct {
 	externalPeers  []*meshpb.Peer
 	selfPeer       *meshpb.Peer
 	getPeersCalled bool
+	rateLimit      *meshpb.FileshareRateLimit
 }
 
 // IsEnabled mock implementation
@@ -178,6 +196,23 @@ func (m *mockMeshClient) GetPeers(ctx context.Context, in *meshpb.Empty, opts ..
 	return response, nil
 }
 
+// SetFileshareRateLimit mock implementation
+func (m *mockMeshClient) SetFileshareRateLimit(ctx context.Context, in *meshpb.FileshareRateLimit, opts ...grpc.CallOption) (*meshpb.MeshnetResponse, error) {
+	m.rateLimit = in
+	return &meshpb.MeshnetResponse{Response: &meshpb.MeshnetResponse_Empty{Empty: &meshpb.Empty{}}}, nil
+}
+
+// GetFileshareRateLimit mock implementation
+func (m *mockMeshClient) GetFileshareRateLimit(ctx context.Context, in *meshpb.Empty, opts ...grpc.CallOption) (*meshpb.GetFileshareRateLimitResponse, error) {
+	rateLimit := m.rateLimit
+	if rateLimit == nil {
+		rateLimit = &meshpb.FileshareRateLimit{}
+	}
+	return &meshpb.GetFileshareRateLimitResponse{
+		Response: &meshpb.GetFileshareRateLimitResponse_RateLimit{RateLimit: rateLimit},
+	}, nil
+}
+
 // NotifyNewTransfer mock implementation
 func (m *mockMeshClient) NotifyNewTransfer(ctx context.Context, in *meshpb.NewTransferNotification, opts ...grpc.CallOption) (*meshpb.NotifyNewTransferResponse, error) {
 	return &meshpb.NotifyNewTransferResponse{
@@ -358,15 +393,20 @@ func TestSend(t *testing.T) {
 		}
 
 		mockFileshare := mockServerFileshare{}
+		queue := NewTransferQueue("")
 		server := NewServer(
 			&mockFileshare,
 			&EventManager{},
+			nil,
+			queue,
+			nil,
 			&mockMeshClient,
 			mockFs,
 			&mockOsInfo{},
 			0,
 			nil,
 		)
+		queue.start = server.StartTransfer
 
 		sendServer := mockSendServer{}
 
@@ -469,15 +509,20 @@ func TestSendDirectoryFilesystemErrorHandling(t *testing.T) {
 	}
 
 	for _, test := range fileshareTests {
+		queue := NewTransferQueue("")
 		server := NewServer(
 			&mockServerFileshare{},
 			&EventManager{},
+			nil,
+			queue,
+			nil,
 			&mockMeshClient{isEnabled: true},
 			mockFs,
 			&mockOsInfo{},
 			0,
 			nil,
 		)
+		queue.start = server.StartTransfer
 
 		sendServer := mockSendServer{}
 
@@ -491,6 +536,99 @@ func TestSendDirectoryFilesystemErrorHandling(t *testing.T) {
 	}
 }
 
+func TestSendText(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	peerIP := "219.150.143.226"
+	peerHostname := "internal.peer2.nord"
+	peerNotAllowedHostname := "internal.peer3.nord"
+	localPeers := []*meshpb.Peer{
+		{
+			Ip:                 peerIP,
+			Pubkey:             "FofTQLNKWoHwep2syHdzEg3RGVErLDizgeMArzwMdWT=",
+			Hostname:           peerHostname,
+			IsFileshareAllowed: true,
+			Status:             meshpb.PeerStatus_CONNECTED,
+		},
+		{
+			Ip:                 "116.51.81.30",
+			Pubkey:             "TndF1zMx38gd3PF5ho1eSc2FqtkojwlYdOxcmLZn8OU",
+			Hostname:           peerNotAllowedHostname,
+			IsFileshareAllowed: false,
+			Status:             meshpb.PeerStatus_CONNECTED,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		peer          string
+		text          string
+		expectedError *pb.Error
+		expectedText  string
+	}{
+		{
+			name:         "text sent",
+			peer:         peerHostname,
+			text:         "hello",
+			expectedText: "hello",
+		},
+		{
+			name:          "empty text",
+			peer:          peerHostname,
+			text:          "",
+			expectedError: fileshareError(pb.FileshareErrorCode_TEXT_EMPTY),
+		},
+		{
+			name:          "text too long",
+			peer:          peerHostname,
+			text:          strings.Repeat("a", TextSizeLimit+1),
+			expectedError: fileshareError(pb.FileshareErrorCode_TEXT_TOO_LONG),
+		},
+		{
+			name:          "invalid peer",
+			peer:          "no peer",
+			text:          "hello",
+			expectedError: fileshareError(pb.FileshareErrorCode_INVALID_PEER),
+		},
+		{
+			name:          "sending not allowed",
+			peer:          peerNotAllowedHostname,
+			text:          "hello",
+			expectedError: fileshareError(pb.FileshareErrorCode_SENDING_NOT_ALLOWED),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockFileshare := mockServerFileshare{}
+			server := NewServer(
+				&mockFileshare,
+				&EventManager{},
+				nil,
+				NewTransferQueue(""),
+				nil,
+				&mockMeshClient{isEnabled: true, localPeers: localPeers},
+				newMockFilesystem(),
+				&mockOsInfo{},
+				0,
+				nil,
+			)
+
+			sendServer := mockSendServer{}
+			err := server.SendText(&pb.SendTextRequest{Peer: test.peer, Text: test.text, Silent: true}, &sendServer)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedError, sendServer.response.GetError())
+			if test.expectedError == nil {
+				assert.Equal(t, pb.Status_REQUESTED, sendServer.response.GetStatus())
+				assert.Equal(t, peerIP, mockFileshare.destinationPeer)
+				assert.Equal(t, []string{test.expectedText}, mockFileshare.sentTexts)
+			} else {
+				assert.Empty(t, mockFileshare.sentTexts)
+			}
+		})
+	}
+}
+
 func TestAccept(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -699,6 +837,9 @@ func TestAccept(t *testing.T) {
 		server := NewServer(
 			&mockServerFileshare{},
 			&eventManager,
+			nil,
+			NewTransferQueue(""),
+			nil,
 			&mockMeshClient{isEnabled: true},
 			mockFs,
 			&mockOsInfo,
@@ -883,6 +1024,9 @@ func TestAcceptDirectory(t *testing.T) {
 		server := NewServer(
 			fileshare,
 			&eventManager,
+			nil,
+			NewTransferQueue(""),
+			nil,
 			&mockMeshClient{isEnabled: true},
 			mockFs,
 			&mockOsInfo,
@@ -907,6 +1051,139 @@ func TestAcceptDirectory(t *testing.T) {
 	}
 }
 
+func TestResume(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	transferID := exampleUUID
+
+	tests := []struct {
+		testName        string
+		isMeshEnabled   bool
+		transferID      string
+		direction       pb.Direction
+		transferStatus  pb.Status
+		resumeError     error
+		respErr         *pb.Error
+		expectedResumed []string
+	}{
+		{
+			testName:       "meshnet disabled",
+			isMeshEnabled:  false,
+			transferID:     transferID,
+			transferStatus: pb.Status_INTERRUPTED,
+			respErr:        serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED),
+		},
+		{
+			testName:       "transfer not found",
+			isMeshEnabled:  true,
+			transferID:     "b537743c-a328-4a3e-b2ec",
+			transferStatus: pb.Status_INTERRUPTED,
+			respErr:        fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_FOUND),
+		},
+		{
+			testName:       "transfer completed",
+			isMeshEnabled:  true,
+			transferID:     transferID,
+			transferStatus: pb.Status_SUCCESS,
+			respErr:        fileshareError(pb.FileshareErrorCode_TRANSFER_INVALIDATED),
+		},
+		{
+			testName:       "transfer canceled",
+			isMeshEnabled:  true,
+			transferID:     transferID,
+			transferStatus: pb.Status_CANCELED,
+			respErr:        fileshareError(pb.FileshareErrorCode_TRANSFER_INVALIDATED),
+		},
+		{
+			testName:       "incoming transfer not accepted",
+			isMeshEnabled:  true,
+			transferID:     transferID,
+			direction:      pb.Direction_INCOMING,
+			transferStatus: pb.Status_REQUESTED,
+			respErr:        fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_RESUMABLE),
+		},
+		{
+			testName:        "transfer unknown to the library",
+			isMeshEnabled:   true,
+			transferID:      transferID,
+			transferStatus:  pb.Status_INTERRUPTED,
+			resumeError:     ErrTransferNotResumable,
+			respErr:         fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_RESUMABLE),
+			expectedResumed: []string{transferID},
+		},
+		{
+			testName:        "lib failure",
+			isMeshEnabled:   true,
+			transferID:      transferID,
+			transferStatus:  pb.Status_ONGOING,
+			resumeError:     errors.New("generic error"),
+			respErr:         fileshareError(pb.FileshareErrorCode_LIB_FAILURE),
+			expectedResumed: []string{transferID},
+		},
+		{
+			testName:        "outgoing transfer not accepted by peer",
+			isMeshEnabled:   true,
+			transferID:      transferID,
+			direction:       pb.Direction_OUTGOING,
+			transferStatus:  pb.Status_REQUESTED,
+			expectedResumed: []string{transferID},
+		},
+		{
+			testName:        "resume success",
+			isMeshEnabled:   true,
+			transferID:      transferID,
+			direction:       pb.Direction_INCOMING,
+			transferStatus:  pb.Status_ONGOING,
+			expectedResumed: []string{transferID},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			transfer := pb.Transfer{
+				Id:        transferID,
+				Direction: test.direction,
+				Status:    test.transferStatus,
+			}
+			eventManager := EventManager{
+				liveTransfers: map[string]*LiveTransfer{transferID: {ID: transferID}},
+				storage: &mockStorage{
+					transfers: map[string]*pb.Transfer{
+						transferID: &transfer,
+					},
+				},
+			}
+			fileshare := &mockServerFileshare{resumeReturnValue: test.resumeError}
+
+			server := NewServer(
+				fileshare,
+				&eventManager,
+				nil,
+				NewTransferQueue(""),
+				nil,
+				&mockMeshClient{isEnabled: test.isMeshEnabled},
+				newMockFilesystem(),
+				&mockOsInfo{},
+				0,
+				nil,
+			)
+
+			resumeServer := &mockAcceptServer{}
+			err := server.Resume(&pb.ResumeRequest{TransferId: test.transferID, Silent: true}, resumeServer)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedResumed, fileshare.resumedTransfers)
+			if test.respErr != nil {
+				assert.Equal(t, test.respErr, resumeServer.response.Error)
+				return
+			}
+			// resumed transfer keeps its original ID and its progress is tracked from storage again
+			assert.Equal(t, transferID, resumeServer.response.TransferId)
+			assert.Equal(t, pb.Status_ONGOING, resumeServer.response.Status)
+			assert.NotContains(t, eventManager.liveTransfers, transferID)
+		})
+	}
+}
+
 func TestCancel(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -996,6 +1273,9 @@ func TestCancel(t *testing.T) {
 		server := NewServer(
 			&mockServerFileshare{cancelReturnValue: test.cancelError},
 			&eventManager,
+			nil,
+			NewTransferQueue(""),
+			nil,
 			&mockMeshClient{isEnabled: test.isMeshEnabled},
 			newMockFilesystem(),
 			&mockOsInfo{},
@@ -1053,6 +1333,9 @@ func TestList(t *testing.T) {
 		server := NewServer(
 			&mockEventManagerFileshare{},
 			&eventManager,
+			nil,
+			NewTransferQueue(""),
+			nil,
 			&mockMeshClient{isEnabled: true},
 			newMockFilesystem(),
 			&mockOsInfo{},
@@ -1086,3 +1369,199 @@ func TestList(t *testing.T) {
 		})
 	}
 }
+
+func TestWatches(t *testing.T) {
+	category.Set(t, category.File)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file")
+	assert.NoError(t, os.WriteFile(file, []byte("file"), 0600))
+
+	peerPubkey := "yaisO7jHDcEeb6NTasfhr3duUGIJKQipv4bC9SSDvQP="
+	meshClient := &mockMeshClient{
+		isEnabled: true,
+		localPeers: []*meshpb.Peer{
+			{
+				Ip:                 "172.20.0.5",
+				Pubkey:             peerPubkey,
+				Hostname:           "internal.peer.nord",
+				IsFileshareAllowed: true,
+			},
+			{
+				Ip:       "172.20.0.6",
+				Pubkey:   "TndF1zMx38gd3PF5ho1eSc2FqtkojwlYdOxcmLZn8OU",
+				Hostname: "not.allowed.nord",
+			},
+		},
+	}
+
+	watchManager := NewWatchManager(
+		filepath.Join(t.TempDir(), "watches.json"),
+		filepath.Join(t.TempDir(), "staging"),
+		time.Second,
+		time.Second,
+	)
+	assert.NoError(t, watchManager.Start(func(string, []string) error { return nil }))
+	defer watchManager.Stop()
+
+	server := NewServer(
+		&mockServerFileshare{},
+		&EventManager{},
+		watchManager,
+		NewTransferQueue(""),
+		nil,
+		meshClient,
+		NewStdFilesystem("/"),
+		&mockOsInfo{},
+		0,
+		nil,
+	)
+
+	addTests := []struct {
+		testName string
+		path     string
+		peer     string
+		response *pb.Error
+	}{
+		{
+			testName: "directory not found",
+			path:     filepath.Join(dir, "missing"),
+			peer:     "internal.peer.nord",
+			response: fileshareError(pb.FileshareErrorCode_FILE_NOT_FOUND),
+		},
+		{
+			testName: "not a directory",
+			path:     file,
+			peer:     "internal.peer.nord",
+			response: fileshareError(pb.FileshareErrorCode_WATCH_NOT_A_DIRECTORY),
+		},
+		{
+			testName: "invalid peer",
+			path:     dir,
+			peer:     "unknown.peer.nord",
+			response: fileshareError(pb.FileshareErrorCode_INVALID_PEER),
+		},
+		{
+			testName: "sending not allowed",
+			path:     dir,
+			peer:     "not.allowed.nord",
+			response: fileshareError(pb.FileshareErrorCode_SENDING_NOT_ALLOWED),
+		},
+		{
+			testName: "success",
+			path:     dir,
+			peer:     "internal.peer.nord",
+			response: empty(),
+		},
+		{
+			testName: "already watched",
+			path:     dir,
+			peer:     "internal.peer.nord",
+			response: fileshareError(pb.FileshareErrorCode_WATCH_ALREADY_EXISTS),
+		},
+	}
+
+	for _, test := range addTests {
+		t.Run(test.testName, func(t *testing.T) {
+			resp, err := server.AddWatch(context.Background(), &pb.AddWatchRequest{Path: test.path, Peer: test.peer})
+			assert.NoError(t, err)
+			assert.Equal(t, test.response, resp)
+		})
+	}
+
+	// peer is stored by the public key, but listed by its name
+	assert.Equal(t, []Watch{{Path: dir, Peer: peerPubkey}}, watchManager.List())
+	listResp, err := server.ListWatches(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, empty(), listResp.Error)
+	assert.Equal(t, []*pb.Watch{{Path: dir, Peer: "internal.peer.nord"}}, listResp.Watches)
+
+	resp, err := server.RemoveWatch(context.Background(), &pb.RemoveWatchRequest{Path: dir})
+	assert.NoError(t, err)
+	assert.Equal(t, empty(), resp)
+
+	resp, err = server.RemoveWatch(context.Background(), &pb.RemoveWatchRequest{Path: dir})
+	assert.NoError(t, err)
+	assert.Equal(t, fileshareError(pb.FileshareErrorCode_WATCH_NOT_FOUND), resp)
+}
+
+func TestLimits(t *testing.T) {
+	category.Set(t, category.File)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file")
+	assert.NoError(t, os.WriteFile(file, []byte("file"), 0600))
+
+	meshClient := &mockMeshClient{
+		isEnabled: true,
+		localPeers: []*meshpb.Peer{
+			{
+				Ip:                 "172.20.0.5",
+				Pubkey:             "yaisO7jHDcEeb6NTasfhr3duUGIJKQipv4bC9SSDvQP=",
+				Hostname:           "internal.peer.nord",
+				IsFileshareAllowed: true,
+				Status:             meshpb.PeerStatus_CONNECTED,
+			},
+		},
+	}
+
+	queue := NewTransferQueue(filepath.Join(t.TempDir(), "limits.json"))
+	server := NewServer(
+		&mockServerFileshare{},
+		&EventManager{storage: &mockStorage{transfers: map[string]*pb.Transfer{}}},
+		nil,
+		queue,
+		nil,
+		meshClient,
+		NewStdFilesystem("/"),
+		&mockOsInfo{},
+		5,
+		nil,
+	)
+	assert.NoError(t, queue.Start(server.StartTransfer))
+
+	resp, err := server.SetLimits(context.Background(), &pb.SetLimitsRequest{
+		MaxConcurrentTransfers: 1,
+		MaxUploadRate:          1024 * 1024,
+		MaxPeerUploadRate:      256 * 1024,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, empty(), resp)
+
+	limits, err := server.GetLimits(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), limits.GetMaxConcurrentTransfers())
+	assert.Equal(t, uint64(1024*1024), limits.GetMaxUploadRate())
+	assert.Equal(t, uint64(256*1024), limits.GetMaxPeerUploadRate())
+
+	send := func() *pb.StatusResponse {
+		sendServer := mockSendServer{}
+		err := server.Send(&pb.SendRequest{
+			Peer:     "internal.peer.nord",
+			Paths:    []string{file},
+			Silent:   true,
+			Priority: pb.TransferPriority_PRIORITY_HIGH,
+		}, &sendServer)
+		assert.NoError(t, err)
+		return sendServer.response
+	}
+
+	assert.Equal(t, pb.Status_REQUESTED, send().GetStatus())
+
+	queued := send()
+	assert.Equal(t, pb.Status_QUEUED, queued.GetStatus())
+
+	listServer := mockListServer{}
+	assert.NoError(t, server.List(&pb.Empty{}, &listServer))
+	assert.Len(t, listServer.responses, 1)
+	assert.Len(t, listServer.responses[0].GetTransfers(), 1)
+	transfer := listServer.responses[0].GetTransfers()[0]
+	assert.Equal(t, queued.GetTransferId(), transfer.GetId())
+	assert.Equal(t, pb.Status_QUEUED, transfer.GetStatus())
+	assert.Equal(t, uint32(1), transfer.GetQueuePosition())
+
+	cancelResp, err := server.Cancel(context.Background(), &pb.CancelRequest{TransferId: queued.GetTransferId()})
+	assert.NoError(t, err)
+	assert.Equal(t, empty(), cancelResp)
+	assert.Empty(t, queue.Transfers())
+}
@@ -0,0 +1,195 @@
+package fileshare
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// TextFileName is the name under which text snippets are transferred. Transfers consisting
+	// only of this file are recognized as text transfers by the receiver.
+	TextFileName = "snippet.nordvpn-text"
+	// TextSizeLimit in bytes, larger snippets should be sent as files
+	TextSizeLimit = 64 * 1024
+	// TextStoreLimit is the number of snippets kept in the history, the oldest are dropped first
+	TextStoreLimit = 100
+
+	textDirName = "nordvpn-fileshare-texts"
+)
+
+// ErrTextDirNotInMemory is returned when the received snippets can't be kept off the disk
+var ErrTextDirNotInMemory = errors.New("text download directory is not in memory")
+
+// TextDownloadDir returns the directory for the received snippets. norddrop can download files
+// only into a directory, so the snippets are downloaded into the user runtime directory or into
+// /dev/shm, which are kept in memory.
+func TextDownloadDir() string {
+	if runtimeDir := os.Getenv("XDG_RUNTIME_DIR"); runtimeDir != "" {
+		return filepath.Join(runtimeDir, textDirName)
+	}
+	return filepath.Join("/dev/shm", fmt.Sprintf("%s-%d", textDirName, os.Getuid()))
+}
+
+// isInMemory returns true if the path is on a memory backed filesystem
+func isInMemory(path string) (bool, error) {
+	var statfs unix.Statfs_t
+	if err := unix.Statfs(path, &statfs); err != nil {
+		return false, err
+	}
+	return statfs.Type == unix.TMPFS_MAGIC || statfs.Type == unix.RAMFS_MAGIC, nil
+}
+
+// IsTextFile returns true if the transferred file carries a text snippet
+func IsTextFile(path string, size uint64) bool {
+	return path == TextFileName && size <= TextSizeLimit
+}
+
+// isTextTransfer returns true if the transfer was sent with SendText
+func isTextTransfer(transfer *pb.Transfer) bool {
+	return len(transfer.Files) == 1 && IsTextFile(transfer.Files[0].Path, transfer.Files[0].Size)
+}
+
+// TextStore keeps the content of the sent and received text snippets, so they stay in the
+// transfer history. Received snippets are downloaded into the memory backed downloadDir and moved
+// to the store right away. At most TextStoreLimit snippets are kept.
+// Thread safe.
+type TextStore struct {
+	mutex       sync.Mutex
+	storagePath string
+	downloadDir string
+	isInMemory  func(path string) (bool, error)
+	// oldest first
+	texts []storedText
+}
+
+type storedText struct {
+	TransferID string `json:"transfer_id"`
+	Text       string `json:"text"`
+}
+
+// NewTextStore creates a text store persisting the snippets at storagePath
+func NewTextStore(storagePath string, downloadDir string) *TextStore {
+	return &TextStore{
+		storagePath: storagePath,
+		downloadDir: downloadDir,
+		isInMemory:  isInMemory,
+	}
+}
+
+// Load the persisted snippets. Must be called before using the store.
+func (ts *TextStore) Load() error {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	data, err := os.ReadFile(filepath.Clean(ts.storagePath))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("loading texts file: %w", err)
+	}
+	if err := json.Unmarshal(data, &ts.texts); err != nil {
+		return fmt.Errorf("unmarshalling texts: %w", err)
+	}
+	return nil
+}
+
+// DownloadDir returns the directory for the received snippets, creating it if needed. The
+// directory must be private to the user and kept in memory, there is no fallback to the disk.
+func (ts *TextStore) DownloadDir() (string, error) {
+	if err := os.Mkdir(ts.downloadDir, internal.PermUserRWX); err != nil && !errors.Is(err, os.ErrExist) {
+		return "", fmt.Errorf("creating texts download directory: %w", err)
+	}
+
+	// directory in /dev/shm could be created by other users beforehand
+	info, err := os.Lstat(ts.downloadDir)
+	if err != nil {
+		return "", fmt.Errorf("checking texts download directory: %w", err)
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !info.IsDir() || !ok || int(stat.Uid) != os.Getuid() {
+		return "", fmt.Errorf("texts download directory %s is not owned by the user", ts.downloadDir)
+	}
+	if info.Mode().Perm() != internal.PermUserRWX {
+		if err := os.Chmod(ts.downloadDir, internal.PermUserRWX); err != nil {
+			return "", fmt.Errorf("changing texts download directory permissions: %w", err)
+		}
+	}
+
+	inMemory, err := ts.isInMemory(ts.downloadDir)
+	if err != nil {
+		return "", fmt.Errorf("checking texts download directory filesystem: %w", err)
+	}
+	if !inMemory {
+		return "", ErrTextDirNotInMemory
+	}
+	return ts.downloadDir, nil
+}
+
+// Save the text of the transfer. The oldest snippets are dropped once the limit is reached.
+func (ts *TextStore) Save(transferID string, text string) error {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	ts.texts = slices.DeleteFunc(ts.texts, func(stored storedText) bool {
+		return stored.TransferID == transferID
+	})
+	ts.texts = append(ts.texts, storedText{TransferID: transferID, Text: text})
+	if len(ts.texts) > TextStoreLimit {
+		ts.texts = slices.Delete(ts.texts, 0, len(ts.texts)-TextStoreLimit)
+	}
+	return ts.save()
+}
+
+// Retain removes the snippets of the transfers which are not listed
+func (ts *TextStore) Retain(transferIDs []string) error {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	count := len(ts.texts)
+	ts.texts = slices.DeleteFunc(ts.texts, func(stored storedText) bool {
+		return !slices.Contains(transferIDs, stored.TransferID)
+	})
+	if len(ts.texts) == count {
+		return nil
+	}
+	return ts.save()
+}
+
+// Apply marks the text transfers and fills in their text
+func (ts *TextStore) Apply(transfer *pb.Transfer) {
+	if !isTextTransfer(transfer) {
+		return
+	}
+
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+	transfer.Type = pb.TransferType_TEXT
+	index := slices.IndexFunc(ts.texts, func(stored storedText) bool {
+		return stored.TransferID == transfer.Id
+	})
+	if index != -1 {
+		transfer.Text = ts.texts[index].Text
+	}
+}
+
+func (ts *TextStore) save() error {
+	data, err := json.Marshal(ts.texts)
+	if err != nil {
+		return fmt.Errorf("marshalling texts: %w", err)
+	}
+	if err := os.WriteFile(filepath.Clean(ts.storagePath), data, internal.PermUserRW); err != nil {
+		return fmt.Errorf("saving texts file: %w", err)
+	}
+	return nil
+}
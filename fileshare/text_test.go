@@ -0,0 +1,262 @@
+package fileshare
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTextStore(t *testing.T) {
+	category.Set(t, category.File)
+
+	storagePath := filepath.Join(t.TempDir(), "texts.json")
+	store := NewTextStore(storagePath, t.TempDir())
+	require.NoError(t, store.Load())
+	require.NoError(t, store.Save("text-transfer", "hello"))
+
+	// texts survive the restarts
+	store = NewTextStore(storagePath, t.TempDir())
+	require.NoError(t, store.Load())
+
+	// received texts are never written to the disk
+	store.isInMemory = func(string) (bool, error) { return false, nil }
+	_, err := store.DownloadDir()
+	assert.ErrorIs(t, err, ErrTextDirNotInMemory)
+
+	textTransfer := &pb.Transfer{
+		Id:    "text-transfer",
+		Files: []*pb.File{{Path: TextFileName, Size: 5}},
+	}
+	store.Apply(textTransfer)
+	assert.Equal(t, pb.TransferType_TEXT, textTransfer.Type)
+	assert.Equal(t, "hello", textTransfer.Text)
+
+	tests := []struct {
+		name  string
+		files []*pb.File
+	}{
+		{
+			name:  "different file name",
+			files: []*pb.File{{Path: "notes.txt", Size: 5}},
+		},
+		{
+			name:  "multiple files",
+			files: []*pb.File{{Path: TextFileName, Size: 5}, {Path: "notes.txt", Size: 5}},
+		},
+		{
+			name:  "over the size limit",
+			files: []*pb.File{{Path: TextFileName, Size: TextSizeLimit + 1}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transfer := &pb.Transfer{Id: "text-transfer", Files: test.files}
+			store.Apply(transfer)
+			assert.Equal(t, pb.TransferType_FILES, transfer.Type)
+			assert.Empty(t, transfer.Text)
+		})
+	}
+}
+
+func TestReceiveText(t *testing.T) {
+	category.Set(t, category.File)
+
+	const (
+		transferID = exampleUUID
+		fileID     = "textfile"
+		hostname   = "internal.peer1.nord"
+		text       = "hello from the peer"
+	)
+
+	tests := []struct {
+		name              string
+		alwaysAcceptFiles bool
+	}{
+		{name: "accepted automatically", alwaysAcceptFiles: true},
+		{name: "accepted by the user", alwaysAcceptFiles: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			notifier := mockNotifier{
+				notifications: []mockNotification{},
+				updateCh:      make(chan struct{}, 1),
+			}
+			notificationManager := NewMockNotificationManager(&mockEventManagerOsInfo{})
+			notificationManager.notifier = &notifier
+			var copiedText string
+			notificationManager.copyTextFunc = func(text string) error {
+				copiedText = text
+				return nil
+			}
+
+			eventManager := NewEventManager(false,
+				&mockMeshClient{externalPeers: []*meshpb.Peer{
+					{
+						Ip:                exampleIP1,
+						Hostname:          hostname,
+						DoIAllowFileshare: true,
+						AlwaysAcceptFiles: test.alwaysAcceptFiles,
+					},
+				}},
+				&mockEventManagerOsInfo{},
+				&mockEventManagerFilesystem{},
+				"")
+			eventManager.notificationManager = &notificationManager
+			notificationManager.eventManager = eventManager
+			mockFileshare := &mockEventManagerFileshare{}
+			eventManager.SetFileshare(mockFileshare)
+
+			store := NewTextStore(filepath.Join(t.TempDir(), "texts.json"), filepath.Join(t.TempDir(), "download"))
+			store.isInMemory = func(string) (bool, error) { return true, nil }
+			eventManager.SetTextStore(store)
+
+			eventManager.SetStorage(&mockStorage{transfers: map[string]*pb.Transfer{
+				transferID: {
+					Id:        transferID,
+					Peer:      exampleIP1,
+					Direction: pb.Direction_INCOMING,
+					Status:    pb.Status_REQUESTED,
+					Files: []*pb.File{
+						{Id: fileID, Path: TextFileName, Size: uint64(len(text)), Status: pb.Status_REQUESTED},
+					},
+				},
+			}})
+
+			eventManager.Event(Event{Kind: EventKindRequestReceived{
+				Peer:       exampleIP1,
+				TransferId: transferID,
+				Files:      []ReceivedFile{{Id: fileID, Path: TextFileName, Size: uint64(len(text))}},
+			}})
+
+			if !test.alwaysAcceptFiles {
+				// texts from other peers need the consent of the user, like the files
+				notifier.Wait()
+				assert.Equal(t, notifyNewTransferSummary, notifier.getLastNotification().summary)
+				assert.Empty(t, mockFileshare.acceptedTransferIDS)
+
+				transfer, err := eventManager.AcceptTransfer(transferID, "/home/user/Downloads", []string{})
+				require.NoError(t, err)
+				assert.Equal(t, pb.TransferType_TEXT, transfer.Type)
+			}
+
+			downloadDir, err := store.DownloadDir()
+			require.NoError(t, err)
+			downloadedPath := filepath.Join(downloadDir, TextFileName)
+			require.NoError(t, os.WriteFile(downloadedPath, []byte(text), 0600))
+
+			eventManager.Event(Event{Kind: EventKindFileDownloaded{
+				TransferId: transferID,
+				FileId:     fileID,
+				FinalPath:  downloadedPath,
+			}})
+			notifier.Wait()
+
+			assert.Equal(t, []string{transferID}, mockFileshare.acceptedTransferIDS)
+			assert.NoFileExists(t, downloadedPath)
+
+			notification := notifier.getLastNotification()
+			assert.Equal(t, fmt.Sprintf(notifyNewTextSummary, hostname), notification.summary)
+			assert.Equal(t, text, notification.body)
+			assert.Equal(t, []Action{{actionKeyCopyText, textCopyAction}}, notification.actions)
+
+			transfer, err := eventManager.GetTransfer(transferID)
+			require.NoError(t, err)
+			assert.Equal(t, pb.TransferType_TEXT, transfer.Type)
+			assert.Equal(t, text, transfer.Text)
+
+			notificationManager.CopyText(notification.id)
+			assert.Equal(t, text, copiedText)
+		})
+	}
+}
+
+func TestCopyTextFailure(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	notifier := mockNotifier{notifications: []mockNotification{}}
+	notificationManager := NewMockNotificationManager(&mockEventManagerOsInfo{})
+	notificationManager.notifier = &notifier
+	notificationManager.copyTextFunc = func(string) error {
+		return errors.New("no clipboard tool found")
+	}
+
+	eventManager := NewEventManager(false,
+		&mockMeshClient{},
+		&mockEventManagerOsInfo{},
+		&mockEventManagerFilesystem{},
+		"")
+	eventManager.SetStorage(&mockStorage{transfers: map[string]*pb.Transfer{
+		exampleUUID: {Id: exampleUUID, Files: []*pb.File{{Path: TextFileName}}},
+	}})
+	notificationManager.eventManager = eventManager
+	notificationManager.notifications.AddTextNotification(0, exampleUUID)
+
+	notificationManager.CopyText(0)
+
+	notification := notifier.getLastNotification()
+	assert.Equal(t, copyTextFailedSummary, notification.summary)
+	assert.Equal(t, fmt.Sprintf(copyTextFailedBody, exampleUUID), notification.body)
+
+	// the notification is handled only once
+	notificationManager.CopyText(0)
+	assert.Len(t, notifier.notifications, 1)
+}
+
+func TestTextStore_Limit(t *testing.T) {
+	category.Set(t, category.File)
+
+	store := NewTextStore(filepath.Join(t.TempDir(), "texts.json"), t.TempDir())
+	require.NoError(t, store.Load())
+	for i := 0; i <= TextStoreLimit; i++ {
+		require.NoError(t, store.Save(fmt.Sprint(i), "hello"))
+	}
+
+	// the oldest text is dropped
+	oldest := &pb.Transfer{Id: "0", Files: []*pb.File{{Path: TextFileName, Size: 5}}}
+	store.Apply(oldest)
+	assert.Empty(t, oldest.Text)
+	newest := &pb.Transfer{Id: fmt.Sprint(TextStoreLimit), Files: []*pb.File{{Path: TextFileName, Size: 5}}}
+	store.Apply(newest)
+	assert.Equal(t, "hello", newest.Text)
+}
+
+func TestPurgeTransfersUntil_PrunesTexts(t *testing.T) {
+	category.Set(t, category.File)
+
+	storagePath := filepath.Join(t.TempDir(), "texts.json")
+	store := NewTextStore(storagePath, t.TempDir())
+	require.NoError(t, store.Load())
+	for _, transferID := range []string{"old", "new"} {
+		require.NoError(t, store.Save(transferID, transferID))
+	}
+
+	now := time.Now()
+	eventManager := NewEventManager(false, &mockMeshClient{}, &mockEventManagerOsInfo{}, &mockEventManagerFilesystem{}, "")
+	eventManager.SetStorage(&mockStorage{transfers: map[string]*pb.Transfer{
+		"old": {Id: "old", Created: timestamppb.New(now.Add(-time.Hour))},
+		"new": {Id: "new", Created: timestamppb.New(now)},
+	}})
+	eventManager.SetTextStore(store)
+	require.NoError(t, eventManager.PurgeTransfersUntil(now.Add(-time.Minute)))
+
+	// purged transfers are removed from the texts file
+	store = NewTextStore(storagePath, t.TempDir())
+	require.NoError(t, store.Load())
+	oldTransfer := &pb.Transfer{Id: "old", Files: []*pb.File{{Path: TextFileName, Size: 3}}}
+	store.Apply(oldTransfer)
+	assert.Empty(t, oldTransfer.Text)
+	newTransfer := &pb.Transfer{Id: "new", Files: []*pb.File{{Path: TextFileName, Size: 3}}}
+	store.Apply(newTransfer)
+	assert.Equal(t, "new", newTransfer.Text)
+}
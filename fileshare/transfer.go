@@ -20,6 +20,7 @@ var (
 	}
 	OutgoingStatus = map[pb.Status]string{
 		pb.Status_REQUESTED:            "request sent",
+		pb.Status_QUEUED:               "queued",
 		pb.Status_ONGOING:              "uploading",
 		pb.Status_SUCCESS:              "completed",
 		pb.Status_INTERRUPTED:          "interrupted",
@@ -0,0 +1,560 @@
+package fileshare
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/filewatch"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/log"
+	"github.com/fsnotify/fsnotify"
+)
+
+// Handleable watch errors
+var (
+	ErrWatchAlreadyExists = errors.New("directory is already watched")
+	ErrWatchNotFound      = errors.New("directory is not watched")
+)
+
+// Watch is a directory which new or modified files are sent to the peer automatically
+type Watch struct {
+	Path string `json:"path"`
+	// Peer public key
+	Peer string `json:"peer"`
+}
+
+// watchRecord is the persisted watch
+type watchRecord struct {
+	Watch
+	// Synced is the time when the last sent changes were collected. Files modified after it are
+	// sent when the watch starts.
+	Synced time.Time `json:"synced"`
+}
+
+// SendFunc sends the paths to the peer
+type SendFunc func(peer string, paths []string) error
+
+// WatchManager observes the watched directories and sends their changes to the peers in batches.
+// Only the changed files are sent. They are staged with the same directory structure as in the
+// watched directory until the transfer is finished. Batches which fail are retried.
+// Watches are persisted in a file, so they survive the restarts of the fileshare process.
+// Thread safe.
+type WatchManager struct {
+	mutex        sync.Mutex
+	storagePath  string
+	stagingPath  string
+	batchTimeout time.Duration
+	retryTimeout time.Duration
+	send         SendFunc
+	// Key is watched directory path
+	watchers map[string]*dirWatcher
+	// Key is staged batch directory, value is the watched directory path
+	batches map[string]string
+}
+
+// NewWatchManager creates a watch manager persisting the watches at storagePath and staging
+// the changes at stagingPath. Changes are sent once no new ones were observed in the directory
+// for batchTimeout. Failed batches are retried after retryTimeout.
+func NewWatchManager(
+	storagePath string,
+	stagingPath string,
+	batchTimeout time.Duration,
+	retryTimeout time.Duration,
+) *WatchManager {
+	return &WatchManager{
+		storagePath:  storagePath,
+		stagingPath:  stagingPath,
+		batchTimeout: batchTimeout,
+		retryTimeout: retryTimeout,
+		watchers:     map[string]*dirWatcher{},
+		batches:      map[string]string{},
+	}
+}
+
+// Start loads the persisted watches and starts observing their directories. Files modified
+// since the last sent changes are sent right away. Watches which can't be started, e.g. because
+// the directory was removed, are kept and retried on the next start. Batches staged before the
+// start are no longer tracked, so they are removed.
+func (wm *WatchManager) Start(send SendFunc) error {
+	wm.mutex.Lock()
+	defer wm.mutex.Unlock()
+
+	wm.send = send
+	if err := os.RemoveAll(wm.stagingPath); err != nil {
+		log.Warnf("removing staged batches: %s", err)
+	}
+	records, err := wm.load()
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		watcher, err := wm.newDirWatcher(record.Watch, record.Synced)
+		if err != nil {
+			log.Warnf("starting watch of %s: %s", record.Path, err)
+			watcher = &dirWatcher{watch: record.Watch, synced: record.Synced}
+		}
+		wm.watchers[record.Path] = watcher
+	}
+	return nil
+}
+
+// Stop observing all directories. Persisted watches are not changed.
+func (wm *WatchManager) Stop() {
+	wm.mutex.Lock()
+	watchers := wm.watchers
+	wm.watchers = map[string]*dirWatcher{}
+	wm.mutex.Unlock()
+
+	// watchers are stopped without holding the lock, because they use it to persist the progress
+	for _, watcher := range watchers {
+		watcher.stop()
+	}
+}
+
+// Add starts observing the directory and sending its changes to the peer
+func (wm *WatchManager) Add(path string, peer string) error {
+	wm.mutex.Lock()
+	if _, ok := wm.watchers[path]; ok {
+		wm.mutex.Unlock()
+		return ErrWatchAlreadyExists
+	}
+
+	watch := Watch{Path: path, Peer: peer}
+	watcher, err := wm.newDirWatcher(watch, time.Now())
+	if err != nil {
+		wm.mutex.Unlock()
+		return fmt.Errorf("starting watch: %w", err)
+	}
+
+	wm.watchers[path] = watcher
+	if err := wm.save(); err != nil {
+		delete(wm.watchers, path)
+		wm.mutex.Unlock()
+		watcher.stop()
+		return err
+	}
+	wm.mutex.Unlock()
+	return nil
+}
+
+// Remove stops observing the directory
+func (wm *WatchManager) Remove(path string) error {
+	wm.mutex.Lock()
+	watcher, ok := wm.watchers[path]
+	if !ok {
+		wm.mutex.Unlock()
+		return ErrWatchNotFound
+	}
+
+	delete(wm.watchers, path)
+	if err := wm.save(); err != nil {
+		wm.watchers[path] = watcher
+		wm.mutex.Unlock()
+		return err
+	}
+	wm.mutex.Unlock()
+	watcher.stop()
+	return nil
+}
+
+// List returns the watches sorted by the directory path
+func (wm *WatchManager) List() []Watch {
+	wm.mutex.Lock()
+	defer wm.mutex.Unlock()
+
+	records := wm.records()
+	watches := make([]Watch, 0, len(records))
+	for _, record := range records {
+		watches = append(watches, record.Watch)
+	}
+	return watches
+}
+
+// TransferFinished removes the staged batch of the finished transfer. Files which were not
+// delivered are sent again.
+func (wm *WatchManager) TransferFinished(transfer *pb.Transfer) {
+	if transfer.GetDirection() != pb.Direction_OUTGOING {
+		return
+	}
+
+	var batch string
+	var failed []string
+	for _, file := range transfer.GetFiles() {
+		dir, path, ok := wm.stagedFile(file.GetFullPath())
+		if !ok {
+			continue
+		}
+		batch = dir
+		if file.GetStatus() != pb.Status_SUCCESS {
+			failed = append(failed, path)
+		}
+	}
+	if batch == "" {
+		return
+	}
+
+	wm.mutex.Lock()
+	watcher := wm.watchers[wm.batches[batch]]
+	delete(wm.batches, batch)
+	wm.mutex.Unlock()
+
+	if err := os.RemoveAll(batch); err != nil {
+		log.Warnf("removing staged batch %s: %s", batch, err)
+	}
+	// batches staged before the restart were removed on start, so their failures are not retried
+	if watcher == nil || watcher.watcher == nil || len(failed) == 0 {
+		return
+	}
+	select {
+	case watcher.requeue <- failed:
+	case <-watcher.done:
+	}
+}
+
+// stagedFile returns the batch directory and the path relative to it of the staged file
+func (wm *WatchManager) stagedFile(path string) (string, string, bool) {
+	rel, ok := relativePath(wm.stagingPath, path)
+	if !ok {
+		return "", "", false
+	}
+	batch, file, ok := strings.Cut(rel, string(filepath.Separator))
+	if !ok {
+		return "", "", false
+	}
+	return filepath.Join(wm.stagingPath, batch), file, true
+}
+
+func (wm *WatchManager) records() []watchRecord {
+	records := make([]watchRecord, 0, len(wm.watchers))
+	for _, watcher := range wm.watchers {
+		records = append(records, watchRecord{Watch: watcher.watch, Synced: watcher.synced})
+	}
+	sort.Slice(records, func(i int, j int) bool {
+		return records[i].Path < records[j].Path
+	})
+	return records
+}
+
+func (wm *WatchManager) load() ([]watchRecord, error) {
+	data, err := os.ReadFile(filepath.Clean(wm.storagePath))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("loading watches file: %w", err)
+	}
+
+	var records []watchRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("unmarshalling watches: %w", err)
+	}
+	return records, nil
+}
+
+func (wm *WatchManager) save() error {
+	data, err := json.Marshal(wm.records())
+	if err != nil {
+		return fmt.Errorf("marshalling watches: %w", err)
+	}
+	if err := os.WriteFile(filepath.Clean(wm.storagePath), data, internal.PermUserRW); err != nil {
+		return fmt.Errorf("saving watches file: %w", err)
+	}
+	return nil
+}
+
+// setSynced persists the time when the sent changes of the watch were collected
+func (wm *WatchManager) setSynced(dw *dirWatcher, synced time.Time) {
+	wm.mutex.Lock()
+	defer wm.mutex.Unlock()
+
+	if wm.watchers[dw.watch.Path] != dw {
+		// watch was removed in the meantime
+		return
+	}
+	dw.synced = synced
+	if err := wm.save(); err != nil {
+		log.Warnf("saving progress of watch %s: %s", dw.watch.Path, err)
+	}
+}
+
+// newDirWatcher starts observing the directory. Files modified after synced are treated as
+// changed right away.
+func (wm *WatchManager) newDirWatcher(watch Watch, synced time.Time) (*dirWatcher, error) {
+	watcher, err := filewatch.GetFileWatcher()
+	if err != nil {
+		return nil, err
+	}
+	files, err := addDirTree(watcher, watch.Path)
+	if err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+
+	changed := map[string]bool{}
+	if !synced.IsZero() {
+		for _, file := range files {
+			info, err := os.Lstat(file)
+			if err != nil || !info.ModTime().After(synced) {
+				continue
+			}
+			if path, ok := relativePath(watch.Path, file); ok {
+				changed[path] = true
+			}
+		}
+	}
+
+	dw := &dirWatcher{
+		watch:   watch,
+		synced:  synced,
+		watcher: watcher,
+		requeue: make(chan []string),
+		done:    make(chan struct{}),
+	}
+	go dw.run(wm, changed)
+	return dw, nil
+}
+
+// sendChanges sends the changed files in batches of at most TransferFileLimit files. Sent files
+// are removed from changed.
+func (wm *WatchManager) sendChanges(watch Watch, changed map[string]bool) error {
+	paths := sortedKeys(changed)
+	for len(paths) > 0 {
+		count := min(len(paths), TransferFileLimit)
+		if err := wm.sendBatch(watch, paths[:count]); err != nil {
+			return err
+		}
+		for _, path := range paths[:count] {
+			delete(changed, path)
+		}
+		paths = paths[count:]
+	}
+	return nil
+}
+
+// sendBatch stages the files and sends them to the peer
+func (wm *WatchManager) sendBatch(watch Watch, paths []string) error {
+	batch, entries, err := stage(wm.stagingPath, watch.Path, paths)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return os.RemoveAll(batch)
+	}
+
+	wm.mutex.Lock()
+	wm.batches[batch] = watch.Path
+	wm.mutex.Unlock()
+
+	if err := wm.send(watch.Peer, entries); err != nil {
+		wm.mutex.Lock()
+		delete(wm.batches, batch)
+		wm.mutex.Unlock()
+		if err := os.RemoveAll(batch); err != nil {
+			log.Warnf("removing staged batch %s: %s", batch, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// dirWatcher observes a single watched directory
+type dirWatcher struct {
+	watch Watch
+	// synced is guarded by the WatchManager mutex
+	synced time.Time
+	// watcher is nil if observing the directory failed to start
+	watcher *fsnotify.Watcher
+	// requeue receives the files of the transfers which were not delivered
+	requeue chan []string
+	done    chan struct{}
+}
+
+// run collects the changed files and sends them once the directory is quiet for batchTimeout.
+// Changes which failed to be sent are kept and retried after retryTimeout.
+func (dw *dirWatcher) run(wm *WatchManager, changed map[string]bool) {
+	defer close(dw.done)
+
+	var batch <-chan time.Time
+	if len(changed) > 0 {
+		batch = time.After(wm.batchTimeout)
+	}
+	for {
+		select {
+		case event, ok := <-dw.watcher.Events:
+			if !ok {
+				return
+			}
+			if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+				continue
+			}
+			files := []string{event.Name}
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Lstat(event.Name); err == nil && info.IsDir() {
+					// files could be created in the directory before it was watched
+					var err error
+					files, err = addDirTree(dw.watcher, event.Name)
+					if err != nil {
+						log.Warnf("watching new directory %s: %s", event.Name, err)
+					}
+				}
+			}
+			for _, file := range files {
+				if path, ok := relativePath(dw.watch.Path, file); ok {
+					changed[path] = true
+					batch = time.After(wm.batchTimeout)
+				}
+			}
+		case paths := <-dw.requeue:
+			for _, path := range paths {
+				changed[path] = true
+			}
+			batch = time.After(wm.retryTimeout)
+		case err, ok := <-dw.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Warnf("watch of %s: %s", dw.watch.Path, err)
+		case <-batch:
+			batch = nil
+			collected := time.Now()
+			changed = existingFiles(dw.watch.Path, changed)
+			if len(changed) == 0 {
+				continue
+			}
+			if err := wm.sendChanges(dw.watch, changed); err != nil {
+				log.Errorf("sending changes of %s, retrying in %s: %s", dw.watch.Path, wm.retryTimeout, err)
+				batch = time.After(wm.retryTimeout)
+				continue
+			}
+			wm.setSynced(dw, collected)
+		}
+	}
+}
+
+func (dw *dirWatcher) stop() {
+	if dw.watcher == nil {
+		return
+	}
+	if err := dw.watcher.Close(); err != nil {
+		log.Warnf("closing watch of %s: %s", dw.watch.Path, err)
+	}
+	<-dw.done
+}
+
+// addDirTree adds the directory and its subdirectories up to DirDepthLimit to the watcher.
+// Returns the regular files found in them.
+func addDirTree(watcher *fsnotify.Watcher, root string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Type().IsRegular() {
+			files = append(files, path)
+			return nil
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+		if strings.Count(path, string(filepath.Separator))-strings.Count(root, string(filepath.Separator)) > DirDepthLimit {
+			return filepath.SkipDir
+		}
+		return watcher.Add(path)
+	})
+	return files, err
+}
+
+// relativePath returns the path relative to root if it is inside of it
+func relativePath(root string, path string) (string, bool) {
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return rel, true
+}
+
+// existingFiles returns the paths relative to root skipping the ones removed before the batch
+// was sent
+func existingFiles(root string, paths map[string]bool) map[string]bool {
+	existing := map[string]bool{}
+	for path := range paths {
+		if info, err := os.Lstat(filepath.Join(root, path)); err == nil && info.Mode().IsRegular() {
+			existing[path] = true
+		}
+	}
+	return existing
+}
+
+// stage links the files into a new batch directory in stagingPath keeping their paths relative
+// to root, so that the peer receives only them with the same directory structure. Returns the
+// batch directory and its top level entries to be sent. Files which can't be staged are skipped.
+func stage(stagingPath string, root string, paths []string) (string, []string, error) {
+	if err := os.MkdirAll(stagingPath, internal.PermUserRWX); err != nil {
+		return "", nil, fmt.Errorf("creating staging directory: %w", err)
+	}
+	batch, err := os.MkdirTemp(stagingPath, "batch")
+	if err != nil {
+		return "", nil, fmt.Errorf("creating batch directory: %w", err)
+	}
+
+	entries := map[string]bool{}
+	for _, path := range paths {
+		target := filepath.Join(batch, path)
+		if err := os.MkdirAll(filepath.Dir(target), internal.PermUserRWX); err != nil {
+			_ = os.RemoveAll(batch)
+			return "", nil, fmt.Errorf("creating batch directory: %w", err)
+		}
+		if err := linkFile(filepath.Join(root, path), target); err != nil {
+			log.Warnf("staging %s: %s", filepath.Join(root, path), err)
+			continue
+		}
+		entry, _, _ := strings.Cut(path, string(filepath.Separator))
+		entries[filepath.Join(batch, entry)] = true
+	}
+	return batch, sortedKeys(entries), nil
+}
+
+// linkFile creates a hard link of the file, so that staging takes no space. The file is copied
+// if it is on a different file system.
+func linkFile(source string, target string) error {
+	if err := os.Link(source, target); err == nil {
+		return nil
+	}
+
+	in, err := os.Open(filepath.Clean(source))
+	if err != nil {
+		return err
+	}
+	// nolint:errcheck // file is only read
+	defer in.Close()
+
+	out, err := os.OpenFile(filepath.Clean(target), os.O_WRONLY|os.O_CREATE|os.O_EXCL, internal.PermUserRW)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
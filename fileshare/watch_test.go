@@ -0,0 +1,237 @@
+package fileshare
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelativePath(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		path     string
+		expected string
+		ok       bool
+	}{
+		{path: "/watched/file", expected: "file", ok: true},
+		{path: "/watched/dir/sub/file", expected: "dir/sub/file", ok: true},
+		{path: "/watched", ok: false},
+		{path: "/other/file", ok: false},
+		{path: "/watched-other/file", ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			path, ok := relativePath("/watched", test.path)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, path)
+		})
+	}
+}
+
+func newTestWatchManager(t *testing.T, storagePath string) *WatchManager {
+	t.Helper()
+	return NewWatchManager(storagePath, filepath.Join(t.TempDir(), "staging"), 100*time.Millisecond, 200*time.Millisecond)
+}
+
+// stagedFiles returns the files of the sent entries relative to their batch directory
+func stagedFiles(t *testing.T, paths []string) []string {
+	t.Helper()
+	var files []string
+	for _, path := range paths {
+		batch := filepath.Dir(path)
+		require.NoError(t, filepath.WalkDir(path, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(batch, path)
+			files = append(files, rel)
+			return err
+		}))
+	}
+	return files
+}
+
+type watchSent struct {
+	peer  string
+	paths []string
+	files []string
+}
+
+func receiveWatchSent(t *testing.T, sentCh <-chan watchSent) watchSent {
+	t.Helper()
+	select {
+	case s := <-sentCh:
+		return s
+	case <-time.After(5 * time.Second):
+		t.Fatal("changes were not sent")
+	}
+	return watchSent{}
+}
+
+func TestWatchManager_Persistence(t *testing.T) {
+	category.Set(t, category.File)
+
+	storagePath := filepath.Join(t.TempDir(), "watches.json")
+	dir := t.TempDir()
+	send := func(string, []string) error { return nil }
+
+	wm := newTestWatchManager(t, storagePath)
+	require.NoError(t, wm.Start(send))
+	assert.Empty(t, wm.List())
+
+	require.NoError(t, wm.Add(dir, "pubkey"))
+	assert.ErrorIs(t, wm.Add(dir, "other"), ErrWatchAlreadyExists)
+	wm.Stop()
+
+	// watches survive the restarts
+	wm = newTestWatchManager(t, storagePath)
+	require.NoError(t, wm.Start(send))
+	assert.Equal(t, []Watch{{Path: dir, Peer: "pubkey"}}, wm.List())
+
+	require.NoError(t, wm.Remove(dir))
+	assert.ErrorIs(t, wm.Remove(dir), ErrWatchNotFound)
+	wm.Stop()
+
+	wm = newTestWatchManager(t, storagePath)
+	require.NoError(t, wm.Start(send))
+	assert.Empty(t, wm.List())
+	wm.Stop()
+}
+
+func TestWatchManager_RemovesBatchesStagedBeforeStart(t *testing.T) {
+	category.Set(t, category.File)
+
+	wm := newTestWatchManager(t, filepath.Join(t.TempDir(), "watches.json"))
+	staged := filepath.Join(wm.stagingPath, "batch123", "file")
+	require.NoError(t, os.MkdirAll(filepath.Dir(staged), 0o700))
+	require.NoError(t, os.WriteFile(staged, []byte("data"), 0o600))
+
+	require.NoError(t, wm.Start(func(string, []string) error { return nil }))
+	defer wm.Stop()
+
+	_, err := os.Stat(wm.stagingPath)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestWatchManager_SendsChangedFiles(t *testing.T) {
+	category.Set(t, category.File)
+
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "build"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build", "old.bin"), []byte("old"), 0600))
+
+	sentCh := make(chan watchSent, 4)
+	wm := newTestWatchManager(t, filepath.Join(t.TempDir(), "watches.json"))
+	require.NoError(t, wm.Start(func(peer string, paths []string) error {
+		sentCh <- watchSent{peer: peer, paths: paths, files: stagedFiles(t, paths)}
+		return nil
+	}))
+	defer wm.Stop()
+	require.NoError(t, wm.Add(dir, "pubkey"))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.bin"), []byte("a"), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "build", "sub"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build", "sub", "b.bin"), []byte("b"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.bin"), []byte("aa"), 0600))
+
+	// only the changed files are sent with the same directory structure
+	s := receiveWatchSent(t, sentCh)
+	assert.Equal(t, "pubkey", s.peer)
+	assert.Len(t, s.paths, 2)
+	assert.Equal(t, []string{"a.bin", filepath.Join("build", "sub", "b.bin")}, s.files)
+
+	// files created and removed before the batch is sent are skipped
+	tmp := filepath.Join(dir, "tmp")
+	require.NoError(t, os.WriteFile(tmp, []byte("tmp"), 0600))
+	require.NoError(t, os.Remove(tmp))
+	select {
+	case s := <-sentCh:
+		t.Fatalf("unexpected send of %v", s.files)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func TestWatchManager_RetriesFailedBatches(t *testing.T) {
+	category.Set(t, category.File)
+
+	dir := t.TempDir()
+	sentCh := make(chan watchSent, 4)
+	attempts := 0
+	wm := newTestWatchManager(t, filepath.Join(t.TempDir(), "watches.json"))
+	require.NoError(t, wm.Start(func(peer string, paths []string) error {
+		attempts++
+		if attempts == 1 {
+			return errors.New("peer is offline")
+		}
+		sentCh <- watchSent{peer: peer, paths: paths, files: stagedFiles(t, paths)}
+		return nil
+	}))
+	defer wm.Stop()
+	require.NoError(t, wm.Add(dir, "pubkey"))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.bin"), []byte("a"), 0600))
+	s := receiveWatchSent(t, sentCh)
+	assert.Equal(t, []string{"a.bin"}, s.files)
+
+	// files which were not delivered are sent again
+	require.Len(t, s.paths, 1)
+	wm.TransferFinished(&pb.Transfer{
+		Direction: pb.Direction_OUTGOING,
+		Files:     []*pb.File{{FullPath: s.paths[0], Status: pb.Status_CANCELED_BY_PEER}},
+	})
+	assert.NoDirExists(t, filepath.Dir(s.paths[0]))
+	s = receiveWatchSent(t, sentCh)
+	assert.Equal(t, []string{"a.bin"}, s.files)
+
+	// delivered batches are only removed
+	wm.TransferFinished(&pb.Transfer{
+		Direction: pb.Direction_OUTGOING,
+		Files:     []*pb.File{{FullPath: s.paths[0], Status: pb.Status_SUCCESS}},
+	})
+	assert.NoDirExists(t, filepath.Dir(s.paths[0]))
+	select {
+	case s := <-sentCh:
+		t.Fatalf("unexpected send of %v", s.files)
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+func TestWatchManager_SendsChangesMissedWhileStopped(t *testing.T) {
+	category.Set(t, category.File)
+
+	storagePath := filepath.Join(t.TempDir(), "watches.json")
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "old.bin"), []byte("old"), 0600))
+
+	sentCh := make(chan watchSent, 4)
+	send := func(peer string, paths []string) error {
+		sentCh <- watchSent{peer: peer, paths: paths, files: stagedFiles(t, paths)}
+		return nil
+	}
+
+	wm := newTestWatchManager(t, storagePath)
+	require.NoError(t, wm.Start(send))
+	require.NoError(t, wm.Add(dir, "pubkey"))
+	wm.Stop()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "build"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build", "new.bin"), []byte("new"), 0600))
+
+	wm = newTestWatchManager(t, storagePath)
+	require.NoError(t, wm.Start(send))
+	defer wm.Stop()
+
+	s := receiveWatchSent(t, sentCh)
+	assert.Equal(t, "pubkey", s.peer)
+	assert.Equal(t, []string{filepath.Join("build", "new.bin")}, s.files)
+}
@@ -88,6 +88,31 @@ const (
 	CodePauseInterrupted                       int64 = 3073
 	CodeECHTechUnsupported                     int64 = 3074
 	CodeECHGloballyDisabled                    int64 = 3075
+	CodeSplitTunnelInvalidApp                  int64 = 3076
+	CodeSplitTunnelAppNoop                     int64 = 3077
+	CodeSplitTunnelNotSupported                int64 = 3078
+	CodeAllowlistInvalidDomain                 int64 = 3079
+	CodeAllowlistDomainNoop                    int64 = 3080
+	CodeScheduleInvalidRule                    int64 = 3081
+	CodeScheduleRuleNoop                       int64 = 3082
+	CodeTrustedNetworkInvalid                  int64 = 3083
+	CodeTrustedNetworkNoop                     int64 = 3084
+	CodeMetricsInvalidListen                   int64 = 3085
+	CodeMetricsListenFailed                    int64 = 3086
+	CodeSettingsFileInvalid                    int64 = 3087
+	CodeSettingsNotApplied                     int64 = 3088
+	CodePolicyLocked                           int64 = 3089
+	CodeVPNConfigExportUnsupported             int64 = 3090
+	CodeMultiHopUnsupported                    int64 = 3091
+	CodeFailoverInvalidThreshold               int64 = 3092
+	CodeServerLatencies                        int64 = 3093
+	CodeFavoriteNotFound                       int64 = 3094
+	CodeFavoriteExists                         int64 = 3095
+	CodeFavoriteInvalidName                    int64 = 3096
+	CodeFavoritesLimitReached                  int64 = 3097
+	CodeInvalidConnectionOverrides             int64 = 3098
+	CodeDNSRouteInvalid                        int64 = 3099
+	CodeDNSRouteNoop                           int64 = 3100
 )
 
 type ErrorWithCode struct {
@@ -84,6 +84,25 @@ const (
 	// FileshareHistoryFile is the storage file used by libdrop
 	FileshareHistoryFileName = "fileshare_history.db"
 
+	// FileshareWatchesFileName is the file where the watched directories are stored
+	FileshareWatchesFileName = "fileshare_watches.json"
+
+	// FileshareWatchStagingDirName is the directory where the changes of the watched directories
+	// are staged until they are sent
+	FileshareWatchStagingDirName = "fileshare_watch_staging"
+
+	// FileshareLimitsFileName is the file where the transfer limits are stored
+	FileshareLimitsFileName = "fileshare_limits.json"
+
+	// FileshareChecksumsFileName is the file where the checksums of the transferred files are stored
+	FileshareChecksumsFileName = "fileshare_checksums.json"
+
+	// FileshareHookFileName is the file where the post-transfer hook is stored
+	FileshareHookFileName = "fileshare_hook.json"
+
+	// FileshareTextsFileName is the file where the sent and received text snippets are stored
+	FileshareTextsFileName = "fileshare_texts.json"
+
 	FileshareSocket = TmpDir + "fileshare.sock"
 
 	FileshareLogFileName = "nordfileshare" + LogFileExtension
@@ -136,6 +155,8 @@ var (
 	ConfigFilesPathCommon        = filepath.Join(AppDataPath, "conf")
 	StaticConfigFilename         = filepath.Join(DatFilesPathCommon, "install_static.dat")
 	RecentVPNConnectionsFilename = filepath.Join(DatFilesPathCommon, "recent_connections.dat")
+	ConnectionHistoryFilename    = filepath.Join(DatFilesPathCommon, "connection_history.dat")
+	FavoritesFilename            = filepath.Join(DatFilesPathCommon, "favorites.dat")
 
 	BakFilesPath = filepath.Join(AppDataPath, "backup")
 
@@ -145,9 +166,15 @@ var (
 	// OvpnObfsTemplatePath defines filename of ovpn obfuscated template file
 	OvpnObfsTemplatePath = filepath.Join(DatFilesPathCommon, "ovpn_xor_template.xslt")
 
+	// PolicyFilename defines the location of the policy file written by the administrator
+	PolicyFilename = "/etc/nordvpn/policy.yaml"
+
 	// DaemonSocket defines system daemon socket file location
 	DaemonSocket = filepath.Join(RunDir, "/nordvpnd.sock")
 
+	// MetricsSocket defines the default location of the metrics exporter socket
+	MetricsSocket = filepath.Join(RunDir, "/metrics.sock")
+
 	// DaemonPid defines daemon PID file location
 	DaemonPid = filepath.Join(RunDir, "/nordvpnd.pid")
 
@@ -169,6 +196,11 @@ var (
 		"192.168.0.0/16",
 		"169.254.0.0/16",
 	}
+	// LocalNetworks6 are the unique local and link local IPv6 networks
+	LocalNetworks6 = []string{
+		"fc00::/7",
+		"fe80::/10",
+	}
 	MDNSSubnet = "224.0.0.251/32"
 )
 
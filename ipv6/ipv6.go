@@ -2,6 +2,7 @@
 package ipv6
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/NordSecurity/nordvpn-linux/kernel"
@@ -12,6 +13,9 @@ import (
 type Blocker interface {
 	Block() error
 	Unblock() error
+	// Allow IPv6 on a single interface while it is blocked in the rest of the system. Blocking
+	// again disables IPv6 on the interface as well.
+	Allow(iface string) error
 }
 
 type Ipv6 struct {
@@ -47,3 +51,13 @@ func (i *Ipv6) Unblock() error {
 	}
 	return nil
 }
+
+// Allow IPv6 on the interface.
+func (i *Ipv6) Allow(iface string) error {
+	i.Lock()
+	defer i.Unlock()
+	if !i.sysctlSetter.Exists() {
+		return fmt.Errorf("allowing IPv6 on %s: IPv6 module is not enabled", iface)
+	}
+	return kernel.SetParameter(fmt.Sprintf("net.ipv6.conf.%s.disable_ipv6", iface), 0)
+}
@@ -25,6 +25,8 @@ type Networker interface {
 	PermitFileshare() error
 	// ForbidFileshare removes a rules enabling fileshare port for all available peers and sets fileshare as forbidden
 	ForbidFileshare() error
+	// SetFileshareRateLimit limits the upload rate of the fileshare transfers to the peers
+	SetFileshareRateLimit(config.FileshareRateLimit) error
 	StatusMap() (map[string]string, error)
 	LastServerName() string
 	Start(
@@ -206,6 +206,141 @@ func (*NotifyNewTransferResponse_ServiceErrorCode) isNotifyNewTransferResponse_R
 
 func (*NotifyNewTransferResponse_MeshnetErrorCode) isNotifyNewTransferResponse_Response() {}
 
+// FileshareRateLimit limits the upload rate of the outgoing transfers in bytes per second.
+// 0 disables the limit.
+type FileshareRateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // Shared by all the transfers
+	Peer  uint64 `protobuf:"varint,2,opt,name=peer,proto3" json:"peer,omitempty"`   // Shared by the transfers to the same peer
+}
+
+func (x *FileshareRateLimit) Reset() {
+	*x = FileshareRateLimit{}
+	mi := &file_fsnotify_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileshareRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileshareRateLimit) ProtoMessage() {}
+
+func (x *FileshareRateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_fsnotify_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileshareRateLimit.ProtoReflect.Descriptor instead.
+func (*FileshareRateLimit) Descriptor() ([]byte, []int) {
+	return file_fsnotify_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FileshareRateLimit) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FileshareRateLimit) GetPeer() uint64 {
+	if x != nil {
+		return x.Peer
+	}
+	return 0
+}
+
+// GetFileshareRateLimitResponse defines a response of the fileshare rate limit request
+type GetFileshareRateLimitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*GetFileshareRateLimitResponse_RateLimit
+	//	*GetFileshareRateLimitResponse_ServiceErrorCode
+	Response isGetFileshareRateLimitResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetFileshareRateLimitResponse) Reset() {
+	*x = GetFileshareRateLimitResponse{}
+	mi := &file_fsnotify_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileshareRateLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileshareRateLimitResponse) ProtoMessage() {}
+
+func (x *GetFileshareRateLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fsnotify_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileshareRateLimitResponse.ProtoReflect.Descriptor instead.
+func (*GetFileshareRateLimitResponse) Descriptor() ([]byte, []int) {
+	return file_fsnotify_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *GetFileshareRateLimitResponse) GetResponse() isGetFileshareRateLimitResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetFileshareRateLimitResponse) GetRateLimit() *FileshareRateLimit {
+	if x, ok := x.GetResponse().(*GetFileshareRateLimitResponse_RateLimit); ok {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *GetFileshareRateLimitResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*GetFileshareRateLimitResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+type isGetFileshareRateLimitResponse_Response interface {
+	isGetFileshareRateLimitResponse_Response()
+}
+
+type GetFileshareRateLimitResponse_RateLimit struct {
+	RateLimit *FileshareRateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3,oneof"`
+}
+
+type GetFileshareRateLimitResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,2,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+func (*GetFileshareRateLimitResponse_RateLimit) isGetFileshareRateLimitResponse_Response() {}
+
+func (*GetFileshareRateLimitResponse_ServiceErrorCode) isGetFileshareRateLimitResponse_Response() {}
+
 var File_fsnotify_proto protoreflect.FileDescriptor
 
 var file_fsnotify_proto_rawDesc = []byte{
@@ -243,11 +378,26 @@ var file_fsnotify_proto_rawDesc = []byte{
 	0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e,
 	0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d,
 	0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42,
-	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c,
-	0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x12, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x22, 0xb2, 0x01, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52,
+	0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e,
+	0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64,
+	0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65,
+	0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -262,25 +412,29 @@ func file_fsnotify_proto_rawDescGZIP() []byte {
 	return file_fsnotify_proto_rawDescData
 }
 
-var file_fsnotify_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_fsnotify_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_fsnotify_proto_goTypes = []any{
-	(*NewTransferNotification)(nil),   // 0: meshpb.NewTransferNotification
-	(*NotifyNewTransferResponse)(nil), // 1: meshpb.NotifyNewTransferResponse
-	(*Empty)(nil),                     // 2: meshpb.Empty
-	(UpdatePeerErrorCode)(0),          // 3: meshpb.UpdatePeerErrorCode
-	(ServiceErrorCode)(0),             // 4: meshpb.ServiceErrorCode
-	(MeshnetErrorCode)(0),             // 5: meshpb.MeshnetErrorCode
+	(*NewTransferNotification)(nil),       // 0: meshpb.NewTransferNotification
+	(*NotifyNewTransferResponse)(nil),     // 1: meshpb.NotifyNewTransferResponse
+	(*FileshareRateLimit)(nil),            // 2: meshpb.FileshareRateLimit
+	(*GetFileshareRateLimitResponse)(nil), // 3: meshpb.GetFileshareRateLimitResponse
+	(*Empty)(nil),                         // 4: meshpb.Empty
+	(UpdatePeerErrorCode)(0),              // 5: meshpb.UpdatePeerErrorCode
+	(ServiceErrorCode)(0),                 // 6: meshpb.ServiceErrorCode
+	(MeshnetErrorCode)(0),                 // 7: meshpb.MeshnetErrorCode
 }
 var file_fsnotify_proto_depIdxs = []int32{
-	2, // 0: meshpb.NotifyNewTransferResponse.empty:type_name -> meshpb.Empty
-	3, // 1: meshpb.NotifyNewTransferResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	4, // 2: meshpb.NotifyNewTransferResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	5, // 3: meshpb.NotifyNewTransferResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4, // 0: meshpb.NotifyNewTransferResponse.empty:type_name -> meshpb.Empty
+	5, // 1: meshpb.NotifyNewTransferResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	6, // 2: meshpb.NotifyNewTransferResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	7, // 3: meshpb.NotifyNewTransferResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	2, // 4: meshpb.GetFileshareRateLimitResponse.rate_limit:type_name -> meshpb.FileshareRateLimit
+	6, // 5: meshpb.GetFileshareRateLimitResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_fsnotify_proto_init() }
@@ -297,13 +451,17 @@ func file_fsnotify_proto_init() {
 		(*NotifyNewTransferResponse_ServiceErrorCode)(nil),
 		(*NotifyNewTransferResponse_MeshnetErrorCode)(nil),
 	}
+	file_fsnotify_proto_msgTypes[3].OneofWrappers = []any{
+		(*GetFileshareRateLimitResponse_RateLimit)(nil),
+		(*GetFileshareRateLimitResponse_ServiceErrorCode)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_fsnotify_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -46,6 +46,8 @@ const (
 	Meshnet_ConnectCancel_FullMethodName             = "/meshpb.Meshnet/ConnectCancel"
 	Meshnet_NotifyNewTransfer_FullMethodName         = "/meshpb.Meshnet/NotifyNewTransfer"
 	Meshnet_GetPrivateKey_FullMethodName             = "/meshpb.Meshnet/GetPrivateKey"
+	Meshnet_SetFileshareRateLimit_FullMethodName     = "/meshpb.Meshnet/SetFileshareRateLimit"
+	Meshnet_GetFileshareRateLimit_FullMethodName     = "/meshpb.Meshnet/GetFileshareRateLimit"
 )
 
 // MeshnetClient is the client API for Meshnet service.
@@ -115,6 +117,10 @@ type MeshnetClient interface {
 	NotifyNewTransfer(ctx context.Context, in *NewTransferNotification, opts ...grpc.CallOption) (*NotifyNewTransferResponse, error)
 	// GetPrivateKey is used to send self private key over to fileshare daemon
 	GetPrivateKey(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PrivateKeyResponse, error)
+	// SetFileshareRateLimit limits the upload rate of the fileshare transfers
+	SetFileshareRateLimit(ctx context.Context, in *FileshareRateLimit, opts ...grpc.CallOption) (*MeshnetResponse, error)
+	// GetFileshareRateLimit returns the upload rate limit of the fileshare transfers
+	GetFileshareRateLimit(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetFileshareRateLimitResponse, error)
 }
 
 type meshnetClient struct {
@@ -395,6 +401,26 @@ func (c *meshnetClient) GetPrivateKey(ctx context.Context, in *Empty, opts ...gr
 	return out, nil
 }
 
+func (c *meshnetClient) SetFileshareRateLimit(ctx context.Context, in *FileshareRateLimit, opts ...grpc.CallOption) (*MeshnetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MeshnetResponse)
+	err := c.cc.Invoke(ctx, Meshnet_SetFileshareRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) GetFileshareRateLimit(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetFileshareRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileshareRateLimitResponse)
+	err := c.cc.Invoke(ctx, Meshnet_GetFileshareRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MeshnetServer is the server API for Meshnet service.
 // All implementations must embed UnimplementedMeshnetServer
 // for forward compatibility.
@@ -462,6 +488,10 @@ type MeshnetServer interface {
 	NotifyNewTransfer(context.Context, *NewTransferNotification) (*NotifyNewTransferResponse, error)
 	// GetPrivateKey is used to send self private key over to fileshare daemon
 	GetPrivateKey(context.Context, *Empty) (*PrivateKeyResponse, error)
+	// SetFileshareRateLimit limits the upload rate of the fileshare transfers
+	SetFileshareRateLimit(context.Context, *FileshareRateLimit) (*MeshnetResponse, error)
+	// GetFileshareRateLimit returns the upload rate limit of the fileshare transfers
+	GetFileshareRateLimit(context.Context, *Empty) (*GetFileshareRateLimitResponse, error)
 	mustEmbedUnimplementedMeshnetServer()
 }
 
@@ -553,6 +583,12 @@ func (UnimplementedMeshnetServer) NotifyNewTransfer(context.Context, *NewTransfe
 func (UnimplementedMeshnetServer) GetPrivateKey(context.Context, *Empty) (*PrivateKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrivateKey not implemented")
 }
+func (UnimplementedMeshnetServer) SetFileshareRateLimit(context.Context, *FileshareRateLimit) (*MeshnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFileshareRateLimit not implemented")
+}
+func (UnimplementedMeshnetServer) GetFileshareRateLimit(context.Context, *Empty) (*GetFileshareRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileshareRateLimit not implemented")
+}
 func (UnimplementedMeshnetServer) mustEmbedUnimplementedMeshnetServer() {}
 func (UnimplementedMeshnetServer) testEmbeddedByValue()                 {}
 
@@ -1060,6 +1096,42 @@ func _Meshnet_GetPrivateKey_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Meshnet_SetFileshareRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileshareRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).SetFileshareRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Meshnet_SetFileshareRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).SetFileshareRateLimit(ctx, req.(*FileshareRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_GetFileshareRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).GetFileshareRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Meshnet_GetFileshareRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).GetFileshareRateLimit(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Meshnet_ServiceDesc is the grpc.ServiceDesc for Meshnet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1175,6 +1247,14 @@ var Meshnet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPrivateKey",
 			Handler:    _Meshnet_GetPrivateKey_Handler,
 		},
+		{
+			MethodName: "SetFileshareRateLimit",
+			Handler:    _Meshnet_SetFileshareRateLimit_Handler,
+		},
+		{
+			MethodName: "GetFileshareRateLimit",
+			Handler:    _Meshnet_GetFileshareRateLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
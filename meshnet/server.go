@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 
 	"golang.org/x/exp/slices"
+	"golang.org/x/sys/unix"
 
 	"github.com/NordSecurity/nordvpn-linux/auth"
 	"github.com/NordSecurity/nordvpn-linux/config"
@@ -194,7 +195,9 @@ func (s *Server) EnableMeshnet(ctx context.Context, _ *pb.Empty) (*pb.MeshnetRes
 
 	ucred, err := internal.UcredFromContext(ctx)
 	if err != nil {
-		s.pub.Publish(fmt.Errorf("failed to get peer credentials when enabling meshnet: %w", err))
+		// meshnet is enabled internally, e.g. on a trusted network, on behalf of the user who
+		// enabled it before
+		ucred = unix.Ucred{Uid: cfg.Meshnet.EnabledByUID, Gid: cfg.Meshnet.EnabledByGID}
 	}
 
 	if err = s.cm.SaveWith(func(c config.Config) config.Config {
@@ -222,7 +225,7 @@ func (s *Server) EnableMeshnet(ctx context.Context, _ *pb.Empty) (*pb.MeshnetRes
 	// because filesharing daemon checks whether meshnet is enabled.
 	// Also not returning errors on filesharing enabling failure because it is not essential
 	// for Meshnet usage.
-	if ucred.Pid != 0 {
+	if ucred.Pid != 0 || ucred.Uid != 0 {
 		if err = s.norduser.StartFileshare(ucred.Uid); err != nil {
 			s.pub.Publish(fmt.Errorf("enabling fileshare: %w", err))
 		}
@@ -1678,6 +1681,63 @@ func (s *Server) DisableAutomaticFileshare(
 	}, nil
 }
 
+// SetFileshareRateLimit limits the upload rate of the fileshare transfers to the peers
+func (s *Server) SetFileshareRateLimit(
+	ctx context.Context,
+	req *pb.FileshareRateLimit,
+) (*pb.MeshnetResponse, error) {
+	limit := config.FileshareRateLimit{Total: req.GetTotal(), Peer: req.GetPeer()}
+	if err := s.netw.SetFileshareRateLimit(limit); err != nil {
+		s.pub.Publish(fmt.Errorf("setting fileshare rate limit: %w", err))
+		return &pb.MeshnetResponse{
+			Response: &pb.MeshnetResponse_MeshnetError{
+				MeshnetError: pb.MeshnetErrorCode_LIB_FAILURE,
+			},
+		}, nil
+	}
+
+	if err := s.cm.SaveWith(func(c config.Config) config.Config {
+		c.FileshareRateLimit = limit
+		return c
+	}); err != nil {
+		s.pub.Publish(err)
+		return &pb.MeshnetResponse{
+			Response: &pb.MeshnetResponse_ServiceError{
+				ServiceError: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	return &pb.MeshnetResponse{
+		Response: &pb.MeshnetResponse_Empty{},
+	}, nil
+}
+
+// GetFileshareRateLimit returns the upload rate limits of the fileshare transfers
+func (s *Server) GetFileshareRateLimit(
+	context.Context,
+	*pb.Empty,
+) (*pb.GetFileshareRateLimitResponse, error) {
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.GetFileshareRateLimitResponse{
+			Response: &pb.GetFileshareRateLimitResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	return &pb.GetFileshareRateLimitResponse{
+		Response: &pb.GetFileshareRateLimitResponse_RateLimit{
+			RateLimit: &pb.FileshareRateLimit{
+				Total: cfg.FileshareRateLimit.Total,
+				Peer:  cfg.FileshareRateLimit.Peer,
+			},
+		},
+	}, nil
+}
+
 // NotifyNewTransfer notifies peer about new fileshare transfer
 func (s *Server) NotifyNewTransfer(
 	ctx context.Context,
@@ -1993,6 +2053,82 @@ func (s *Server) getPeerWithIdentifier(id string, peers mesh.MachinePeers) *mesh
 	return &peers[index]
 }
 
+// PeerPermissions returns the hostname of this machine and the permissions granted to its peers
+func (s *Server) PeerPermissions() (string, []config.SettingsFileMeshPeer, error) {
+	_, self, peers, grpcErr := s.fetchPeers()
+	if grpcErr != nil {
+		return "", nil, fetchError(grpcErr)
+	}
+
+	permissions := make([]config.SettingsFileMeshPeer, 0, len(peers))
+	for _, peer := range peers {
+		permissions = append(permissions, config.SettingsFileMeshPeer{
+			Hostname:          peer.Hostname,
+			AllowIncoming:     &peer.DoIAllowInbound,
+			AllowRouting:      &peer.DoIAllowRouting,
+			AllowLocalNetwork: &peer.DoIAllowLocalNetwork,
+			AllowFileshare:    &peer.DoIAllowFileshare,
+			AlwaysAcceptFiles: &peer.AlwaysAcceptFiles,
+		})
+	}
+	return self.Hostname, permissions, nil
+}
+
+// SetPeerPermissions updates the permissions granted to the peers. Permissions which are not set
+// are left unchanged.
+func (s *Server) SetPeerPermissions(permissions []config.SettingsFileMeshPeer) error {
+	token, self, peers, grpcErr := s.fetchPeers()
+	if grpcErr != nil {
+		return fetchError(grpcErr)
+	}
+
+	for _, permission := range permissions {
+		index := slices.IndexFunc(peers, func(p mesh.MachinePeer) bool {
+			return strings.EqualFold(p.Hostname, permission.Hostname)
+		})
+		if index == -1 {
+			return fmt.Errorf("peer %s not found", permission.Hostname)
+		}
+
+		peer := peers[index]
+		setIfPresent(&peer.DoIAllowInbound, permission.AllowIncoming)
+		setIfPresent(&peer.DoIAllowRouting, permission.AllowRouting)
+		setIfPresent(&peer.DoIAllowLocalNetwork, permission.AllowLocalNetwork)
+		setIfPresent(&peer.DoIAllowFileshare, permission.AllowFileshare)
+		setIfPresent(&peer.AlwaysAcceptFiles, permission.AlwaysAcceptFiles)
+		if err := s.updatePeerPermissions(token, self.ID, peer); err != nil {
+			return fmt.Errorf("updating permissions of peer %s: %w", peer.Hostname, err)
+		}
+	}
+	return nil
+}
+
+func setIfPresent(target *bool, value *bool) {
+	if value != nil {
+		*target = *value
+	}
+}
+
+// fetchError converts the error of fetchPeers
+func fetchError(grpcErr *pb.Error) error {
+	switch grpcErr.GetError().(type) {
+	case *pb.Error_MeshnetErrorCode:
+		if grpcErr.GetMeshnetErrorCode() == pb.MeshnetErrorCode_NOT_ENABLED {
+			return ErrMeshnetNotEnabled
+		}
+		return ErrDeviceNotRegistered
+	case *pb.Error_ServiceErrorCode:
+		//exhaustive:ignore
+		switch grpcErr.GetServiceErrorCode() {
+		case pb.ServiceErrorCode_NOT_LOGGED_IN:
+			return ErrNotLoggedIn
+		case pb.ServiceErrorCode_CONFIG_FAILURE:
+			return ErrConfigLoad
+		}
+	}
+	return fmt.Errorf("listing peers: %s", grpcErr)
+}
+
 func (s *Server) RemoteConfigUpdate(config remote.RemoteConfigEvent) error {
 	if !config.MeshnetFeatureEnabled {
 		if _, err := s.DisableMeshnet(context.Background(), &pb.Empty{}); err != nil {
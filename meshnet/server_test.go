@@ -66,7 +66,9 @@ type registrationChecker struct {
 func (r registrationChecker) CheckAndRegisterMeshnet() bool { return r.registrationErr == nil }
 func (r registrationChecker) ForceRegisterMeshnet() error   { return r.registrationErr }
 
-type workingNetworker struct{}
+type workingNetworker struct {
+	fileshareRateLimit config.FileshareRateLimit
+}
 
 func (workingNetworker) Start(
 	context.Context,
@@ -92,6 +94,11 @@ func (n *workingNetworker) ForbidFileshare() error {
 	return nil
 }
 
+func (n *workingNetworker) SetFileshareRateLimit(limit config.FileshareRateLimit) error {
+	n.fileshareRateLimit = limit
+	return nil
+}
+
 func (*workingNetworker) Refresh(mesh.MachineMap) error { return nil }
 func (*workingNetworker) StatusMap() (map[string]string, error) {
 	return map[string]string{}, nil
@@ -235,6 +242,28 @@ func TestServer_EnableMeshnet(t *testing.T) {
 	}
 }
 
+func TestServer_EnableMeshnetWithoutCredentialsKeepsOwner(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	mserver := newMockedServer(t, false)
+	assert.NoError(t, mserver.cm.SaveWith(func(c config.Config) config.Config {
+		c.Meshnet.EnabledByUID = 1000
+		c.Meshnet.EnabledByGID = 1001
+		return c
+	}))
+
+	// trusted networks enable meshnet without the peer credentials
+	resp, err := mserver.EnableMeshnet(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.MeshnetResponse_Empty{}, resp.GetResponse())
+
+	var cfg config.Config
+	assert.NoError(t, mserver.cm.Load(&cfg))
+	assert.True(t, cfg.Mesh)
+	assert.Equal(t, uint32(1000), cfg.Meshnet.EnabledByUID)
+	assert.Equal(t, uint32(1001), cfg.Meshnet.EnabledByGID)
+}
+
 func TestServer_DisableMeshnet(t *testing.T) {
 	category.Set(t, category.Unit)
 	tests := []struct {
@@ -274,6 +303,60 @@ func TestServer_DisableMeshnet(t *testing.T) {
 	}
 }
 
+func TestServer_FileshareRateLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	mserver := newMockedServer(t, true)
+	limit := &pb.FileshareRateLimit{Total: 1024 * 1024, Peer: 256 * 1024}
+	resp, err := mserver.SetFileshareRateLimit(context.Background(), limit)
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.MeshnetResponse_Empty{}, resp.GetResponse())
+
+	expected := config.FileshareRateLimit{Total: 1024 * 1024, Peer: 256 * 1024}
+	assert.Equal(t, expected, mserver.netw.(*workingNetworker).fileshareRateLimit)
+	var cfg config.Config
+	assert.NoError(t, mserver.cm.Load(&cfg))
+	assert.Equal(t, expected, cfg.FileshareRateLimit)
+
+	getResp, err := mserver.GetFileshareRateLimit(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, limit.Total, getResp.GetRateLimit().GetTotal())
+	assert.Equal(t, limit.Peer, getResp.GetRateLimit().GetPeer())
+}
+
+func TestServer_PeerPermissions(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	registryApi := mock.RegistryMock{Peers: mesh.MachinePeers{{
+		ID:              uuid.New(),
+		Hostname:        "peer-everest.nord",
+		DoIAllowInbound: true,
+	}}}
+	server := newMockedServer(t, true)
+	server.mapper = &registryApi
+	server.reg = &registryApi
+
+	allow := true
+	require.NoError(t, server.SetPeerPermissions([]config.SettingsFileMeshPeer{
+		{Hostname: "PEER-everest.nord", AllowFileshare: &allow},
+	}))
+	assert.True(t, registryApi.Peers[0].DoIAllowFileshare)
+	// permissions which are not set are left unchanged
+	assert.True(t, registryApi.Peers[0].DoIAllowInbound)
+	assert.False(t, registryApi.Peers[0].DoIAllowRouting)
+
+	_, permissions, err := server.PeerPermissions()
+	require.NoError(t, err)
+	require.Len(t, permissions, 1)
+	assert.Equal(t, "peer-everest.nord", permissions[0].Hostname)
+	assert.True(t, *permissions[0].AllowIncoming)
+	assert.True(t, *permissions[0].AllowFileshare)
+	assert.False(t, *permissions[0].AllowRouting)
+
+	err = server.SetPeerPermissions([]config.SettingsFileMeshPeer{{Hostname: "unknown.nord", AllowFileshare: &allow}})
+	assert.Error(t, err)
+}
+
 func TestServer_DisableMeshnetViaRemoteConfig(t *testing.T) {
 	category.Set(t, category.Unit)
 	t.Run("basic test", func(t *testing.T) {
@@ -29,6 +29,10 @@ func (m *mockResolver) Resolve(domain string) ([]netip.Addr, error) {
 	return m.ips, m.err
 }
 
+func (m *mockResolver) ResolveWithTTL(domain string) ([]netip.Addr, time.Duration, error) {
+	return m.ips, time.Minute, m.err
+}
+
 func newMockResolver(ips ...string) *mockResolver {
 	addrs := make([]netip.Addr, 0, len(ips))
 	for _, ip := range ips {
@@ -2,10 +2,13 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/netip"
 	"time"
+
+	"github.com/miekg/dns"
 )
 
 const noFwMark uint32 = 0
@@ -42,3 +45,60 @@ func lookupAddress(addr string, dns string, protocol string, fwmark uint32) ([]n
 	}
 	return ips, nil
 }
+
+// lookupAddressWithTTL queries A and AAAA records of the domain and returns the addresses
+// together with the lowest TTL of the answers
+func lookupAddressWithTTL(addr string, nameserver string, protocol string, fwmark uint32) ([]netip.Addr, time.Duration, error) {
+	dialer := &net.Dialer{Timeout: time.Second * 7}
+	if fwmark != noFwMark {
+		dialer.Control = NewFwmarkControlFn(fwmark)
+	}
+	client := dns.Client{Net: protocol, Dialer: dialer}
+
+	// if the server address doesn't have port number then add port 53
+	if _, _, err := net.SplitHostPort(nameserver); err != nil {
+		nameserver = net.JoinHostPort(nameserver, "53")
+	}
+
+	var ips []netip.Addr
+	var ttl uint32
+	var errs []error
+	for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		msg := new(dns.Msg)
+		msg.SetQuestion(dns.Fqdn(addr), qtype)
+		resp, _, err := client.Exchange(msg, nameserver)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("querying %s: %w", dns.TypeToString[qtype], err))
+			continue
+		}
+		if resp.Rcode != dns.RcodeSuccess {
+			errs = append(errs, fmt.Errorf("querying %s: %s", dns.TypeToString[qtype], dns.RcodeToString[resp.Rcode]))
+			continue
+		}
+
+		for _, answer := range resp.Answer {
+			var ip netip.Addr
+			switch record := answer.(type) {
+			case *dns.A:
+				ip, _ = netip.AddrFromSlice(record.A.To4())
+			case *dns.AAAA:
+				ip, _ = netip.AddrFromSlice(record.AAAA)
+			}
+			if !ip.IsValid() {
+				continue
+			}
+			ips = append(ips, ip)
+			if ttl == 0 || answer.Header().Ttl < ttl {
+				ttl = answer.Header().Ttl
+			}
+		}
+	}
+
+	if len(ips) == 0 {
+		if err := errors.Join(errs...); err != nil {
+			return nil, 0, fmt.Errorf("looking addr ip up: %w", err)
+		}
+		return nil, 0, fmt.Errorf("looking addr ip up: no addresses found for %s", addr)
+	}
+	return ips, time.Duration(ttl) * time.Second, nil
+}
@@ -86,6 +86,24 @@ func TestResolveHostUsingLocalDnsServer(t *testing.T) {
 	}
 }
 
+func TestLookupAddressWithTTLUsingLocalDnsServer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	domainName := "nordvpn.com"
+	ipAddress := netip.MustParseAddr("1.2.3.4")
+
+	dnsAddr, shutdown := startTestDNSServer(t, domainName, ipAddress)
+	defer shutdown()
+
+	result, ttl, err := lookupAddressWithTTL(domainName, dnsAddr, "udp", noFwMark)
+	assert.NoError(t, err)
+	assert.Equal(t, []netip.Addr{ipAddress}, result)
+	assert.Equal(t, 60*time.Second, ttl)
+
+	_, _, err = lookupAddressWithTTL("unknown.com", dnsAddr, "udp", noFwMark)
+	assert.Error(t, err)
+}
+
 func TestResolveHost(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -0,0 +1,83 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/netip"
+	"os"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+)
+
+const icmpProtocolIPv4 = 1
+
+// ICMPPinger measures the round trip time using ICMP echo. Sockets are marked with the
+// firewall mark, so the servers are reached directly even while the VPN is connected.
+type ICMPPinger struct {
+	fwmark func() uint32
+}
+
+// NewICMPPinger creates the pinger. The firewall mark is read for every ping, since it can be
+// changed while the daemon is running.
+func NewICMPPinger(fwmark func() uint32) *ICMPPinger {
+	return &ICMPPinger{fwmark: fwmark}
+}
+
+// Ping sends a single echo request and waits for the reply until the context is done.
+func (p *ICMPPinger) Ping(ctx context.Context, addr netip.Addr) (time.Duration, error) {
+	if !addr.Is4() {
+		return 0, fmt.Errorf("pinging %s: only IPv4 is supported", addr)
+	}
+
+	lc := net.ListenConfig{Control: NewFwmarkControlFn(p.fwmark())}
+	conn, err := lc.ListenPacket(ctx, "ip4:icmp", "0.0.0.0")
+	if err != nil {
+		return 0, fmt.Errorf("opening icmp socket: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return 0, fmt.Errorf("setting icmp deadline: %w", err)
+		}
+	}
+
+	// #nosec G404 -- not used for cryptographic purposes
+	seq := rand.Intn(1 << 16)
+	id := os.Getpid() & 0xffff
+	request, err := (&icmp.Message{
+		Type: ipv4.ICMPTypeEcho,
+		Body: &icmp.Echo{ID: id, Seq: seq, Data: []byte("nordvpn")},
+	}).Marshal(nil)
+	if err != nil {
+		return 0, fmt.Errorf("marshaling icmp echo: %w", err)
+	}
+
+	start := time.Now()
+	if _, err := conn.WriteTo(request, &net.IPAddr{IP: addr.AsSlice()}); err != nil {
+		return 0, fmt.Errorf("sending icmp echo: %w", err)
+	}
+
+	buf := make([]byte, 1500)
+	for {
+		n, peer, err := conn.ReadFrom(buf)
+		if err != nil {
+			return 0, fmt.Errorf("receiving icmp echo reply: %w", err)
+		}
+		// raw socket receives every ICMP packet of the host, skip the unrelated ones
+		peerAddr, ok := peer.(*net.IPAddr)
+		if !ok || !peerAddr.IP.Equal(addr.AsSlice()) {
+			continue
+		}
+		reply, err := icmp.ParseMessage(icmpProtocolIPv4, buf[:n])
+		if err != nil || reply.Type != ipv4.ICMPTypeEchoReply {
+			continue
+		}
+		if echo, ok := reply.Body.(*icmp.Echo); ok && echo.ID == id && echo.Seq == seq {
+			return time.Since(start), nil
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/netip"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
 	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
@@ -47,6 +48,8 @@ func NewResolver(
 
 type DNSResolver interface {
 	Resolve(domain string) ([]netip.Addr, error)
+	// ResolveWithTTL returns the addresses together with the duration they can be cached for
+	ResolveWithTTL(domain string) ([]netip.Addr, time.Duration, error)
 }
 
 func (r *Resolver) Resolve(domain string) ([]netip.Addr, error) {
@@ -79,6 +82,31 @@ func (r *Resolver) resolveWithNameservers(domain string, nameservers []string, p
 	return ipAddrs, nil
 }
 
+// ResolveWithTTL returns both IPv4 and IPv6 addresses of the domain and the lowest TTL of the records
+// The lookups are not serialized, since the resolver keeps no state which they would modify.
+func (r *Resolver) ResolveWithTTL(domain string) ([]netip.Addr, time.Duration, error) {
+	nameservers := FilterInvalidIPs(r.servers.Get(false))
+	var err error
+	for _, nameserver := range nameservers {
+		var fwmark = r.fwmark
+		if r.isVpnConnected.Load() {
+			// While connected to VPN, send the DNS requests thru the tunnel so no fwmark
+			fwmark = noFwMark
+		}
+
+		var ipAddrs []netip.Addr
+		var ttl time.Duration
+		ipAddrs, ttl, err = lookupAddressWithTTL(domain, nameserver, "udp", fwmark)
+		if err == nil {
+			return ipAddrs, ttl, nil
+		}
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("looking address up: %w", err)
+	}
+	return nil, 0, fmt.Errorf("no nameservers available to resolve %s", domain)
+}
+
 func (r *Resolver) updateVpnStatus(isConnected bool) {
 	log.Info("resolver set VPN connected to", isConnected)
 	r.isVpnConnected.Store(isConnected)
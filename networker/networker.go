@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/netip"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/daemon/device"
 	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/leaktest"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/events"
@@ -25,6 +27,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/kernel"
 	"github.com/NordSecurity/nordvpn-linux/log"
 	"github.com/NordSecurity/nordvpn-linux/meshnet"
+	"github.com/NordSecurity/nordvpn-linux/tunnel"
 	mapset "github.com/deckarep/golang-set/v2"
 )
 
@@ -82,6 +85,11 @@ type Networker interface {
 	UnsetFirewall() error
 	GetConnectionParameters() (vpn.ServerData, bool)
 	SetARPIgnore(bool) error
+	SetSplitTunnel(firewall.SplitTunnelMode, []firewall.SplitTunnelCgroup) error
+	SetAllowlistDomainIPs([]netip.Addr) error
+	SetBlockPlainDNS(bool) error
+	SetDNSRoutes([]dns.Route) error
+	LeakTest() leaktest.Report
 }
 
 type killSwitchState int
@@ -112,11 +120,13 @@ type Combined struct {
 	isNetworkSet    bool // used during cleanup
 	isVpnSet        bool // used during cleanup
 	isMeshnetSet    bool
+	isTunnelIPv6    bool // the VPN tunnel carries IPv6 traffic
 	nextVPN         vpn.VPN
 	cfg             mesh.MachineMap
 	lastServer      vpn.ServerData
 	lastCreds       vpn.Credentials
 	lastNameservers []string
+	dnsRoutes       []dns.Route
 	lastPrivateKey  string
 	fwmark          uint32
 	mu              sync.Mutex
@@ -136,6 +146,7 @@ type Combined struct {
 	KillSwitchState killSwitchState
 	fwConfig        firewall.Config
 	ipForwardSetter kernel.SysctlSetter
+	leakTestSystem  leaktest.System
 }
 
 // NewCombined returns a ready made version of
@@ -184,6 +195,7 @@ func NewCombined(
 		allowlist:          allowlist,
 		fwConfig:           firewall.Config{Allowlist: allowlist},
 		ipForwardSetter:    ipForwardSetter,
+		leakTestSystem:     leaktest.HostSystem{},
 	}
 }
 
@@ -248,12 +260,15 @@ func failureRecover(netw *Combined) {
 
 	cfg := netw.fwConfig.CopyWith(
 		firewall.WithTunnelInterface(""),
+		firewall.WithEntryTunnelInterface(""),
+		firewall.WithTunnelIPv6(false),
 	)
 	if err := netw.configureFirewall(cfg); err != nil {
 		log.Error(err)
 	}
 
 	netw.unblockIPv6()
+	netw.isTunnelIPv6 = false
 
 	if err := netw.arpIgnoreSetter.Unset(); err != nil {
 		log.Debug("unsetting arp ignore when recovering from failure:", err)
@@ -309,6 +324,7 @@ func (netw *Combined) start(
 		}
 		return err
 	}
+	netw.isTunnelIPv6 = netw.allowTunnelIPv6()
 	netw.publisher.Publish("Setting the routing rules up")
 
 	// if routing rules were set - they will be adjusted as needed
@@ -337,7 +353,9 @@ func (netw *Combined) start(
 	// updated to also contain LAN addresses for LAN discovery enabled
 	newCfg := netw.fwConfig.CopyWith(
 		firewall.WithTunnelInterface(tunnelInterface),
+		firewall.WithEntryTunnelInterface(netw.entryTunnelInterface()),
 		firewall.WithAllowlist(netw.allowlist),
+		firewall.WithTunnelIPv6(netw.isTunnelIPv6),
 	)
 	if err := netw.configureFirewall(newCfg); err != nil {
 		return fmt.Errorf("configuring firewall: %w", err)
@@ -382,6 +400,12 @@ func (netw *Combined) configureNetwork(
 }
 
 func (netw *Combined) configureDNS(serverData vpn.ServerData, nameservers config.DNS) error {
+	// in include-only split tunnel mode the default route and DNS of the system are left alone,
+	// only the split tunnel apps are routed into the tunnel by the firewall
+	if netw.isIncludeOnly() {
+		return nil
+	}
+
 	dnsGetter := &dns.NameServers{}
 
 	if netw.isMeshnetSet && internal.MeshSubnet.Contains(serverData.IP) {
@@ -400,7 +424,70 @@ func (netw *Combined) addDefaultRoute() error {
 	if err != nil {
 		return fmt.Errorf("adding the default route: %w", err)
 	}
-	return err
+	if netw.isTunnelIPv6 {
+		err := netw.router.Add(routes.Route{
+			Subnet:  netip.MustParsePrefix("::/0"),
+			Device:  netw.vpnet.Tun().Interface(),
+			TableID: netw.policyRouter.TableID(),
+		})
+		if err != nil {
+			return fmt.Errorf("adding the IPv6 default route: %w", err)
+		}
+	}
+	return netw.addEntryRoute()
+}
+
+// allowTunnelIPv6 enables IPv6 on the tunnel if the server supports it, IPv6 stays blocked in
+// the rest of the system. Returns false if the tunnel carries only IPv4 traffic.
+func (netw *Combined) allowTunnelIPv6() bool {
+	tun := netw.vpnet.Tun()
+	if _, ok := tun.IPv6(); !ok {
+		return false
+	}
+	if err := netw.ipv6Blocker.Allow(tun.Interface().Name); err != nil {
+		log.Warn("Failed to allow ipv6 on the tunnel, falling back to blocking it", err)
+		return false
+	}
+	// disabled IPv6 drops the address of the interface
+	if err := tun.AddAddrs(); err != nil {
+		log.Warn("Failed to add ipv6 address to the tunnel", err)
+		return false
+	}
+	return true
+}
+
+// addEntryRoute routes the transport of the exit tunnel through the entry tunnel of
+// the multi-hop connection. The transport is marked, so the route goes to the main table.
+func (netw *Combined) addEntryRoute() error {
+	entryTun := netw.entryTun()
+	if entryTun == nil {
+		return nil
+	}
+	serverData, _ := netw.vpnet.GetConnectionParameters()
+	err := netw.router.Add(routes.Route{
+		Subnet: netip.PrefixFrom(serverData.IP, serverData.IP.BitLen()),
+		Device: entryTun.Interface(),
+	})
+	if err != nil {
+		return fmt.Errorf("adding the route to the exit server: %w", err)
+	}
+	return nil
+}
+
+// entryTun returns the entry tunnel of the multi-hop connection or nil for direct connections
+func (netw *Combined) entryTun() tunnel.T {
+	multiHop, ok := netw.vpnet.(vpn.MultiHop)
+	if !ok {
+		return nil
+	}
+	return multiHop.EntryTun()
+}
+
+func (netw *Combined) entryTunnelInterface() string {
+	if entryTun := netw.entryTun(); entryTun != nil {
+		return entryTun.Interface().Name
+	}
+	return ""
 }
 
 func (netw *Combined) restart(
@@ -446,6 +533,14 @@ func (netw *Combined) restart(
 		return err
 	}
 
+	// Always disable IPv6 with sysctl in the system
+	// We also do that when there is a change in network interfaces
+	if err = netw.ipv6Blocker.Block(); err != nil {
+		log.Error("Failed to block ipv6 during restart using sysctl ", err)
+		return err
+	}
+	netw.isTunnelIPv6 = netw.allowTunnelIPv6()
+
 	// after restarting need to restore routing - because tun interface was recreated
 	// assuming all other routing rules are left as it was before restart
 	if err = netw.addDefaultRoute(); err != nil {
@@ -456,13 +551,6 @@ func (netw *Combined) restart(
 		return err
 	}
 
-	// Always disable IPv6 with sysctl in the system
-	// We also do that when there is a change in network interfaces
-	if err = netw.ipv6Blocker.Block(); err != nil {
-		log.Error("Failed to block ipv6 during restart using sysctl ", err)
-		return err
-	}
-
 	if netw.ignoreARP {
 		if err := netw.arpIgnoreSetter.Set(); err != nil {
 			return fmt.Errorf("setting arp ignore: %w", err)
@@ -473,6 +561,8 @@ func (netw *Combined) restart(
 	// update only the interface name, in case there is a different VPN technology used
 	newCfg := netw.fwConfig.CopyWith(
 		firewall.WithTunnelInterface(netw.vpnet.Tun().Interface().Name),
+		firewall.WithEntryTunnelInterface(netw.entryTunnelInterface()),
+		firewall.WithTunnelIPv6(netw.isTunnelIPv6),
 	)
 	if err := netw.configureFirewall(newCfg); err != nil {
 		return fmt.Errorf("configuring firewall: %w", err)
@@ -550,10 +640,13 @@ func (netw *Combined) stop() error {
 	// configure firewall
 	newCfg := netw.fwConfig.CopyWith(
 		firewall.WithTunnelInterface(""),
+		firewall.WithEntryTunnelInterface(""),
+		firewall.WithTunnelIPv6(false),
 	)
 	if err := netw.configureFirewall(newCfg); err != nil {
 		return fmt.Errorf("configuring firewall at stop: %w", err)
 	}
+	netw.isTunnelIPv6 = false
 
 	netw.switchToNextVpn()
 
@@ -582,10 +675,20 @@ func (netw *Combined) SetDNS(nameservers []string) error {
 	}
 
 	netw.lastNameservers = nameservers
+	if netw.isIncludeOnly() {
+		// nameservers are remembered and applied once full tunnel mode is back
+		return nil
+	}
 	return netw.setDNS(nameservers)
 }
 
 func (netw *Combined) setDNS(nameservers []string) error {
+	if netw.isTunnelIPv6 {
+		nameservers = dns.WithIPv6(nameservers)
+	}
+	if routeSetter, ok := netw.dnsSetter.(dns.RouteSetter); ok {
+		routeSetter.SetRoutes(netw.routesWithInterfaces())
+	}
 	err := netw.dnsSetter.Set(netw.vpnet.Tun().Interface().Name, nameservers)
 	if err != nil {
 		return fmt.Errorf("networker setting dns: %w", err)
@@ -715,6 +818,19 @@ func (netw *Combined) blockIPv6() {
 	if err != nil {
 		log.Warn("Failed to block ipv6 using sysctl", err)
 	}
+
+	// blocking disables IPv6 on the tunnel as well, its address and routes are gone
+	if netw.isVpnSet && netw.isTunnelIPv6 {
+		if !netw.allowTunnelIPv6() {
+			return
+		}
+		if err := netw.router.Flush(); err != nil {
+			log.Warn(err)
+		}
+		if err := netw.addDefaultRoute(); err != nil {
+			log.Warn("Failed to restore the default routes", err)
+		}
+	}
 }
 
 func (netw *Combined) unblockIPv6() {
@@ -1196,6 +1312,174 @@ func (netw *Combined) configureFileshareAccess(block bool) error {
 	return netw.configureFirewall(cfg)
 }
 
+// SetFileshareRateLimit updates the upload rate limits of the fileshare transfers to the
+// meshnet peers. The limits take effect only while meshnet is set.
+func (netw *Combined) SetFileshareRateLimit(limit config.FileshareRateLimit) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+
+	if netw.fwConfig.FileshareRateLimit == limit {
+		return nil
+	}
+
+	cfg := netw.fwConfig.CopyWith(
+		firewall.WithFileshareRateLimit(limit),
+	)
+	// the limits are kept for the time the firewall is configured
+	if cfg.IsEmpty() {
+		netw.fwConfig = cfg
+		return nil
+	}
+	if err := netw.configureFirewall(cfg); err != nil {
+		return fmt.Errorf("firewall at fileshare rate limit: %w", err)
+	}
+	return nil
+}
+
+// SetSplitTunnel updates the split tunnel mode and the cgroups which traffic is routed according to it
+func (netw *Combined) SetSplitTunnel(mode firewall.SplitTunnelMode, cgroups []firewall.SplitTunnelCgroup) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+
+	modeChanged := netw.fwConfig.SplitTunnelMode != mode
+	if !modeChanged && slices.Equal(netw.fwConfig.SplitTunnel, cgroups) {
+		return nil
+	}
+
+	cfg := netw.fwConfig.CopyWith(
+		firewall.WithSplitTunnel(mode, cgroups),
+	)
+	if err := netw.configureFirewall(cfg); err != nil {
+		return err
+	}
+
+	if !modeChanged || !netw.isConnectedToVPN() {
+		return nil
+	}
+
+	// system DNS is managed only while all the traffic goes through the tunnel
+	if mode == firewall.SplitTunnelInclude {
+		return netw.unsetDNS()
+	}
+	return netw.configureDNS(netw.lastServer, netw.lastNameservers)
+}
+
+// SetAllowlistDomainIPs updates the resolved addresses of the allowlisted domains
+func (netw *Combined) SetAllowlistDomainIPs(ips []netip.Addr) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+
+	if slices.Equal(netw.fwConfig.AllowlistDomainIPs, ips) {
+		return nil
+	}
+
+	cfg := netw.fwConfig.CopyWith(
+		firewall.WithAllowlistDomainIPs(ips),
+	)
+	if err := netw.configureFirewall(cfg); err != nil {
+		return fmt.Errorf("firewall at allowlist domains: %w", err)
+	}
+	return nil
+}
+
+// SetBlockPlainDNS configures whether kill switch drops the DNS bypassing the local encrypted
+// DNS resolver
+func (netw *Combined) SetBlockPlainDNS(block bool) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+
+	if netw.fwConfig.BlockPlainDNS == block {
+		return nil
+	}
+
+	cfg := netw.fwConfig.CopyWith(
+		firewall.WithBlockPlainDNS(block),
+	)
+	if err := netw.configureFirewall(cfg); err != nil {
+		return fmt.Errorf("firewall at plain DNS blocking: %w", err)
+	}
+	return nil
+}
+
+// SetDNSRoutes configures the split DNS routes. Only DNS to the route nameservers is allowed
+// outside of the VPN tunnel.
+func (netw *Combined) SetDNSRoutes(routes []dns.Route) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+
+	ips := make([]netip.Addr, 0, len(routes))
+	for _, route := range routes {
+		ip, err := netip.ParseAddr(route.Nameserver)
+		if err != nil {
+			return fmt.Errorf("parsing route nameserver: %w", err)
+		}
+		if !slices.Contains(ips, ip) {
+			ips = append(ips, ip)
+		}
+	}
+	netw.dnsRoutes = slices.Clone(routes)
+
+	if !slices.Equal(netw.fwConfig.DNSRouteResolvers, ips) {
+		cfg := netw.fwConfig.CopyWith(
+			firewall.WithDNSRouteResolvers(ips),
+		)
+		if err := netw.configureFirewall(cfg); err != nil {
+			return fmt.Errorf("firewall at DNS routes: %w", err)
+		}
+	}
+
+	if !netw.isConnectedToVPN() || netw.isIncludeOnly() {
+		return nil
+	}
+	return netw.setDNS(netw.lastNameservers)
+}
+
+// routesWithInterfaces fills in the interfaces the route nameservers are reachable through
+// outside of the tunnel
+func (netw *Combined) routesWithInterfaces() []dns.Route {
+	dnsRoutes := slices.Clone(netw.dnsRoutes)
+	if netw.gateway == nil {
+		return dnsRoutes
+	}
+	for i, route := range dnsRoutes {
+		ip := netip.MustParseAddr(route.Nameserver)
+		_, iface, err := netw.gateway.Retrieve(netip.PrefixFrom(ip, ip.BitLen()), routes.TableID())
+		if err != nil {
+			log.Warn("retrieving interface for DNS route nameserver:", err)
+			continue
+		}
+		dnsRoutes[i].Interface = iface.Name
+	}
+	return dnsRoutes
+}
+
+// LeakTest checks that the traffic of the VPN connection can not bypass the tunnel
+func (netw *Combined) LeakTest() leaktest.Report {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+
+	state := leaktest.State{
+		TunnelInterface: netw.fwConfig.TunnelInterface,
+		Firewall:        netw.fwConfig,
+	}
+	for _, route := range netw.dnsRoutes {
+		if ip, err := netip.ParseAddr(route.Nameserver); err == nil {
+			state.RouteNameservers = append(state.RouteNameservers, ip)
+		}
+	}
+
+	var verify leaktest.VerifyFunc
+	if verifier, ok := netw.fw.(firewall.Verifier); ok {
+		verify = verifier.Verify
+	}
+	return leaktest.Run(state, netw.leakTestSystem, verify)
+}
+
+// isIncludeOnly returns true if only split tunnel apps are routed through the VPN tunnel
+func (netw *Combined) isIncludeOnly() bool {
+	return netw.fwConfig.SplitTunnelMode == firewall.SplitTunnelInclude
+}
+
 func getHostsFromConfig(peers mesh.MachinePeers) dns.Hosts {
 	hosts := make(dns.Hosts, 0, len(peers))
 	for _, peer := range peers {
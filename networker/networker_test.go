@@ -14,6 +14,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/daemon/device"
 	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/leaktest"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/events/subs"
@@ -81,10 +82,14 @@ type failingDNS struct{}
 func (failingDNS) Set(string, []string) error { return mock.ErrOnPurpose }
 func (failingDNS) Unset(string) error         { return mock.ErrOnPurpose }
 
-type workingIpv6 struct{}
+type workingIpv6 struct{ allowed []string }
 
-func (workingIpv6) Block() error   { return nil }
-func (workingIpv6) Unblock() error { return nil }
+func (*workingIpv6) Block() error   { return nil }
+func (*workingIpv6) Unblock() error { return nil }
+func (w *workingIpv6) Allow(iface string) error {
+	w.allowed = append(w.allowed, iface)
+	return nil
+}
 
 func workingDeviceList(mapset.Set[string]) mapset.Set[string] {
 	return mapset.NewSet(mock.En0Interface.Name)
@@ -306,6 +311,157 @@ func TestCombined_Start(t *testing.T) {
 	}
 }
 
+type multiHopVPN struct {
+	mock.WorkingVPN
+	serverData vpn.ServerData
+}
+
+func (*multiHopVPN) EntryTun() tunnel.T { return mock.WorkingT{} }
+func (m *multiHopVPN) GetConnectionParameters() (vpn.ServerData, bool) {
+	return m.serverData, m.IsActive()
+}
+
+type recordingRouter struct {
+	workingRouter
+	routes []routes.Route
+}
+
+func (r *recordingRouter) Add(route routes.Route) error {
+	r.routes = append(r.routes, route)
+	return nil
+}
+
+func TestCombined_StartMultiHop(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	serverData := vpn.ServerData{
+		IP:    netip.MustParseAddr("5.6.7.8"),
+		Entry: &vpn.ServerData{IP: netip.MustParseAddr("1.2.3.4")},
+	}
+	fw := firewallmock.NewFirewall()
+	router := &recordingRouter{}
+	netw := NewCombined(
+		&multiHopVPN{serverData: serverData},
+		nil,
+		workingGateway{},
+		&subs.Subject[string]{},
+		workingRouter{},
+		&workingDNS{},
+		fw,
+		nil,
+		&workingRoutingSetup{},
+		nil,
+		router,
+		nil,
+		0,
+		false,
+		&workingIpv6{},
+		false,
+		&mock.SysctlSetterMock{},
+		config.Allowlist{},
+		&mock.SysctlSetterMock{},
+	)
+
+	err := netw.Start(
+		context.Background(),
+		vpn.Credentials{},
+		serverData,
+		config.NewAllowlist(nil, nil, nil),
+		[]string{"1.1.1.1"},
+		true,
+		noopNetworkerCallback,
+	)
+	assert.NoError(t, err)
+	assert.Contains(t, router.routes, routes.Route{
+		Subnet: netip.MustParsePrefix("5.6.7.8/32"),
+		Device: mock.En0Interface,
+	})
+	assert.Equal(t, mock.En0Interface.Name, fw.Config().EntryTunnelInterface)
+
+	assert.NoError(t, netw.Stop())
+	assert.Empty(t, fw.Config().EntryTunnelInterface)
+}
+
+type ipv6Tunnel struct{ mock.WorkingT }
+
+func (ipv6Tunnel) IPv6() (netip.Addr, bool) {
+	return netip.MustParseAddr("2a02:5740:1:9:0:11:5:2"), true
+}
+
+type ipv6VPN struct{ mock.WorkingVPN }
+
+func (*ipv6VPN) Tun() tunnel.T { return ipv6Tunnel{} }
+
+func TestCombined_StartIPv6Tunnel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		vpn          vpn.VPN
+		expectedIPv6 bool
+	}{
+		{name: "server with IPv6", vpn: &ipv6VPN{}, expectedIPv6: true},
+		{name: "server without IPv6", vpn: &mock.WorkingVPN{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fw := firewallmock.NewFirewall()
+			router := &recordingRouter{}
+			dnsSetter := &workingDNS{}
+			ipv6Blocker := &workingIpv6{}
+			netw := NewCombined(
+				test.vpn,
+				nil,
+				workingGateway{},
+				&subs.Subject[string]{},
+				workingRouter{},
+				dnsSetter,
+				fw,
+				nil,
+				&workingRoutingSetup{},
+				nil,
+				router,
+				nil,
+				0,
+				false,
+				ipv6Blocker,
+				false,
+				&mock.SysctlSetterMock{},
+				config.Allowlist{},
+				&mock.SysctlSetterMock{},
+			)
+
+			err := netw.Start(
+				context.Background(),
+				vpn.Credentials{},
+				vpn.ServerData{},
+				config.NewAllowlist(nil, nil, nil),
+				[]string{"103.86.96.100"},
+				true,
+				noopNetworkerCallback,
+			)
+			assert.NoError(t, err)
+
+			ipv6Route := routes.Route{Subnet: netip.MustParsePrefix("::/0"), Device: mock.En0Interface}
+			ipv6DNS := "2400:bb40:4444::100"
+			if test.expectedIPv6 {
+				assert.Equal(t, []string{mock.En0Interface.Name}, ipv6Blocker.allowed)
+				assert.Contains(t, router.routes, ipv6Route)
+				assert.Contains(t, dnsSetter.setDNS, ipv6DNS)
+			} else {
+				assert.Empty(t, ipv6Blocker.allowed)
+				assert.NotContains(t, router.routes, ipv6Route)
+				assert.NotContains(t, dnsSetter.setDNS, ipv6DNS)
+			}
+			assert.Equal(t, test.expectedIPv6, fw.Config().TunnelIPv6)
+
+			assert.NoError(t, netw.Stop())
+			assert.False(t, fw.Config().TunnelIPv6)
+		})
+	}
+}
+
 func TestCombined_Stop(t *testing.T) {
 	category.Set(t, category.Link)
 
@@ -1223,3 +1379,80 @@ func TestCombined_SetARPIgnore(t *testing.T) {
 		})
 	}
 }
+
+func TestCombined_SetAllowlistDomainIPs(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fw := firewallmock.NewFirewall()
+	netw := GetTestCombined()
+	netw.fw = fw
+	assert.NoError(t, netw.SetKillSwitch())
+
+	ips := []netip.Addr{netip.MustParseAddr("1.1.1.1"), netip.MustParseAddr("2001:db8::1")}
+	assert.NoError(t, netw.SetAllowlistDomainIPs(ips))
+	assert.Equal(t, ips, fw.Config().AllowlistDomainIPs)
+
+	assert.NoError(t, netw.SetAllowlistDomainIPs(nil))
+	assert.Empty(t, fw.Config().AllowlistDomainIPs)
+}
+
+func TestCombined_SetDNSRoutes(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fw := firewallmock.NewFirewall()
+	netw := GetTestCombined()
+	netw.fw = fw
+	assert.NoError(t, netw.SetKillSwitch())
+
+	routes := []dns.Route{
+		{Domain: "corp.example", Nameserver: "10.0.0.53"},
+		{Domain: "lab.corp.example", Nameserver: "10.0.0.53"},
+		{Domain: "internal", Nameserver: "192.168.1.1"},
+	}
+	assert.NoError(t, netw.SetDNSRoutes(routes))
+	assert.Equal(t,
+		[]netip.Addr{netip.MustParseAddr("10.0.0.53"), netip.MustParseAddr("192.168.1.1")},
+		fw.Config().DNSRouteResolvers)
+
+	assert.Error(t, netw.SetDNSRoutes([]dns.Route{{Domain: "corp.example", Nameserver: "corp"}}))
+
+	assert.NoError(t, netw.SetDNSRoutes(nil))
+	assert.Empty(t, fw.Config().DNSRouteResolvers)
+}
+
+type leakTestSystem struct {
+	routes map[netip.Addr]string
+}
+
+func (s leakTestSystem) RouteInterface(dst netip.Addr) (string, error) {
+	return s.routes[dst], nil
+}
+
+func (s leakTestSystem) Resolvers() ([]netip.Addr, error) {
+	return []netip.Addr{netip.MustParseAddr("10.0.0.53")}, nil
+}
+
+func TestCombined_LeakTest(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	netw := GetTestCombined()
+	netw.fw = firewallmock.NewFirewall()
+	netw.fwConfig = netw.fwConfig.CopyWith(firewall.WithTunnelInterface("nordlynx"))
+	netw.leakTestSystem = leakTestSystem{routes: map[netip.Addr]string{
+		netip.MustParseAddr("1.1.1.1"):   "nordlynx",
+		netip.MustParseAddr("10.0.0.53"): "eth0",
+	}}
+
+	report := netw.LeakTest()
+	assert.False(t, report.Passed())
+
+	netw.dnsRoutes = []dns.Route{{Domain: "corp.example", Nameserver: "10.0.0.53"}}
+	report = netw.LeakTest()
+	assert.True(t, report.Passed())
+	assert.Equal(t, leaktest.Report{
+		{Name: leaktest.CheckRouting, Status: leaktest.StatusPass, Detail: "internet traffic is routed through nordlynx"},
+		{Name: leaktest.CheckDNS, Status: leaktest.StatusPass, Detail: "10.0.0.53 is a DNS route nameserver"},
+		{Name: leaktest.CheckIPv6, Status: leaktest.StatusPass, Detail: "no global IPv6 route"},
+		{Name: leaktest.CheckFirewall, Status: leaktest.StatusSkip, Detail: firewall.ErrVerificationNotSupported.Error()},
+	}, report)
+}
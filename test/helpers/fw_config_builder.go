@@ -26,6 +26,11 @@ func (b *FirewallConfigBuilder) TunnelInterface(iface string) *FirewallConfigBui
 	return b
 }
 
+func (b *FirewallConfigBuilder) EntryTunnelInterface(iface string) *FirewallConfigBuilder {
+	b.cfg.EntryTunnelInterface = iface
+	return b
+}
+
 func (b *FirewallConfigBuilder) AllowlistTCPPort(port int64) *FirewallConfigBuilder {
 	if b.cfg.Allowlist.Ports.TCP == nil {
 		b.cfg.Allowlist.Ports.TCP = config.PortSet{}
@@ -52,6 +57,21 @@ func (b *FirewallConfigBuilder) BlockFileshare() *FirewallConfigBuilder {
 	return b
 }
 
+func (b *FirewallConfigBuilder) BlockPlainDNS() *FirewallConfigBuilder {
+	b.cfg.BlockPlainDNS = true
+	return b
+}
+
+func (b *FirewallConfigBuilder) DNSRouteResolver(ip string) *FirewallConfigBuilder {
+	b.cfg.DNSRouteResolvers = append(b.cfg.DNSRouteResolvers, netip.MustParseAddr(ip))
+	return b
+}
+
+func (b *FirewallConfigBuilder) TunnelIPv6() *FirewallConfigBuilder {
+	b.cfg.TunnelIPv6 = true
+	return b
+}
+
 func (b *FirewallConfigBuilder) Meshnet(iface string, selfMeshIP netip.Addr) *FirewallConfigBuilder {
 	b.cfg.MeshnetInfo = &firewall.MeshInfo{MeshInterface: iface}
 	b.cfg.MeshnetInfo.MeshnetMap.Address = selfMeshIP
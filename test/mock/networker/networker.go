@@ -8,6 +8,9 @@ import (
 
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/leaktest"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/test/mock"
@@ -26,6 +29,12 @@ type Mock struct {
 	SetAllowlistErr   error
 	UnsetAllowlistErr error
 	StopErr           error
+	SplitTunnel       []firewall.SplitTunnelCgroup
+	SplitTunnelMode   firewall.SplitTunnelMode
+	AllowlistDomains  []netip.Addr
+	BlockPlainDNS     bool
+	DNSRoutes         []dns.Route
+	LeakTestReport    leaktest.Report
 
 	// ProvidedCredentials contain vpn.Credentials provided to the networker in the last Start call
 	ProvidedCredentials vpn.Credentials
@@ -124,6 +133,31 @@ func (m *Mock) GetConnectionParameters() (vpn.ServerData, bool) {
 
 func (*Mock) SetARPIgnore(bool) error { return nil }
 
+func (m *Mock) SetSplitTunnel(mode firewall.SplitTunnelMode, cgroups []firewall.SplitTunnelCgroup) error {
+	m.SplitTunnelMode = mode
+	m.SplitTunnel = cgroups
+	return nil
+}
+
+func (m *Mock) SetAllowlistDomainIPs(ips []netip.Addr) error {
+	m.AllowlistDomains = ips
+	return nil
+}
+
+func (m *Mock) SetBlockPlainDNS(block bool) error {
+	m.BlockPlainDNS = block
+	return nil
+}
+
+func (m *Mock) SetDNSRoutes(routes []dns.Route) error {
+	m.DNSRoutes = routes
+	return nil
+}
+
+func (m *Mock) LeakTest() leaktest.Report {
+	return m.LeakTestReport
+}
+
 type Failing struct{}
 
 func (Failing) Start(
@@ -165,3 +199,13 @@ func (Failing) SetLanDiscovery(bool)                                {}
 func (Failing) UnsetFirewall() error                                { return mock.ErrOnPurpose }
 func (Failing) GetConnectionParameters() (vpn.ServerData, bool)     { return vpn.ServerData{}, false }
 func (Failing) SetARPIgnore(bool) error                             { return nil }
+func (Failing) SetSplitTunnel(firewall.SplitTunnelMode, []firewall.SplitTunnelCgroup) error {
+	return mock.ErrOnPurpose
+}
+
+func (Failing) SetAllowlistDomainIPs([]netip.Addr) error { return mock.ErrOnPurpose }
+func (Failing) SetBlockPlainDNS(bool) error              { return mock.ErrOnPurpose }
+func (Failing) SetDNSRoutes([]dns.Route) error           { return mock.ErrOnPurpose }
+func (Failing) LeakTest() leaktest.Report {
+	return leaktest.Report{{Name: leaktest.CheckRouting, Status: leaktest.StatusFail}}
+}
@@ -15,6 +15,8 @@ func (WorkingT) IP() (netip.Addr, bool) {
 	return netip.MustParseAddr("127.0.0.1"), true
 }
 
+func (WorkingT) IPv6() (netip.Addr, bool) { return netip.Addr{}, false }
+
 func (WorkingT) TransferRates() (tunnel.Statistics, error) {
 	return tunnel.Statistics{Tx: 1337, Rx: 1337}, nil
 }
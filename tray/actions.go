@@ -368,6 +368,25 @@ func (ti *Instance) connectWithUIEvent(
 		return false
 	}
 
+	return ti.waitForConnection(resp, func() bool {
+		return ti.connectWithUIEvent(serverTag, serverGroup, itemName, itemValue)
+	})
+}
+
+// connectFavorite connects to the favorite using its settings
+func (ti *Instance) connectFavorite(name string) bool {
+	resp, err := ti.client.ConnectFavorite(context.Background(), &pb.FavoriteRequest{Name: name})
+	if err != nil {
+		ti.notify(NoForce, "Connect error: %s", err)
+		return false
+	}
+
+	return ti.waitForConnection(resp, func() bool { return ti.connectFavorite(name) })
+}
+
+// waitForConnection notifies about the connection progress and returns true once connected.
+// The retry is called after the expired token is renewed by logging in again.
+func (ti *Instance) waitForConnection(resp pb.Daemon_ConnectClient, retry func() bool) bool {
 	for {
 		out, err := resp.Recv()
 		if err != nil {
@@ -384,7 +403,7 @@ func (ti *Instance) connectWithUIEvent(
 		case internal.CodeExpiredRenewToken:
 			ti.notify(NoForce, client.RelogRequest)
 			ti.login()
-			return ti.connectWithUIEvent(serverTag, serverGroup, itemName, itemValue)
+			return retry()
 		case internal.CodeTokenRenewError:
 			ti.notify(NoForce, client.AccountTokenRenewError)
 		case internal.CodeAccountExpired:
@@ -425,6 +444,10 @@ func (ti *Instance) connectWithUIEvent(
 			ti.notify(Force, cli.DedicatedServersConnectionLimitReached)
 		case internal.CodeDedicatedServersPq:
 			ti.notify(Force, internal.ServerUnavailableErrorMessage)
+		case internal.CodeInvalidConnectionOverrides:
+			ti.notify(Force, cli.InvalidConnectionOverridesMessage)
+		case internal.CodeFavoriteNotFound:
+			ti.notify(NoForce, cli.FavoriteNotFoundMessage)
 		case internal.CodeConnecting:
 		case internal.CodeConnected:
 			return true
@@ -26,6 +26,8 @@ func (ti *Instance) onDaemonStateEvent(item *pb.AppState) {
 		changed = ti.handleVersionHealthState(st)
 	case *pb.AppState_PauseEvent:
 		changed = ti.handlePauseEventState(st)
+	case *pb.AppState_FailoverEvent:
+		changed = ti.handleFailoverEventState(st)
 	default:
 		log.Systray.Warnf("Unknown state type: %T", item)
 	}
@@ -91,6 +93,9 @@ func (ti *Instance) handleUpdateEventState(st *pb.AppState_UpdateEvent) bool {
 
 	case pb.UpdateEvent_RECENTS_LIST_UPDATE:
 		return ti.updateRecentConnections()
+
+	case pb.UpdateEvent_FAVORITES_LIST_UPDATE:
+		return ti.updateFavorites()
 	}
 
 	return false
@@ -110,3 +115,14 @@ func (ti *Instance) handleVersionHealthState(st *pb.AppState_VersionHealth) bool
 func (ti *Instance) handlePauseEventState(st *pb.AppState_PauseEvent) bool {
 	return ti.handlePauseEvent(st.PauseEvent)
 }
+
+// handleFailoverEventState notifies about the reconnect caused by the degraded connection.
+func (ti *Instance) handleFailoverEventState(st *pb.AppState_FailoverEvent) bool {
+	event := st.FailoverEvent
+	if event.GetToHostname() == "" {
+		ti.notify(NoForce, "Connection to %s degraded, failed to reconnect to a different server", event.GetFromHostname())
+		return false
+	}
+	ti.notify(NoForce, "Connection to %s degraded, reconnected to %s", event.GetFromHostname(), event.GetToHostname())
+	return false
+}
@@ -0,0 +1,72 @@
+package tray
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"google.golang.org/grpc"
+)
+
+const favoritesTimeout = 2 * time.Second
+
+// Favorite represents a named connection preset
+type Favorite struct {
+	Name  string
+	Label string
+}
+
+type favoritesManager struct {
+	mu        sync.RWMutex
+	favorites []Favorite
+	client    pb.DaemonClient
+}
+
+// newFavoritesManager creates a new favorites manager
+func newFavoritesManager(client pb.DaemonClient) *favoritesManager {
+	return &favoritesManager{
+		favorites: make([]Favorite, 0),
+		client:    client,
+	}
+}
+
+// UpdateFavorites updates local list of favorites
+func (m *favoritesManager) UpdateFavorites() error {
+	ctx, cancel := context.WithTimeout(context.Background(), favoritesTimeout)
+	defer cancel()
+
+	resp, err := m.client.ListFavorites(ctx, &pb.Empty{}, grpc.WaitForReady(true))
+	if err != nil || resp == nil {
+		return err
+	}
+
+	favorites := make([]Favorite, 0, len(resp.Favorites))
+	for _, favorite := range resp.Favorites {
+		favorites = append(favorites, Favorite{
+			Name: favorite.Name,
+			Label: makeDisplayLabel(&RecentConnection{
+				Country:            favorite.Country,
+				City:               favorite.City,
+				Group:              favorite.Group,
+				CountryCode:        favorite.CountryCode,
+				SpecificServerName: favorite.SpecificServer,
+				SpecificServer:     favorite.SpecificServer,
+				ConnectionType:     favorite.ConnectionType,
+			}),
+		})
+	}
+
+	m.mu.Lock()
+	m.favorites = favorites
+	m.mu.Unlock()
+	return nil
+}
+
+// GetFavorites returns the favorites
+func (m *favoritesManager) GetFavorites() []Favorite {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return slices.Clone(m.favorites)
+}
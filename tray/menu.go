@@ -25,6 +25,7 @@ const (
 	labelSecureMyConnection    = "Secure my connection"
 	labelConnectionSelection   = "All connections"
 	labelRecentConnections     = "Recent Connections:"
+	labelFavorites             = "Favorites"
 	labelReconnectTo           = "Reconnect to"
 	labelConnectTo             = "Connect to"
 	labelCountries             = "Countries:"
@@ -41,6 +42,7 @@ const (
 	labelDownloadGUI           = "Download NordVPN app"
 	labelNotifications         = "Notifications"
 	labelTrayIcon              = "Tray icon"
+	labelPolicyLocked          = "Managed by your organization: %s"
 	labelPause5Min             = "Pause for 5 minutes"
 	labelPause15Min            = "Pause for 15 minutes"
 	labelPause30Min            = "Pause for 30 minutes"
@@ -50,6 +52,7 @@ const (
 	// Menu item tooltips
 	tooltipConnectionSelection = "Choose connection type"
 	tooltipRecentConnections   = "Select recent connection"
+	tooltipFavorites           = "Connect using a saved favorite"
 	tooltipCountries           = "Select Country"
 	tooltipSpecialtyServers    = "Select Specialty server"
 	tooltipActiveGoroutines    = "Shows number of active background processes"
@@ -63,6 +66,7 @@ const (
 	tooltipDownloadGUI         = "Download the NordVPN app"
 	tooltipNotifications       = "Toggle desktop notifications"
 	tooltipTrayIcon            = "Show or hide tray icon"
+	tooltipPolicyLocked        = "These settings are locked by the policy file and can't be changed"
 
 	// System messages
 	msgShutdownNotification = "Shutting down norduserd. To restart the process, run the \"nordvpn set tray on command\"."
@@ -222,6 +226,7 @@ func buildConnectionSection(ti *Instance) {
 	}
 
 	buildConnectToItem(ti)
+	buildFavoritesItem(ti)
 	systray.AddSeparator()
 }
 
@@ -456,6 +461,28 @@ func buildConnectToItem(ti *Instance) {
 	buildCountriesSection(ti, connectionSelector, countries)
 }
 
+func buildFavoritesItem(ti *Instance) {
+	if ti == nil || ti.favorites == nil {
+		return
+	}
+	favorites := ti.favorites.GetFavorites()
+	if len(favorites) == 0 {
+		return
+	}
+
+	parent := systray.AddMenuItem(labelFavorites, tooltipFavorites)
+	for _, favorite := range favorites {
+		title := favorite.Name
+		if favorite.Label != "" {
+			title = fmt.Sprintf("%s (%s)", favorite.Name, favorite.Label)
+		}
+		tooltip := fmt.Sprintf("%s %s", labelConnectTo, favorite.Name)
+		item := parent.AddSubMenuItem(title, tooltip)
+
+		go handleFavoriteClick(ti, item, favorite.Name)
+	}
+}
+
 func buildRecentConnectionsSection(
 	ti *Instance,
 	parent *systray.MenuItem,
@@ -517,6 +544,13 @@ func handleRecentConnectionClick(ti *Instance, item *systray.MenuItem, model *Re
 	handleMenuItemClick(item, func() { connectByConnectionModel(ti, model) })
 }
 
+func handleFavoriteClick(ti *Instance, item *systray.MenuItem, name string) {
+	if ti == nil {
+		return
+	}
+	handleMenuItemClick(item, func() { ti.connectFavorite(name) })
+}
+
 func handleCountryClick(ti *Instance, item *systray.MenuItem, country string) {
 	if ti == nil {
 		return
@@ -601,6 +635,13 @@ func buildSettingsSubitems(ti *Instance, menu *systray.MenuItem) {
 
 	go handleNotificationsOption(ti, notificationsCheckbox)
 	go handleTrayOption(ti, trayCheckbox)
+
+	if len(ti.state.lockedSettings) > 0 {
+		menu.AddSubMenuItem(
+			fmt.Sprintf(labelPolicyLocked, strings.Join(ti.state.lockedSettings, ", ")),
+			tooltipPolicyLocked,
+		).Disable()
+	}
 }
 
 func buildGUISection(ti *Instance) {
@@ -125,6 +125,9 @@ func (ti *Instance) update() bool {
 	changed = ti.updateRecentConnections()
 	needsRedraw = needsRedraw || changed
 
+	changed = ti.updateFavorites()
+	needsRedraw = needsRedraw || changed
+
 	return needsRedraw
 }
 
@@ -238,6 +241,18 @@ func (ti *Instance) updateRecentConnections() bool {
 	return !slices.Equal(oldConnectionsList, newConnectionsList)
 }
 
+func (ti *Instance) updateFavorites() bool {
+	oldFavorites := ti.favorites.GetFavorites()
+
+	err := ti.favorites.UpdateFavorites()
+	if err != nil {
+		log.Systray.Error("Error retrieving favorites:", err)
+		return false
+	}
+
+	return !slices.Equal(oldFavorites, ti.favorites.GetFavorites())
+}
+
 func (ti *Instance) setSettings(settings *pb.Settings) bool {
 	if settings == nil {
 		return false
@@ -282,6 +297,11 @@ func (ti *Instance) setSettings(settings *pb.Settings) bool {
 			}
 		}
 
+		if !slices.Equal(ti.state.lockedSettings, settings.LockedSettings) {
+			changed = true
+			ti.state.lockedSettings = settings.LockedSettings
+		}
+
 		var newTrayStatus Status
 		if userSettings.Tray {
 			newTrayStatus = Enabled
@@ -551,6 +571,12 @@ func (ti *Instance) handlePauseEvent(event *pb.PauseEvent) bool {
 	case pb.PauseEventType_RECONNECT_FAILED:
 		ti.notify(NoForce, "Connect error: %s", client.ConnectCantConnect)
 		log.Error("Reconnect failed after pause expired")
+	case pb.PauseEventType_SCHEDULED_PAUSE_STARTED:
+		ti.notify(NoForce, "Connection paused on schedule until %s", event.ScheduleEnd)
+	case pb.PauseEventType_SCHEDULED_DISCONNECT_STARTED:
+		ti.notify(NoForce, "Disconnected on schedule until %s", event.ScheduleEnd)
+	case pb.PauseEventType_SCHEDULE_ENDED:
+		log.Info("Schedule rule ", event.ScheduleRuleId, " ended")
 	default:
 		log.Warn("Unexpected pause event received ", event.Type)
 	}
@@ -135,6 +135,7 @@ type Instance struct {
 	stateListener         *stateListener
 	connSensor            *connectionSettingsChangeSensor
 	recentConnections     *recentConnectionsManager
+	favorites             *favoritesManager
 	checkboxSync          *CheckboxSynchronizer
 	isVisible             atomic.Bool
 	stopVisibilityMonitor chan struct{}
@@ -146,6 +147,7 @@ type trayState struct {
 	vpnActive            bool
 	notificationsStatus  Status
 	trayStatus           Status
+	lockedSettings       []string
 	daemonError          string
 	accountName          string
 	vpnStatus            pb.ConnectionState
@@ -183,6 +185,7 @@ func NewTrayInstance(client pb.DaemonClient, quitChan chan<- norduser.StopReques
 		quitChan:              quitChan,
 		connSensor:            newConnectionSettingsChangeSensor(),
 		recentConnections:     newRecentConnectionsManager(client),
+		favorites:             newFavoritesManager(client),
 		checkboxSync:          NewCheckboxSynchronizer(),
 		stopVisibilityMonitor: make(chan struct{}),
 	}
@@ -51,6 +51,8 @@ func GetTransferRates(nicName string) (Statistics, error) {
 type T interface {
 	Interface() net.Interface
 	IP() (netip.Addr, bool)
+	// IPv6 attached to the tunnel, false if the tunnel carries only IPv4 traffic
+	IPv6() (netip.Addr, bool)
 	TransferRates() (Statistics, error)
 	AddAddrs() error
 	DelAddrs() error
@@ -61,14 +63,20 @@ type Tunnel struct {
 	// might be a good idea to change this to a pointer now
 	// so that we could see changes to the interface at real time
 	// but this would need testing first to check if it actually works
-	iface  net.Interface
-	prefix netip.Prefix
+	iface   net.Interface
+	prefix  netip.Prefix
+	prefix6 netip.Prefix
 }
 
 func New(iface net.Interface, prefix netip.Prefix) *Tunnel {
 	return &Tunnel{iface: iface, prefix: prefix}
 }
 
+// NewWithIPv6 creates a tunnel which carries both IPv4 and IPv6 traffic.
+func NewWithIPv6(iface net.Interface, prefix netip.Prefix, prefix6 netip.Prefix) *Tunnel {
+	return &Tunnel{iface: iface, prefix: prefix, prefix6: prefix6}
+}
+
 // Interface returns the underlying network interface.
 func (t *Tunnel) Interface() net.Interface { return t.iface }
 
@@ -77,6 +85,11 @@ func (t *Tunnel) IP() (netip.Addr, bool) {
 	return t.prefix.Addr(), t.prefix.IsValid()
 }
 
+// IPv6 attached to the tunnel.
+func (t *Tunnel) IPv6() (netip.Addr, bool) {
+	return t.prefix6.Addr(), t.prefix6.IsValid()
+}
+
 // Statistics defines what information can be collected about the tunnel
 type Statistics struct {
 	Tx uint64
@@ -128,6 +141,9 @@ func addDelAddr(cmd string, ifaceName string, addr string) ([]byte, error) {
 
 func (t *Tunnel) cmdAddrs(cmd string) error {
 	_, _ = addDelAddr(cmd, t.iface.Name, t.prefix.String())
+	if t.prefix6.IsValid() {
+		_, _ = addDelAddr(cmd, t.iface.Name, t.prefix6.String())
+	}
 	return nil
 }
 